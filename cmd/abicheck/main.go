@@ -0,0 +1,93 @@
+// Command abicheck checks that the bindings of a release implement every
+// interface binding they are paired with, e.g. DelegationManager and
+// IDelegationManager, and classifies the changes since a previous revision
+// of the bindings. It prints a JSON report and exits non-zero if an
+// implementation does not conform, or with -fail-breaking if a change is
+// breaking.
+//
+// Usage:
+//
+//	go run ./cmd/abicheck [-release v0_3] [-previous <release>|path/to/pkg/bindings] [-fail-breaking]
+//
+// A previous revision of the same release can be compared from a worktree:
+//
+//	git worktree add /tmp/previous <rev>
+//	go run ./cmd/abicheck -previous /tmp/previous/pkg/bindings
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindgen"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings"
+)
+
+// report is the JSON output of the command.
+type report struct {
+	Release     string              `json:"release"`
+	Conformance []bindgen.Violation `json:"conformance"`
+	Previous    string              `json:"previous,omitempty"`
+	Changes     []bindgen.Change    `json:"changes,omitempty"`
+	Breaking    int                 `json:"breaking"`
+}
+
+func main() {
+	var (
+		release      string
+		previous     string
+		failBreaking bool
+	)
+	flag.StringVar(&release, "release", bindings.Latest.Release, "binding set to check")
+	flag.StringVar(&previous, "previous", "", "release, or binding directory of another revision, to compare against")
+	flag.BoolVar(&failBreaking, "fail-breaking", false, "exit non-zero if any change since -previous is breaking")
+	flag.Parse()
+
+	set, ok := bindings.Release(release)
+	if !ok {
+		fatalf("unknown release %q", release)
+	}
+	violations, err := bindgen.CheckConformance(set.MetaData, bindgen.InterfacePairs(set.MetaData))
+	if err != nil {
+		fatalf("%v", err)
+	}
+	r := report{Release: release, Conformance: violations, Previous: previous}
+	if r.Conformance == nil {
+		r.Conformance = []bindgen.Violation{}
+	}
+	if previous != "" {
+		var old map[string]*bind.MetaData
+		if prev, ok := bindings.Release(previous); ok {
+			old = prev.MetaData
+		} else if old, err = bindgen.ReadMetaData(previous); err != nil {
+			fatalf("failed to read previous bindings: %v", err)
+		}
+		if r.Changes, err = bindgen.Compare(old, set.MetaData); err != nil {
+			fatalf("%v", err)
+		}
+		for _, c := range r.Changes {
+			if c.Kind.Breaking() {
+				r.Breaking++
+			}
+		}
+	}
+
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(r); err != nil {
+		fatalf("%v", err)
+	}
+	if len(violations) > 0 || failBreaking && r.Breaking > 0 {
+		fmt.Fprintf(os.Stderr, "abicheck: %d conformance violations and %d breaking changes\n", len(violations), r.Breaking)
+		os.Exit(1)
+	}
+}
+
+func fatalf(format string, args ...any) {
+	fmt.Fprintf(os.Stderr, "abicheck: "+format+"\n", args...)
+	os.Exit(1)
+}
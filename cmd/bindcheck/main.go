@@ -0,0 +1,86 @@
+// Command bindcheck reports bindings of every release in pkg/bindings whose
+// embedded MetaData or storage layout no longer matches the forge artifacts
+// in out/, constants in pkg/constants whose Solidity value has changed, and
+// revert reasons added to or removed from the contracts since pkg/reverts
+// was generated. It exits non-zero if anything is stale.
+//
+// Usage:
+//
+//	forge build
+//	go run ./cmd/bindcheck [-release v0_3] [-include Name,...] [-exclude Name,...]
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindgen"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/constants"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/reverts"
+)
+
+func main() {
+	var cfg bindgen.Config
+	cfg.RegisterFlags(flag.CommandLine)
+	flag.Parse()
+
+	var stale, staleLayouts int
+	for _, cfg := range cfg.ReleaseConfigs() {
+		set, ok := bindings.Release(cfg.Release)
+		if !ok {
+			fmt.Fprintf(os.Stderr, "bindcheck: no binding set for release %s, run `make bindings`\n", cfg.Release)
+			os.Exit(1)
+		}
+		drifts, err := bindgen.Check(cfg, set.MetaData)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "bindcheck: %v\n", err)
+			os.Exit(1)
+		}
+		for _, d := range drifts {
+			d.Name = cfg.Release + "/" + d.Name
+			fmt.Println(d)
+		}
+		layoutDrifts, err := bindgen.CheckLayouts(cfg, set.StorageLayouts)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "bindcheck: %v\n", err)
+			os.Exit(1)
+		}
+		for _, d := range layoutDrifts {
+			d.Name = cfg.Release + "/" + d.Name
+			fmt.Println(d)
+		}
+		stale += len(drifts)
+		staleLayouts += len(layoutDrifts)
+	}
+	constDrifts, err := bindgen.CheckConstants(cfg.SourceDir, constants.Solidity)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "bindcheck: %v\n", err)
+		os.Exit(1)
+	}
+	for _, d := range constDrifts {
+		fmt.Println(d)
+	}
+	revertDrifts, err := bindgen.CheckReverts(cfg.SourceDir, catalogued())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "bindcheck: %v\n", err)
+		os.Exit(1)
+	}
+	for _, d := range revertDrifts {
+		fmt.Println(d)
+	}
+	if stale > 0 || staleLayouts > 0 || len(constDrifts) > 0 || len(revertDrifts) > 0 {
+		fmt.Fprintf(os.Stderr, "bindcheck: %d stale bindings, %d stale storage layouts, %d stale constants and %d stale revert reasons, run `make bindings`\n", stale, staleLayouts, len(constDrifts), len(revertDrifts))
+		os.Exit(1)
+	}
+}
+
+// catalogued returns the reason of every entry of reverts.Catalogue.
+func catalogued() []string {
+	out := make([]string, 0, len(reverts.Catalogue))
+	for reason := range reverts.Catalogue {
+		out = append(out, reason)
+	}
+	return out
+}
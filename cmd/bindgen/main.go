@@ -0,0 +1,50 @@
+// Command bindgen regenerates the binding set of every release under
+// pkg/bindings from the forge artifacts in out/.
+//
+// Usage:
+//
+//	forge build
+//	go run ./cmd/bindgen [-release v0_3] [-include Name,...] [-exclude Name,...]
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindgen"
+)
+
+func main() {
+	var (
+		cfg          bindgen.Config
+		outDir       string
+		typesDir     string
+		constantsDir string
+		revertsDir   string
+	)
+	cfg.RegisterFlags(flag.CommandLine)
+	flag.StringVar(&outDir, "out", bindgen.DefaultOutputDir, "directory the binding packages are written to")
+	flag.StringVar(&typesDir, "types-out", bindgen.DefaultTypesDir, "directory the canonical struct types are written to")
+	flag.StringVar(&constantsDir, "constants-out", bindgen.DefaultConstantsDir, "directory the Solidity constants are written to")
+	flag.StringVar(&revertsDir, "reverts-out", bindgen.DefaultRevertsDir, "directory the revert catalogue is written to")
+	flag.Parse()
+
+	for _, cfg := range cfg.ReleaseConfigs() {
+		res, err := bindgen.Run(cfg)
+		if err != nil {
+			fatalf("%v", err)
+		}
+		if err := res.Write(outDir, typesDir, constantsDir, revertsDir); err != nil {
+			fatalf("failed to write bindings: %v", err)
+		}
+		for _, b := range res.Bindings {
+			fmt.Printf("generated %s/%s\n", res.Release, b.Name)
+		}
+	}
+}
+
+func fatalf(format string, args ...any) {
+	fmt.Fprintf(os.Stderr, "bindgen: "+format+"\n", args...)
+	os.Exit(1)
+}
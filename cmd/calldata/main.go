@@ -0,0 +1,101 @@
+// Command calldata decodes the input of a transaction to the EigenLayer
+// contracts into a readable call, naming addresses after a deployment config
+// and decoding wrapped Safe, MultiSend, Timelock and ProxyAdmin calls.
+//
+// Usage:
+//
+//	go run ./cmd/calldata [-deployment script/configs/mainnet/v0.3.0-eigenlayer-addresses.config.json] [-to 0x...] [-value wei] [-release v0_3] 0x<calldata>
+//
+// The calldata is read from standard input if given as "-".
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/calldata"
+)
+
+func main() {
+	var (
+		deployment string
+		toHex      string
+		valueStr   string
+		release    string
+	)
+	flag.StringVar(&deployment, "deployment", "", "deployment config or output naming the addresses")
+	flag.StringVar(&toHex, "to", "", "address the transaction is sent to")
+	flag.StringVar(&valueStr, "value", "", "wei sent with the transaction")
+	flag.StringVar(&release, "release", bindings.Latest.Release, "binding set to decode with")
+	flag.Parse()
+	if flag.NArg() != 1 {
+		fatalf("expected the calldata as the only argument")
+	}
+
+	set, ok := bindings.Release(release)
+	if !ok {
+		fatalf("unknown release %q", release)
+	}
+	book := calldata.NewAddressBook()
+	if deployment != "" {
+		var err error
+		if book, err = calldata.LoadAddressBook(deployment); err != nil {
+			fatalf("%v", err)
+		}
+	}
+	dec, err := calldata.NewDecoder(set, book)
+	if err != nil {
+		fatalf("%v", err)
+	}
+
+	var to *common.Address
+	if toHex != "" {
+		if !common.IsHexAddress(toHex) {
+			fatalf("invalid address %q", toHex)
+		}
+		addr := common.HexToAddress(toHex)
+		to = &addr
+	}
+	var value *big.Int
+	if valueStr != "" {
+		var ok bool
+		if value, ok = new(big.Int).SetString(valueStr, 0); !ok {
+			fatalf("invalid value %q", valueStr)
+		}
+	}
+	input := flag.Arg(0)
+	if input == "-" {
+		b, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			fatalf("failed to read calldata: %v", err)
+		}
+		input = string(b)
+	}
+	input = strings.TrimSpace(input)
+	if !strings.HasPrefix(input, "0x") {
+		input = "0x" + input
+	}
+	data, err := hexutil.Decode(input)
+	if err != nil {
+		fatalf("invalid calldata: %v", err)
+	}
+
+	call, err := dec.Decode(to, value, data)
+	if err != nil {
+		fatalf("%v", err)
+	}
+	fmt.Println(call)
+}
+
+func fatalf(format string, args ...any) {
+	fmt.Fprintf(os.Stderr, "calldata: "+format+"\n", args...)
+	os.Exit(1)
+}
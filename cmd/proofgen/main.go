@@ -0,0 +1,133 @@
+// Command proofgen generates EigenPod proofs from SSZ-encoded beacon states
+// and blocks on disk, writing them in the layout of the proof files in
+// src/test/test-data.
+//
+// Usage:
+//
+//	go run ./cmd/proofgen -kind credential -state state.ssz -validator 302913 [-out proof.json]
+//	go run ./cmd/proofgen -kind balance -state state.ssz -validator 302913 [-out proof.json]
+//	go run ./cmd/proofgen -kind withdrawal -state state.ssz -historical-state historical.ssz -block block.ssz -withdrawal 0 [-network mainnet | -deneb-fork-timestamp 1710338135] [-out proof.json]
+//
+// The state is the one whose root the oracle serves. For withdrawals the
+// historical state is any state whose block_roots hold the block, which the
+// state's historical_summaries summarize. The proof is written to standard
+// output unless -out is given. Withdrawals are laid out for the fork of
+// their block under the network's fork schedule, or under the Deneb fork
+// timestamp an EigenPodManager reports.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/beaconproofs"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/prooffile"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/proofgen"
+)
+
+func main() {
+	var (
+		kind            string
+		statePath       string
+		historicalPath  string
+		blockPath       string
+		validatorIndex  uint64
+		withdrawalIndex uint64
+		network         string
+		denebFork       uint64
+		out             string
+	)
+	flag.StringVar(&kind, "kind", "credential", "proof to generate: credential, balance or withdrawal")
+	flag.StringVar(&statePath, "state", "", "SSZ-encoded BeaconState to prove against")
+	flag.StringVar(&historicalPath, "historical-state", "", "SSZ-encoded BeaconState whose block_roots hold the withdrawal's block")
+	flag.StringVar(&blockPath, "block", "", "SSZ-encoded BeaconBlock or SignedBeaconBlock holding the withdrawal")
+	flag.Uint64Var(&validatorIndex, "validator", 0, "index of the validator to prove")
+	flag.Uint64Var(&withdrawalIndex, "withdrawal", 0, "index of the withdrawal in the block's execution payload")
+	flag.StringVar(&network, "network", "mainnet", "network whose fork schedule lays out withdrawals: mainnet, holesky or goerli")
+	flag.Uint64Var(&denebFork, "deneb-fork-timestamp", 0, "Deneb fork timestamp overriding the network's")
+	flag.StringVar(&out, "out", "", "file to write the proof to")
+	flag.Parse()
+	if flag.NArg() != 0 {
+		fatalf("unexpected arguments %v", flag.Args())
+	}
+	if statePath == "" {
+		fatalf("-state is required")
+	}
+
+	schedule, ok := beaconproofs.Schedules[network]
+	if !ok {
+		fatalf("unknown network %q", network)
+	}
+	if denebFork != 0 {
+		schedule.DenebForkTimestamp = denebFork
+	}
+
+	state, err := proofgen.LoadState(statePath)
+	if err != nil {
+		fatal(err)
+	}
+	prover, err := proofgen.NewProver(state, schedule)
+	if err != nil {
+		fatal(err)
+	}
+
+	var f *prooffile.File
+	switch kind {
+	case "credential":
+		f, err = prover.WithdrawalCredentialProof(validatorIndex)
+	case "balance":
+		f, err = prover.BalanceUpdateProof(validatorIndex)
+	case "withdrawal":
+		if historicalPath == "" || blockPath == "" {
+			fatalf("-historical-state and -block are required for withdrawals")
+		}
+		historical, err := proofgen.LoadState(historicalPath)
+		if err != nil {
+			fatal(err)
+		}
+		block, err := proofgen.LoadBlock(blockPath)
+		if err != nil {
+			fatal(err)
+		}
+		f, err = prover.WithdrawalProof(historical, block, withdrawalIndex)
+		if err != nil {
+			fatal(err)
+		}
+	default:
+		fatalf("unknown kind %q", kind)
+	}
+	if err != nil {
+		fatal(err)
+	}
+
+	if out != "" {
+		if err := f.Write(out); err != nil {
+			fatal(err)
+		}
+		return
+	}
+	data, err := json.Marshal(f)
+	if err != nil {
+		fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, data, "", "    "); err != nil {
+		fatal(err)
+	}
+	buf.WriteByte('\n')
+	os.Stdout.Write(buf.Bytes())
+}
+
+func fatalf(format string, args ...any) {
+	fmt.Fprintf(os.Stderr, "proofgen: "+format+"\n", args...)
+	os.Exit(1)
+}
+
+// fatal exits on err, which already names the package it comes from.
+func fatal(err error) {
+	fmt.Fprintln(os.Stderr, err)
+	os.Exit(1)
+}
@@ -2,7 +2,10 @@ module github.com/Layr-Labs/eigenlayer-contracts
 
 go 1.21
 
-require github.com/ethereum/go-ethereum v1.14.0
+require (
+	github.com/ethereum/go-ethereum v1.14.0
+	github.com/holiman/uint256 v1.2.4
+)
 
 require (
 	github.com/Microsoft/go-winio v0.6.1 // indirect
@@ -19,7 +22,6 @@ require (
 	github.com/go-ole/go-ole v1.3.0 // indirect
 	github.com/google/uuid v1.3.0 // indirect
 	github.com/gorilla/websocket v1.4.2 // indirect
-	github.com/holiman/uint256 v1.2.4 // indirect
 	github.com/mmcloughlin/addchain v0.4.0 // indirect
 	github.com/shirou/gopsutil v3.21.4-0.20210419000835-c7a38de76ee5+incompatible // indirect
 	github.com/supranational/blst v0.3.11 // indirect
@@ -0,0 +1,222 @@
+// Package beacon reads the beacon chain through the subset of the beacon
+// node API the EigenPod proof pipeline uses: genesis and spec, block
+// headers, SSZ-encoded blocks and states, and validators.
+//
+// Client has two implementations. REST calls a beacon node. Recorder wraps
+// another Client and saves every response as a fixture in a directory,
+// from which Replayer serves them back, so pod tooling can be run and
+// tested offline and deterministically.
+//
+// ForkSchedule derives the schedule a proofgen.Prover needs from the
+// genesis and spec, and recorded headers load straight into a
+// beaconoracle.Archive.
+package beacon
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/beaconproofs"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/constants"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/proofgen"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/ssz"
+)
+
+// Client reads the beacon chain. Block and state IDs are those of the
+// beacon API: "head", "finalized", "genesis", a slot or a root.
+type Client interface {
+	// Genesis returns the genesis of the chain.
+	Genesis(ctx context.Context) (*Genesis, error)
+	// Spec returns the chain's configuration, by name, as strings.
+	Spec(ctx context.Context) (map[string]string, error)
+	// Header returns the header of a block.
+	Header(ctx context.Context, blockID string) (*BlockHeader, error)
+	// Block returns a block.
+	Block(ctx context.Context, blockID string) (*proofgen.Block, error)
+	// State returns a state.
+	State(ctx context.Context, stateID string) (*proofgen.State, error)
+	// Validators returns the validators of a state with the given indices
+	// or hex-encoded public keys. Unknown validators are left out.
+	Validators(ctx context.Context, stateID string, ids []string) ([]Validator, error)
+}
+
+// ErrNotFound is matched by the APIError of a request for a block, state
+// or validator the node does not have.
+var ErrNotFound = errors.New("beacon: not found")
+
+// Genesis is the genesis of a beacon chain.
+type Genesis struct {
+	GenesisTime           uint64        `json:"genesis_time,string"`
+	GenesisValidatorsRoot common.Hash   `json:"genesis_validators_root"`
+	GenesisForkVersion    hexutil.Bytes `json:"genesis_fork_version"`
+}
+
+// ForkSchedule returns the fork schedule of a chain with the given genesis
+// and spec, as its EigenPodManager should record it.
+func ForkSchedule(genesis *Genesis, spec map[string]string) (beaconproofs.ForkSchedule, error) {
+	epoch, err := strconv.ParseUint(spec["DENEB_FORK_EPOCH"], 10, 64)
+	if err != nil {
+		return beaconproofs.ForkSchedule{}, fmt.Errorf("beacon: invalid DENEB_FORK_EPOCH %q", spec["DENEB_FORK_EPOCH"])
+	}
+	if epoch == beaconproofs.FarFutureEpoch {
+		return beaconproofs.UnsetSchedule, nil
+	}
+	return beaconproofs.ForkSchedule{DenebForkTimestamp: genesis.GenesisTime + epoch*constants.BeaconChainProofsSecondsPerEpoch}, nil
+}
+
+// BlockHeader is a signed block header with its root, as the headers
+// endpoints return it.
+type BlockHeader struct {
+	Root      common.Hash
+	Canonical bool
+	Message   proofgen.Header
+	Signature hexutil.Bytes
+}
+
+type headerJSON struct {
+	Root      common.Hash `json:"root"`
+	Canonical bool        `json:"canonical"`
+	Header    struct {
+		Message struct {
+			Slot          uint64      `json:"slot,string"`
+			ProposerIndex uint64      `json:"proposer_index,string"`
+			ParentRoot    common.Hash `json:"parent_root"`
+			StateRoot     common.Hash `json:"state_root"`
+			BodyRoot      common.Hash `json:"body_root"`
+		} `json:"message"`
+		Signature hexutil.Bytes `json:"signature"`
+	} `json:"header"`
+}
+
+// MarshalJSON encodes h as the headers endpoints do.
+func (h BlockHeader) MarshalJSON() ([]byte, error) {
+	var j headerJSON
+	j.Root, j.Canonical, j.Header.Signature = h.Root, h.Canonical, h.Signature
+	m := &j.Header.Message
+	m.Slot, m.ProposerIndex = h.Message.Slot, h.Message.ProposerIndex
+	m.ParentRoot, m.StateRoot, m.BodyRoot = h.Message.ParentRoot, h.Message.StateRoot, h.Message.BodyRoot
+	return json.Marshal(j)
+}
+
+// UnmarshalJSON decodes a header as the headers endpoints encode it,
+// failing if its root is not the root of its message.
+func (h *BlockHeader) UnmarshalJSON(data []byte) error {
+	var j headerJSON
+	if err := json.Unmarshal(data, &j); err != nil {
+		return err
+	}
+	m := j.Header.Message
+	*h = BlockHeader{
+		Root:      j.Root,
+		Canonical: j.Canonical,
+		Message:   proofgen.Header{Slot: m.Slot, ProposerIndex: m.ProposerIndex, ParentRoot: m.ParentRoot, StateRoot: m.StateRoot, BodyRoot: m.BodyRoot},
+		Signature: j.Header.Signature,
+	}
+	if root := h.Message.Root(); root != h.Root {
+		return fmt.Errorf("beacon: header at slot %d has root %s, not %s", m.Slot, root, h.Root)
+	}
+	return nil
+}
+
+// DecodeHeaders decodes the response of a headers endpoint, whose data is
+// a header or a list of headers.
+func DecodeHeaders(data []byte) ([]BlockHeader, error) {
+	var resp struct {
+		Data json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, err
+	}
+	if resp.Data == nil {
+		return nil, errors.New("beacon: response has no data")
+	}
+	if bytes.HasPrefix(bytes.TrimSpace(resp.Data), []byte("[")) {
+		var headers []BlockHeader
+		if err := json.Unmarshal(resp.Data, &headers); err != nil {
+			return nil, err
+		}
+		return headers, nil
+	}
+	var h BlockHeader
+	if err := json.Unmarshal(resp.Data, &h); err != nil {
+		return nil, err
+	}
+	return []BlockHeader{h}, nil
+}
+
+// ValidatorStatus is the status of a validator.
+type ValidatorStatus string
+
+// The validator statuses of the beacon API.
+const (
+	StatusPendingInitialized ValidatorStatus = "pending_initialized"
+	StatusPendingQueued      ValidatorStatus = "pending_queued"
+	StatusActiveOngoing      ValidatorStatus = "active_ongoing"
+	StatusActiveExiting      ValidatorStatus = "active_exiting"
+	StatusActiveSlashed      ValidatorStatus = "active_slashed"
+	StatusExitedUnslashed    ValidatorStatus = "exited_unslashed"
+	StatusExitedSlashed      ValidatorStatus = "exited_slashed"
+	StatusWithdrawalPossible ValidatorStatus = "withdrawal_possible"
+	StatusWithdrawalDone     ValidatorStatus = "withdrawal_done"
+)
+
+// Validator is a validator of a state, as the validators endpoint returns
+// it. Balances are in gwei.
+type Validator struct {
+	Index     uint64          `json:"index,string"`
+	Balance   uint64          `json:"balance,string"`
+	Status    ValidatorStatus `json:"status"`
+	Validator ValidatorData   `json:"validator"`
+}
+
+// HasID reports whether id, as the validators endpoint takes it, is the
+// index or hex-encoded public key of v.
+func (v Validator) HasID(id string) bool {
+	if index, err := strconv.ParseUint(id, 10, 64); err == nil {
+		return v.Index == index
+	}
+	return strings.EqualFold(id, hexutil.Encode(v.Validator.Pubkey))
+}
+
+// ValidatorData is the Validator container of the state.
+type ValidatorData struct {
+	Pubkey                     hexutil.Bytes `json:"pubkey"`
+	WithdrawalCredentials      common.Hash   `json:"withdrawal_credentials"`
+	EffectiveBalance           uint64        `json:"effective_balance,string"`
+	Slashed                    bool          `json:"slashed"`
+	ActivationEligibilityEpoch uint64        `json:"activation_eligibility_epoch,string"`
+	ActivationEpoch            uint64        `json:"activation_epoch,string"`
+	ExitEpoch                  uint64        `json:"exit_epoch,string"`
+	WithdrawableEpoch          uint64        `json:"withdrawable_epoch,string"`
+}
+
+// Fields returns the validator fields a proof of v proves, the leaves of
+// its SSZ tree.
+func (v ValidatorData) Fields() (beaconproofs.ValidatorFields, error) {
+	pubkeyHash, err := beaconproofs.HashValidatorBLSPubkey(v.Pubkey)
+	if err != nil {
+		return nil, err
+	}
+	var slashed common.Hash
+	if v.Slashed {
+		slashed[0] = 1
+	}
+	return beaconproofs.ValidatorFields{
+		pubkeyHash,
+		v.WithdrawalCredentials,
+		ssz.Uint64Chunk(v.EffectiveBalance),
+		slashed,
+		ssz.Uint64Chunk(v.ActivationEligibilityEpoch),
+		ssz.Uint64Chunk(v.ActivationEpoch),
+		ssz.Uint64Chunk(v.ExitEpoch),
+		ssz.Uint64Chunk(v.WithdrawableEpoch),
+	}, nil
+}
@@ -0,0 +1,433 @@
+// Package beacontest builds beacon chain states and blocks in memory and
+// serves them as a beacon.Client, so that tests can record them as the
+// fixtures a beacon.Replayer serves without a beacon node.
+package beacontest
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/beacon"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/beaconproofs"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/constants"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/proofgen"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/ssz"
+)
+
+// slotsPerHistoricalRoot is the number of slots of the block_roots vector
+// of a state, and of the period a historical summary summarizes.
+const slotsPerHistoricalRoot = 8192
+
+// Validator is a validator of a built state. Balances are in gwei.
+type Validator struct {
+	Pubkey                []byte
+	WithdrawalCredentials common.Hash
+	EffectiveBalance      uint64
+	Balance               uint64
+	Slashed               bool
+	ActivationEpoch       uint64
+	ExitEpoch             uint64
+	WithdrawableEpoch     uint64
+}
+
+// Pubkey returns the 48 byte public key of the i'th test validator.
+func Pubkey(i uint64) []byte {
+	return common.LeftPadBytes(ssz.EncodeUint64(i+1), 48)
+}
+
+// Withdrawal is a withdrawal of a built block. The amount is in gwei.
+type Withdrawal struct {
+	ValidatorIndex uint64
+	Address        common.Address
+	Amount         uint64
+}
+
+// Chain is a beacon.Client serving the states and blocks built on it. The
+// block_roots of a state hold the roots of the blocks of the slots before
+// it, and its historical summaries summarize the block roots of each
+// period from the one of the chain's first block, so that the withdrawals
+// of a block can be proven against any state after the end of its period,
+// with the state at the start of the next period as the historical state.
+// The fork of a state or block is the one the chain's schedule puts its
+// slot in.
+//
+// Chain is not safe for concurrent use.
+type Chain struct {
+	genesis  beacon.Genesis
+	schedule beaconproofs.ForkSchedule
+
+	blocks          map[uint64]*proofgen.Block
+	states          map[string]*state
+	withdrawalIndex uint64
+}
+
+// state is a built state with what the chain serves of it.
+type state struct {
+	*proofgen.State
+	header     proofgen.Header
+	validators []beacon.Validator
+}
+
+var _ beacon.Client = (*Chain)(nil)
+
+// NewChain returns a chain started at genesisTime whose Deneb fork is at
+// the timestamp of schedule.
+func NewChain(genesisTime uint64, schedule beaconproofs.ForkSchedule) *Chain {
+	return &Chain{
+		genesis:  beacon.Genesis{GenesisTime: genesisTime},
+		schedule: schedule,
+		blocks:   make(map[uint64]*proofgen.Block),
+		states:   make(map[string]*state),
+	}
+}
+
+// Timestamp returns the timestamp of slot.
+func (c *Chain) Timestamp(slot uint64) uint64 {
+	return c.genesis.GenesisTime + slot*constants.BeaconChainProofsSecondsPerSlot
+}
+
+// Epoch returns the epoch of slot.
+func (c *Chain) Epoch(slot uint64) uint64 {
+	return slot / constants.BeaconChainProofsSlotsPerEpoch
+}
+
+func (c *Chain) fork(slot uint64) proofgen.Fork {
+	return c.schedule.ForkAt(c.Timestamp(slot))
+}
+
+// AddBlock builds the block at slot holding withdrawals, which are given
+// the next withdrawal indices of the chain. Blocks must be added before
+// the states after them.
+func (c *Chain) AddBlock(slot uint64, withdrawals ...Withdrawal) (*proofgen.Block, error) {
+	block := proofgen.NewBlock(c.fork(slot)).Value
+	body := block.Field("body")
+	payload := body.Field("execution_payload")
+	list := payload.Field("withdrawals").Type
+	parts := make([][]byte, len(withdrawals))
+	for i, w := range withdrawals {
+		enc, err := ssz.Compose(list.Elem,
+			ssz.EncodeUint64(c.withdrawalIndex),
+			ssz.EncodeUint64(w.ValidatorIndex),
+			w.Address.Bytes(),
+			ssz.EncodeUint64(w.Amount),
+		)
+		if err != nil {
+			return nil, err
+		}
+		parts[i] = enc
+		c.withdrawalIndex++
+	}
+	enc, err := ssz.Compose(list, parts...)
+	if err != nil {
+		return nil, err
+	}
+	if payload, err = with(payload, map[string][]byte{"withdrawals": enc, "timestamp": ssz.EncodeUint64(c.Timestamp(slot))}); err != nil {
+		return nil, err
+	}
+	if body, err = body.With("execution_payload", payload.Bytes()); err != nil {
+		return nil, err
+	}
+	if block, err = with(block, map[string][]byte{"slot": ssz.EncodeUint64(slot), "body": body.Bytes()}); err != nil {
+		return nil, err
+	}
+	b := &proofgen.Block{Fork: c.fork(slot), Value: block}
+	c.blocks[slot] = b
+	return b, nil
+}
+
+// AddState builds the state at slot holding validators, served under its
+// slot and each of ids, such as "finalized". Its latest block header is a
+// header at its slot committing to it, whose root is the block root the
+// oracle must serve for the state.
+func (c *Chain) AddState(slot uint64, validators []Validator, ids ...string) (*proofgen.State, error) {
+	v := proofgen.NewState(c.fork(slot)).Value
+	vt := v.Field("validators").Type
+	var vs, balances [][]byte
+	served := make([]beacon.Validator, len(validators))
+	for i, val := range validators {
+		enc, err := ssz.Compose(vt.Elem,
+			val.Pubkey,
+			val.WithdrawalCredentials.Bytes(),
+			ssz.EncodeUint64(val.EffectiveBalance),
+			boolean(val.Slashed),
+			ssz.EncodeUint64(0),
+			ssz.EncodeUint64(val.ActivationEpoch),
+			ssz.EncodeUint64(val.ExitEpoch),
+			ssz.EncodeUint64(val.WithdrawableEpoch),
+		)
+		if err != nil {
+			return nil, err
+		}
+		vs = append(vs, enc)
+		balances = append(balances, ssz.EncodeUint64(val.Balance))
+		served[i] = beacon.Validator{
+			Index:   uint64(i),
+			Balance: val.Balance,
+			Status:  c.status(slot, val),
+			Validator: beacon.ValidatorData{
+				Pubkey:                val.Pubkey,
+				WithdrawalCredentials: val.WithdrawalCredentials,
+				EffectiveBalance:      val.EffectiveBalance,
+				Slashed:               val.Slashed,
+				ActivationEpoch:       val.ActivationEpoch,
+				ExitEpoch:             val.ExitEpoch,
+				WithdrawableEpoch:     val.WithdrawableEpoch,
+			},
+		}
+	}
+	validatorsEnc, err := ssz.Compose(vt, vs...)
+	if err != nil {
+		return nil, err
+	}
+	balancesEnc, err := ssz.Compose(v.Field("balances").Type, balances...)
+	if err != nil {
+		return nil, err
+	}
+	blockRoots, err := c.blockRoots(v.Field("block_roots").Type, slot-min(slot, slotsPerHistoricalRoot), slot)
+	if err != nil {
+		return nil, err
+	}
+	summaries, err := c.historicalSummaries(v.Field("historical_summaries").Type, slot/slotsPerHistoricalRoot)
+	if err != nil {
+		return nil, err
+	}
+	header := v.Field("latest_block_header")
+	if header, err = header.With("slot", ssz.EncodeUint64(slot)); err != nil {
+		return nil, err
+	}
+	v, err = with(v, map[string][]byte{
+		"genesis_time":         ssz.EncodeUint64(c.genesis.GenesisTime),
+		"slot":                 ssz.EncodeUint64(slot),
+		"latest_block_header":  header.Bytes(),
+		"block_roots":          blockRoots,
+		"validators":           validatorsEnc,
+		"balances":             balancesEnc,
+		"historical_summaries": summaries,
+	})
+	if err != nil {
+		return nil, err
+	}
+	s := &proofgen.State{Fork: c.fork(slot), Value: v}
+	p, err := proofgen.NewProver(s, c.schedule)
+	if err != nil {
+		return nil, err
+	}
+	built := &state{State: s, header: p.LatestBlockHeader(), validators: served}
+	for _, id := range append([]string{strconv.FormatUint(slot, 10)}, ids...) {
+		c.states[id] = built
+	}
+	return s, nil
+}
+
+// status returns the status of val at slot, as the validators endpoint
+// reports it.
+func (c *Chain) status(slot uint64, val Validator) beacon.ValidatorStatus {
+	epoch := c.Epoch(slot)
+	switch {
+	case epoch < val.ActivationEpoch:
+		return beacon.StatusPendingQueued
+	case epoch < val.ExitEpoch:
+		return beacon.StatusActiveOngoing
+	case epoch < val.WithdrawableEpoch:
+		return beacon.StatusExitedUnslashed
+	case val.Balance > 0:
+		return beacon.StatusWithdrawalPossible
+	default:
+		return beacon.StatusWithdrawalDone
+	}
+}
+
+// blockRoots returns the block_roots vector holding the roots of the
+// blocks of the slots from start up to end.
+func (c *Chain) blockRoots(t *ssz.Type, start, end uint64) ([]byte, error) {
+	roots := make([][]byte, slotsPerHistoricalRoot)
+	for i := range roots {
+		roots[i] = common.Hash{}.Bytes()
+	}
+	for slot, b := range c.blocks {
+		if start <= slot && slot < end {
+			roots[slot%slotsPerHistoricalRoot] = b.Root().Bytes()
+		}
+	}
+	return ssz.Compose(t, roots...)
+}
+
+// historicalSummaries returns the historical summaries of the periods
+// before period, from the one of the chain's first block.
+func (c *Chain) historicalSummaries(t *ssz.Type, period uint64) ([]byte, error) {
+	if len(c.blocks) == 0 {
+		return ssz.Compose(t)
+	}
+	first := uint64(1<<64 - 1)
+	for slot := range c.blocks {
+		first = min(first, slot/slotsPerHistoricalRoot)
+	}
+	blockRootsType := proofgen.NewState(proofgen.Capella).Value.Field("block_roots").Type
+	var parts [][]byte
+	for p := first; p < period; p++ {
+		enc, err := c.blockRoots(blockRootsType, p*slotsPerHistoricalRoot, (p+1)*slotsPerHistoricalRoot)
+		if err != nil {
+			return nil, err
+		}
+		roots, err := ssz.Decode(blockRootsType, enc)
+		if err != nil {
+			return nil, err
+		}
+		summary, err := ssz.Compose(t.Elem, roots.Root().Bytes(), common.Hash{}.Bytes())
+		if err != nil {
+			return nil, err
+		}
+		parts = append(parts, summary)
+	}
+	return ssz.Compose(t, parts...)
+}
+
+// Record saves everything the chain serves as the fixtures of dir, through
+// a beacon.Recorder: the genesis and spec, each state and its header under
+// each of its IDs with its validators under their indices, and each block
+// and its header under its slot.
+func (c *Chain) Record(dir string) error {
+	ctx := context.Background()
+	r := beacon.NewRecorder(c, dir)
+	if _, err := r.Genesis(ctx); err != nil {
+		return err
+	}
+	if _, err := r.Spec(ctx); err != nil {
+		return err
+	}
+	for _, id := range sortedKeys(c.states) {
+		if _, err := r.State(ctx, id); err != nil {
+			return err
+		}
+		if _, err := r.Header(ctx, id); err != nil {
+			return err
+		}
+		ids := make([]string, len(c.states[id].validators))
+		for i := range ids {
+			ids[i] = strconv.Itoa(i)
+		}
+		if _, err := r.Validators(ctx, id, ids); err != nil {
+			return err
+		}
+	}
+	for _, slot := range sortedKeys(c.blocks) {
+		id := strconv.FormatUint(slot, 10)
+		if _, err := r.Block(ctx, id); err != nil {
+			return err
+		}
+		if _, err := r.Header(ctx, id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Genesis returns the chain's genesis.
+func (c *Chain) Genesis(ctx context.Context) (*beacon.Genesis, error) {
+	g := c.genesis
+	return &g, nil
+}
+
+// Spec returns the DENEB_FORK_EPOCH of the chain's schedule.
+func (c *Chain) Spec(ctx context.Context) (map[string]string, error) {
+	epoch := uint64(beaconproofs.FarFutureEpoch)
+	if c.schedule != beaconproofs.UnsetSchedule {
+		epoch = (c.schedule.DenebForkTimestamp - c.genesis.GenesisTime) / constants.BeaconChainProofsSecondsPerEpoch
+	}
+	return map[string]string{"DENEB_FORK_EPOCH": strconv.FormatUint(epoch, 10)}, nil
+}
+
+// Header returns the latest block header of a state, or else the header of
+// a block.
+func (c *Chain) Header(ctx context.Context, blockID string) (*beacon.BlockHeader, error) {
+	h, ok := proofgen.Header{}, false
+	if s, found := c.states[blockID]; found {
+		h, ok = s.header, true
+	} else if b, found := c.block(blockID); found {
+		h, ok = b.Header(), true
+	}
+	if !ok {
+		return nil, notFound("header", blockID)
+	}
+	return &beacon.BlockHeader{Root: h.Root(), Canonical: true, Message: h}, nil
+}
+
+// Block returns the block at a slot.
+func (c *Chain) Block(ctx context.Context, blockID string) (*proofgen.Block, error) {
+	b, ok := c.block(blockID)
+	if !ok {
+		return nil, notFound("block", blockID)
+	}
+	return b, nil
+}
+
+func (c *Chain) block(blockID string) (*proofgen.Block, bool) {
+	slot, err := strconv.ParseUint(blockID, 10, 64)
+	if err != nil {
+		return nil, false
+	}
+	b, ok := c.blocks[slot]
+	return b, ok
+}
+
+// State returns a state.
+func (c *Chain) State(ctx context.Context, stateID string) (*proofgen.State, error) {
+	s, ok := c.states[stateID]
+	if !ok {
+		return nil, notFound("state", stateID)
+	}
+	return s.State, nil
+}
+
+// Validators returns the validators of a state with the given IDs.
+func (c *Chain) Validators(ctx context.Context, stateID string, ids []string) ([]beacon.Validator, error) {
+	s, ok := c.states[stateID]
+	if !ok {
+		return nil, notFound("state", stateID)
+	}
+	var out []beacon.Validator
+	for _, v := range s.validators {
+		for _, id := range ids {
+			if v.HasID(id) {
+				out = append(out, v)
+				break
+			}
+		}
+	}
+	return out, nil
+}
+
+func notFound(kind, id string) error {
+	return fmt.Errorf("beacontest: no %s %s: %w", kind, id, beacon.ErrNotFound)
+}
+
+// with replaces the named fields of the container v by their encodings.
+func with(v ssz.Value, fields map[string][]byte) (ssz.Value, error) {
+	for name, enc := range fields {
+		var err error
+		if v, err = v.With(name, enc); err != nil {
+			return ssz.Value{}, err
+		}
+	}
+	return v, nil
+}
+
+func boolean(b bool) []byte {
+	if b {
+		return []byte{1}
+	}
+	return []byte{0}
+}
+
+func sortedKeys[K interface{ ~string | ~uint64 }, V any](m map[K]V) []K {
+	keys := make([]K, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	return keys
+}
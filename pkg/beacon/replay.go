@@ -0,0 +1,237 @@
+package beacon
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/proofgen"
+)
+
+// ErrNotRecorded is returned by a Replayer for a request whose response
+// was never recorded.
+var ErrNotRecorded = errors.New("beacon: response not recorded")
+
+// The fixtures of a directory are laid out as:
+//
+//	genesis.json                    /eth/v1/beacon/genesis
+//	spec.json                       /eth/v1/config/spec
+//	headers/<block_id>.json         /eth/v1/beacon/headers/<block_id>
+//	blocks/<block_id>.ssz           the block of /eth/v2/beacon/blocks/<block_id>
+//	states/<state_id>.ssz           /eth/v2/debug/beacon/states/<state_id>
+//	validators/<state_id>/<id>.json /eth/v1/beacon/states/<state_id>/validators?id=<id>
+//
+// JSON fixtures hold the responses of the endpoints, so a recorded header
+// can be read by anything reading the beacon API's headers, such as
+// beaconoracle.Load. A validator the node does not know is recorded with
+// an empty list.
+func fixturePath(dir string, elems ...string) string {
+	parts := []string{dir}
+	for _, e := range elems {
+		parts = append(parts, url.PathEscape(e))
+	}
+	return filepath.Join(parts...)
+}
+
+// Recorder is a Client saving every response of another Client as a
+// fixture in Dir, for a Replayer to serve.
+type Recorder struct {
+	Client Client
+	Dir    string
+}
+
+var _ Client = (*Recorder)(nil)
+
+// NewRecorder returns a Recorder of client's responses into dir.
+func NewRecorder(client Client, dir string) *Recorder {
+	return &Recorder{Client: client, Dir: dir}
+}
+
+func writeFile(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
+
+// writeData writes v as the data of a JSON response.
+func writeData(path string, v any) error {
+	data, err := json.MarshalIndent(struct {
+		Data any `json:"data"`
+	}{v}, "", "    ")
+	if err != nil {
+		return err
+	}
+	return writeFile(path, data)
+}
+
+// Genesis records the genesis.
+func (r *Recorder) Genesis(ctx context.Context) (*Genesis, error) {
+	g, err := r.Client.Genesis(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return g, writeData(fixturePath(r.Dir, "genesis.json"), g)
+}
+
+// Spec records the spec.
+func (r *Recorder) Spec(ctx context.Context) (map[string]string, error) {
+	spec, err := r.Client.Spec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return spec, writeData(fixturePath(r.Dir, "spec.json"), spec)
+}
+
+// Header records the header of a block.
+func (r *Recorder) Header(ctx context.Context, blockID string) (*BlockHeader, error) {
+	h, err := r.Client.Header(ctx, blockID)
+	if err != nil {
+		return nil, err
+	}
+	return h, writeData(fixturePath(r.Dir, "headers", blockID+".json"), h)
+}
+
+// Block records a block, without its signature.
+func (r *Recorder) Block(ctx context.Context, blockID string) (*proofgen.Block, error) {
+	b, err := r.Client.Block(ctx, blockID)
+	if err != nil {
+		return nil, err
+	}
+	return b, writeFile(fixturePath(r.Dir, "blocks", blockID+".ssz"), b.Value.Bytes())
+}
+
+// State records a state.
+func (r *Recorder) State(ctx context.Context, stateID string) (*proofgen.State, error) {
+	s, err := r.Client.State(ctx, stateID)
+	if err != nil {
+		return nil, err
+	}
+	return s, writeFile(fixturePath(r.Dir, "states", stateID+".ssz"), s.Value.Bytes())
+}
+
+// Validators records each validator under each ID it was asked for by.
+func (r *Recorder) Validators(ctx context.Context, stateID string, ids []string) ([]Validator, error) {
+	vs, err := r.Client.Validators(ctx, stateID, ids)
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range ids {
+		found := []Validator{}
+		for _, v := range vs {
+			if v.HasID(id) {
+				found = append(found, v)
+				break
+			}
+		}
+		if err := writeData(fixturePath(r.Dir, "validators", stateID, id+".json"), found); err != nil {
+			return nil, err
+		}
+	}
+	return vs, nil
+}
+
+// Replayer is a Client serving the fixtures a Recorder saved in Dir.
+type Replayer struct {
+	Dir string
+}
+
+var _ Client = (*Replayer)(nil)
+
+// NewReplayer returns a Replayer of the fixtures in dir.
+func NewReplayer(dir string) *Replayer {
+	return &Replayer{Dir: dir}
+}
+
+func readFile(path string) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("%w: %s", ErrNotRecorded, path)
+	}
+	return data, err
+}
+
+// readData decodes the data of the JSON response recorded at path into v.
+func readData(path string, v any) error {
+	data, err := readFile(path)
+	if err != nil {
+		return err
+	}
+	if err := decodeData(data, v); err != nil {
+		return fmt.Errorf("beacon: %s: %w", path, err)
+	}
+	return nil
+}
+
+// Genesis replays the genesis.
+func (r *Replayer) Genesis(ctx context.Context) (*Genesis, error) {
+	var g Genesis
+	if err := readData(fixturePath(r.Dir, "genesis.json"), &g); err != nil {
+		return nil, err
+	}
+	return &g, nil
+}
+
+// Spec replays the spec.
+func (r *Replayer) Spec(ctx context.Context) (map[string]string, error) {
+	var spec map[string]string
+	if err := readData(fixturePath(r.Dir, "spec.json"), &spec); err != nil {
+		return nil, err
+	}
+	return spec, nil
+}
+
+// Header replays the header of a block.
+func (r *Replayer) Header(ctx context.Context, blockID string) (*BlockHeader, error) {
+	var h BlockHeader
+	if err := readData(fixturePath(r.Dir, "headers", blockID+".json"), &h); err != nil {
+		return nil, err
+	}
+	return &h, nil
+}
+
+// Block replays a block.
+func (r *Replayer) Block(ctx context.Context, blockID string) (*proofgen.Block, error) {
+	path := fixturePath(r.Dir, "blocks", blockID+".ssz")
+	data, err := readFile(path)
+	if err != nil {
+		return nil, err
+	}
+	b, err := proofgen.DecodeBlock(data)
+	if err != nil {
+		return nil, fmt.Errorf("beacon: %s: %w", path, err)
+	}
+	return b, nil
+}
+
+// State replays a state.
+func (r *Replayer) State(ctx context.Context, stateID string) (*proofgen.State, error) {
+	path := fixturePath(r.Dir, "states", stateID+".ssz")
+	data, err := readFile(path)
+	if err != nil {
+		return nil, err
+	}
+	s, err := proofgen.DecodeState(data)
+	if err != nil {
+		return nil, fmt.Errorf("beacon: %s: %w", path, err)
+	}
+	return s, nil
+}
+
+// Validators replays the validators with the given IDs, every one of
+// which must have been recorded.
+func (r *Replayer) Validators(ctx context.Context, stateID string, ids []string) ([]Validator, error) {
+	var out []Validator
+	for _, id := range ids {
+		var vs []Validator
+		if err := readData(fixturePath(r.Dir, "validators", stateID, id+".json"), &vs); err != nil {
+			return nil, err
+		}
+		out = append(out, vs...)
+	}
+	return out, nil
+}
@@ -0,0 +1,250 @@
+package beacon_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/beacon"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/beacon/beacontest"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/beaconproofs"
+)
+
+const genesisTime = 1_606_824_023
+
+// newChain returns a chain with a block at slot 10 and the state at slot
+// 100, also served as "finalized", holding two validators.
+func newChain(t *testing.T) *beacontest.Chain {
+	t.Helper()
+	chain := beacontest.NewChain(genesisTime, beaconproofs.ForkSchedule{DenebForkTimestamp: genesisTime + 32*12})
+	if _, err := chain.AddBlock(10, beacontest.Withdrawal{ValidatorIndex: 1, Address: common.Address{1}, Amount: 1e9}); err != nil {
+		t.Fatal(err)
+	}
+	validators := make([]beacontest.Validator, 2)
+	for i := range validators {
+		validators[i] = beacontest.Validator{
+			Pubkey:            beacontest.Pubkey(uint64(i)),
+			EffectiveBalance:  32e9,
+			Balance:           32e9 + uint64(i),
+			ExitEpoch:         beaconproofs.FarFutureEpoch,
+			WithdrawableEpoch: beaconproofs.FarFutureEpoch,
+		}
+	}
+	if _, err := chain.AddState(100, validators, "finalized"); err != nil {
+		t.Fatal(err)
+	}
+	return chain
+}
+
+// serve serves client over the endpoints of the beacon API a beacon.REST
+// calls, answering 404 for what it does not have.
+func serve(t *testing.T, client beacon.Client) *httptest.Server {
+	t.Helper()
+	writeData := func(w http.ResponseWriter, v any) {
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(struct {
+			Data any `json:"data"`
+		}{v}); err != nil {
+			t.Error(err)
+		}
+	}
+	handle := func(w http.ResponseWriter, r *http.Request) error {
+		ctx := r.Context()
+		path := r.URL.Path
+		switch {
+		case path == "/eth/v1/beacon/genesis":
+			g, err := client.Genesis(ctx)
+			if err == nil {
+				writeData(w, g)
+			}
+			return err
+		case path == "/eth/v1/config/spec":
+			spec, err := client.Spec(ctx)
+			if err == nil {
+				writeData(w, spec)
+			}
+			return err
+		case strings.HasPrefix(path, "/eth/v1/beacon/headers/"):
+			h, err := client.Header(ctx, strings.TrimPrefix(path, "/eth/v1/beacon/headers/"))
+			if err == nil {
+				writeData(w, h)
+			}
+			return err
+		case strings.HasPrefix(path, "/eth/v2/beacon/blocks/"):
+			b, err := client.Block(ctx, strings.TrimPrefix(path, "/eth/v2/beacon/blocks/"))
+			if err == nil {
+				_, err = w.Write(b.Value.Bytes())
+			}
+			return err
+		case strings.HasPrefix(path, "/eth/v2/debug/beacon/states/"):
+			s, err := client.State(ctx, strings.TrimPrefix(path, "/eth/v2/debug/beacon/states/"))
+			if err == nil {
+				_, err = w.Write(s.Value.Bytes())
+			}
+			return err
+		case strings.HasPrefix(path, "/eth/v1/beacon/states/") && strings.HasSuffix(path, "/validators"):
+			stateID := strings.TrimSuffix(strings.TrimPrefix(path, "/eth/v1/beacon/states/"), "/validators")
+			vs, err := client.Validators(ctx, stateID, strings.Split(r.URL.Query().Get("id"), ","))
+			if err == nil {
+				writeData(w, vs)
+			}
+			return err
+		}
+		return beacon.ErrNotFound
+	}
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := handle(w, r); err != nil {
+			code := http.StatusInternalServerError
+			if errors.Is(err, beacon.ErrNotFound) {
+				code = http.StatusNotFound
+			}
+			w.WriteHeader(code)
+			json.NewEncoder(w).Encode(map[string]any{"code": code, "message": err.Error()})
+		}
+	}))
+}
+
+// responses are what a client returned, blocks and states by their roots.
+type responses struct {
+	Genesis    *beacon.Genesis
+	Spec       map[string]string
+	Headers    []*beacon.BlockHeader
+	Block      common.Hash
+	State      common.Hash
+	Validators []beacon.Validator
+}
+
+// read reads everything newChain serves from client.
+func read(t *testing.T, client beacon.Client) responses {
+	t.Helper()
+	ctx := context.Background()
+	var r responses
+	var err error
+	if r.Genesis, err = client.Genesis(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if r.Spec, err = client.Spec(ctx); err != nil {
+		t.Fatal(err)
+	}
+	for _, id := range []string{"10", "100", "finalized"} {
+		h, err := client.Header(ctx, id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		r.Headers = append(r.Headers, h)
+	}
+	block, err := client.Block(ctx, "10")
+	if err != nil {
+		t.Fatal(err)
+	}
+	state, err := client.State(ctx, "finalized")
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.Block, r.State = block.Root(), state.Value.Root()
+	// Validator 2 is unknown.
+	if r.Validators, err = client.Validators(ctx, "finalized", []string{"0", "1", "2"}); err != nil {
+		t.Fatal(err)
+	}
+	return r
+}
+
+func TestRecordReplay(t *testing.T) {
+	chain := newChain(t)
+	srv := serve(t, chain)
+	// Empty byte strings come back from JSON as empty rather than nil, so
+	// what the node serves is checked against the chain by its roots.
+	want, direct := read(t, beacon.NewREST(srv.URL)), read(t, chain)
+	for i, h := range want.Headers {
+		if h.Root != direct.Headers[i].Root || h.Message != direct.Headers[i].Message {
+			t.Errorf("node served header %+v, want %+v", h, direct.Headers[i])
+		}
+	}
+	if want.Block != direct.Block || want.State != direct.State || !reflect.DeepEqual(want.Validators, direct.Validators) {
+		t.Fatalf("node served %+v, want %+v", want, direct)
+	}
+
+	dir := t.TempDir()
+	if got := read(t, beacon.NewRecorder(beacon.NewREST(srv.URL), dir)); !reflect.DeepEqual(got, want) {
+		t.Fatalf("recorded %+v, want %+v", got, want)
+	}
+	// The replayer serves the responses without the node.
+	srv.Close()
+	if got := read(t, beacon.NewReplayer(dir)); !reflect.DeepEqual(got, want) {
+		t.Errorf("replayed %+v, want %+v", got, want)
+	}
+	schedule, err := beacon.ForkSchedule(want.Genesis, want.Spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if schedule.DenebForkTimestamp != genesisTime+32*12 {
+		t.Errorf("replayed Deneb fork at %d, want %d", schedule.DenebForkTimestamp, genesisTime+32*12)
+	}
+}
+
+func TestReplayNotRecorded(t *testing.T) {
+	chain := newChain(t)
+	srv := serve(t, chain)
+	defer srv.Close()
+	dir := t.TempDir()
+	ctx := context.Background()
+	rec := beacon.NewRecorder(beacon.NewREST(srv.URL), dir)
+	if _, err := rec.Header(ctx, "10"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := rec.Validators(ctx, "finalized", []string{"0"}); err != nil {
+		t.Fatal(err)
+	}
+	// The node has no block at slot 11, so there is nothing to record.
+	if _, err := rec.Block(ctx, "11"); !errors.Is(err, beacon.ErrNotFound) {
+		t.Fatalf("recorded block 11: err = %v, want %v", err, beacon.ErrNotFound)
+	}
+
+	rep := beacon.NewReplayer(dir)
+	tests := []struct {
+		name string
+		call func() error
+		// path is the fixture missing, relative to dir.
+		path string
+	}{
+		{"genesis", func() error { _, err := rep.Genesis(ctx); return err }, "genesis.json"},
+		{"spec", func() error { _, err := rep.Spec(ctx); return err }, "spec.json"},
+		{"header of another block", func() error { _, err := rep.Header(ctx, "100"); return err }, "headers/100.json"},
+		{"block not found when recording", func() error { _, err := rep.Block(ctx, "11"); return err }, "blocks/11.ssz"},
+		{"block not recorded", func() error { _, err := rep.Block(ctx, "10"); return err }, "blocks/10.ssz"},
+		{"state", func() error { _, err := rep.State(ctx, "finalized"); return err }, "states/finalized.ssz"},
+		{"validator not recorded", func() error {
+			_, err := rep.Validators(ctx, "finalized", []string{"0", "1"})
+			return err
+		}, "validators/finalized/1.json"},
+		{"validators of another state", func() error {
+			_, err := rep.Validators(ctx, "100", []string{"0"})
+			return err
+		}, "validators/100/0.json"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.call()
+			want := "beacon: response not recorded: " + filepath.Join(dir, filepath.FromSlash(tt.path))
+			if !errors.Is(err, beacon.ErrNotRecorded) || err.Error() != want {
+				t.Errorf("err = %v, want %q", err, want)
+			}
+		})
+	}
+
+	// What was recorded still replays.
+	if h, err := rep.Header(ctx, "10"); err != nil || h.Message.Slot != 10 {
+		t.Errorf("replayed header %+v, %v, want the header at slot 10", h, err)
+	}
+	if vs, err := rep.Validators(ctx, "finalized", []string{"0"}); err != nil || len(vs) != 1 || vs[0].Index != 0 {
+		t.Errorf("replayed validators %+v, %v, want validator 0", vs, err)
+	}
+}
@@ -0,0 +1,171 @@
+package beacon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/proofgen"
+)
+
+// validatorBatchSize is the number of validator IDs requested at once,
+// keeping the query string within the limits nodes put on URLs.
+const validatorBatchSize = 64
+
+// APIError is the error response of a beacon node.
+type APIError struct {
+	Path       string
+	StatusCode int
+	Message    string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("beacon: %s: %d %s", e.Path, e.StatusCode, e.Message)
+}
+
+// Is reports whether target is ErrNotFound for a 404 response.
+func (e *APIError) Is(target error) bool {
+	return target == ErrNotFound && e.StatusCode == http.StatusNotFound
+}
+
+// REST is a Client of a beacon node's REST API.
+type REST struct {
+	// URL is the base URL of the node, such as http://localhost:5052.
+	URL  string
+	HTTP *http.Client
+}
+
+var _ Client = (*REST)(nil)
+
+// NewREST returns a client of the beacon node at url.
+func NewREST(url string) *REST {
+	return &REST{URL: strings.TrimSuffix(url, "/"), HTTP: http.DefaultClient}
+}
+
+// get returns the body of a successful GET of path, asking for accept.
+func (c *REST) get(ctx context.Context, path, accept string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.URL+path, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", accept)
+	resp, err := c.HTTP.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		apiErr := &APIError{Path: path, StatusCode: resp.StatusCode, Message: strings.TrimSpace(string(body))}
+		var msg struct {
+			Message string `json:"message"`
+		}
+		if json.Unmarshal(body, &msg) == nil && msg.Message != "" {
+			apiErr.Message = msg.Message
+		}
+		return nil, apiErr
+	}
+	return body, nil
+}
+
+// getData decodes the data of a JSON response into v.
+func (c *REST) getData(ctx context.Context, path string, v any) error {
+	body, err := c.get(ctx, path, "application/json")
+	if err != nil {
+		return err
+	}
+	if err := decodeData(body, v); err != nil {
+		return fmt.Errorf("beacon: %s: %w", path, err)
+	}
+	return nil
+}
+
+// decodeData decodes the data of a JSON response into v.
+func decodeData(body []byte, v any) error {
+	var resp struct {
+		Data json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return err
+	}
+	return json.Unmarshal(resp.Data, v)
+}
+
+// Genesis calls /eth/v1/beacon/genesis.
+func (c *REST) Genesis(ctx context.Context) (*Genesis, error) {
+	var g Genesis
+	if err := c.getData(ctx, "/eth/v1/beacon/genesis", &g); err != nil {
+		return nil, err
+	}
+	return &g, nil
+}
+
+// Spec calls /eth/v1/config/spec.
+func (c *REST) Spec(ctx context.Context) (map[string]string, error) {
+	var spec map[string]string
+	if err := c.getData(ctx, "/eth/v1/config/spec", &spec); err != nil {
+		return nil, err
+	}
+	return spec, nil
+}
+
+// Header calls /eth/v1/beacon/headers/{block_id}.
+func (c *REST) Header(ctx context.Context, blockID string) (*BlockHeader, error) {
+	var h BlockHeader
+	if err := c.getData(ctx, "/eth/v1/beacon/headers/"+url.PathEscape(blockID), &h); err != nil {
+		return nil, err
+	}
+	return &h, nil
+}
+
+// Block calls /eth/v2/beacon/blocks/{block_id} for the SSZ-encoded block.
+func (c *REST) Block(ctx context.Context, blockID string) (*proofgen.Block, error) {
+	path := "/eth/v2/beacon/blocks/" + url.PathEscape(blockID)
+	body, err := c.get(ctx, path, "application/octet-stream")
+	if err != nil {
+		return nil, err
+	}
+	b, err := proofgen.DecodeBlock(body)
+	if err != nil {
+		return nil, fmt.Errorf("beacon: %s: %w", path, err)
+	}
+	return b, nil
+}
+
+// State calls /eth/v2/debug/beacon/states/{state_id} for the SSZ-encoded
+// state.
+func (c *REST) State(ctx context.Context, stateID string) (*proofgen.State, error) {
+	path := "/eth/v2/debug/beacon/states/" + url.PathEscape(stateID)
+	body, err := c.get(ctx, path, "application/octet-stream")
+	if err != nil {
+		return nil, err
+	}
+	s, err := proofgen.DecodeState(body)
+	if err != nil {
+		return nil, fmt.Errorf("beacon: %s: %w", path, err)
+	}
+	return s, nil
+}
+
+// Validators calls /eth/v1/beacon/states/{state_id}/validators, a batch of
+// IDs at a time.
+func (c *REST) Validators(ctx context.Context, stateID string, ids []string) ([]Validator, error) {
+	var out []Validator
+	for start := 0; start < len(ids); start += validatorBatchSize {
+		batch := ids[start:min(start+validatorBatchSize, len(ids))]
+		path := "/eth/v1/beacon/states/" + url.PathEscape(stateID) + "/validators?id=" + url.QueryEscape(strings.Join(batch, ","))
+		var vs []Validator
+		if err := c.getData(ctx, path, &vs); err != nil {
+			return nil, err
+		}
+		out = append(out, vs...)
+	}
+	return out, nil
+}
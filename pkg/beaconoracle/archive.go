@@ -0,0 +1,237 @@
+// Package beaconoracle stands in for the beacon chain oracle the
+// EigenPodManager reads block roots from, IBeaconChainOracle, serving the
+// block roots of a local archive of beacon block headers instead of a
+// beacon node.
+//
+// An Archive maps timestamps to block roots. It is read from beacon API
+// header responses, SSZ-encoded headers, blocks and states, or a plain
+// JSON object of timestamps to roots. It implements the
+// IBeaconChainOracleReader interface of the binding, so it can be
+// registered on a fakes.Chain as the oracle of the fake EigenPodManager,
+// and it can be published to a Mock deployed on any backend, such as a
+// simulated one. Proof builders look roots up in the same Archive.
+// VerifyStateRootProof tells whether a proof would pass against the root
+// at an oracle timestamp without sending it.
+package beaconoracle
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/beacon"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/beaconproofs"
+	ibeaconchainoracle "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/IBeaconChainOracle"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/constants"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/proofgen"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/reverts"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/types"
+)
+
+const secondsPerSlot = constants.BeaconChainProofsSecondsPerSlot
+
+// Archive maps timestamps to the block roots the oracle serves for them.
+// It is safe for concurrent use.
+type Archive struct {
+	mu    sync.RWMutex
+	roots map[uint64]common.Hash
+}
+
+var _ ibeaconchainoracle.IBeaconChainOracleReader = (*Archive)(nil)
+
+// NewArchive returns an empty archive.
+func NewArchive() *Archive {
+	return &Archive{roots: make(map[uint64]common.Hash)}
+}
+
+// Load reads an archive from the given files, or from every .json and
+// .ssz file of the given directories. Headers are served at the timestamp
+// of their slot on a chain that started at genesisTime.
+func Load(genesisTime uint64, paths ...string) (*Archive, error) {
+	a := NewArchive()
+	for _, path := range paths {
+		err := filepath.WalkDir(path, func(path string, d fs.DirEntry, err error) error {
+			if err != nil || d.IsDir() {
+				return err
+			}
+			switch filepath.Ext(path) {
+			case ".json", ".ssz":
+				return a.LoadFile(path, genesisTime)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return a, nil
+}
+
+// SlotTimestamp returns the timestamp of slot on a chain that started at
+// genesisTime.
+func SlotTimestamp(genesisTime, slot uint64) uint64 {
+	return genesisTime + slot*secondsPerSlot
+}
+
+// Add serves root at timestamp, replacing any root served there.
+func (a *Archive) Add(timestamp uint64, root common.Hash) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.roots[timestamp] = root
+}
+
+// AddHeader serves the root of h at the timestamp of its slot on a chain
+// that started at genesisTime.
+func (a *Archive) AddHeader(genesisTime uint64, h proofgen.Header) {
+	a.Add(SlotTimestamp(genesisTime, h.Slot), h.Root())
+}
+
+// BlockRoot returns the root served at timestamp.
+func (a *Archive) BlockRoot(timestamp uint64) (common.Hash, bool) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	root, ok := a.roots[timestamp]
+	return root, ok
+}
+
+// Timestamp returns the earliest timestamp root is served at.
+func (a *Archive) Timestamp(root common.Hash) (uint64, bool) {
+	for _, ts := range a.Timestamps() {
+		if r, _ := a.BlockRoot(ts); r == root {
+			return ts, true
+		}
+	}
+	return 0, false
+}
+
+// Timestamps returns the timestamps roots are served at, in increasing
+// order.
+func (a *Archive) Timestamps() []uint64 {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	out := make([]uint64, 0, len(a.roots))
+	for ts := range a.roots {
+		out = append(out, ts)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i] < out[j] })
+	return out
+}
+
+// TimestampToBlockRoot returns the root served at timestamp, or the zero
+// root, as IBeaconChainOracle.timestampToBlockRoot does.
+func (a *Archive) TimestampToBlockRoot(opts *bind.CallOpts, timestamp *big.Int) ([32]byte, error) {
+	if !timestamp.IsUint64() {
+		return [32]byte{}, nil
+	}
+	root, _ := a.BlockRoot(timestamp.Uint64())
+	return root, nil
+}
+
+// VerifyStateRootProof checks proof against the root served at
+// oracleTimestamp, as the pod does with EigenPodManager.getBlockRootAtTimestamp
+// before verifying any proof against the state root.
+func (a *Archive) VerifyStateRootProof(oracleTimestamp uint64, proof types.StateRootProof) error {
+	root, ok := a.BlockRoot(oracleTimestamp)
+	if !ok || root == (common.Hash{}) {
+		return reverts.FromReason("EigenPodManager.getBlockRootAtTimestamp: state root at timestamp not yet finalized")
+	}
+	return beaconproofs.VerifyStateRootProof(root, proof)
+}
+
+// LoadFile adds the roots of a file: a JSON object of timestamps to
+// roots, a beacon API response of one or more headers, or an SSZ-encoded
+// header, block or state, whose latest block header is served.
+func (a *Archive) LoadFile(path string, genesisTime uint64) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if filepath.Ext(path) == ".ssz" {
+		h, err := decodeSSZ(data)
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		a.AddHeader(genesisTime, h)
+		return nil
+	}
+	if err := a.decodeJSON(data, genesisTime); err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	return nil
+}
+
+// decodeSSZ returns the header of an SSZ-encoded header, block or state.
+func decodeSSZ(data []byte) (proofgen.Header, error) {
+	if h, err := proofgen.DecodeHeader(data); err == nil {
+		return h, nil
+	}
+	if b, err := proofgen.DecodeBlock(data); err == nil {
+		return b.Header(), nil
+	}
+	state, err := proofgen.DecodeState(data)
+	if err != nil {
+		return proofgen.Header{}, fmt.Errorf("beaconoracle: not a beacon block header, block or state")
+	}
+	p, err := proofgen.NewProver(state, beaconproofs.UnsetSchedule)
+	if err != nil {
+		return proofgen.Header{}, err
+	}
+	return p.LatestBlockHeader(), nil
+}
+
+func (a *Archive) decodeJSON(data []byte, genesisTime uint64) error {
+	var resp struct {
+		Data json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return err
+	}
+	if resp.Data != nil {
+		headers, err := beacon.DecodeHeaders(data)
+		if err != nil {
+			return err
+		}
+		for _, h := range headers {
+			a.AddHeader(genesisTime, h.Message)
+		}
+		return nil
+	}
+
+	var roots map[string]common.Hash
+	if err := json.Unmarshal(data, &roots); err != nil {
+		return fmt.Errorf("beaconoracle: not a header response or a map of timestamps to roots: %w", err)
+	}
+	for key, root := range roots {
+		ts, err := strconv.ParseUint(key, 10, 64)
+		if err != nil {
+			return fmt.Errorf("beaconoracle: invalid timestamp %q", key)
+		}
+		a.Add(ts, root)
+	}
+	return nil
+}
+
+// Write writes the archive to path as a JSON object of timestamps to
+// roots, which Load reads back.
+func (a *Archive) Write(path string) error {
+	a.mu.RLock()
+	roots := make(map[string]common.Hash, len(a.roots))
+	for ts, root := range a.roots {
+		roots[strconv.FormatUint(ts, 10)] = root
+	}
+	a.mu.RUnlock()
+	data, err := json.MarshalIndent(roots, "", "    ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
@@ -0,0 +1,254 @@
+package beaconoracle_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/beacon/beacontest"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/beaconoracle"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/beaconproofs"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/beaconproofs/beaconproofstest"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/prooffile"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/proofgen"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/reverts"
+)
+
+const genesisTime = 1_606_824_023
+
+func TestBlockRoot(t *testing.T) {
+	a := beaconoracle.NewArchive()
+	h5 := proofgen.Header{Slot: 5, BodyRoot: common.Hash{5}}
+	h7 := proofgen.Header{Slot: 7, BodyRoot: common.Hash{7}}
+	a.AddHeader(genesisTime, h5)
+	a.AddHeader(genesisTime, h7)
+	// A root added again at a later timestamp is served at both.
+	a.Add(beaconoracle.SlotTimestamp(genesisTime, 9), h5.Root())
+
+	tests := []struct {
+		name      string
+		timestamp *big.Int
+		// want is the root served, none if zero.
+		want common.Hash
+	}{
+		{name: "slot of a header", timestamp: big.NewInt(genesisTime + 5*12), want: h5.Root()},
+		{name: "slot of another header", timestamp: big.NewInt(genesisTime + 7*12), want: h7.Root()},
+		{name: "slot of an added root", timestamp: big.NewInt(genesisTime + 9*12), want: h5.Root()},
+		{name: "within the slot of a header", timestamp: big.NewInt(genesisTime + 5*12 + 1)},
+		{name: "empty slot", timestamp: big.NewInt(genesisTime + 6*12)},
+		{name: "genesis", timestamp: big.NewInt(genesisTime)},
+		{name: "beyond uint64", timestamp: new(big.Int).Lsh(big.NewInt(1), 64)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := a.TimestampToBlockRoot(nil, tt.timestamp)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("TimestampToBlockRoot(%v) = %x, want %x", tt.timestamp, got, tt.want)
+			}
+			if !tt.timestamp.IsUint64() {
+				return
+			}
+			root, ok := a.BlockRoot(tt.timestamp.Uint64())
+			if root != tt.want || ok != (tt.want != common.Hash{}) {
+				t.Errorf("BlockRoot(%v) = %x, %t, want %x", tt.timestamp, root, ok, tt.want)
+			}
+		})
+	}
+
+	want := []uint64{genesisTime + 5*12, genesisTime + 7*12, genesisTime + 9*12}
+	if got := a.Timestamps(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Timestamps() = %v, want %v", got, want)
+	}
+	if ts, ok := a.Timestamp(h5.Root()); !ok || ts != genesisTime+5*12 {
+		t.Errorf("Timestamp(%x) = %d, %t, want the earliest, %d", h5.Root(), ts, ok, genesisTime+5*12)
+	}
+	if ts, ok := a.Timestamp(common.Hash{1}); ok {
+		t.Errorf("Timestamp of a root not served = %d", ts)
+	}
+
+	// Adding a root at a timestamp replaces the one served there.
+	a.Add(genesisTime+7*12, common.Hash{1})
+	if root, _ := a.BlockRoot(genesisTime + 7*12); root != (common.Hash{1}) {
+		t.Errorf("replaced root is %x, want %x", root, common.Hash{1})
+	}
+	if _, ok := a.Timestamp(h7.Root()); ok {
+		t.Errorf("replaced root %x is still served", h7.Root())
+	}
+}
+
+func TestVerifyStateRootProof(t *testing.T) {
+	f, err := prooffile.Load(filepath.Join("../..", beaconproofstest.FixtureDir, "withdrawal_credential_proof_302913.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	proof := f.ToStateRootProof().Canonical()
+	a := beaconoracle.NewArchive()
+	a.Add(1000, f.LatestBlockHeaderRoot)
+	a.Add(2000, common.Hash{1})
+	a.Add(3000, common.Hash{})
+
+	tests := []struct {
+		name      string
+		timestamp uint64
+		sentinel  error
+	}{
+		{name: "root of the proof", timestamp: 1000},
+		{name: "another root", timestamp: 2000, sentinel: reverts.ErrInvalidLatestBlockHeaderRootMerkleProof},
+		{name: "zero root", timestamp: 3000, sentinel: reverts.ErrStateRootAtTimestampNotFinalized},
+		{name: "no root", timestamp: 1001, sentinel: reverts.ErrStateRootAtTimestampNotFinalized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := a.VerifyStateRootProof(tt.timestamp, proof)
+			switch {
+			case tt.sentinel == nil && err != nil:
+				t.Fatal(err)
+			case tt.sentinel != nil && !errors.Is(err, tt.sentinel):
+				t.Fatalf("err = %v, want %v", err, tt.sentinel)
+			}
+		})
+	}
+}
+
+func TestLoad(t *testing.T) {
+	chain := beacontest.NewChain(genesisTime, beaconproofs.UnsetSchedule)
+	for _, slot := range []uint64{10, 11} {
+		if _, err := chain.AddBlock(slot); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := chain.AddState(20, nil); err != nil {
+		t.Fatal(err)
+	}
+	dir := t.TempDir()
+	if err := chain.Record(dir); err != nil {
+		t.Fatal(err)
+	}
+	// want is the archive of the chain's headers.
+	want := make(map[uint64]common.Hash)
+	for _, slot := range []uint64{10, 11, 20} {
+		h, err := chain.Header(context.Background(), strconv.FormatUint(slot, 10))
+		if err != nil {
+			t.Fatal(err)
+		}
+		want[chain.Timestamp(slot)] = h.Root
+	}
+	roots := func(a *beaconoracle.Archive) map[uint64]common.Hash {
+		got := make(map[uint64]common.Hash)
+		for _, ts := range a.Timestamps() {
+			got[ts], _ = a.BlockRoot(ts)
+		}
+		return got
+	}
+
+	tests := []struct {
+		name  string
+		paths []string
+	}{
+		{name: "header responses", paths: []string{filepath.Join(dir, "headers")}},
+		{name: "blocks and states", paths: []string{filepath.Join(dir, "blocks"), filepath.Join(dir, "states")}},
+		{name: "files", paths: []string{
+			filepath.Join(dir, "headers", "10.json"),
+			filepath.Join(dir, "blocks", "11.ssz"),
+			filepath.Join(dir, "states", "20.ssz"),
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, err := beaconoracle.Load(genesisTime, tt.paths...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := roots(a); !reflect.DeepEqual(got, want) {
+				t.Errorf("loaded %v, want %v", got, want)
+			}
+		})
+	}
+
+	t.Run("written archive", func(t *testing.T) {
+		a, err := beaconoracle.Load(genesisTime, filepath.Join(dir, "headers"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		path := filepath.Join(t.TempDir(), "roots.json")
+		if err := a.Write(path); err != nil {
+			t.Fatal(err)
+		}
+		// The genesis time does not apply to roots keyed by timestamp.
+		read, err := beaconoracle.Load(0, path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := roots(read); !reflect.DeepEqual(got, want) {
+			t.Errorf("read back %v, want %v", got, want)
+		}
+	})
+
+	t.Run("header", func(t *testing.T) {
+		h := proofgen.Header{Slot: 3, BodyRoot: common.Hash{3}}
+		path := filepath.Join(t.TempDir(), "header.ssz")
+		if err := os.WriteFile(path, h.Encode(), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		a, err := beaconoracle.Load(genesisTime, path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if root, _ := a.BlockRoot(genesisTime + 3*12); root != h.Root() {
+			t.Errorf("loaded %x, want %x", root, h.Root())
+		}
+	})
+}
+
+func TestLoadFileErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		file string
+		data string
+		err  string
+	}{
+		{
+			name: "invalid timestamp",
+			file: "roots.json",
+			data: `{"soon":"0x0100000000000000000000000000000000000000000000000000000000000000"}`,
+			err:  `beaconoracle: invalid timestamp "soon"`,
+		},
+		{
+			name: "invalid root",
+			file: "roots.json",
+			data: `{"1":1}`,
+			err:  "beaconoracle: not a header response or a map of timestamps to roots: json: cannot unmarshal non-string into Go value of type common.Hash",
+		},
+		{
+			name: "not ssz",
+			file: "header.ssz",
+			data: "header",
+			err:  "beaconoracle: not a beacon block header, block or state",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), tt.file)
+			if err := os.WriteFile(path, []byte(tt.data), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			err := beaconoracle.NewArchive().LoadFile(path, genesisTime)
+			if want := path + ": " + tt.err; err == nil || err.Error() != want {
+				t.Errorf("err = %v, want %q", err, want)
+			}
+		})
+	}
+	if _, err := beaconoracle.Load(genesisTime, filepath.Join(t.TempDir(), "missing")); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("loaded a missing directory: err = %v, want %v", err, os.ErrNotExist)
+	}
+}
@@ -0,0 +1,104 @@
+package beaconoracle
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	ibeaconchainoracle "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/IBeaconChainOracle"
+)
+
+// MockABI is the ABI of the mock oracle: IBeaconChainOracle and a setter
+// anyone may call, as with src/test/mocks/BeaconChainOracleMock.sol.
+const MockABI = `[
+	{"type":"function","name":"timestampToBlockRoot","stateMutability":"view","inputs":[{"name":"timestamp","type":"uint256"}],"outputs":[{"name":"","type":"bytes32"}]},
+	{"type":"function","name":"setBlockRoot","stateMutability":"nonpayable","inputs":[{"name":"timestamp","type":"uint256"},{"name":"blockRoot","type":"bytes32"}],"outputs":[]}
+]`
+
+// mockBin is the creation code of the mock oracle, which keeps the root of
+// each timestamp in the storage slot numbered by the timestamp. Assembled
+// by hand so it deploys without a compiler:
+//
+//	6033 80 600b 6000 39 6000 f3                 return the 0x33 bytes of runtime code
+//	6000 35 60e0 1c                              selector
+//	80 63643599f2 14 601d 57                     timestampToBlockRoot(uint256)
+//	63825904e5 14 602a 57                        setBlockRoot(uint256,bytes32)
+//	6000 80 fd                                   revert otherwise
+//	5b 6004 35 54 6000 52 6020 6000 f3           return sload(timestamp)
+//	5b 6024 35 6004 35 55 00                     sstore(timestamp, blockRoot)
+var mockBin = common.FromHex("0x603380600b6000396000f3" +
+	"600035" + "60e01c" +
+	"8063643599f214601d57" +
+	"63825904e514602a57" +
+	"600080fd" +
+	"5b600435546000526020" + "6000f3" +
+	"5b602435600435" + "5500")
+
+// Mock is a deployed mock oracle.
+type Mock struct {
+	*ibeaconchainoracle.IBeaconChainOracleCaller
+	address  common.Address
+	contract *bind.BoundContract
+}
+
+var _ ibeaconchainoracle.IBeaconChainOracleReader = (*Mock)(nil)
+
+// DeployMock deploys a mock oracle serving no roots.
+func DeployMock(opts *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *Mock, error) {
+	parsed, err := abi.JSON(strings.NewReader(MockABI))
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	address, tx, contract, err := bind.DeployContract(opts, parsed, mockBin, backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	caller, err := ibeaconchainoracle.NewIBeaconChainOracleCaller(address, backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &Mock{IBeaconChainOracleCaller: caller, address: address, contract: contract}, nil
+}
+
+// NewMock binds the mock oracle deployed at address.
+func NewMock(address common.Address, backend bind.ContractBackend) (*Mock, error) {
+	parsed, err := abi.JSON(strings.NewReader(MockABI))
+	if err != nil {
+		return nil, err
+	}
+	caller, err := ibeaconchainoracle.NewIBeaconChainOracleCaller(address, backend)
+	if err != nil {
+		return nil, err
+	}
+	contract := bind.NewBoundContract(address, parsed, backend, backend, backend)
+	return &Mock{IBeaconChainOracleCaller: caller, address: address, contract: contract}, nil
+}
+
+// Address returns the address of the mock.
+func (m *Mock) Address() common.Address {
+	return m.address
+}
+
+// SetBlockRoot serves root at timestamp.
+func (m *Mock) SetBlockRoot(opts *bind.TransactOpts, timestamp uint64, root common.Hash) (*types.Transaction, error) {
+	return m.contract.Transact(opts, "setBlockRoot", new(big.Int).SetUint64(timestamp), root)
+}
+
+// Publish serves every root of the archive, one transaction per root in
+// increasing timestamp order.
+func (m *Mock) Publish(opts *bind.TransactOpts, a *Archive) ([]*types.Transaction, error) {
+	var txs []*types.Transaction
+	for _, ts := range a.Timestamps() {
+		root, _ := a.BlockRoot(ts)
+		tx, err := m.SetBlockRoot(opts, ts, root)
+		if err != nil {
+			return txs, err
+		}
+		txs = append(txs, tx)
+	}
+	return txs, nil
+}
@@ -0,0 +1,210 @@
+// Package beaconproofs is a Go port of the verification functions of
+// src/contracts/libraries/BeaconChainProofs.sol, whose functions are all
+// internal and so absent from its binding. Proofs can be checked with it
+// before paying gas for EigenPod.VerifyWithdrawalCredentials,
+// VerifyBalanceUpdates and VerifyAndProcessWithdrawals: a proof it accepts
+// passes the library, and a proof it rejects fails with the library's
+// revert reason, matching the sentinels of pkg/reverts. ValidatorFields and
+// WithdrawalFields port the library's getters.
+//
+// Withdrawal proofs are laid out for the execution payload header of their
+// block's fork, which the pod picks by EigenPodManager.denebForkTimestamp.
+// A ForkSchedule, read from a pod manager with ReadForkSchedule or taken
+// from MainnetSchedule, HoleskySchedule or GoerliSchedule, picks the same
+// layout, and its VerifyWithdrawal fails with a ForkMismatchError rather
+// than a length check for a proof laid out for the wrong fork. A Capella
+// withdrawal proven against a Deneb state keeps the Capella layout.
+package beaconproofs
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/constants"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/merkle"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/reverts"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/types"
+)
+
+// The tree heights and field indices of the beacon chain containers, as
+// declared by the library.
+const (
+	blockHeaderFieldTreeHeight = constants.BeaconChainProofsBeaconBlockHeaderFieldTreeHeight
+	blockBodyFieldTreeHeight   = constants.BeaconChainProofsBeaconBlockBodyFieldTreeHeight
+	stateFieldTreeHeight       = constants.BeaconChainProofsBeaconStateFieldTreeHeight
+	validatorFieldTreeHeight   = constants.BeaconChainProofsValidatorFieldTreeHeight
+	validatorTreeHeight        = constants.BeaconChainProofsValidatorTreeHeight
+	blockRootsTreeHeight       = constants.BeaconChainProofsBlockRootsTreeHeight
+	historicalSummariesHeight  = constants.BeaconChainProofsHistoricalSummariesTreeHeight
+	withdrawalFieldTreeHeight  = constants.BeaconChainProofsWithdrawalFieldTreeHeight
+	withdrawalsTreeHeight      = constants.BeaconChainProofsWithdrawalsTreeHeight
+	payloadHeaderHeightCapella = constants.BeaconChainProofsExecutionPayloadHeaderFieldTreeHeightCapella
+	payloadHeaderHeightDeneb   = constants.BeaconChainProofsExecutionPayloadHeaderFieldTreeHeightDeneb
+	blockSummaryRootIndex      = constants.BeaconChainProofsBlockSummaryRootIndex
+	executionPayloadIndex      = constants.BeaconChainProofsExecutionPayloadIndex
+	slotIndex                  = constants.BeaconChainProofsSlotIndex
+	stateRootIndex             = constants.BeaconChainProofsStateRootIndex
+	bodyRootIndex              = constants.BeaconChainProofsBodyRootIndex
+	validatorTreeRootIndex     = constants.BeaconChainProofsValidatorTreeRootIndex
+	historicalSummariesIndex   = constants.BeaconChainProofsHistoricalSummariesIndex
+	timestampIndex             = constants.BeaconChainProofsTimestampIndex
+	withdrawalsIndex           = constants.BeaconChainProofsWithdrawalsIndex
+	slotsPerEpoch              = constants.BeaconChainProofsSlotsPerEpoch
+)
+
+// MaxValidatorIndex is the largest validator index the library takes, as
+// a uint40.
+const MaxValidatorIndex = 1<<40 - 1
+
+// VerifyValidatorFields checks validatorFieldsProof of the fields of the
+// validator at validatorIndex against beaconStateRoot, as
+// BeaconChainProofs.verifyValidatorFields does.
+func VerifyValidatorFields(beaconStateRoot common.Hash, validatorFields []common.Hash, validatorFieldsProof []byte, validatorIndex uint64) error {
+	if validatorIndex > MaxValidatorIndex {
+		return fmt.Errorf("beaconproofs: validator index %d does not fit in a uint40", validatorIndex)
+	}
+	if len(validatorFields) != 1<<validatorFieldTreeHeight {
+		return reverts.FromReason("BeaconChainProofs.verifyValidatorFields: Validator fields has incorrect length")
+	}
+	// The validator list root is mixed in with the list's length, one more
+	// level than the validator tree itself.
+	if len(validatorFieldsProof) != 32*((validatorTreeHeight+1)+stateFieldTreeHeight) {
+		return reverts.FromReason("BeaconChainProofs.verifyValidatorFields: Proof has incorrect length")
+	}
+	index := uint64(validatorTreeRootIndex)<<(validatorTreeHeight+1) | validatorIndex
+	validatorRoot, err := merkle.MerkleizeSha256(validatorFields)
+	if err != nil {
+		return err
+	}
+	return verify(validatorFieldsProof, beaconStateRoot, validatorRoot, index,
+		"BeaconChainProofs.verifyValidatorFields: Invalid merkle proof")
+}
+
+// VerifyStateRootAgainstLatestBlockRoot checks stateRootProof of
+// beaconStateRoot against the root of the latest block header, as
+// BeaconChainProofs.verifyStateRootAgainstLatestBlockRoot does.
+func VerifyStateRootAgainstLatestBlockRoot(latestBlockRoot, beaconStateRoot common.Hash, stateRootProof []byte) error {
+	if len(stateRootProof) != 32*blockHeaderFieldTreeHeight {
+		return reverts.FromReason("BeaconChainProofs.verifyStateRootAgainstLatestBlockRoot: Proof has incorrect length")
+	}
+	return verify(stateRootProof, latestBlockRoot, beaconStateRoot, stateRootIndex,
+		"BeaconChainProofs.verifyStateRootAgainstLatestBlockRoot: Invalid latest block header root merkle proof")
+}
+
+// VerifyStateRootProof is VerifyStateRootAgainstLatestBlockRoot of a
+// StateRootProof as the EigenPod takes it.
+func VerifyStateRootProof(latestBlockRoot common.Hash, proof types.StateRootProof) error {
+	return VerifyStateRootAgainstLatestBlockRoot(latestBlockRoot, proof.BeaconStateRoot, proof.Proof)
+}
+
+// VerifyWithdrawal checks withdrawalProof of the withdrawal with
+// withdrawalFields against beaconStateRoot, as
+// BeaconChainProofs.verifyWithdrawal does. Withdrawals whose timestamp is
+// before denebForkTimestamp are proven against the Capella execution
+// payload header, later ones against the Deneb header, which has one more
+// level.
+func VerifyWithdrawal(beaconStateRoot common.Hash, withdrawalFields []common.Hash, withdrawalProof types.WithdrawalProof, denebForkTimestamp uint64) error {
+	const reason = "BeaconChainProofs.verifyWithdrawal: "
+	p := withdrawalProof
+	if len(withdrawalFields) != 1<<withdrawalFieldTreeHeight {
+		return reverts.FromReason(reason + "withdrawalFields has incorrect length")
+	}
+	if p.BlockRootIndex >= 1<<blockRootsTreeHeight {
+		return reverts.FromReason(reason + "blockRootIndex is too large")
+	}
+	if p.WithdrawalIndex >= 1<<withdrawalsTreeHeight {
+		return reverts.FromReason(reason + "withdrawalIndex is too large")
+	}
+	if p.HistoricalSummaryIndex >= 1<<historicalSummariesHeight {
+		return reverts.FromReason(reason + "historicalSummaryIndex is too large")
+	}
+
+	headerHeight := ExecutionPayloadHeaderFieldTreeHeight(WithdrawalTimestamp(p), denebForkTimestamp)
+	if len(p.WithdrawalProof) != 32*(headerHeight+withdrawalsTreeHeight+1) {
+		return reverts.FromReason(reason + "withdrawalProof has incorrect length")
+	}
+	if len(p.ExecutionPayloadProof) != 32*(blockHeaderFieldTreeHeight+blockBodyFieldTreeHeight) {
+		return reverts.FromReason(reason + "executionPayloadProof has incorrect length")
+	}
+	if len(p.SlotProof) != 32*blockHeaderFieldTreeHeight {
+		return reverts.FromReason(reason + "slotProof has incorrect length")
+	}
+	if len(p.TimestampProof) != 32*headerHeight {
+		return reverts.FromReason(reason + "timestampProof has incorrect length")
+	}
+	if len(p.HistoricalSummaryBlockRootProof) != 32*(stateFieldTreeHeight+(historicalSummariesHeight+1)+1+blockRootsTreeHeight) {
+		return reverts.FromReason(reason + "historicalSummaryBlockRootProof has incorrect length")
+	}
+
+	// The historical summaries list is mixed in with its length, and the
+	// extra level below it picks block_summary_root out of the summary.
+	historicalBlockHeaderIndex := uint64(historicalSummariesIndex)<<((historicalSummariesHeight+1)+1+blockRootsTreeHeight) |
+		p.HistoricalSummaryIndex<<(1+blockRootsTreeHeight) |
+		uint64(blockSummaryRootIndex)<<blockRootsTreeHeight |
+		p.BlockRootIndex
+	if err := verify(p.HistoricalSummaryBlockRootProof, beaconStateRoot, p.BlockRoot, historicalBlockHeaderIndex,
+		reason+"Invalid historicalsummary merkle proof"); err != nil {
+		return err
+	}
+	if err := verify(p.SlotProof, p.BlockRoot, p.SlotRoot, slotIndex,
+		reason+"Invalid slot merkle proof"); err != nil {
+		return err
+	}
+	payloadIndex := uint64(bodyRootIndex)<<blockBodyFieldTreeHeight | executionPayloadIndex
+	if err := verify(p.ExecutionPayloadProof, p.BlockRoot, p.ExecutionPayloadRoot, payloadIndex,
+		reason+"Invalid executionPayload merkle proof"); err != nil {
+		return err
+	}
+	if err := verify(p.TimestampProof, p.ExecutionPayloadRoot, p.TimestampRoot, timestampIndex,
+		reason+"Invalid timestamp merkle proof"); err != nil {
+		return err
+	}
+	// The withdrawals list is mixed in with its length.
+	withdrawalIndex := uint64(withdrawalsIndex)<<(withdrawalsTreeHeight+1) | p.WithdrawalIndex
+	withdrawalRoot, err := merkle.MerkleizeSha256(withdrawalFields)
+	if err != nil {
+		return err
+	}
+	return verify(p.WithdrawalProof, p.ExecutionPayloadRoot, withdrawalRoot, withdrawalIndex,
+		reason+"Invalid withdrawal merkle proof")
+}
+
+// ExecutionPayloadHeaderFieldTreeHeight returns the height of the execution
+// payload header of a block with the given timestamp: that of Capella
+// before denebForkTimestamp, and that of Deneb, which grew from 15 to 17
+// fields, from it on.
+func ExecutionPayloadHeaderFieldTreeHeight(timestamp, denebForkTimestamp uint64) int {
+	return ForkSchedule{DenebForkTimestamp: denebForkTimestamp}.ForkAt(timestamp).ExecutionPayloadHeaderFieldTreeHeight()
+}
+
+// WithdrawalTimestamp returns the timestamp of the block a withdrawal proof
+// is for, as BeaconChainProofs.getWithdrawalTimestamp does.
+func WithdrawalTimestamp(p types.WithdrawalProof) uint64 {
+	return FromLittleEndianUint64(p.TimestampRoot)
+}
+
+// WithdrawalEpoch returns the epoch of the slot a withdrawal proof is for,
+// as BeaconChainProofs.getWithdrawalEpoch does.
+func WithdrawalEpoch(p types.WithdrawalProof) uint64 {
+	return FromLittleEndianUint64(p.SlotRoot) / slotsPerEpoch
+}
+
+// FromLittleEndianUint64 decodes the SSZ uint64 leading a leaf, as
+// Endian.fromLittleEndianUint64 does.
+func FromLittleEndianUint64(leaf common.Hash) uint64 {
+	return binary.LittleEndian.Uint64(leaf[:8])
+}
+
+// verify checks a sha256 inclusion proof, failing with reason.
+func verify(proof []byte, root, leaf common.Hash, index uint64, reason string) error {
+	ok, err := merkle.VerifyInclusionSha256(proof, root, leaf, index)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return reverts.FromReason(reason)
+	}
+	return nil
+}
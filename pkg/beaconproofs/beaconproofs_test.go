@@ -0,0 +1,26 @@
+package beaconproofs_test
+
+import (
+	"testing"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/beaconproofs"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/beaconproofs/beaconproofstest"
+)
+
+func TestFixturesVerify(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		schedule beaconproofs.ForkSchedule
+	}{
+		{"fixtures", beaconproofstest.Schedule},
+		// Every withdrawal is laid out for Capella, as before the Deneb
+		// fork timestamp is set.
+		{"capella", beaconproofs.UnsetSchedule},
+		// Every withdrawal is laid out for Deneb.
+		{"deneb", beaconproofs.ForkSchedule{DenebForkTimestamp: 0}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			beaconproofstest.AssertFixturesVerify(t, "../..", tt.schedule)
+		})
+	}
+}
@@ -0,0 +1,99 @@
+// Package beaconproofstest checks pkg/beaconproofs against the beacon chain
+// proof fixtures the Solidity tests use, in src/test/test-data.
+//
+// go test ./pkg/beaconproofs runs AssertFixturesVerify under the fixtures'
+// own schedule, and under all-Capella and all-Deneb ones.
+package beaconproofstest
+
+import (
+	"errors"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/beaconproofs"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/prooffile"
+)
+
+// FixtureDir is the directory of the fixtures, relative to the checkout.
+const FixtureDir = "src/test/test-data"
+
+// Schedule is the Goerli fork schedule the withdrawal fixtures were
+// generated against, whose Deneb fork timestamp is
+// DENEB_FORK_TIMESTAMP_GOERLI of src/test/EigenPod.t.sol.
+var Schedule = beaconproofs.GoerliSchedule
+
+// AssertFixturesVerify fails t unless every proof in the fixtures of the
+// checkout at root verifies: the validator fields against the state root,
+// the state root against the latest block root and, for withdrawal
+// fixtures, the withdrawal against the state root under schedule if it
+// places the withdrawal in the same fork as Schedule does, and otherwise
+// fails with a fork mismatch. Under Schedule itself, each withdrawal must
+// also fail under a schedule placing it in the other fork. As in the pod,
+// the validator of a withdrawal is the one its fields name. Files holding
+// no proofs are ignored.
+func AssertFixturesVerify(t testing.TB, root string, schedule beaconproofs.ForkSchedule) {
+	t.Helper()
+	files, err := prooffile.LoadDir(filepath.Join(root, FixtureDir))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) == 0 {
+		t.Fatalf("no proof fixtures in %s", filepath.Join(root, FixtureDir))
+	}
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		f := files[name]
+		validatorIndex := f.ValidatorIndex
+		if f.IsWithdrawal() {
+			var err error
+			if validatorIndex, err = beaconproofs.WithdrawalFields(f.WithdrawalFields).ValidatorIndex(); err != nil {
+				t.Errorf("%s: %v", name, err)
+				continue
+			}
+		}
+		if err := beaconproofs.VerifyValidatorFields(f.BeaconStateRoot, f.ValidatorFields, f.ValidatorFieldsProof(), validatorIndex); err != nil {
+			t.Errorf("%s: validator %d: %v", name, validatorIndex, err)
+		}
+
+		switch {
+		case f.StateRootAgainstLatestBlockHeaderProof != nil:
+			if err := beaconproofs.VerifyStateRootProof(f.LatestBlockHeaderRoot, f.ToStateRootProof().Canonical()); err != nil {
+				t.Errorf("%s: state root: %v", name, err)
+			}
+		case f.LatestBlockHeaderProof != nil:
+			t.Logf("%s: not checking the state root, whose proof predates the library", name)
+		default:
+			t.Errorf("%s: no state root proof", name)
+		}
+
+		if !f.IsWithdrawal() {
+			continue
+		}
+		proof := f.ToWithdrawalProof().Canonical()
+		timestamp := beaconproofs.WithdrawalTimestamp(proof)
+		err := schedule.VerifyWithdrawal(f.BeaconStateRoot, f.WithdrawalFields, proof)
+		switch fork := Schedule.ForkAt(timestamp); {
+		case schedule.ForkAt(timestamp) == fork && err != nil:
+			t.Errorf("%s: %s withdrawal: %v", name, fork, err)
+		case schedule.ForkAt(timestamp) != fork && !errors.Is(err, beaconproofs.ErrForkMismatch):
+			t.Errorf("%s: %s withdrawal under a %s schedule: got %v, want a fork mismatch", name, fork, schedule.ForkAt(timestamp), err)
+		}
+		if schedule != Schedule {
+			continue
+		}
+		// Moving the fork across the withdrawal's timestamp makes its
+		// layout the wrong one.
+		moved := beaconproofs.ForkSchedule{DenebForkTimestamp: timestamp}
+		if Schedule.ForkAt(timestamp) == beaconproofs.Deneb {
+			moved.DenebForkTimestamp++
+		}
+		if err := moved.VerifyWithdrawal(f.BeaconStateRoot, f.WithdrawalFields, proof); !errors.Is(err, beaconproofs.ErrForkMismatch) {
+			t.Errorf("%s: withdrawal with the Deneb fork at %d: got %v, want a fork mismatch", name, moved.DenebForkTimestamp, err)
+		}
+	}
+}
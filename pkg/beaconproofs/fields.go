@@ -0,0 +1,166 @@
+package beaconproofs
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/constants"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/reverts"
+)
+
+// The indices of the validator and withdrawal fields, the ones the library
+// declares and those of the remaining validator fields, which it only
+// lists.
+const (
+	validatorPubkeyIndex                = constants.BeaconChainProofsValidatorPubkeyIndex
+	validatorWithdrawalCredentialsIndex = constants.BeaconChainProofsValidatorWithdrawalCredentialsIndex
+	validatorBalanceIndex               = constants.BeaconChainProofsValidatorBalanceIndex
+	validatorSlashedIndex               = 3
+	validatorActivationEligibilityIndex = 4
+	validatorActivationEpochIndex       = 5
+	validatorExitEpochIndex             = 6
+	validatorWithdrawableEpochIndex     = constants.BeaconChainProofsValidatorWithdrawableEpochIndex
+
+	withdrawalIndexIndex          = 0
+	withdrawalValidatorIndexIndex = constants.BeaconChainProofsWithdrawalValidatorIndexIndex
+	withdrawalAddressIndex        = 2
+	withdrawalAmountIndex         = constants.BeaconChainProofsWithdrawalValidatorAmountIndex
+)
+
+// ErrFieldCount is returned by the getters of ValidatorFields and
+// WithdrawalFields when there are not as many fields as the SSZ tree of a
+// validator or withdrawal has leaves.
+var ErrFieldCount = errors.New("beaconproofs: wrong number of fields")
+
+// FarFutureEpoch is the epoch of a validator that has not yet reached it,
+// such as the exit epoch of a validator that has not exited.
+const FarFutureEpoch = 1<<64 - 1
+
+// ValidatorFields are the fields of a validator as the pod takes them, the
+// leaves of the validator's SSZ tree. Its getters index it as the library
+// does, and fail with ErrFieldCount unless it has as many fields as
+// VerifyValidatorFields requires.
+type ValidatorFields []common.Hash
+
+func (v ValidatorFields) field(index int) (common.Hash, error) {
+	if len(v) != 1<<validatorFieldTreeHeight {
+		return common.Hash{}, fmt.Errorf("%w: validator has %d, want %d", ErrFieldCount, len(v), 1<<validatorFieldTreeHeight)
+	}
+	return v[index], nil
+}
+
+func (v ValidatorFields) uint64Field(index int) (uint64, error) {
+	f, err := v.field(index)
+	return FromLittleEndianUint64(f), err
+}
+
+// PubkeyHash returns the hash of the validator's BLS public key, as
+// BeaconChainProofs.getPubkeyHash does. The pod keys its validators by it.
+func (v ValidatorFields) PubkeyHash() (common.Hash, error) {
+	return v.field(validatorPubkeyIndex)
+}
+
+// WithdrawalCredentials returns the validator's withdrawal credentials, as
+// BeaconChainProofs.getWithdrawalCredentials does.
+func (v ValidatorFields) WithdrawalCredentials() (common.Hash, error) {
+	return v.field(validatorWithdrawalCredentialsIndex)
+}
+
+// EffectiveBalanceGwei returns the validator's effective balance, as
+// BeaconChainProofs.getEffectiveBalanceGwei does.
+func (v ValidatorFields) EffectiveBalanceGwei() (uint64, error) {
+	return v.uint64Field(validatorBalanceIndex)
+}
+
+// Slashed reports whether the validator was slashed.
+func (v ValidatorFields) Slashed() (bool, error) {
+	f, err := v.field(validatorSlashedIndex)
+	return f[0] != 0, err
+}
+
+// ActivationEligibilityEpoch returns the epoch the validator became
+// eligible for activation.
+func (v ValidatorFields) ActivationEligibilityEpoch() (uint64, error) {
+	return v.uint64Field(validatorActivationEligibilityIndex)
+}
+
+// ActivationEpoch returns the epoch the validator became active, or
+// FarFutureEpoch.
+func (v ValidatorFields) ActivationEpoch() (uint64, error) {
+	return v.uint64Field(validatorActivationEpochIndex)
+}
+
+// ExitEpoch returns the epoch the validator exits, or FarFutureEpoch.
+func (v ValidatorFields) ExitEpoch() (uint64, error) {
+	return v.uint64Field(validatorExitEpochIndex)
+}
+
+// WithdrawableEpoch returns the epoch from which the validator's balance is
+// withdrawn in full, or FarFutureEpoch, as
+// BeaconChainProofs.getWithdrawableEpoch does.
+func (v ValidatorFields) WithdrawableEpoch() (uint64, error) {
+	return v.uint64Field(validatorWithdrawableEpochIndex)
+}
+
+// WithdrawalFields are the fields of a withdrawal as the pod takes them,
+// the leaves of the withdrawal's SSZ tree. Its getters index it as the
+// library does, and fail with ErrFieldCount unless it has as many fields
+// as VerifyWithdrawal requires.
+type WithdrawalFields []common.Hash
+
+func (w WithdrawalFields) field(index int) (common.Hash, error) {
+	if len(w) != 1<<withdrawalFieldTreeHeight {
+		return common.Hash{}, fmt.Errorf("%w: withdrawal has %d, want %d", ErrFieldCount, len(w), 1<<withdrawalFieldTreeHeight)
+	}
+	return w[index], nil
+}
+
+// Index returns the withdrawal's index, counting every withdrawal of the
+// beacon chain.
+func (w WithdrawalFields) Index() (uint64, error) {
+	f, err := w.field(withdrawalIndexIndex)
+	return FromLittleEndianUint64(f), err
+}
+
+// ValidatorIndex returns the index of the withdrawing validator, truncated
+// to a uint40 as BeaconChainProofs.getValidatorIndex does.
+func (w WithdrawalFields) ValidatorIndex() (uint64, error) {
+	f, err := w.field(withdrawalValidatorIndexIndex)
+	return FromLittleEndianUint64(f) & MaxValidatorIndex, err
+}
+
+// Address returns the execution address the withdrawal is paid to.
+func (w WithdrawalFields) Address() (common.Address, error) {
+	f, err := w.field(withdrawalAddressIndex)
+	return common.BytesToAddress(f[:common.AddressLength]), err
+}
+
+// AmountGwei returns the amount withdrawn, as
+// BeaconChainProofs.getWithdrawalAmountGwei does.
+func (w WithdrawalFields) AmountGwei() (uint64, error) {
+	f, err := w.field(withdrawalAmountIndex)
+	return FromLittleEndianUint64(f), err
+}
+
+// HashValidatorBLSPubkey returns the hash of a 48-byte BLS public key that
+// ValidatorFields.PubkeyHash returns, its SSZ root, as
+// BeaconChainProofs.hashValidatorBLSPubkey does.
+func HashValidatorBLSPubkey(pubkey []byte) (common.Hash, error) {
+	if len(pubkey) != 48 {
+		return common.Hash{}, reverts.FromReason("Input should be 48 bytes in length")
+	}
+	return sha256.Sum256(append(append([]byte(nil), pubkey...), make([]byte, 16)...)), nil
+}
+
+// PodWithdrawalCredentials returns the withdrawal credentials of a
+// validator withdrawing to pod, which the pod requires its validators to
+// have: the 0x01 prefix followed by the pod's address.
+func PodWithdrawalCredentials(pod common.Address) common.Hash {
+	var c common.Hash
+	c[0] = 0x01
+	copy(c[12:], pod.Bytes())
+	return c
+}
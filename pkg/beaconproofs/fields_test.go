@@ -0,0 +1,259 @@
+package beaconproofs_test
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/beaconproofs"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/beaconproofs/beaconproofstest"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/prooffile"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/reverts"
+)
+
+// pubkey is the BLS public key of validator 302913, which the fixtures
+// prove.
+var pubkey = common.FromHex("0x93a0dd04ccddf3f1b419fdebf99481a2182c17d67cf14d32d6e50fc4bf8effc8db4a04b7c2f3a5975c1b9b74e2841888")
+
+func fixture(t *testing.T, name string) *prooffile.File {
+	t.Helper()
+	f, err := prooffile.Load(filepath.Join("../..", beaconproofstest.FixtureDir, name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return f
+}
+
+// validator is what the getters of ValidatorFields read.
+type validator struct {
+	pubkeyHash, credentials                      common.Hash
+	balanceGwei                                  uint64
+	slashed                                      bool
+	eligibilityEpoch, activationEpoch, exitEpoch uint64
+	withdrawableEpoch                            uint64
+}
+
+// readValidator reads v with each getter, returning the error of each.
+func readValidator(v beaconproofs.ValidatorFields) (validator, []error) {
+	var r validator
+	errs := make([]error, 8)
+	r.pubkeyHash, errs[0] = v.PubkeyHash()
+	r.credentials, errs[1] = v.WithdrawalCredentials()
+	r.balanceGwei, errs[2] = v.EffectiveBalanceGwei()
+	r.slashed, errs[3] = v.Slashed()
+	r.eligibilityEpoch, errs[4] = v.ActivationEligibilityEpoch()
+	r.activationEpoch, errs[5] = v.ActivationEpoch()
+	r.exitEpoch, errs[6] = v.ExitEpoch()
+	r.withdrawableEpoch, errs[7] = v.WithdrawableEpoch()
+	return r, errs
+}
+
+func TestValidatorFields(t *testing.T) {
+	pubkeyHash, err := beaconproofs.HashValidatorBLSPubkey(pubkey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name   string
+		fields func(t *testing.T) []common.Hash
+		want   validator
+		err    string
+	}{
+		{
+			name: "active",
+			fields: func(t *testing.T) []common.Hash {
+				return fixture(t, "withdrawal_credential_proof_302913.json").ValidatorFields
+			},
+			want: validator{
+				pubkeyHash:        pubkeyHash,
+				credentials:       common.HexToHash("0x01000000000000000000000049c486e3f4303bc11c02f952fe5b08d0ab22d443"),
+				balanceGwei:       32000115173,
+				eligibilityEpoch:  91626,
+				activationEpoch:   91634,
+				exitEpoch:         beaconproofs.FarFutureEpoch,
+				withdrawableEpoch: beaconproofs.FarFutureEpoch,
+			},
+		},
+		{
+			name:   "withdrawable",
+			fields: func(t *testing.T) []common.Hash { return fixture(t, "fullWithdrawalProof_Latest.json").ValidatorFields },
+			want: validator{
+				pubkeyHash:       pubkeyHash,
+				credentials:      common.HexToHash("0x0100000000000000000000008e35f095545c56b07c942a4f3b055ef1ec4cb148"),
+				balanceGwei:      32e9,
+				eligibilityEpoch: 91626,
+				activationEpoch:  91634,
+				exitEpoch:        beaconproofs.FarFutureEpoch,
+			},
+		},
+		{
+			name: "too few fields",
+			fields: func(t *testing.T) []common.Hash {
+				return fixture(t, "withdrawal_credential_proof_302913.json").ValidatorFields[:7]
+			},
+			err: "beaconproofs: wrong number of fields: validator has 7, want 8",
+		},
+		{
+			name: "too many fields",
+			fields: func(t *testing.T) []common.Hash {
+				return append(fixture(t, "withdrawal_credential_proof_302913.json").ValidatorFields, common.Hash{})
+			},
+			err: "beaconproofs: wrong number of fields: validator has 9, want 8",
+		},
+		{
+			name:   "no fields",
+			fields: func(t *testing.T) []common.Hash { return nil },
+			err:    "beaconproofs: wrong number of fields: validator has 0, want 8",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, errs := readValidator(tt.fields(t))
+			checkErrs(t, errs, tt.err)
+			if tt.err == "" && got != tt.want {
+				t.Errorf("read %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+// checkErrs checks that every getter failed with ErrFieldCount and want, or
+// that none failed if want is empty.
+func checkErrs(t *testing.T, errs []error, want string) {
+	t.Helper()
+	for i, err := range errs {
+		switch {
+		case want == "" && err != nil:
+			t.Fatalf("getter %d: %v", i, err)
+		case want != "" && (!errors.Is(err, beaconproofs.ErrFieldCount) || err.Error() != want):
+			t.Fatalf("getter %d: err = %v, want %q", i, err, want)
+		}
+	}
+}
+
+// withdrawal is what the getters of WithdrawalFields read.
+type withdrawal struct {
+	index, validatorIndex uint64
+	address               common.Address
+	amountGwei            uint64
+}
+
+// readWithdrawal reads w with each getter, returning the error of each.
+func readWithdrawal(w beaconproofs.WithdrawalFields) (withdrawal, []error) {
+	var r withdrawal
+	errs := make([]error, 4)
+	r.index, errs[0] = w.Index()
+	r.validatorIndex, errs[1] = w.ValidatorIndex()
+	r.address, errs[2] = w.Address()
+	r.amountGwei, errs[3] = w.AmountGwei()
+	return r, errs
+}
+
+func TestWithdrawalFields(t *testing.T) {
+	address := common.HexToAddress("0x59b0d71688da01057c08e4c1baa8faa629819c2a")
+	tests := []struct {
+		name    string
+		fixture string
+		// fields, if set, edits the fields of the fixture.
+		fields func(w []common.Hash) []common.Hash
+		want   withdrawal
+		err    string
+	}{
+		{
+			name:    "full",
+			fixture: "fullWithdrawalProof_Latest.json",
+			want:    withdrawal{index: 15060549, validatorIndex: 302913, address: address, amountGwei: 32000115173},
+		},
+		{
+			name:    "full of 28 ETH",
+			fixture: "fullWithdrawalProof_Latest_28ETH.json",
+			want:    withdrawal{index: 15060549, validatorIndex: 302913, address: address, amountGwei: 28000115173},
+		},
+		{
+			name:    "partial",
+			fixture: "partialWithdrawalProof_Latest.json",
+			want:    withdrawal{index: 15060549, validatorIndex: 302913, address: address, amountGwei: 2119357},
+		},
+		{
+			// The fixture records validator index 0, but its withdrawal is
+			// of validator 302913.
+			name:    "deneb",
+			fixture: "fullWithdrawalDeneb.json",
+			want: withdrawal{
+				index:          27761125,
+				validatorIndex: 302913,
+				address:        common.HexToAddress("0xd982a5927741bfd9b8cf16234061d7a592ca2b1c"),
+				amountGwei:     32000115173,
+			},
+		},
+		{
+			name:    "too few fields",
+			fixture: "fullWithdrawalProof_Latest.json",
+			fields:  func(w []common.Hash) []common.Hash { return w[:3] },
+			err:     "beaconproofs: wrong number of fields: withdrawal has 3, want 4",
+		},
+		{
+			name:    "too many fields",
+			fixture: "fullWithdrawalProof_Latest.json",
+			fields:  func(w []common.Hash) []common.Hash { return append(w, common.Hash{}) },
+			err:     "beaconproofs: wrong number of fields: withdrawal has 5, want 4",
+		},
+		{
+			name:    "validator index over a uint40",
+			fixture: "fullWithdrawalProof_Latest.json",
+			fields: func(w []common.Hash) []common.Hash {
+				w = append([]common.Hash(nil), w...)
+				w[1][5] = 0xff
+				return w
+			},
+			want: withdrawal{index: 15060549, validatorIndex: 302913, address: address, amountGwei: 32000115173},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fields := fixture(t, tt.fixture).WithdrawalFields
+			if tt.fields != nil {
+				fields = tt.fields(fields)
+			}
+			got, errs := readWithdrawal(fields)
+			checkErrs(t, errs, tt.err)
+			if tt.err == "" && got != tt.want {
+				t.Errorf("read %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHashValidatorBLSPubkey(t *testing.T) {
+	tests := []struct {
+		name   string
+		pubkey []byte
+		want   common.Hash
+		err    error
+	}{
+		{
+			name:   "validator 302913",
+			pubkey: pubkey,
+			want:   fixture(t, "withdrawal_credential_proof_302913.json").ValidatorFields[0],
+		},
+		{name: "short", pubkey: pubkey[:47], err: reverts.ErrInputShouldBe48BytesInLength},
+		{name: "long", pubkey: append(common.CopyBytes(pubkey), 0), err: reverts.ErrInputShouldBe48BytesInLength},
+		{name: "empty", err: reverts.ErrInputShouldBe48BytesInLength},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := beaconproofs.HashValidatorBLSPubkey(tt.pubkey)
+			switch {
+			case tt.err == nil && err != nil:
+				t.Fatal(err)
+			case tt.err != nil && !errors.Is(err, tt.err):
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+			if got != tt.want {
+				t.Errorf("hash %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,138 @@
+package beaconproofs
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/types"
+)
+
+// Fork is a beacon chain fork whose execution payload header layout a
+// withdrawal proof follows.
+type Fork string
+
+const (
+	Capella Fork = "capella"
+	Deneb   Fork = "deneb"
+)
+
+// ExecutionPayloadHeaderFieldTreeHeight returns the height of the execution
+// payload header of the fork.
+func (f Fork) ExecutionPayloadHeaderFieldTreeHeight() int {
+	if f == Deneb {
+		return payloadHeaderHeightDeneb
+	}
+	return payloadHeaderHeightCapella
+}
+
+// ErrForkMismatch is matched by a ForkMismatchError.
+var ErrForkMismatch = errors.New("beaconproofs: withdrawal proof is not of the fork of its timestamp")
+
+// ForkMismatchError is returned for a withdrawal proof laid out for a fork
+// other than the one the fork schedule puts its timestamp in. The library
+// would reject it with no more than an incorrect proof length.
+type ForkMismatchError struct {
+	Timestamp uint64
+	// Want is the fork of Timestamp under the schedule, Got the fork of the
+	// proof's layout.
+	Want, Got Fork
+}
+
+func (e *ForkMismatchError) Error() string {
+	return fmt.Sprintf("beaconproofs: withdrawal at timestamp %d is a %s withdrawal, but its proof is laid out for %s", e.Timestamp, e.Want, e.Got)
+}
+
+// Unwrap returns ErrForkMismatch.
+func (e *ForkMismatchError) Unwrap() error {
+	return ErrForkMismatch
+}
+
+// ForkSchedule is when the forks the library distinguishes activate, as
+// EigenPodManager.denebForkTimestamp records it.
+type ForkSchedule struct {
+	DenebForkTimestamp uint64
+}
+
+// The fork schedules of the networks EigenLayer is deployed on, and that of
+// an EigenPodManager whose Deneb fork timestamp was never set, which
+// denebForkTimestamp reports as type(uint64).max.
+var (
+	MainnetSchedule = ForkSchedule{DenebForkTimestamp: 1710338135}
+	HoleskySchedule = ForkSchedule{DenebForkTimestamp: 1707305664}
+	GoerliSchedule  = ForkSchedule{DenebForkTimestamp: 1705473120}
+	UnsetSchedule   = ForkSchedule{DenebForkTimestamp: math.MaxUint64}
+)
+
+// Schedules are the network fork schedules by network name.
+var Schedules = map[string]ForkSchedule{
+	"mainnet": MainnetSchedule,
+	"holesky": HoleskySchedule,
+	"goerli":  GoerliSchedule,
+}
+
+// DenebForkTimestampReader is the part of the EigenPodManager binding, or
+// of its fake, that a ForkSchedule is read from.
+type DenebForkTimestampReader interface {
+	DenebForkTimestamp(opts *bind.CallOpts) (uint64, error)
+}
+
+// ReadForkSchedule returns the fork schedule the EigenPodManager verifies
+// withdrawals with.
+func ReadForkSchedule(opts *bind.CallOpts, manager DenebForkTimestampReader) (ForkSchedule, error) {
+	ts, err := manager.DenebForkTimestamp(opts)
+	if err != nil {
+		return ForkSchedule{}, fmt.Errorf("failed to read Deneb fork timestamp: %w", err)
+	}
+	return ForkSchedule{DenebForkTimestamp: ts}, nil
+}
+
+// ForkAt returns the fork of the block with the given timestamp.
+func (s ForkSchedule) ForkAt(timestamp uint64) Fork {
+	if timestamp < s.DenebForkTimestamp {
+		return Capella
+	}
+	return Deneb
+}
+
+// WithdrawalProofFork returns the fork whose execution payload header
+// withdrawalProof is laid out for, as told by the length of its timestamp
+// proof, the header's height.
+func WithdrawalProofFork(withdrawalProof types.WithdrawalProof) (Fork, error) {
+	for _, f := range []Fork{Capella, Deneb} {
+		if len(withdrawalProof.TimestampProof) == 32*f.ExecutionPayloadHeaderFieldTreeHeight() {
+			return f, nil
+		}
+	}
+	return "", fmt.Errorf("beaconproofs: timestamp proof of %d bytes is of no known execution payload header", len(withdrawalProof.TimestampProof))
+}
+
+// CheckWithdrawalFork returns a ForkMismatchError unless withdrawalProof is
+// laid out for the fork the schedule puts its timestamp in. A withdrawal is
+// proven in the layout of its own block, whatever the fork of the state it
+// is proven against: a Capella withdrawal proven against a Deneb state root
+// keeps the Capella layout.
+func (s ForkSchedule) CheckWithdrawalFork(withdrawalProof types.WithdrawalProof) error {
+	got, err := WithdrawalProofFork(withdrawalProof)
+	if err != nil {
+		return err
+	}
+	timestamp := WithdrawalTimestamp(withdrawalProof)
+	if want := s.ForkAt(timestamp); want != got {
+		return &ForkMismatchError{Timestamp: timestamp, Want: want, Got: got}
+	}
+	return nil
+}
+
+// VerifyWithdrawal is VerifyWithdrawal under the schedule, failing with a
+// ForkMismatchError, rather than the library's length check, for a proof
+// laid out for the wrong fork.
+func (s ForkSchedule) VerifyWithdrawal(beaconStateRoot common.Hash, withdrawalFields []common.Hash, withdrawalProof types.WithdrawalProof) error {
+	if err := s.CheckWithdrawalFork(withdrawalProof); err != nil {
+		return err
+	}
+	return VerifyWithdrawal(beaconStateRoot, withdrawalFields, withdrawalProof, s.DenebForkTimestamp)
+}
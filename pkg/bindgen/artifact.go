@@ -0,0 +1,105 @@
+package bindgen
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/storage"
+)
+
+// Artifact is the subset of a forge build artifact that is needed to generate
+// a Go binding.
+type Artifact struct {
+	// Name is the contract name, which is also used as the Go package name.
+	Name string
+	// ABI is the JSON encoded contract ABI, compacted but otherwise as forge
+	// wrote it. Whitespace inside strings such as "struct IStrategy.X" is
+	// kept, since abigen derives struct names from it.
+	ABI string
+	// Bytecode is the hex encoded creation bytecode, or empty for interfaces
+	// and abstract contracts.
+	Bytecode string
+	// StorageLayout is the storage layout solc reported, or nil if forge was
+	// not asked for it or the contract has no state variables.
+	StorageLayout *storage.Layout
+}
+
+// forgeArtifact mirrors the layout of out/<File>.sol/<Contract>.json.
+type forgeArtifact struct {
+	ABI      json.RawMessage `json:"abi"`
+	Bytecode struct {
+		Object string `json:"object"`
+	} `json:"bytecode"`
+	StorageLayout json.RawMessage `json:"storageLayout"`
+}
+
+// ArtifactPath returns the path forge writes the artifact for name to, under
+// the convention that a contract lives in a file of the same name.
+func ArtifactPath(artifactDir, name string) string {
+	return filepath.Join(artifactDir, name+".sol", name+".json")
+}
+
+// LoadArtifact reads the forge artifact for the named contract from
+// artifactDir.
+func LoadArtifact(artifactDir, name string) (*Artifact, error) {
+	path := ArtifactPath(artifactDir, name)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read artifact for %s: %w", name, err)
+	}
+	return ParseArtifact(name, data)
+}
+
+// ParseArtifact decodes a forge artifact for the named contract.
+func ParseArtifact(name string, data []byte) (*Artifact, error) {
+	var raw forgeArtifact
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("failed to decode artifact for %s: %w", name, err)
+	}
+	if len(raw.ABI) == 0 {
+		return nil, fmt.Errorf("artifact for %s has no abi", name)
+	}
+	var compact bytes.Buffer
+	if err := json.Compact(&compact, raw.ABI); err != nil {
+		return nil, fmt.Errorf("failed to compact abi of %s: %w", name, err)
+	}
+	bin := strings.TrimSpace(raw.Bytecode.Object)
+	if strings.Contains(bin, "__$") {
+		return nil, fmt.Errorf("artifact for %s has unlinked library references", name)
+	}
+	if bin == "0x" {
+		bin = ""
+	}
+	artifact := &Artifact{
+		Name:     name,
+		ABI:      compact.String(),
+		Bytecode: bin,
+	}
+	if len(raw.StorageLayout) > 0 && string(raw.StorageLayout) != "null" {
+		layout, err := storage.ParseLayout(raw.StorageLayout)
+		if err != nil {
+			return nil, fmt.Errorf("invalid storage layout for %s: %w", name, err)
+		}
+		if len(layout.Storage) > 0 {
+			artifact.StorageLayout = layout
+		}
+	}
+	return artifact, nil
+}
+
+// StripABI removes all whitespace from a JSON encoded ABI. This is the form
+// abigen embeds into a binding's MetaData, so hashes computed over it can be
+// compared against both artifacts and generated bindings.
+func StripABI(abi string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) {
+			return -1
+		}
+		return r
+	}, abi)
+}
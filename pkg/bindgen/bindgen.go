@@ -0,0 +1,487 @@
+// Package bindgen generates the Go bindings under pkg/bindings from forge
+// build artifacts.
+//
+// Each contract gets a package, and manifest.json records the ABI and
+// bytecode hash each package was generated from. The bindings of this
+// tree's release sit at pkg/bindings/<Contract>. An older release gets its
+// own set under pkg/bindings/<release> once its entry in Releases points at
+// a forge built checkout of its tag. The OpenZeppelin ProxyAdmin,
+// TransparentUpgradeableProxy and UpgradeableBeacon that deployments sit
+// behind are bound from the artifacts forge builds for them under lib/.
+//
+// Next to abigen's output, each package declares <Contract>Reader,
+// <Contract>Writer and <Contract>Events interfaces over its Caller,
+// Transactor and Filterer methods, converts its copies of shared structs to
+// the types of pkg/types, and embeds the contract's storage layout as
+// <Contract>StorageLayout. The internal constants, revert reasons and
+// events of src/contracts are extracted into pkg/constants, pkg/reverts
+// and the event list of each release.
+package bindgen
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+)
+
+const (
+	DefaultSourceDir   = "src/contracts"
+	DefaultArtifactDir = "out"
+	DefaultOutputDir   = "pkg/bindings"
+
+	// BindingFile is the name of the generated file in each binding package.
+	BindingFile = "binding.go"
+)
+
+// DefaultInclude lists contracts outside src/contracts that are bound unless
+// explicitly excluded: the OpenZeppelin proxies every deployment sits behind.
+var DefaultInclude = []string{"ProxyAdmin", "TransparentUpgradeableProxy", "UpgradeableBeacon"}
+
+// DefaultExclude lists contracts under src/contracts that are not bound
+// unless explicitly included.
+var DefaultExclude []string
+
+// Release is a contracts release whose bindings are generated side by side
+// with the others.
+type Release struct {
+	Name string
+	// Dir is the root of a forge built checkout of the release's tag, whose
+	// sources and artifacts an older release is generated from. It is empty
+	// for the release of this tree.
+	Dir string
+}
+
+// Releases lists the bound releases, oldest first. The last one is the
+// release of this tree, which the canonical types and constants follow. Its
+// binding packages are written to the output directory itself, keeping their
+// import paths stable across releases; those of an older release are written
+// to a subdirectory named after it.
+var Releases = []Release{
+	{Name: "v0_3"},
+}
+
+// LatestRelease returns the name of the release of this tree.
+func LatestRelease() string {
+	return Releases[len(Releases)-1].Name
+}
+
+// Package returns the name of the Go package holding the registry of the
+// release: bindings for the latest release and the release name otherwise.
+func (r Release) Package() string {
+	if r.Name == LatestRelease() {
+		return "bindings"
+	}
+	return r.Name
+}
+
+// FindRelease returns the release named name.
+func FindRelease(name string) (Release, bool) {
+	for _, r := range Releases {
+		if r.Name == name {
+			return r, true
+		}
+	}
+	return Release{}, false
+}
+
+// Config selects the contracts to bind and where to read their artifacts.
+type Config struct {
+	// SourceDir is walked for .sol files; every file name is taken to be a
+	// contract to bind.
+	SourceDir string
+	// ArtifactDir is the forge output directory.
+	ArtifactDir string
+	// Release names the entry of Releases to generate. Defaults to
+	// LatestRelease.
+	Release string
+	// Include names contracts to bind in addition to those found in
+	// SourceDir. Naming a contract in DefaultExclude opts it back in.
+	Include []string
+	// Exclude names contracts to skip.
+	Exclude []string
+	// ImportPath is the import path of the release's output directory, used
+	// by the generated registry. Defaults to DefaultImportPath for the latest
+	// release and DefaultImportPath/<Release> otherwise.
+	ImportPath string
+	// TypesImportPath is the import path of the canonical types package.
+	// Defaults to DefaultTypesImportPath.
+	TypesImportPath string
+	// StorageImportPath is the import path of the storage package. Defaults
+	// to DefaultStorageImportPath.
+	StorageImportPath string
+	// EventsImportPath is the import path of the events package. Defaults
+	// to DefaultEventsImportPath.
+	EventsImportPath string
+}
+
+// Binding is a single generated binding package.
+type Binding struct {
+	Name   string
+	ABI    string
+	Source string
+	// Converters is the source converting the package's struct copies to
+	// and from the canonical types, or empty if it declares none.
+	Converters string
+	// Interfaces is the source declaring the package's Reader, Writer and
+	// Events interfaces.
+	Interfaces string
+	// Layout is the source embedding the contract's storage layout, or
+	// empty if its artifact has none.
+	Layout string
+}
+
+// Result holds the output of a generation run.
+type Result struct {
+	Release  string
+	Bindings []Binding
+	Manifest *Manifest
+	// Registry is the source of the bindings package indexing every
+	// generated binding.
+	Registry string
+	// Events is the source listing the events of every deployable binding.
+	Events string
+	// Types is the source of the canonical struct types.
+	Types string
+	// Constants is the source of the constants package, or empty if no
+	// source directory was walked.
+	Constants string
+	// Reverts is the source of the revert catalogue of the reverts package,
+	// or empty if no source directory was walked.
+	Reverts string
+	// Releases is the source of the bindings package indexing every
+	// release.
+	Releases string
+}
+
+// release returns the release selected by cfg.
+func (cfg Config) release() (Release, error) {
+	name := cfg.Release
+	if name == "" {
+		name = LatestRelease()
+	}
+	r, ok := FindRelease(name)
+	if !ok {
+		return Release{}, fmt.Errorf("unknown release %s", name)
+	}
+	return r, nil
+}
+
+// Contracts returns the sorted names of the contracts selected by cfg.
+func (cfg Config) Contracts() ([]string, error) {
+	if _, err := cfg.release(); err != nil {
+		return nil, err
+	}
+	selected := make(map[string]bool)
+	if cfg.SourceDir != "" {
+		err := filepath.WalkDir(cfg.SourceDir, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if d.IsDir() || filepath.Ext(path) != ".sol" {
+				return nil
+			}
+			name := strings.TrimSuffix(d.Name(), ".sol")
+			if selected[name] {
+				return fmt.Errorf("duplicate contract name %s at %s", name, path)
+			}
+			selected[name] = true
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to walk %s: %w", cfg.SourceDir, err)
+		}
+	}
+	for _, name := range DefaultInclude {
+		selected[name] = true
+	}
+	included := make(map[string]bool)
+	for _, name := range cfg.Include {
+		included[name] = true
+		selected[name] = true
+	}
+	for _, name := range DefaultExclude {
+		if !included[name] {
+			delete(selected, name)
+		}
+	}
+	for _, name := range cfg.Exclude {
+		if included[name] {
+			return nil, fmt.Errorf("contract %s is both included and excluded", name)
+		}
+		delete(selected, name)
+	}
+	names := make([]string, 0, len(selected))
+	for name := range selected {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// Run generates the bindings of the release selected by cfg without
+// touching the output directory.
+func Run(cfg Config) (*Result, error) {
+	release, err := cfg.release()
+	if err != nil {
+		return nil, err
+	}
+	names, err := cfg.Contracts()
+	if err != nil {
+		return nil, err
+	}
+	storageImportPath := cfg.StorageImportPath
+	if storageImportPath == "" {
+		storageImportPath = DefaultStorageImportPath
+	}
+	eventsImportPath := cfg.EventsImportPath
+	if eventsImportPath == "" {
+		eventsImportPath = DefaultEventsImportPath
+	}
+	var set *SolidityConstants
+	if cfg.SourceDir != "" {
+		if set, err = ParseConstants(cfg.SourceDir); err != nil {
+			return nil, err
+		}
+	}
+	res := &Result{Release: release.Name, Manifest: new(Manifest)}
+	var (
+		layouts []string
+		events  []EventEntry
+	)
+	for _, name := range names {
+		artifact, err := LoadArtifact(cfg.ArtifactDir, name)
+		if err != nil {
+			return nil, err
+		}
+		src, err := Bind(artifact)
+		if err != nil {
+			return nil, err
+		}
+		ifaces, err := Interfaces(name, src)
+		if err != nil {
+			return nil, err
+		}
+		layout, err := LayoutSource(name, storageImportPath, artifact.StorageLayout)
+		if err != nil {
+			return nil, err
+		}
+		if layout != "" {
+			layouts = append(layouts, name)
+		}
+		// Without sources, every contract is taken to be deployable.
+		if set == nil || set.Deployable(name) {
+			entries, err := EventEntries(name, artifact.ABI)
+			if err != nil {
+				return nil, err
+			}
+			events = append(events, entries...)
+		}
+		res.Bindings = append(res.Bindings, Binding{Name: name, ABI: artifact.ABI, Source: src, Interfaces: ifaces, Layout: layout})
+		res.Manifest.Add(NewManifestEntry(name, artifact.ABI, artifact.Bytecode))
+	}
+	importPath := cfg.ImportPath
+	if importPath == "" {
+		importPath = DefaultImportPath
+		if release.Name != LatestRelease() {
+			importPath += "/" + release.Name
+		}
+	}
+	if res.Registry, err = Registry(release.Package(), importPath, storageImportPath, names, layouts); err != nil {
+		return nil, err
+	}
+	if res.Events, err = EventsSource(release.Package(), importPath, eventsImportPath, events); err != nil {
+		return nil, err
+	}
+	rootImportPath := importPath
+	if release.Name != LatestRelease() {
+		rootImportPath = path.Dir(importPath)
+	}
+	if res.Releases, err = ReleasesIndex(rootImportPath, storageImportPath, eventsImportPath); err != nil {
+		return nil, err
+	}
+	if err := res.canonicalize(cfg); err != nil {
+		return nil, err
+	}
+	if set != nil {
+		if res.Constants, err = ConstantsSource(set); err != nil {
+			return nil, err
+		}
+		reverts, err := ParseReverts(cfg.SourceDir)
+		if err != nil {
+			return nil, err
+		}
+		if res.Reverts, err = RevertsSource(reverts); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+// canonicalize renders the canonical struct types and the converters of
+// every binding declaring a copy of one.
+func (res *Result) canonicalize(cfg Config) error {
+	typesImportPath := cfg.TypesImportPath
+	if typesImportPath == "" {
+		typesImportPath = DefaultTypesImportPath
+	}
+	structs, err := Canonicalize(res.Bindings)
+	if err != nil {
+		return err
+	}
+	if res.Types, err = Types(structs); err != nil {
+		return err
+	}
+	for i := range res.Bindings {
+		b := &res.Bindings[i]
+		if b.Converters, err = Converters(b.Name, typesImportPath, structs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Bind renders the Go binding for an artifact, exactly as
+// `abigen --abi --bin --pkg <Name>` would.
+func Bind(artifact *Artifact) (string, error) {
+	src, err := bind.Bind(
+		[]string{artifact.Name},
+		[]string{artifact.ABI},
+		[]string{artifact.Bytecode},
+		nil,
+		artifact.Name,
+		bind.LangGo,
+		make(map[string]string),
+		make(map[string]string),
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate binding for %s: %w", artifact.Name, err)
+	}
+	return src, nil
+}
+
+// Write stores every binding of the latest release as
+// <outDir>/<Name>/binding.go, alongside its interfaces in interfaces.go,
+// converters in types.go and storage layout in layout.go, the registry as
+// <outDir>/metadata.go, the event list as <outDir>/events.go and the
+// manifest as <outDir>/manifest.json. Those of an older release are stored
+// the same way below <outDir>/<Release>. The index of all releases is stored
+// as <outDir>/releases.go. For the latest release it also stores the
+// canonical types as <typesDir>/structs.go and, if a source directory was
+// walked, the constants as <constantsDir>/constants.go and the revert
+// catalogue as <revertsDir>/catalogue.go.
+//
+// The packages of contracts listed in the manifest of a previous run but no
+// longer bound are removed.
+func (res *Result) Write(outDir, typesDir, constantsDir, revertsDir string) error {
+	if err := os.MkdirAll(outDir, 0o755); err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(outDir, ReleasesFile), []byte(res.Releases), 0o644); err != nil {
+		return err
+	}
+	if res.Release != LatestRelease() {
+		outDir = filepath.Join(outDir, res.Release)
+	}
+	if err := res.removeStale(outDir); err != nil {
+		return err
+	}
+	for _, b := range res.Bindings {
+		dir := filepath.Join(outDir, b.Name)
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return err
+		}
+		if err := os.WriteFile(filepath.Join(dir, BindingFile), []byte(b.Source), 0o644); err != nil {
+			return err
+		}
+		if err := os.WriteFile(filepath.Join(dir, InterfacesFile), []byte(b.Interfaces), 0o644); err != nil {
+			return err
+		}
+		if err := writeOrRemove(filepath.Join(dir, ConvertersFile), b.Converters); err != nil {
+			return err
+		}
+		if err := writeOrRemove(filepath.Join(dir, LayoutFile), b.Layout); err != nil {
+			return err
+		}
+	}
+	if err := os.WriteFile(filepath.Join(outDir, RegistryFile), []byte(res.Registry), 0o644); err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(outDir, EventsFile), []byte(res.Events), 0o644); err != nil {
+		return err
+	}
+	data, err := res.Manifest.Marshal()
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(outDir, ManifestFile), data, 0o644); err != nil {
+		return err
+	}
+	if res.Release != LatestRelease() {
+		return nil
+	}
+	if err := os.MkdirAll(typesDir, 0o755); err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(typesDir, TypesFile), []byte(res.Types), 0o644); err != nil {
+		return err
+	}
+	if res.Constants != "" {
+		if err := os.MkdirAll(constantsDir, 0o755); err != nil {
+			return err
+		}
+		if err := os.WriteFile(filepath.Join(constantsDir, ConstantsFile), []byte(res.Constants), 0o644); err != nil {
+			return err
+		}
+	}
+	if res.Reverts != "" {
+		if err := os.MkdirAll(revertsDir, 0o755); err != nil {
+			return err
+		}
+		if err := os.WriteFile(filepath.Join(revertsDir, RevertsFile), []byte(res.Reverts), 0o644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// removeStale removes the package directories under outDir of contracts the
+// manifest previously written there lists but res does not bind.
+func (res *Result) removeStale(outDir string) error {
+	prev, err := ReadManifest(filepath.Join(outDir, ManifestFile))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	for _, entry := range prev.Contracts {
+		if _, ok := res.Manifest.Entry(entry.Name); ok {
+			continue
+		}
+		if entry.Name == "" || entry.Name != filepath.Base(entry.Name) {
+			return fmt.Errorf("invalid contract name %q in %s", entry.Name, ManifestFile)
+		}
+		if err := os.RemoveAll(filepath.Join(outDir, entry.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeOrRemove writes src to path, or removes a previously generated file
+// if src is empty.
+func writeOrRemove(path, src string) error {
+	if src == "" {
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return nil
+	}
+	return os.WriteFile(path, []byte(src), 0o644)
+}
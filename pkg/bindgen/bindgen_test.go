@@ -0,0 +1,291 @@
+package bindgen_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindgen"
+)
+
+const (
+	counterSource = `// SPDX-License-Identifier: BUSL-1.1
+pragma solidity =0.8.12;
+
+contract Counter {
+    uint256 internal constant MAX_COUNT = 2**8 - 1;
+
+    uint256 public count;
+
+    event Incremented(address indexed caller, uint256 count);
+
+    function increment() external {
+        require(count < MAX_COUNT, "Counter.increment: max count reached");
+        count += 1;
+        emit Incremented(msg.sender, count);
+    }
+}
+`
+	counterABI = `[
+  {"type":"function","name":"count","inputs":[],"outputs":[{"name":"","type":"uint256","internalType":"uint256"}],"stateMutability":"view"},
+  {"type":"function","name":"increment","inputs":[],"outputs":[],"stateMutability":"nonpayable"},
+  {"type":"event","name":"Incremented","inputs":[{"name":"caller","type":"address","indexed":true,"internalType":"address"},{"name":"count","type":"uint256","indexed":false,"internalType":"uint256"}],"anonymous":false}
+]`
+	counterLayout = `{
+  "storage": [{"astId":3,"contract":"src/Counter.sol:Counter","label":"count","offset":0,"slot":"0","type":"t_uint256"}],
+  "types": {"t_uint256":{"encoding":"inplace","label":"uint256","numberOfBytes":"32"}}
+}`
+
+	ownedSource = `// SPDX-License-Identifier: BUSL-1.1
+pragma solidity =0.8.12;
+
+interface IOwned {
+    function owner() external view returns (address);
+}
+`
+	ownedABI = `[
+  {"type":"function","name":"owner","inputs":[],"outputs":[{"name":"","type":"address","internalType":"address"}],"stateMutability":"view"}
+]`
+)
+
+// project lays out a checkout with a source directory and forge output
+// directory holding Counter and, if withOwned is set, IOwned.
+func project(t *testing.T, withOwned bool) (srcDir, artifactDir string) {
+	t.Helper()
+	root := t.TempDir()
+	srcDir = filepath.Join(root, bindgen.DefaultSourceDir)
+	artifactDir = filepath.Join(root, bindgen.DefaultArtifactDir)
+	writeContract(t, srcDir, artifactDir, "Counter", counterSource, counterABI, "0x6080604052348015600f57600080fd5b50", counterLayout)
+	if withOwned {
+		writeContract(t, srcDir, artifactDir, "IOwned", ownedSource, ownedABI, "0x", "")
+	}
+	return srcDir, artifactDir
+}
+
+func writeContract(t *testing.T, srcDir, artifactDir, name, src, abi, bin, layout string) {
+	t.Helper()
+	if err := os.MkdirAll(srcDir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(srcDir, name+".sol"), []byte(src), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	artifact := map[string]any{
+		"abi":      json.RawMessage(abi),
+		"bytecode": map[string]string{"object": bin},
+	}
+	if layout != "" {
+		artifact["storageLayout"] = json.RawMessage(layout)
+	}
+	data, err := json.Marshal(artifact)
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := bindgen.ArtifactPath(artifactDir, name)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// generate runs the generator over the given project and writes its output
+// below outRoot.
+func generate(t *testing.T, srcDir, artifactDir, outRoot string) *bindgen.Result {
+	t.Helper()
+	res, err := bindgen.Run(bindgen.Config{
+		SourceDir:   srcDir,
+		ArtifactDir: artifactDir,
+		Exclude:     bindgen.DefaultInclude,
+	})
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	err = res.Write(
+		filepath.Join(outRoot, "bindings"),
+		filepath.Join(outRoot, "types"),
+		filepath.Join(outRoot, "constants"),
+		filepath.Join(outRoot, "reverts"),
+	)
+	if err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	return res
+}
+
+func TestRun(t *testing.T) {
+	srcDir, artifactDir := project(t, true)
+	res, err := bindgen.Run(bindgen.Config{
+		SourceDir:   srcDir,
+		ArtifactDir: artifactDir,
+		Exclude:     bindgen.DefaultInclude,
+	})
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if res.Release != bindgen.LatestRelease() {
+		t.Errorf("release %s, want %s", res.Release, bindgen.LatestRelease())
+	}
+	var names []string
+	for _, b := range res.Bindings {
+		names = append(names, b.Name)
+	}
+	if got := strings.Join(names, ","); got != "Counter,IOwned" {
+		t.Fatalf("bound %s, want Counter,IOwned", got)
+	}
+
+	counter, owned := res.Bindings[0], res.Bindings[1]
+	for _, want := range []string{"package Counter", "func DeployCounter(", "func (_Counter *CounterCaller) Count(", "func (_Counter *CounterFilterer) FilterIncremented("} {
+		if !strings.Contains(counter.Source, want) {
+			t.Errorf("Counter binding lacks %q", want)
+		}
+	}
+	if strings.Contains(owned.Source, "func DeployIOwned(") {
+		t.Error("IOwned binding has a deploy function without bytecode")
+	}
+	if !strings.Contains(counter.Interfaces, "type CounterReader interface") {
+		t.Error("Counter interfaces lack CounterReader")
+	}
+	if counter.Layout == "" {
+		t.Error("Counter has no storage layout")
+	}
+	if owned.Layout != "" {
+		t.Error("IOwned has a storage layout")
+	}
+
+	entry, ok := res.Manifest.Entry("Counter")
+	if !ok {
+		t.Fatal("manifest lacks Counter")
+	}
+	if entry != bindgen.NewManifestEntry("Counter", counterABI, "0x6080604052348015600f57600080fd5b50") {
+		t.Errorf("manifest entry %+v does not hash the artifact", entry)
+	}
+	if !strings.Contains(res.Events, `Contract:  "Counter"`) || strings.Contains(res.Events, `"IOwned"`) {
+		t.Error("events list should hold Counter only")
+	}
+	if !strings.Contains(res.Constants, "MaxCount") {
+		t.Error("constants lack Counter.MAX_COUNT")
+	}
+	if !strings.Contains(res.Reverts, "Counter.increment: max count reached") {
+		t.Error("revert catalogue lacks the Counter.increment reason")
+	}
+}
+
+func TestRunMissingArtifact(t *testing.T) {
+	srcDir, _ := project(t, true)
+	_, err := bindgen.Run(bindgen.Config{
+		SourceDir:   srcDir,
+		ArtifactDir: t.TempDir(),
+		Exclude:     bindgen.DefaultInclude,
+	})
+	if err == nil || !strings.Contains(err.Error(), "failed to read artifact for Counter") {
+		t.Fatalf("err = %v, want a missing Counter artifact", err)
+	}
+}
+
+func TestWrite(t *testing.T) {
+	outRoot := t.TempDir()
+	srcDir, artifactDir := project(t, true)
+	generate(t, srcDir, artifactDir, outRoot)
+
+	releaseDir := filepath.Join(outRoot, "bindings")
+	for _, path := range []string{
+		filepath.Join(outRoot, "bindings", bindgen.ReleasesFile),
+		filepath.Join(releaseDir, bindgen.RegistryFile),
+		filepath.Join(releaseDir, bindgen.EventsFile),
+		filepath.Join(releaseDir, bindgen.ManifestFile),
+		filepath.Join(releaseDir, "Counter", bindgen.BindingFile),
+		filepath.Join(releaseDir, "Counter", bindgen.InterfacesFile),
+		filepath.Join(releaseDir, "Counter", bindgen.LayoutFile),
+		filepath.Join(releaseDir, "IOwned", bindgen.BindingFile),
+		filepath.Join(outRoot, "types", bindgen.TypesFile),
+		filepath.Join(outRoot, "constants", bindgen.ConstantsFile),
+		filepath.Join(outRoot, "reverts", bindgen.RevertsFile),
+	} {
+		if _, err := os.Stat(path); err != nil {
+			t.Errorf("missing output: %v", err)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(releaseDir, "IOwned", bindgen.LayoutFile)); !os.IsNotExist(err) {
+		t.Errorf("IOwned layout written: %v", err)
+	}
+	m, err := bindgen.ReadManifest(filepath.Join(releaseDir, bindgen.ManifestFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(m.Contracts) != 2 {
+		t.Errorf("manifest lists %d contracts, want 2", len(m.Contracts))
+	}
+}
+
+func TestWriteRemovesStale(t *testing.T) {
+	outRoot := t.TempDir()
+	srcDir, artifactDir := project(t, true)
+	generate(t, srcDir, artifactDir, outRoot)
+	releaseDir := filepath.Join(outRoot, "bindings")
+
+	// A directory the generator did not write survives regeneration.
+	handWritten := filepath.Join(releaseDir, "bindingstest")
+	if err := os.MkdirAll(handWritten, 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	srcDir, artifactDir = project(t, false)
+	generate(t, srcDir, artifactDir, outRoot)
+
+	if _, err := os.Stat(filepath.Join(releaseDir, "IOwned")); !os.IsNotExist(err) {
+		t.Errorf("IOwned package not removed: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(releaseDir, "Counter", bindgen.BindingFile)); err != nil {
+		t.Errorf("Counter binding missing: %v", err)
+	}
+	if _, err := os.Stat(handWritten); err != nil {
+		t.Errorf("unrelated directory removed: %v", err)
+	}
+	m, err := bindgen.ReadManifest(filepath.Join(releaseDir, bindgen.ManifestFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := m.Entry("IOwned"); ok {
+		t.Error("manifest still lists IOwned")
+	}
+}
+
+func TestWriteOlderRelease(t *testing.T) {
+	srcDir, _ := project(t, true)
+	checkout := filepath.Dir(filepath.Dir(srcDir))
+	latest := bindgen.Releases[len(bindgen.Releases)-1]
+	saved := bindgen.Releases
+	bindgen.Releases = []bindgen.Release{{Name: "v0_1", Dir: checkout}, latest}
+	t.Cleanup(func() { bindgen.Releases = saved })
+
+	cfgs := bindgen.Config{Release: "v0_1", Exclude: bindgen.DefaultInclude}.ReleaseConfigs()
+	if len(cfgs) != 1 || cfgs[0].SourceDir != srcDir {
+		t.Fatalf("release configs %+v do not read the release checkout", cfgs)
+	}
+	res, err := bindgen.Run(cfgs[0])
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	outRoot := t.TempDir()
+	if err := res.Write(filepath.Join(outRoot, "bindings"), filepath.Join(outRoot, "types"), "", ""); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+
+	if _, err := os.Stat(filepath.Join(outRoot, "bindings", "v0_1", "Counter", bindgen.BindingFile)); err != nil {
+		t.Errorf("older release binding missing: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(outRoot, "types")); !os.IsNotExist(err) {
+		t.Errorf("older release wrote canonical types: %v", err)
+	}
+	if !strings.Contains(res.Registry, "package v0_1") {
+		t.Error("older release registry is not in package v0_1")
+	}
+	if !strings.Contains(res.Releases, `"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/v0_1"`) ||
+		!strings.Contains(res.Releases, `{Release: "`+latest.Name+`", MetaData: MetaData,`) {
+		t.Errorf("releases index does not list both releases:\n%s", res.Releases)
+	}
+}
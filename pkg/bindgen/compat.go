@@ -0,0 +1,300 @@
+package bindgen
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+)
+
+// ChangeKind classifies a change between two revisions of a binding.
+type ChangeKind string
+
+const (
+	// Additive changes add a contract, function, event, error or struct
+	// and break no caller.
+	Additive ChangeKind = "additive"
+	// BreakingSelector changes remove a contract, function or error
+	// selector, or change the parameters of a function and so its
+	// selector.
+	BreakingSelector ChangeKind = "breaking-selector"
+	// BreakingReturns changes keep the selector of a function but change
+	// its return types or state mutability.
+	BreakingReturns ChangeKind = "breaking-returns"
+	// EventSignature changes remove an event or change its parameters or
+	// indexed parameters, and so its topics.
+	EventSignature ChangeKind = "event-signature"
+	// StructLayout changes add, remove, retype, rename or reorder the
+	// fields of a struct, or remove it.
+	StructLayout ChangeKind = "struct-layout"
+)
+
+// Breaking reports whether changes of the kind can break callers of the
+// previous revision.
+func (k ChangeKind) Breaking() bool {
+	return k != Additive
+}
+
+// Change is a difference between two revisions of a binding.
+type Change struct {
+	Contract string     `json:"contract"`
+	Kind     ChangeKind `json:"kind"`
+	// Item is what changed, e.g. "function queueWithdrawals" or
+	// "struct IDelegationManager.Withdrawal".
+	Item string `json:"item"`
+	// Old and New describe the item in each revision, if it exists there.
+	Old string `json:"old,omitempty"`
+	New string `json:"new,omitempty"`
+}
+
+func (c Change) String() string {
+	s := fmt.Sprintf("%s: %s %s", c.Contract, c.Kind, c.Item)
+	switch {
+	case c.Old != "" && c.New != "":
+		s += ": " + c.Old + " -> " + c.New
+	case c.Old != "":
+		s += ": removed " + c.Old
+	case c.New != "":
+		s += ": added " + c.New
+	}
+	return s
+}
+
+// Compare classifies every change between the bindings of two revisions,
+// e.g. the MetaData of two releases. Constructors are not compared.
+func Compare(old, cur map[string]*bind.MetaData) ([]Change, error) {
+	var out []Change
+	for _, name := range sortedKeys(old) {
+		if _, ok := cur[name]; !ok {
+			out = append(out, Change{Contract: name, Kind: BreakingSelector, Item: "contract " + name, Old: name})
+		}
+	}
+	for _, name := range sortedKeys(cur) {
+		md, ok := old[name]
+		if !ok {
+			out = append(out, Change{Contract: name, Kind: Additive, Item: "contract " + name, New: name})
+			continue
+		}
+		changes, err := compareBinding(name, md, cur[name])
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, changes...)
+	}
+	return out, nil
+}
+
+func compareBinding(name string, old, cur *bind.MetaData) ([]Change, error) {
+	oldABI, err := abi.JSON(strings.NewReader(old.ABI))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse previous abi of %s: %w", name, err)
+	}
+	curABI, err := abi.JSON(strings.NewReader(cur.ABI))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse abi of %s: %w", name, err)
+	}
+	var out []Change
+	change := func(kind ChangeKind, item, old, cur string) {
+		out = append(out, Change{Contract: name, Kind: kind, Item: item, Old: old, New: cur})
+	}
+
+	// A function whose only overload changed parameters is reported as one
+	// change of its selector rather than as a removal and an addition.
+	oldMethods, curMethods := methodsBySig(oldABI), methodsBySig(curABI)
+	added, removed := diffKeys(curMethods, oldMethods)
+	pairs := pairByName(removed, added, func(sig string) string { return oldMethods[sig].RawName }, func(sig string) string { return curMethods[sig].RawName })
+	for _, sig := range removed {
+		m := oldMethods[sig]
+		if to, ok := pairs[sig]; ok {
+			change(BreakingSelector, "function "+m.RawName, describeMethod(m), describeMethod(curMethods[to]))
+		} else {
+			change(BreakingSelector, "function "+m.RawName, describeMethod(m), "")
+		}
+	}
+	for _, sig := range added {
+		if !paired(pairs, sig) {
+			m := curMethods[sig]
+			change(Additive, "function "+m.RawName, "", describeMethod(m))
+		}
+	}
+	for _, sig := range sortedKeys(curMethods) {
+		m, was := curMethods[sig], oldMethods[sig]
+		if _, ok := oldMethods[sig]; ok && (m.StateMutability != was.StateMutability || !sameTypes(m.Outputs, was.Outputs)) {
+			change(BreakingReturns, "function "+m.RawName, describeMethod(was), describeMethod(m))
+		}
+	}
+
+	oldEvents, curEvents := eventsBySig(oldABI), eventsBySig(curABI)
+	added, removed = diffKeys(curEvents, oldEvents)
+	pairs = pairByName(removed, added, func(sig string) string { return oldEvents[sig].RawName }, func(sig string) string { return curEvents[sig].RawName })
+	for _, sig := range removed {
+		e := oldEvents[sig]
+		if to, ok := pairs[sig]; ok {
+			change(EventSignature, "event "+e.RawName, describeEvent(e), describeEvent(curEvents[to]))
+		} else {
+			change(EventSignature, "event "+e.RawName, describeEvent(e), "")
+		}
+	}
+	for _, sig := range added {
+		if !paired(pairs, sig) {
+			e := curEvents[sig]
+			change(Additive, "event "+e.RawName, "", describeEvent(e))
+		}
+	}
+	for _, sig := range sortedKeys(curEvents) {
+		e, was := curEvents[sig], oldEvents[sig]
+		if _, ok := oldEvents[sig]; ok && !sameIndexed(e.Inputs, was.Inputs) {
+			change(EventSignature, "event "+e.RawName, describeEvent(was), describeEvent(e))
+		}
+	}
+
+	added, removed = diffKeys(errorsBySig(curABI), errorsBySig(oldABI))
+	for _, sig := range removed {
+		change(BreakingSelector, "error "+sig, "error "+sig, "")
+	}
+	for _, sig := range added {
+		change(Additive, "error "+sig, "", "error "+sig)
+	}
+
+	oldStructs, err := Structs(old.ABI)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read previous structs of %s: %w", name, err)
+	}
+	curStructs, err := Structs(cur.ABI)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read structs of %s: %w", name, err)
+	}
+	describeStruct := func(fields []string) string {
+		return "(" + strings.Join(fields, ", ") + ")"
+	}
+	for _, s := range sortedKeys(oldStructs) {
+		fields, ok := curStructs[s]
+		switch {
+		case !ok:
+			change(StructLayout, "struct "+s, describeStruct(oldStructs[s]), "")
+		case describeStruct(fields) != describeStruct(oldStructs[s]):
+			change(StructLayout, "struct "+s, describeStruct(oldStructs[s]), describeStruct(fields))
+		}
+	}
+	for _, s := range sortedKeys(curStructs) {
+		if _, ok := oldStructs[s]; !ok {
+			change(Additive, "struct "+s, "", describeStruct(curStructs[s]))
+		}
+	}
+	return out, nil
+}
+
+// pairByName maps each removed signature to the added one with the same
+// name, where the name has exactly one of each.
+func pairByName(removed, added []string, oldName, curName func(string) string) map[string]string {
+	count := func(sigs []string, name func(string) string) map[string][]string {
+		out := make(map[string][]string)
+		for _, sig := range sigs {
+			out[name(sig)] = append(out[name(sig)], sig)
+		}
+		return out
+	}
+	was, is := count(removed, oldName), count(added, curName)
+	pairs := make(map[string]string)
+	for name, sigs := range was {
+		if len(sigs) == 1 && len(is[name]) == 1 {
+			pairs[sigs[0]] = is[name][0]
+		}
+	}
+	return pairs
+}
+
+func paired(pairs map[string]string, added string) bool {
+	for _, to := range pairs {
+		if to == added {
+			return true
+		}
+	}
+	return false
+}
+
+// ReadMetaData reads the MetaData embedded in the binding packages of a
+// release directory, such as pkg/bindings in a checkout of another
+// revision, without compiling them.
+func ReadMetaData(dir string) (map[string]*bind.MetaData, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	out := make(map[string]*bind.MetaData)
+	for _, e := range entries {
+		if !e.IsDir() {
+			continue
+		}
+		path := filepath.Join(dir, e.Name(), "binding.go")
+		if _, err := os.Stat(path); os.IsNotExist(err) {
+			continue
+		}
+		md, err := readMetaData(path, e.Name()+"MetaData")
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		out[e.Name()] = md
+	}
+	return out, nil
+}
+
+// readMetaData extracts the literal assigned to the named variable of a
+// binding file, of the form &bind.MetaData{ABI: "...", Bin: "..."}.
+func readMetaData(path, varName string) (*bind.MetaData, error) {
+	file, err := parser.ParseFile(token.NewFileSet(), path, nil, parser.SkipObjectResolution)
+	if err != nil {
+		return nil, err
+	}
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.VAR {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			vs := spec.(*ast.ValueSpec)
+			if len(vs.Names) != 1 || vs.Names[0].Name != varName || len(vs.Values) != 1 {
+				continue
+			}
+			unary, ok := vs.Values[0].(*ast.UnaryExpr)
+			if !ok {
+				break
+			}
+			lit, ok := unary.X.(*ast.CompositeLit)
+			if !ok {
+				break
+			}
+			md := new(bind.MetaData)
+			for _, elt := range lit.Elts {
+				kv, ok := elt.(*ast.KeyValueExpr)
+				if !ok {
+					continue
+				}
+				key, ok := kv.Key.(*ast.Ident)
+				val, isLit := kv.Value.(*ast.BasicLit)
+				if !ok || !isLit || val.Kind != token.STRING {
+					continue
+				}
+				s, err := strconv.Unquote(val.Value)
+				if err != nil {
+					return nil, err
+				}
+				switch key.Name {
+				case "ABI":
+					md.ABI = s
+				case "Bin":
+					md.Bin = s
+				}
+			}
+			return md, nil
+		}
+	}
+	return nil, fmt.Errorf("no %s literal", varName)
+}
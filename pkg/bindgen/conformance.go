@@ -0,0 +1,233 @@
+package bindgen
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+)
+
+// implementations lists the bound contracts implementing an interface that
+// is not named after them, in addition to the I<Name>/<Name> pairs found by
+// InterfacePairs.
+var implementations = map[string][]string{
+	"IStrategy": {"StrategyBase", "StrategyBaseTVLLimits", "EigenStrategy"},
+	"IPausable": {
+		"AVSDirectory", "DelayedWithdrawalRouter", "DelegationManager", "EigenPodManager", "EigenStrategy",
+		"RewardsCoordinator", "Slasher", "StrategyBase", "StrategyBaseTVLLimits", "StrategyManager",
+	},
+}
+
+// InterfacePair is an interface binding and a binding of a contract
+// implementing it.
+type InterfacePair struct {
+	Interface      string `json:"interface"`
+	Implementation string `json:"implementation"`
+}
+
+// InterfacePairs lists the interfaces of bound and the bound contracts
+// implementing them: every I<Name> with a <Name> binding, such as
+// IDelegationManager and DelegationManager, and the implementations of
+// IStrategy and IPausable.
+func InterfacePairs(bound map[string]*bind.MetaData) []InterfacePair {
+	var pairs []InterfacePair
+	for name := range bound {
+		if len(name) < 2 || name[0] != 'I' || name[1] < 'A' || name[1] > 'Z' {
+			continue
+		}
+		impls := implementations[name]
+		if _, ok := bound[name[1:]]; ok {
+			impls = append([]string{name[1:]}, impls...)
+		}
+		seen := make(map[string]bool)
+		for _, impl := range impls {
+			if _, ok := bound[impl]; ok && !seen[impl] {
+				seen[impl] = true
+				pairs = append(pairs, InterfacePair{Interface: name, Implementation: impl})
+			}
+		}
+	}
+	sort.Slice(pairs, func(i, j int) bool {
+		if pairs[i].Interface != pairs[j].Interface {
+			return pairs[i].Interface < pairs[j].Interface
+		}
+		return pairs[i].Implementation < pairs[j].Implementation
+	})
+	return pairs
+}
+
+// Violation kinds.
+const (
+	// MissingMethod is a function of the interface the implementation does
+	// not declare under any signature.
+	MissingMethod = "missing-method"
+	// MethodSignature is a function the implementation declares with
+	// other parameter types, and so another selector.
+	MethodSignature = "method-signature"
+	// MethodReturns is a function the implementation declares with the
+	// same selector but other return types, or a state mutability an
+	// override could not narrow the interface's to.
+	MethodReturns = "method-returns"
+	// MissingEvent is an event of the interface the implementation does
+	// not declare under any signature.
+	MissingEvent = "missing-event"
+	// EventMismatch is an event the implementation declares with other
+	// parameter types or indexed parameters, and so other topics.
+	EventMismatch = "event-mismatch"
+)
+
+// Violation is a function or event of an interface that the binding of an
+// implementation does not expose identically.
+type Violation struct {
+	InterfacePair
+	Kind string `json:"kind"`
+	// Item is the signature in the interface, e.g.
+	// "function delegateTo(address,(bytes,uint256),bytes32)".
+	Item string `json:"item"`
+	// Found is what the implementation declares instead, if anything.
+	Found string `json:"found,omitempty"`
+}
+
+func (v Violation) String() string {
+	s := fmt.Sprintf("%s does not conform to %s: %s %s", v.Implementation, v.Interface, v.Kind, v.Item)
+	if v.Found != "" {
+		s += ", found " + v.Found
+	}
+	return s
+}
+
+// CheckConformance checks that the implementation of every pair exposes a
+// superset of the functions and events of its interface, with identical
+// selectors, return types, state mutability and event topics, as far as
+// their embedded ABIs tell.
+func CheckConformance(bound map[string]*bind.MetaData, pairs []InterfacePair) ([]Violation, error) {
+	parsed := make(map[string]abi.ABI)
+	load := func(name string) (abi.ABI, error) {
+		if a, ok := parsed[name]; ok {
+			return a, nil
+		}
+		md, ok := bound[name]
+		if !ok {
+			return abi.ABI{}, fmt.Errorf("no binding for %s", name)
+		}
+		a, err := abi.JSON(strings.NewReader(md.ABI))
+		if err != nil {
+			return abi.ABI{}, fmt.Errorf("failed to parse binding abi for %s: %w", name, err)
+		}
+		parsed[name] = a
+		return a, nil
+	}
+
+	var out []Violation
+	for _, p := range pairs {
+		iface, err := load(p.Interface)
+		if err != nil {
+			return nil, err
+		}
+		impl, err := load(p.Implementation)
+		if err != nil {
+			return nil, err
+		}
+		violation := func(kind, item, found string) {
+			out = append(out, Violation{InterfacePair: p, Kind: kind, Item: item, Found: found})
+		}
+
+		ifaceMethods, implMethods := methodsBySig(iface), methodsBySig(impl)
+		for _, sig := range sortedKeys(ifaceMethods) {
+			m := ifaceMethods[sig]
+			got, ok := implMethods[sig]
+			switch {
+			case ok && (!overrides(got.StateMutability, m.StateMutability) || !sameTypes(got.Outputs, m.Outputs)):
+				violation(MethodReturns, describeMethod(m), describeMethod(got))
+			case !ok:
+				if other := sameName(impl, m.RawName); other != nil {
+					violation(MethodSignature, "function "+sig, "function "+other.Sig)
+				} else {
+					violation(MissingMethod, "function "+sig, "")
+				}
+			}
+		}
+
+		ifaceEvents, implEvents := eventsBySig(iface), eventsBySig(impl)
+		for _, sig := range sortedKeys(ifaceEvents) {
+			e := ifaceEvents[sig]
+			got, ok := implEvents[sig]
+			switch {
+			case ok && !sameIndexed(got.Inputs, e.Inputs):
+				violation(EventMismatch, describeEvent(e), describeEvent(got))
+			case !ok:
+				if other, ok := impl.Events[e.RawName]; ok {
+					violation(EventMismatch, describeEvent(e), describeEvent(other))
+				} else {
+					violation(MissingEvent, "event "+sig, "")
+				}
+			}
+		}
+	}
+	return out, nil
+}
+
+// mutability ranks the state mutabilities a nonpayable function may be
+// narrowed to by an override.
+var mutability = map[string]int{"pure": 0, "view": 1, "nonpayable": 2}
+
+// overrides reports whether a function of the given state mutability may
+// implement one declared with declared, as Solidity allows nonpayable to
+// be narrowed to view and view to pure.
+func overrides(impl, declared string) bool {
+	if impl == "payable" || declared == "payable" {
+		return impl == declared
+	}
+	return mutability[impl] <= mutability[declared]
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// sameName returns a method of a named name under any signature, if any.
+func sameName(a abi.ABI, name string) *abi.Method {
+	for _, k := range sortedKeys(a.Methods) {
+		if m := a.Methods[k]; m.RawName == name {
+			return &m
+		}
+	}
+	return nil
+}
+
+// describeMethod renders a method with what its selector does not cover,
+// e.g. "function stakerNonce(address) view returns (uint256)".
+func describeMethod(m abi.Method) string {
+	s := "function " + m.Sig
+	if m.StateMutability != "" && m.StateMutability != "nonpayable" {
+		s += " " + m.StateMutability
+	}
+	if len(m.Outputs) > 0 {
+		types := make([]string, len(m.Outputs))
+		for i, out := range m.Outputs {
+			types[i] = out.Type.String()
+		}
+		s += " returns (" + strings.Join(types, ",") + ")"
+	}
+	return s
+}
+
+// describeEvent renders an event with its indexed parameters, e.g.
+// "event StakerDelegated(address indexed,address indexed)".
+func describeEvent(e abi.Event) string {
+	types := make([]string, len(e.Inputs))
+	for i, in := range e.Inputs {
+		types[i] = in.Type.String()
+		if in.Indexed {
+			types[i] += " indexed"
+		}
+	}
+	return "event " + e.RawName + "(" + strings.Join(types, ",") + ")"
+}
@@ -0,0 +1,482 @@
+package bindgen
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"io/fs"
+	"math/big"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"text/template"
+	"unicode"
+)
+
+const (
+	// DefaultConstantsDir is the directory of the generated constants
+	// package.
+	DefaultConstantsDir = "pkg/constants"
+
+	// ConstantsFile is the name of the generated constants file.
+	ConstantsFile = "constants.go"
+
+	// pauseFlagPrefix marks a uint8 constant as an index into a Pausable
+	// contract's paused status.
+	pauseFlagPrefix = "PAUSED_"
+)
+
+// Constant is an integer internal constant declared by a Solidity contract.
+type Constant struct {
+	Contract string
+	Name     string
+	Type     string
+	Value    *big.Int
+}
+
+// Key identifies the constant as "<Contract>.<NAME>".
+func (c *Constant) Key() string {
+	return c.Contract + "." + c.Name
+}
+
+// GoName is the exported Go identifier of the constant.
+func (c *Constant) GoName() string {
+	return c.Contract + camel(c.Name)
+}
+
+// solContract is a contract, library or interface declaration.
+type solContract struct {
+	Name     string
+	Kind     string
+	Abstract bool
+	Parents  []string
+	// decls holds the type, name and initializer of each declared
+	// constant until the contract is evaluated.
+	decls     [][3]string
+	evaluated bool
+	Constants []*Constant
+}
+
+// SolidityConstants holds the integer internal constants of every contract
+// under a source directory.
+type SolidityConstants struct {
+	contracts map[string]*solContract
+}
+
+var (
+	contractPattern = regexp.MustCompile(`(?s)\b(abstract\s+)?(contract|library|interface)\s+(\w+)\s*(?:is\s+([^{]*))?\{`)
+	constantPattern = regexp.MustCompile(`(?s)\b(u?int\d*)\s+internal\s+constant\s+(\w+)\s*=\s*([^;]+);`)
+)
+
+// ParseConstants extracts the integer internal constants declared in the
+// .sol files under sourceDir. Constants whose value is not a compile time
+// arithmetic expression, such as keccak256 hashes, are skipped.
+func ParseConstants(sourceDir string) (*SolidityConstants, error) {
+	set := &SolidityConstants{contracts: make(map[string]*solContract)}
+	err := filepath.WalkDir(sourceDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || filepath.Ext(path) != ".sol" {
+			return nil
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		if err := set.parseFile(stripComments(string(data))); err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse constants under %s: %w", sourceDir, err)
+	}
+	for _, c := range set.contracts {
+		set.evaluate(c)
+	}
+	return set, nil
+}
+
+func (set *SolidityConstants) parseFile(src string) error {
+	decls := contractPattern.FindAllStringSubmatchIndex(src, -1)
+	for i, m := range decls {
+		end := len(src)
+		if i+1 < len(decls) {
+			end = decls[i+1][0]
+		}
+		c := &solContract{
+			Name:     src[m[6]:m[7]],
+			Kind:     src[m[4]:m[5]],
+			Abstract: m[2] >= 0,
+		}
+		if m[8] >= 0 {
+			for _, parent := range strings.Split(src[m[8]:m[9]], ",") {
+				// Drop constructor arguments such as `Base(arg)`.
+				parent, _, _ = strings.Cut(strings.TrimSpace(parent), "(")
+				c.Parents = append(c.Parents, strings.TrimSpace(parent))
+			}
+		}
+		if _, ok := set.contracts[c.Name]; ok {
+			return fmt.Errorf("duplicate contract %s", c.Name)
+		}
+		set.contracts[c.Name] = c
+
+		for _, cm := range constantPattern.FindAllStringSubmatch(src[m[1]:end], -1) {
+			c.decls = append(c.decls, [3]string{cm[1], cm[2], cm[3]})
+		}
+	}
+	return nil
+}
+
+// evaluate computes the constants of c, after those of its ancestors.
+func (set *SolidityConstants) evaluate(c *solContract) {
+	if c.evaluated {
+		return
+	}
+	c.evaluated = true
+	for _, p := range c.Parents {
+		if parent, ok := set.contracts[p]; ok {
+			set.evaluate(parent)
+		}
+	}
+	for _, d := range c.decls {
+		value, err := evalConstant(d[2], func(name string) *big.Int {
+			return set.lookup(c, name)
+		})
+		if err != nil {
+			continue
+		}
+		c.Constants = append(c.Constants, &Constant{Contract: c.Name, Name: d[1], Type: d[0], Value: value})
+	}
+}
+
+// lookup resolves name among the constants c inherits.
+func (set *SolidityConstants) lookup(c *solContract, name string) *big.Int {
+	for _, k := range set.inherited(c) {
+		if k.Name == name {
+			return k.Value
+		}
+	}
+	return nil
+}
+
+// inherited returns the constants declared by c and its ancestors that have
+// been evaluated so far, nearest first.
+func (set *SolidityConstants) inherited(c *solContract) []*Constant {
+	var (
+		out  []*Constant
+		seen = make(map[string]bool)
+		walk func(c *solContract)
+	)
+	walk = func(c *solContract) {
+		if seen[c.Name] {
+			return
+		}
+		seen[c.Name] = true
+		out = append(out, c.Constants...)
+		for _, p := range c.Parents {
+			if parent, ok := set.contracts[p]; ok {
+				walk(parent)
+			}
+		}
+	}
+	walk(c)
+	return out
+}
+
+// inherits reports whether c is or derives from the named contract.
+func (set *SolidityConstants) inherits(c *solContract, name string) bool {
+	if c.Name == name {
+		return true
+	}
+	for _, p := range c.Parents {
+		if parent, ok := set.contracts[p]; ok && set.inherits(parent, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// Constants returns every parsed constant, sorted by key.
+func (set *SolidityConstants) Constants() []*Constant {
+	var out []*Constant
+	for _, c := range set.contracts {
+		out = append(out, c.Constants...)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Key() < out[j].Key() })
+	return out
+}
+
+// Values maps the key of every parsed constant to its decimal value.
+func (set *SolidityConstants) Values() map[string]string {
+	out := make(map[string]string)
+	for _, k := range set.Constants() {
+		out[k.Key()] = k.Value.String()
+	}
+	return out
+}
+
+// PauseFlagSet is the set of pause flags a concrete contract declares or
+// inherits.
+type PauseFlagSet struct {
+	Contract string
+	Flags    []*Constant
+}
+
+// PauseFlags returns the pause flags of every concrete Pausable contract
+// that has any, sorted by contract name and flag value. Contracts such as
+// EigenPod that only check another contract's paused status get none.
+func (set *SolidityConstants) PauseFlags() ([]*PauseFlagSet, error) {
+	var out []*PauseFlagSet
+	for _, c := range set.contracts {
+		if c.Kind != "contract" || c.Abstract || !set.inherits(c, "Pausable") {
+			continue
+		}
+		fs := &PauseFlagSet{Contract: c.Name}
+		byValue := make(map[string]string)
+		for _, k := range set.inherited(c) {
+			if k.Type != "uint8" || !strings.HasPrefix(k.Name, pauseFlagPrefix) {
+				continue
+			}
+			if prev, ok := byValue[k.Value.String()]; ok {
+				return nil, fmt.Errorf("pause flags %s and %s of %s share index %s", prev, k.Key(), c.Name, k.Value)
+			}
+			byValue[k.Value.String()] = k.Key()
+			fs.Flags = append(fs.Flags, k)
+		}
+		if len(fs.Flags) == 0 {
+			continue
+		}
+		sort.Slice(fs.Flags, func(i, j int) bool { return fs.Flags[i].Value.Cmp(fs.Flags[j].Value) < 0 })
+		out = append(out, fs)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Contract < out[j].Contract })
+	return out, nil
+}
+
+var constantsTemplate = template.Must(template.New("constants").Funcs(template.FuncMap{
+	"camel": camel,
+}).Parse(`// Code generated by bindgen - DO NOT EDIT.
+
+// Package constants exports the internal constants of the EigenLayer
+// contracts, which never reach an ABI and so have no binding. Each Pausable
+// contract's pause flags are typed, so that
+// DelegationManagerPausedEnterWithdrawalQueue.Mask() is the paused status
+// to pass to Pause.
+package constants
+
+import (
+	"fmt"
+	"math/big"
+)
+{{range .Groups}}
+// Internal constants of {{.Contract}}.
+const (
+{{- range .Constants}}
+	{{.GoName}} = {{.Value}} // {{.Type}} {{.Name}}
+{{- end}}
+)
+{{end}}
+{{- range .PauseFlags}}
+{{- $contract := .Contract}}
+{{- $type := printf "%sPauseFlag" $contract}}
+// {{$type}} is a bit index into the paused status of {{.Contract}}.
+type {{$type}} uint8
+
+// Pause flags of {{.Contract}}.
+const (
+{{- range .Flags}}
+	{{$contract}}{{camel .Name}} {{$type}} = {{.Value}} // {{.Key}}
+{{- end}}
+)
+
+// Mask returns the paused status with only f set.
+func (f {{$type}}) Mask() *big.Int {
+	return new(big.Int).Lsh(big.NewInt(1), uint(f))
+}
+
+// String returns the Solidity name of f.
+func (f {{$type}}) String() string {
+	switch f {
+{{- range .Flags}}
+	case {{$contract}}{{camel .Name}}:
+		return "{{.Name}}"
+{{- end}}
+	}
+	return fmt.Sprintf("{{$type}}(%d)", uint8(f))
+}
+{{end}}
+// Solidity maps every constant, as "<Contract>.<NAME>", to the decimal
+// value it was generated from.
+var Solidity = map[string]string{
+{{- range .All}}
+	"{{.Key}}": "{{.Value}}",
+{{- end}}
+}
+`))
+
+// ConstantsSource renders the constants package.
+func ConstantsSource(set *SolidityConstants) (string, error) {
+	flags, err := set.PauseFlags()
+	if err != nil {
+		return "", err
+	}
+	type group struct {
+		Contract  string
+		Constants []*Constant
+	}
+	var groups []group
+	for _, k := range set.Constants() {
+		if n := len(groups); n == 0 || groups[n-1].Contract != k.Contract {
+			groups = append(groups, group{Contract: k.Contract})
+		}
+		groups[len(groups)-1].Constants = append(groups[len(groups)-1].Constants, k)
+	}
+	// Pause flags are emitted as typed constants only, under the concrete
+	// contracts that use them.
+	for i := range groups {
+		var kept []*Constant
+		for _, k := range groups[i].Constants {
+			if k.Type != "uint8" || !strings.HasPrefix(k.Name, pauseFlagPrefix) {
+				kept = append(kept, k)
+			}
+		}
+		groups[i].Constants = kept
+	}
+	var nonEmpty []group
+	for _, g := range groups {
+		if len(g.Constants) > 0 {
+			nonEmpty = append(nonEmpty, g)
+		}
+	}
+
+	var buf bytes.Buffer
+	err = constantsTemplate.Execute(&buf, struct {
+		Groups     []group
+		PauseFlags []*PauseFlagSet
+		All        []*Constant
+	}{nonEmpty, flags, set.Constants()})
+	if err != nil {
+		return "", err
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return "", fmt.Errorf("failed to format constants: %w", err)
+	}
+	return string(src), nil
+}
+
+// ConstantDrift describes a constant whose Solidity value differs from the
+// one it was generated from. Bound or Source is empty if the constant is
+// missing on that side.
+type ConstantDrift struct {
+	Key    string
+	Bound  string
+	Source string
+}
+
+func (d ConstantDrift) String() string {
+	switch {
+	case d.Bound == "":
+		return fmt.Sprintf("%s: + constant = %s", d.Key, d.Source)
+	case d.Source == "":
+		return fmt.Sprintf("%s: - constant = %s", d.Key, d.Bound)
+	}
+	return fmt.Sprintf("%s: ~ constant %s -> %s", d.Key, d.Bound, d.Source)
+}
+
+// CheckConstants compares the values a constants package was generated
+// from against the constants currently declared under sourceDir.
+func CheckConstants(sourceDir string, bound map[string]string) ([]ConstantDrift, error) {
+	set, err := ParseConstants(sourceDir)
+	if err != nil {
+		return nil, err
+	}
+	source := set.Values()
+	var drifts []ConstantDrift
+	for key, v := range source {
+		if bound[key] != v {
+			drifts = append(drifts, ConstantDrift{Key: key, Bound: bound[key], Source: v})
+		}
+	}
+	for key, v := range bound {
+		if _, ok := source[key]; !ok {
+			drifts = append(drifts, ConstantDrift{Key: key, Bound: v})
+		}
+	}
+	sort.Slice(drifts, func(i, j int) bool { return drifts[i].Key < drifts[j].Key })
+	return drifts, nil
+}
+
+// camel converts a SCREAMING_SNAKE_CASE name to CamelCase, spelling
+// initialisms and compound names as Go code does.
+func camel(name string) string {
+	var b strings.Builder
+	for _, part := range strings.Split(name, "_") {
+		if part == "" {
+			continue
+		}
+		if word, ok := words[part]; ok {
+			b.WriteString(word)
+			continue
+		}
+		lower := strings.ToLower(part)
+		b.WriteRune(unicode.ToUpper(rune(lower[0])))
+		b.WriteString(lower[1:])
+	}
+	return b.String()
+}
+
+var words = map[string]string{
+	"AVS":       "AVS",
+	"BLS":       "BLS",
+	"EIP":       "EIP",
+	"ERC1271":   "ERC1271",
+	"ETH":       "ETH",
+	"ID":        "ID",
+	"URI":       "URI",
+	"EIGENPOD":  "EigenPod",
+	"EIGENPODS": "EigenPods",
+}
+
+// stripComments blanks out Solidity comments, leaving string literals intact.
+func stripComments(src string) string {
+	var (
+		b     strings.Builder
+		quote byte
+	)
+	for i := 0; i < len(src); i++ {
+		ch := src[i]
+		switch {
+		case quote != 0:
+			b.WriteByte(ch)
+			if ch == '\\' && i+1 < len(src) {
+				i++
+				b.WriteByte(src[i])
+			} else if ch == quote {
+				quote = 0
+			}
+		case ch == '"' || ch == '\'':
+			quote = ch
+			b.WriteByte(ch)
+		case strings.HasPrefix(src[i:], "//"):
+			for i < len(src) && src[i] != '\n' {
+				i++
+			}
+			b.WriteByte('\n')
+		case strings.HasPrefix(src[i:], "/*"):
+			end := strings.Index(src[i+2:], "*/")
+			if end < 0 {
+				return b.String()
+			}
+			b.WriteString(strings.Repeat("\n", strings.Count(src[i:i+2+end+2], "\n")))
+			i += 2 + end + 1
+		default:
+			b.WriteByte(ch)
+		}
+	}
+	return b.String()
+}
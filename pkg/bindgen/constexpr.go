@@ -0,0 +1,262 @@
+package bindgen
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+)
+
+// units are the Solidity ether and time unit suffixes.
+var units = map[string]*big.Rat{
+	"wei":     big.NewRat(1, 1),
+	"gwei":    big.NewRat(1e9, 1),
+	"ether":   big.NewRat(1e18, 1),
+	"seconds": big.NewRat(1, 1),
+	"minutes": big.NewRat(60, 1),
+	"hours":   big.NewRat(60*60, 1),
+	"days":    big.NewRat(24*60*60, 1),
+	"weeks":   big.NewRat(7*24*60*60, 1),
+}
+
+var (
+	tokenPattern   = regexp.MustCompile(`\s*(0x[0-9a-fA-F_]+|[0-9][0-9_]*(?:\.[0-9_]+)?(?:[eE][0-9]+)?|[A-Za-z_]\w*|\*\*|[-+*/%().])`)
+	intTypePattern = regexp.MustCompile(`^u?int\d*$`)
+
+	errNotConstant = errors.New("not a compile time constant")
+)
+
+// evalConstant evaluates a Solidity compile time integer expression built
+// from number literals with optional units, other constants resolved by
+// lookup, type(T).max and type(T).min, integer casts, + - * / % ** and
+// parentheses.
+func evalConstant(expr string, lookup func(name string) *big.Int) (*big.Int, error) {
+	var tokens []string
+	rest := strings.TrimSpace(expr)
+	for rest != "" {
+		m := tokenPattern.FindStringSubmatchIndex(rest)
+		if m == nil || m[0] != 0 {
+			return nil, fmt.Errorf("%w: %q", errNotConstant, expr)
+		}
+		tokens = append(tokens, rest[m[2]:m[3]])
+		rest = strings.TrimSpace(rest[m[1]:])
+	}
+	p := &exprParser{tokens: tokens, lookup: lookup}
+	v, err := p.sum()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos != len(p.tokens) || !v.IsInt() {
+		return nil, fmt.Errorf("%w: %q", errNotConstant, expr)
+	}
+	return new(big.Int).Set(v.Num()), nil
+}
+
+type exprParser struct {
+	tokens []string
+	pos    int
+	lookup func(name string) *big.Int
+}
+
+func (p *exprParser) peek() string {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos]
+	}
+	return ""
+}
+
+func (p *exprParser) next() string {
+	t := p.peek()
+	p.pos++
+	return t
+}
+
+func (p *exprParser) expect(t string) error {
+	if p.next() != t {
+		return errNotConstant
+	}
+	return nil
+}
+
+func (p *exprParser) sum() (*big.Rat, error) {
+	v, err := p.product()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek() == "+" || p.peek() == "-" {
+		op := p.next()
+		r, err := p.product()
+		if err != nil {
+			return nil, err
+		}
+		if op == "+" {
+			v = new(big.Rat).Add(v, r)
+		} else {
+			v = new(big.Rat).Sub(v, r)
+		}
+	}
+	return v, nil
+}
+
+func (p *exprParser) product() (*big.Rat, error) {
+	v, err := p.power()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek() == "*" || p.peek() == "/" || p.peek() == "%" {
+		op := p.next()
+		r, err := p.power()
+		if err != nil {
+			return nil, err
+		}
+		switch op {
+		case "*":
+			v = new(big.Rat).Mul(v, r)
+		case "/", "%":
+			if !v.IsInt() || !r.IsInt() || r.Sign() == 0 {
+				return nil, errNotConstant
+			}
+			q, m := new(big.Int).QuoRem(v.Num(), r.Num(), new(big.Int))
+			if op == "/" {
+				v = new(big.Rat).SetInt(q)
+			} else {
+				v = new(big.Rat).SetInt(m)
+			}
+		}
+	}
+	return v, nil
+}
+
+func (p *exprParser) power() (*big.Rat, error) {
+	v, err := p.unary()
+	if err != nil {
+		return nil, err
+	}
+	if p.peek() != "**" {
+		return v, nil
+	}
+	p.next()
+	e, err := p.power()
+	if err != nil {
+		return nil, err
+	}
+	if !v.IsInt() || !e.IsInt() || e.Sign() < 0 {
+		return nil, errNotConstant
+	}
+	return new(big.Rat).SetInt(new(big.Int).Exp(v.Num(), e.Num(), nil)), nil
+}
+
+func (p *exprParser) unary() (*big.Rat, error) {
+	if p.peek() == "-" {
+		p.next()
+		v, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		return new(big.Rat).Neg(v), nil
+	}
+	return p.primary()
+}
+
+func (p *exprParser) primary() (*big.Rat, error) {
+	t := p.next()
+	switch {
+	case t == "(":
+		v, err := p.sum()
+		if err != nil {
+			return nil, err
+		}
+		return v, p.expect(")")
+	case t == "type":
+		return p.typeBound()
+	case intTypePattern.MatchString(t) && p.peek() == "(":
+		// A cast between integer types leaves a constant unchanged.
+		p.next()
+		v, err := p.sum()
+		if err != nil {
+			return nil, err
+		}
+		return v, p.expect(")")
+	case t != "" && (t[0] >= '0' && t[0] <= '9'):
+		v, err := parseNumber(t)
+		if err != nil {
+			return nil, err
+		}
+		if unit, ok := units[p.peek()]; ok {
+			p.next()
+			v = new(big.Rat).Mul(v, unit)
+		}
+		return v, nil
+	case t != "" && (t[0] == '_' || t[0] >= 'A' && t[0] <= 'Z' || t[0] >= 'a' && t[0] <= 'z'):
+		v := p.lookup(t)
+		if v == nil {
+			return nil, errNotConstant
+		}
+		return new(big.Rat).SetInt(v), nil
+	}
+	return nil, errNotConstant
+}
+
+// typeBound evaluates the remainder of type(T).max or type(T).min.
+func (p *exprParser) typeBound() (*big.Rat, error) {
+	if err := p.expect("("); err != nil {
+		return nil, err
+	}
+	typ := p.next()
+	if !intTypePattern.MatchString(typ) {
+		return nil, errNotConstant
+	}
+	if err := p.expect(")"); err != nil {
+		return nil, err
+	}
+	if err := p.expect("."); err != nil {
+		return nil, err
+	}
+	signed := !strings.HasPrefix(typ, "u")
+	bits := 256
+	if size := strings.TrimLeft(typ, "uint"); size != "" {
+		if _, err := fmt.Sscan(size, &bits); err != nil {
+			return nil, errNotConstant
+		}
+	}
+	if signed {
+		bits--
+	}
+	limit := new(big.Int).Lsh(big.NewInt(1), uint(bits))
+	switch p.next() {
+	case "max":
+		return new(big.Rat).SetInt(limit.Sub(limit, big.NewInt(1))), nil
+	case "min":
+		if !signed {
+			return new(big.Rat), nil
+		}
+		return new(big.Rat).SetInt(limit.Neg(limit)), nil
+	}
+	return nil, errNotConstant
+}
+
+// parseNumber parses a decimal, hex or scientific Solidity number literal.
+func parseNumber(t string) (*big.Rat, error) {
+	t = strings.ReplaceAll(t, "_", "")
+	if strings.HasPrefix(t, "0x") {
+		v, ok := new(big.Int).SetString(t[2:], 16)
+		if !ok {
+			return nil, errNotConstant
+		}
+		return new(big.Rat).SetInt(v), nil
+	}
+	mantissa, exp, _ := strings.Cut(strings.ToLower(t), "e")
+	v, ok := new(big.Rat).SetString(mantissa)
+	if !ok {
+		return nil, errNotConstant
+	}
+	if exp != "" {
+		e, ok := new(big.Int).SetString(exp, 10)
+		if !ok {
+			return nil, errNotConstant
+		}
+		v.Mul(v, new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), e, nil)))
+	}
+	return v, nil
+}
@@ -0,0 +1,332 @@
+package bindgen
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Drift describes how a binding's embedded MetaData differs from the forge
+// artifact it should have been generated from. Added entries exist in the
+// artifact but not in the binding, removed entries the other way around.
+type Drift struct {
+	Name string
+	// MissingBinding is set when a selected contract has no binding package.
+	MissingBinding bool
+	// MissingArtifact is set when a binding package has no selected contract.
+	MissingArtifact bool
+
+	AddedMethods   []string
+	RemovedMethods []string
+	// ChangedMethods have the same signature but different outputs or state
+	// mutability.
+	ChangedMethods []string
+	AddedEvents    []string
+	RemovedEvents  []string
+	// ChangedEvents have the same signature but different indexed inputs.
+	ChangedEvents []string
+	AddedErrors   []string
+	RemovedErrors []string
+	Structs       []StructDrift
+
+	BytecodeChanged bool
+}
+
+// StructDrift lists the fields added to or removed from a Solidity struct.
+// Fields are formatted as "<type> <name>", so a retyped field shows up as
+// both removed and added.
+type StructDrift struct {
+	Struct  string
+	Added   []string
+	Removed []string
+}
+
+// Empty reports whether the binding is up to date.
+func (d *Drift) Empty() bool {
+	return !d.MissingBinding && !d.MissingArtifact && !d.BytecodeChanged &&
+		len(d.AddedMethods) == 0 && len(d.RemovedMethods) == 0 && len(d.ChangedMethods) == 0 &&
+		len(d.AddedEvents) == 0 && len(d.RemovedEvents) == 0 && len(d.ChangedEvents) == 0 &&
+		len(d.AddedErrors) == 0 && len(d.RemovedErrors) == 0 && len(d.Structs) == 0
+}
+
+// String renders the drift as a human readable report, one change per line.
+func (d *Drift) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s:", d.Name)
+	if d.Empty() {
+		b.WriteString(" up to date")
+		return b.String()
+	}
+	if d.MissingBinding {
+		b.WriteString("\n  no binding package")
+	}
+	if d.MissingArtifact {
+		b.WriteString("\n  binding package has no matching contract")
+	}
+	list := func(prefix string, items []string) {
+		for _, item := range items {
+			fmt.Fprintf(&b, "\n  %s %s", prefix, item)
+		}
+	}
+	list("+ method", d.AddedMethods)
+	list("- method", d.RemovedMethods)
+	list("~ method", d.ChangedMethods)
+	list("+ event", d.AddedEvents)
+	list("- event", d.RemovedEvents)
+	list("~ event", d.ChangedEvents)
+	list("+ error", d.AddedErrors)
+	list("- error", d.RemovedErrors)
+	for _, s := range d.Structs {
+		list("+ field "+s.Struct+":", s.Added)
+		list("- field "+s.Struct+":", s.Removed)
+	}
+	if d.BytecodeChanged {
+		b.WriteString("\n  bytecode changed")
+	}
+	return b.String()
+}
+
+// Diff compares a binding's MetaData against the artifact of its contract.
+func Diff(md *bind.MetaData, artifact *Artifact) (*Drift, error) {
+	bound, err := abi.JSON(strings.NewReader(md.ABI))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse binding abi for %s: %w", artifact.Name, err)
+	}
+	built, err := abi.JSON(strings.NewReader(artifact.ABI))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse artifact abi for %s: %w", artifact.Name, err)
+	}
+	d := &Drift{Name: artifact.Name}
+
+	boundMethods, builtMethods := methodsBySig(bound), methodsBySig(built)
+	d.AddedMethods, d.RemovedMethods = diffKeys(builtMethods, boundMethods)
+	for sig, m := range builtMethods {
+		if old, ok := boundMethods[sig]; ok {
+			if m.StateMutability != old.StateMutability || !sameTypes(m.Outputs, old.Outputs) {
+				d.ChangedMethods = append(d.ChangedMethods, sig)
+			}
+		}
+	}
+	sort.Strings(d.ChangedMethods)
+
+	boundEvents, builtEvents := eventsBySig(bound), eventsBySig(built)
+	d.AddedEvents, d.RemovedEvents = diffKeys(builtEvents, boundEvents)
+	for sig, e := range builtEvents {
+		if old, ok := boundEvents[sig]; ok && !sameIndexed(e.Inputs, old.Inputs) {
+			d.ChangedEvents = append(d.ChangedEvents, sig)
+		}
+	}
+	sort.Strings(d.ChangedEvents)
+
+	d.AddedErrors, d.RemovedErrors = diffKeys(errorsBySig(built), errorsBySig(bound))
+
+	boundStructs, err := Structs(md.ABI)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read binding structs for %s: %w", artifact.Name, err)
+	}
+	builtStructs, err := Structs(artifact.ABI)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read artifact structs for %s: %w", artifact.Name, err)
+	}
+	d.Structs = diffStructs(builtStructs, boundStructs)
+
+	d.BytecodeChanged = !bytes.Equal(common.FromHex(md.Bin), common.FromHex(artifact.Bytecode))
+	return d, nil
+}
+
+// Check loads the artifact of every contract selected by cfg and diffs it
+// against the binding of the same name in bound, typically bindings.MetaData.
+// Only bindings that differ are returned.
+func Check(cfg Config, bound map[string]*bind.MetaData) ([]*Drift, error) {
+	names, err := cfg.Contracts()
+	if err != nil {
+		return nil, err
+	}
+	var (
+		drifts   []*Drift
+		selected = make(map[string]bool)
+	)
+	for _, name := range names {
+		selected[name] = true
+		md, ok := bound[name]
+		if !ok {
+			drifts = append(drifts, &Drift{Name: name, MissingBinding: true})
+			continue
+		}
+		artifact, err := LoadArtifact(cfg.ArtifactDir, name)
+		if err != nil {
+			return nil, err
+		}
+		d, err := Diff(md, artifact)
+		if err != nil {
+			return nil, err
+		}
+		if !d.Empty() {
+			drifts = append(drifts, d)
+		}
+	}
+	for name := range bound {
+		if !selected[name] {
+			drifts = append(drifts, &Drift{Name: name, MissingArtifact: true})
+		}
+	}
+	sort.Slice(drifts, func(i, j int) bool { return drifts[i].Name < drifts[j].Name })
+	return drifts, nil
+}
+
+func methodsBySig(a abi.ABI) map[string]abi.Method {
+	out := make(map[string]abi.Method, len(a.Methods))
+	for _, m := range a.Methods {
+		out[m.Sig] = m
+	}
+	return out
+}
+
+func eventsBySig(a abi.ABI) map[string]abi.Event {
+	out := make(map[string]abi.Event, len(a.Events))
+	for _, e := range a.Events {
+		out[e.Sig] = e
+	}
+	return out
+}
+
+func errorsBySig(a abi.ABI) map[string]abi.Error {
+	out := make(map[string]abi.Error, len(a.Errors))
+	for _, e := range a.Errors {
+		out[e.Sig] = e
+	}
+	return out
+}
+
+// diffKeys returns the sorted keys only in want and only in have.
+func diffKeys[V any](want, have map[string]V) (added, removed []string) {
+	for k := range want {
+		if _, ok := have[k]; !ok {
+			added = append(added, k)
+		}
+	}
+	for k := range have {
+		if _, ok := want[k]; !ok {
+			removed = append(removed, k)
+		}
+	}
+	sort.Strings(added)
+	sort.Strings(removed)
+	return added, removed
+}
+
+func sameTypes(a, b abi.Arguments) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].Type.String() != b[i].Type.String() {
+			return false
+		}
+	}
+	return true
+}
+
+func sameIndexed(a, b abi.Arguments) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].Indexed != b[i].Indexed {
+			return false
+		}
+	}
+	return true
+}
+
+func diffStructs(want, have map[string][]string) []StructDrift {
+	var out []StructDrift
+	for name, fields := range want {
+		old, ok := have[name]
+		if !ok {
+			continue
+		}
+		added, removed := diffKeys(setOf(fields), setOf(old))
+		if len(added) > 0 || len(removed) > 0 {
+			out = append(out, StructDrift{Struct: name, Added: added, Removed: removed})
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Struct < out[j].Struct })
+	return out
+}
+
+func setOf(items []string) map[string]struct{} {
+	out := make(map[string]struct{}, len(items))
+	for _, item := range items {
+		out[item] = struct{}{}
+	}
+	return out
+}
+
+// abiParam is a function, event or error parameter of a JSON ABI.
+type abiParam struct {
+	Name         string     `json:"name"`
+	Type         string     `json:"type"`
+	InternalType string     `json:"internalType"`
+	Components   []abiParam `json:"components"`
+}
+
+type abiEntry struct {
+	Inputs  []abiParam `json:"inputs"`
+	Outputs []abiParam `json:"outputs"`
+}
+
+// Structs returns the fields, formatted as "<type> <name>", of every
+// Solidity struct referenced by a JSON ABI. Structs are keyed by their
+// qualified Solidity name, e.g. "IDelegationManager.Withdrawal".
+func Structs(abiJSON string) (map[string][]string, error) {
+	var entries []abiEntry
+	if err := json.Unmarshal([]byte(abiJSON), &entries); err != nil {
+		return nil, err
+	}
+	structs := make(map[string][]string)
+	var visit func(params []abiParam)
+	visit = func(params []abiParam) {
+		for _, p := range params {
+			if len(p.Components) == 0 {
+				continue
+			}
+			if name := StructName(p.InternalType); name != "" {
+				if _, ok := structs[name]; !ok {
+					fields := make([]string, len(p.Components))
+					for i, c := range p.Components {
+						fields[i] = c.Type + " " + c.Name
+					}
+					structs[name] = fields
+				}
+			}
+			visit(p.Components)
+		}
+	}
+	for _, e := range entries {
+		visit(e.Inputs)
+		visit(e.Outputs)
+	}
+	return structs, nil
+}
+
+// StructName extracts the qualified struct name from an ABI internalType
+// such as "struct IDelegationManager.Withdrawal[]", tolerating the stripped
+// form abigen embeds. It returns "" for non-struct types.
+func StructName(internalType string) string {
+	name := StripABI(internalType)
+	if !strings.HasPrefix(name, "struct") {
+		return ""
+	}
+	name = strings.TrimPrefix(name, "struct")
+	if i := strings.IndexByte(name, '['); i >= 0 {
+		name = name[:i]
+	}
+	return name
+}
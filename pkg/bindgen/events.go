@@ -0,0 +1,141 @@
+package bindgen
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+const (
+	// EventsFile is the name of the generated file, placed in a release's
+	// directory, that lists the events of every deployable contract.
+	EventsFile = "events.go"
+
+	// DefaultEventsImportPath is the import path of the package the
+	// generated event list is declared with.
+	DefaultEventsImportPath = "github.com/Layr-Labs/eigenlayer-contracts/pkg/events"
+)
+
+// EventEntry is an event of a bound contract, named as in its binding.
+type EventEntry struct {
+	Contract  string
+	Name      string
+	Signature string
+	ID        string
+	Topics    int
+	// GoName is the name abigen gives the event: its struct is
+	// <Contract><GoName> and its filterer parses it with Parse<GoName>.
+	GoName string
+}
+
+// EventEntries lists the non-anonymous events in the ABI of the named
+// contract, sorted by name.
+func EventEntries(name, abiJSON string) ([]EventEntry, error) {
+	parsed, err := abi.JSON(strings.NewReader(abiJSON))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse abi of %s: %w", name, err)
+	}
+	var sorted []abi.Event
+	for _, e := range parsed.Events {
+		sorted = append(sorted, e)
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Name < sorted[j].Name })
+
+	var out []EventEntry
+	used := make(map[string]bool)
+	for _, e := range sorted {
+		if e.Anonymous {
+			continue
+		}
+		// Deduplicate identifiers as abigen does.
+		goName := abi.ToCamelCase(e.Name)
+		for i := 0; used[goName]; i++ {
+			goName = fmt.Sprintf("%s%d", abi.ToCamelCase(e.Name), i)
+		}
+		used[goName] = true
+		topics := 1
+		for _, in := range e.Inputs {
+			if in.Indexed {
+				topics++
+			}
+		}
+		out = append(out, EventEntry{
+			Contract:  name,
+			Name:      e.Name,
+			Signature: e.Sig,
+			ID:        e.ID.Hex(),
+			Topics:    topics,
+			GoName:    goName,
+		})
+	}
+	return out, nil
+}
+
+// Deployable reports whether the named contract can be deployed, and so
+// emit events from an address of its own: a contract under the walked
+// source directory that is neither abstract, an interface nor a library,
+// or one declared elsewhere, such as the OpenZeppelin proxies.
+func (set *SolidityConstants) Deployable(name string) bool {
+	c, ok := set.contracts[name]
+	return !ok || c.Kind == "contract" && !c.Abstract
+}
+
+var eventsTemplate = template.Must(template.New("events").Parse(`// Code generated by bindgen - DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+{{range .Names}}
+	"{{$.ImportPath}}/{{.}}"
+{{- end}}
+	"{{.EventsImportPath}}"
+)
+
+// Events lists the events of every deployable bound contract, each parsed
+// into the struct of its binding package.
+var Events = []events.Event{
+{{- range .Events}}
+	{
+		Contract:  "{{.Contract}}",
+		Name:      "{{.Name}}",
+		Signature: "{{.Signature}}",
+		ID:        common.HexToHash("{{.ID}}"),
+		Topics:    {{.Topics}},
+		Parse:     events.Parser({{.Contract}}.New{{.Contract}}Filterer, (*{{.Contract}}.{{.Contract}}Filterer).Parse{{.GoName}}),
+	},
+{{- end}}
+}
+`))
+
+// EventsSource renders the file of a release's registry package pkg listing
+// entries, whose binding packages are found under importPath.
+func EventsSource(pkg, importPath, eventsImportPath string, entries []EventEntry) (string, error) {
+	var names []string
+	for _, e := range entries {
+		if n := len(names); n == 0 || names[n-1] != e.Contract {
+			names = append(names, e.Contract)
+		}
+	}
+	var buf bytes.Buffer
+	err := eventsTemplate.Execute(&buf, struct {
+		Package          string
+		ImportPath       string
+		EventsImportPath string
+		Names            []string
+		Events           []EventEntry
+	}{pkg, importPath, eventsImportPath, names, entries})
+	if err != nil {
+		return "", err
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return "", fmt.Errorf("failed to format events: %w", err)
+	}
+	return string(src), nil
+}
@@ -0,0 +1,55 @@
+package bindgen
+
+import (
+	"flag"
+	"path/filepath"
+	"strings"
+)
+
+// nameList is a comma separated, repeatable flag.Value collecting names.
+type nameList []string
+
+func (l *nameList) String() string { return strings.Join(*l, ",") }
+
+func (l *nameList) Set(value string) error {
+	for _, name := range strings.Split(value, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			*l = append(*l, name)
+		}
+	}
+	return nil
+}
+
+// RegisterFlags binds the contract selection flags shared by the binding
+// commands to cfg.
+func (cfg *Config) RegisterFlags(fs *flag.FlagSet) {
+	fs.StringVar(&cfg.SourceDir, "src", DefaultSourceDir, "directory walked for .sol files to bind")
+	fs.StringVar(&cfg.ArtifactDir, "artifacts", DefaultArtifactDir, "forge output directory")
+	fs.StringVar(&cfg.Release, "release", "", "release to process, e.g. "+LatestRelease()+" (default all)")
+	fs.Var((*nameList)(&cfg.Include), "include", "additional contracts to bind (comma separated)")
+	fs.Var((*nameList)(&cfg.Exclude), "exclude", "contracts to skip (comma separated)")
+}
+
+// ReleaseConfigs returns a copy of cfg per release it selects: the release
+// it names, or every entry of Releases if it names none. The copy of an
+// older release reads the sources and artifacts in the release's Dir.
+func (cfg Config) ReleaseConfigs() []Config {
+	var out []Config
+	for _, r := range Releases {
+		if cfg.Release != "" && cfg.Release != r.Name {
+			continue
+		}
+		c := cfg
+		c.Release = r.Name
+		if r.Dir != "" {
+			c.SourceDir = filepath.Join(r.Dir, DefaultSourceDir)
+			c.ArtifactDir = filepath.Join(r.Dir, DefaultArtifactDir)
+		}
+		out = append(out, c)
+	}
+	if len(out) == 0 {
+		// Left for Run to report as unknown.
+		out = append(out, cfg)
+	}
+	return out
+}
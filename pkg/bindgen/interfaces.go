@@ -0,0 +1,174 @@
+package bindgen
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+// InterfacesFile is the name of the generated file declaring the interfaces
+// of a binding package.
+const InterfacesFile = "interfaces.go"
+
+// knownImports maps the package names used in abigen method signatures to
+// their import paths.
+var knownImports = map[string]string{
+	"big":      "math/big",
+	"ethereum": "github.com/ethereum/go-ethereum",
+	"abi":      "github.com/ethereum/go-ethereum/accounts/abi",
+	"bind":     "github.com/ethereum/go-ethereum/accounts/abi/bind",
+	"common":   "github.com/ethereum/go-ethereum/common",
+	"types":    "github.com/ethereum/go-ethereum/core/types",
+	"event":    "github.com/ethereum/go-ethereum/event",
+}
+
+// surface is one of the interfaces generated per contract.
+type surface struct {
+	// Name is the interface name, e.g. "DelegationManagerReader".
+	Name string
+	// Impl is the abigen type implementing it, e.g. "DelegationManagerCaller".
+	Impl string
+	// Doc describes the interface.
+	Doc     string
+	Methods []string
+}
+
+var interfacesTemplate = template.Must(template.New("interfaces").Parse(`// Code generated by bindgen - DO NOT EDIT.
+
+package {{.Package}}
+{{- if or .StdImports .Imports}}
+
+import (
+{{- range .StdImports}}
+	{{printf "%q" .}}
+{{- end}}
+{{- if and .StdImports .Imports}}
+{{end}}
+{{- range .Imports}}
+	{{printf "%q" .}}
+{{- end}}
+)
+{{- end}}
+{{range .Surfaces}}
+// {{.Name}} {{.Doc}}
+type {{.Name}} interface {
+{{- range .Methods}}
+	{{.}}
+{{- end}}
+}
+{{end}}
+var (
+{{- range .Surfaces}}
+	_ {{.Name}} = (*{{.Impl}})(nil)
+{{- end}}
+{{- range .Surfaces}}
+	_ {{.Name}} = (*{{$.Package}})(nil)
+{{- end}}
+)
+`))
+
+// Interfaces renders the Reader, Writer and Events interfaces of a binding
+// package from the methods abigen declares on its Caller, Transactor and
+// Filterer types.
+func Interfaces(name, src string) (string, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "", src, 0)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse binding %s: %w", name, err)
+	}
+	surfaces := []*surface{
+		{Name: name + "Reader", Impl: name + "Caller", Doc: "is the read-only, constant method surface of " + name + "."},
+		{Name: name + "Writer", Impl: name + "Transactor", Doc: "is the transaction sending method surface of " + name + "."},
+		{Name: name + "Events", Impl: name + "Filterer", Doc: "is the log filtering, watching and parsing surface of " + name + "."},
+	}
+	byImpl := make(map[string]*surface, len(surfaces))
+	for _, s := range surfaces {
+		byImpl[s.Impl] = s
+	}
+	declared := make(map[string]bool)
+	used := make(map[string]bool)
+	for _, decl := range file.Decls {
+		switch decl := decl.(type) {
+		case *ast.GenDecl:
+			for _, spec := range decl.Specs {
+				if ts, ok := spec.(*ast.TypeSpec); ok {
+					declared[ts.Name.Name] = true
+				}
+			}
+		case *ast.FuncDecl:
+			if decl.Recv == nil || !decl.Name.IsExported() {
+				continue
+			}
+			star, ok := decl.Recv.List[0].Type.(*ast.StarExpr)
+			if !ok {
+				continue
+			}
+			recv, ok := star.X.(*ast.Ident)
+			if !ok || byImpl[recv.Name] == nil {
+				continue
+			}
+			var buf bytes.Buffer
+			if err := printer.Fprint(&buf, fset, decl.Type); err != nil {
+				return "", err
+			}
+			// Drop the leading "func" keyword of the printed signature.
+			sig := decl.Name.Name + buf.String()[len("func"):]
+			s := byImpl[recv.Name]
+			s.Methods = append(s.Methods, sig)
+			ast.Inspect(decl.Type, func(n ast.Node) bool {
+				if sel, ok := n.(*ast.SelectorExpr); ok {
+					if pkg, ok := sel.X.(*ast.Ident); ok {
+						used[pkg.Name] = true
+					}
+				}
+				return true
+			})
+		}
+	}
+	for _, s := range surfaces {
+		if declared[s.Name] {
+			return "", fmt.Errorf("binding %s already declares %s", name, s.Name)
+		}
+		if !declared[s.Impl] {
+			return "", fmt.Errorf("binding %s does not declare %s", name, s.Impl)
+		}
+		sort.Strings(s.Methods)
+	}
+	var std, imports []string
+	for pkg := range used {
+		path, ok := knownImports[pkg]
+		if !ok {
+			return "", fmt.Errorf("binding %s uses unknown package %s", name, pkg)
+		}
+		if strings.Contains(path, ".") {
+			imports = append(imports, path)
+		} else {
+			std = append(std, path)
+		}
+	}
+	sort.Strings(std)
+	sort.Strings(imports)
+
+	var buf bytes.Buffer
+	err = interfacesTemplate.Execute(&buf, struct {
+		Package    string
+		StdImports []string
+		Imports    []string
+		Surfaces   []*surface
+	}{name, std, imports, surfaces})
+	if err != nil {
+		return "", err
+	}
+	out, err := format.Source(buf.Bytes())
+	if err != nil {
+		return "", fmt.Errorf("failed to format interfaces for %s: %w", name, err)
+	}
+	return string(out), nil
+}
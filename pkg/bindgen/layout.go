@@ -0,0 +1,134 @@
+package bindgen
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"strings"
+	"text/template"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/storage"
+)
+
+const (
+	// LayoutFile is the name of the generated file embedding the storage
+	// layout of a binding package's contract.
+	LayoutFile = "layout.go"
+
+	// DefaultStorageImportPath is the import path of the package the
+	// embedded storage layouts are parsed with.
+	DefaultStorageImportPath = "github.com/Layr-Labs/eigenlayer-contracts/pkg/storage"
+)
+
+var layoutTemplate = template.Must(template.New("layout").Parse(`// Code generated by bindgen - DO NOT EDIT.
+
+package {{.Name}}
+
+import "{{.ImportPath}}"
+
+// {{.Name}}StorageLayout is the storage layout of {{.Name}}, base contracts
+// first, as reported by solc.
+var {{.Name}}StorageLayout = storage.MustParseLayout(` + "`{{.JSON}}`" + `)
+`))
+
+// LayoutSource renders the file embedding layout into the binding package
+// of the named contract, or returns "" if layout is nil.
+func LayoutSource(name, storageImportPath string, layout *storage.Layout) (string, error) {
+	if layout == nil {
+		return "", nil
+	}
+	data, err := json.MarshalIndent(layout, "", "\t")
+	if err != nil {
+		return "", err
+	}
+	var buf bytes.Buffer
+	err = layoutTemplate.Execute(&buf, struct {
+		Name       string
+		ImportPath string
+		JSON       string
+	}{name, storageImportPath, string(data)})
+	if err != nil {
+		return "", err
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return "", fmt.Errorf("failed to format storage layout of %s: %w", name, err)
+	}
+	return string(src), nil
+}
+
+// LayoutDrift lists the state variables and struct members whose placement
+// differs between a binding's embedded storage layout and its artifact.
+// Entries are formatted as "<owner>.<label> <type> at slot <n> offset <n>",
+// so a moved or retyped variable shows up as both removed and added.
+type LayoutDrift struct {
+	Name    string
+	Added   []string
+	Removed []string
+}
+
+func (d *LayoutDrift) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s:", d.Name)
+	for _, item := range d.Added {
+		fmt.Fprintf(&b, "\n  + storage %s", item)
+	}
+	for _, item := range d.Removed {
+		fmt.Fprintf(&b, "\n  - storage %s", item)
+	}
+	return b.String()
+}
+
+// DiffLayout compares the storage layout a binding embeds against the one
+// in its artifact. Either may be nil. It returns nil if they agree.
+// Identifiers that depend on the compilation, such as AST ids in type
+// names, are ignored.
+func DiffLayout(name string, bound, built *storage.Layout) *LayoutDrift {
+	added, removed := diffKeys(layoutEntries(built), layoutEntries(bound))
+	if len(added) == 0 && len(removed) == 0 {
+		return nil
+	}
+	return &LayoutDrift{Name: name, Added: added, Removed: removed}
+}
+
+func layoutEntries(l *storage.Layout) map[string]struct{} {
+	out := make(map[string]struct{})
+	if l == nil {
+		return out
+	}
+	add := func(owner string, v storage.Variable) {
+		out[fmt.Sprintf("%s.%s %s at slot %s offset %d", owner, v.Label, l.Types[v.Type].Label, v.Slot, v.Offset)] = struct{}{}
+	}
+	for _, v := range l.Storage {
+		add(v.ContractName(), v)
+	}
+	for _, t := range l.Types {
+		for _, m := range t.Members {
+			add(t.Label, m)
+		}
+	}
+	return out
+}
+
+// CheckLayouts loads the artifact of every contract selected by cfg and
+// diffs its storage layout against the one embedded in its binding, taken
+// from bound, typically bindings.StorageLayouts. Only layouts that differ
+// are returned.
+func CheckLayouts(cfg Config, bound map[string]*storage.Layout) ([]*LayoutDrift, error) {
+	names, err := cfg.Contracts()
+	if err != nil {
+		return nil, err
+	}
+	var drifts []*LayoutDrift
+	for _, name := range names {
+		artifact, err := LoadArtifact(cfg.ArtifactDir, name)
+		if err != nil {
+			return nil, err
+		}
+		if d := DiffLayout(name, bound[name], artifact.StorageLayout); d != nil {
+			drifts = append(drifts, d)
+		}
+	}
+	return drifts, nil
+}
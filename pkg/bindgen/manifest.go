@@ -0,0 +1,90 @@
+package bindgen
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// ManifestFile is the name of the manifest written next to the generated
+// binding packages.
+const ManifestFile = "manifest.json"
+
+// Manifest records the ABI and bytecode each binding package was generated
+// from, so that a regeneration can be checked for reproducibility.
+type Manifest struct {
+	Contracts []ManifestEntry `json:"contracts"`
+}
+
+// ManifestEntry describes the inputs of a single binding package.
+type ManifestEntry struct {
+	Name string `json:"name"`
+	// ABIHash is the keccak256 hash of the whitespace stripped ABI.
+	ABIHash common.Hash `json:"abiHash"`
+	// BytecodeHash is the keccak256 hash of the creation bytecode, or the
+	// zero hash if the contract has none.
+	BytecodeHash common.Hash `json:"bytecodeHash"`
+}
+
+// NewManifestEntry hashes the given ABI and hex encoded bytecode.
+func NewManifestEntry(name, abi, bin string) ManifestEntry {
+	entry := ManifestEntry{
+		Name:    name,
+		ABIHash: crypto.Keccak256Hash([]byte(StripABI(abi))),
+	}
+	if code := common.FromHex(bin); len(code) > 0 {
+		entry.BytecodeHash = crypto.Keccak256Hash(code)
+	}
+	return entry
+}
+
+// Entry returns the manifest entry for the named contract.
+func (m *Manifest) Entry(name string) (ManifestEntry, bool) {
+	for _, entry := range m.Contracts {
+		if entry.Name == name {
+			return entry, true
+		}
+	}
+	return ManifestEntry{}, false
+}
+
+// Add inserts or replaces the entry for a contract, keeping entries sorted by
+// name.
+func (m *Manifest) Add(entry ManifestEntry) {
+	for i := range m.Contracts {
+		if m.Contracts[i].Name == entry.Name {
+			m.Contracts[i] = entry
+			return
+		}
+	}
+	m.Contracts = append(m.Contracts, entry)
+	sort.Slice(m.Contracts, func(i, j int) bool {
+		return m.Contracts[i].Name < m.Contracts[j].Name
+	})
+}
+
+// Marshal encodes the manifest in its canonical on-disk form.
+func (m *Manifest) Marshal() ([]byte, error) {
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(data, '\n'), nil
+}
+
+// ReadManifest loads a manifest previously written by Result.Write.
+func ReadManifest(path string) (*Manifest, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var m Manifest
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, fmt.Errorf("failed to decode manifest %s: %w", path, err)
+	}
+	return &m, nil
+}
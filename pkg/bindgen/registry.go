@@ -0,0 +1,148 @@
+package bindgen
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"text/template"
+)
+
+const (
+	// DefaultImportPath is the import path of the directory holding the
+	// binding set of the latest release, and that of every older release in
+	// a subdirectory.
+	DefaultImportPath = "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings"
+
+	// RegistryFile is the name of the generated file, placed in a release's
+	// directory itself, that indexes every binding package of the release.
+	RegistryFile = "metadata.go"
+
+	// ReleasesFile is the name of the generated file, placed in the output
+	// directory, that indexes the binding set of every release.
+	ReleasesFile = "releases.go"
+)
+
+var registryTemplate = template.Must(template.New("registry").Parse(`// Code generated by bindgen - DO NOT EDIT.
+{{if ne .Package "bindings"}}
+// Package {{.Package}} indexes the generated binding packages of release
+// {{.Package}}.
+{{- end}}
+package {{.Package}}
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+{{range .Names}}
+	"{{$.ImportPath}}/{{.}}"
+{{- end}}
+	"{{.StorageImportPath}}"
+)
+
+// MetaData maps every bound contract name to the MetaData embedded in its
+// binding package.
+var MetaData = map[string]*bind.MetaData{
+{{- range .Names}}
+	"{{.}}": {{.}}.{{.}}MetaData,
+{{- end}}
+}
+
+// StorageLayouts maps every bound contract with state variables to the
+// storage layout embedded in its binding package.
+var StorageLayouts = map[string]*storage.Layout{
+{{- range .Layouts}}
+	"{{.}}": {{.}}.{{.}}StorageLayout,
+{{- end}}
+}
+`))
+
+// Registry renders the file of a release's registry package pkg that indexes
+// the named binding packages under importPath. Those named in layouts embed a storage layout.
+func Registry(pkg, importPath, storageImportPath string, names, layouts []string) (string, error) {
+	var buf bytes.Buffer
+	err := registryTemplate.Execute(&buf, struct {
+		Package           string
+		ImportPath        string
+		StorageImportPath string
+		Names             []string
+		Layouts           []string
+	}{pkg, importPath, storageImportPath, names, layouts})
+	if err != nil {
+		return "", err
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return "", fmt.Errorf("failed to format registry: %w", err)
+	}
+	return string(src), nil
+}
+
+var releasesTemplate = template.Must(template.New("releases").Parse(`// Code generated by bindgen - DO NOT EDIT.
+
+// Package bindings holds the generated binding packages of the release of
+// this tree, and those of every older release in a subdirectory named after
+// it.
+package bindings
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+{{range .Older}}
+	"{{$.ImportPath}}/{{.Name}}"
+{{- end}}
+	"{{.EventsImportPath}}"
+	"{{.StorageImportPath}}"
+)
+
+// Set is the binding set of a single release.
+type Set struct {
+	// Release is the name of the release, e.g. "v0_3", and for an older
+	// release also of the package holding its registry.
+	Release        string
+	MetaData       map[string]*bind.MetaData
+	StorageLayouts map[string]*storage.Layout
+	// Events lists the events of every deployable contract, for
+	// events.NewRegistry.
+	Events []events.Event
+}
+
+// Releases lists the binding set of every release, oldest first.
+var Releases = []Set{
+{{- range .Older}}
+	{Release: "{{.Name}}", MetaData: {{.Name}}.MetaData, StorageLayouts: {{.Name}}.StorageLayouts, Events: {{.Name}}.Events},
+{{- end}}
+	{Release: "{{.Latest}}", MetaData: MetaData, StorageLayouts: StorageLayouts, Events: Events},
+}
+
+// Latest is the binding set of the release in this tree.
+var Latest = Releases[len(Releases)-1]
+
+// Release returns the binding set of the named release.
+func Release(name string) (Set, bool) {
+	for _, s := range Releases {
+		if s.Release == name {
+			return s, true
+		}
+	}
+	return Set{}, false
+}
+`))
+
+// ReleasesIndex renders the file indexing the registry of every entry of
+// Releases: that of the latest release, in the same package at importPath,
+// and those of older releases under importPath.
+func ReleasesIndex(importPath, storageImportPath, eventsImportPath string) (string, error) {
+	var buf bytes.Buffer
+	err := releasesTemplate.Execute(&buf, struct {
+		ImportPath        string
+		StorageImportPath string
+		EventsImportPath  string
+		Older             []Release
+		Latest            string
+	}{importPath, storageImportPath, eventsImportPath, Releases[:len(Releases)-1], LatestRelease()})
+	if err != nil {
+		return "", err
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return "", fmt.Errorf("failed to format releases index: %w", err)
+	}
+	return string(src), nil
+}
@@ -0,0 +1,334 @@
+package bindgen
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+	"unicode"
+)
+
+const (
+	// DefaultRevertsDir is the directory of the generated reverts package.
+	DefaultRevertsDir = "pkg/reverts"
+
+	// RevertsFile is the name of the generated revert catalogue file.
+	RevertsFile = "catalogue.go"
+)
+
+// Revert is a reason string passed to require or revert.
+type Revert struct {
+	// Contract and Function declare the first require or revert raising
+	// Reason. Function is empty outside of functions and modifiers.
+	Contract string
+	Function string
+	Reason   string
+	// Message is Reason without its "Contract.function: " prefix, if any.
+	// Reasons sharing a message share a sentinel error.
+	Message string
+}
+
+// SentinelName is the Go identifier of the sentinel error of a revert
+// message, e.g. ErrStakerAlreadyDelegated.
+func SentinelName(message string) string {
+	if name, ok := sentinelNames[message]; ok {
+		return name
+	}
+	var b strings.Builder
+	b.WriteString("Err")
+	for _, word := range wordPattern.FindAllString(strings.ReplaceAll(message, "'", ""), -1) {
+		switch {
+		case stopWords[strings.ToLower(word)]:
+		case strings.ToUpper(word) == word:
+			b.WriteString(camel(word))
+		default:
+			for _, part := range strings.Split(word, "_") {
+				if part != "" {
+					b.WriteRune(unicode.ToUpper(rune(part[0])))
+					b.WriteString(part[1:])
+				}
+			}
+		}
+	}
+	return b.String()
+}
+
+var wordPattern = regexp.MustCompile(`[A-Za-z0-9_]+`)
+
+// stopWords are left out of sentinel names.
+var stopWords = map[string]bool{
+	"a": true, "an": true, "the": true, "is": true, "are": true, "has": true,
+	"have": true, "been": true, "yet": true,
+}
+
+// sentinelNames overrides the derived name of sentinels whose message reads
+// poorly as an identifier, such as those comparing with ">".
+var sentinelNames = map[string]string{
+	"staker is already actively delegated":                                              "ErrStakerAlreadyDelegated",
+	"minWithdrawalDelayBlocks period has not yet passed":                                "ErrWithdrawalDelayNotPassed",
+	"withdrawalDelayBlocks period has not yet passed for this strategy":                 "ErrStrategyWithdrawalDelayNotPassed",
+	"_minWithdrawalDelayBlocks cannot be > MAX_WITHDRAWAL_DELAY_BLOCKS":                 "ErrMinWithdrawalDelayBlocksTooLarge",
+	"_withdrawalDelayBlocks cannot be > MAX_WITHDRAWAL_DELAY_BLOCKS":                    "ErrWithdrawalDelayBlocksTooLarge",
+	"stakerOptOutWindowBlocks cannot be > MAX_STAKER_OPT_OUT_WINDOW_BLOCKS":             "ErrStakerOptOutWindowBlocksTooLarge",
+	"withdrawer must be same address as staker if thirdPartyTransfersForbidden are set": "ErrThirdPartyTransfersForbidden",
+}
+
+var (
+	revertCallPattern = regexp.MustCompile(`\b(require|revert)\s*\(`)
+	reasonPattern     = regexp.MustCompile(`(?:^|,)\s*((?:"(?:[^"\\]|\\.)*"\s*)+)$`)
+	literalPattern    = regexp.MustCompile(`"(?:[^"\\]|\\.)*"`)
+	functionPattern   = regexp.MustCompile(`\b(?:function|modifier)\s+(\w+)|\b(constructor|receive|fallback)\s*\(`)
+)
+
+// ParseReverts extracts the reason of every require and revert in the .sol
+// files under sourceDir, sorted by reason. Reasons are string literals;
+// calls without one, custom errors and assembly reverts are skipped.
+func ParseReverts(sourceDir string) ([]*Revert, error) {
+	byReason := make(map[string]*Revert)
+	var contracts []string
+	err := filepath.WalkDir(sourceDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || filepath.Ext(path) != ".sol" {
+			return nil
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		found, names, err := parseRevertFile(stripComments(string(data)))
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		contracts = append(contracts, names...)
+		for _, r := range found {
+			if _, ok := byReason[r.Reason]; !ok {
+				byReason[r.Reason] = r
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse reverts under %s: %w", sourceDir, err)
+	}
+	prefix := regexp.MustCompile(`^(?:` + strings.Join(contracts, "|") + `)(?:\.(\w+))?(?::\s*|\s+|$)`)
+	out := make([]*Revert, 0, len(byReason))
+	for _, r := range byReason {
+		r.Message = r.Reason
+		if m := prefix.FindStringSubmatchIndex(r.Reason); m != nil {
+			r.Message = r.Reason[m[1]:]
+			if r.Message == "" && m[2] >= 0 {
+				// Modifiers such as "StrategyBase.onlyStrategyManager" give
+				// only their name.
+				r.Message = r.Reason[m[2]:m[3]]
+			}
+		}
+		out = append(out, r)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Reason < out[j].Reason })
+	return out, nil
+}
+
+// parseRevertFile returns the reverts in src, and the names of the
+// contracts it declares.
+func parseRevertFile(src string) ([]*Revert, []string, error) {
+	var (
+		out   []*Revert
+		names []string
+	)
+	decls := contractPattern.FindAllStringSubmatchIndex(src, -1)
+	for i, m := range decls {
+		end := len(src)
+		if i+1 < len(decls) {
+			end = decls[i+1][0]
+		}
+		contract := src[m[6]:m[7]]
+		names = append(names, contract)
+		body := src[m[1]:end]
+		functions := functionPattern.FindAllStringSubmatchIndex(body, -1)
+		for _, call := range revertCallPattern.FindAllStringIndex(body, -1) {
+			args, err := callArgs(body, call[1])
+			if err != nil {
+				return nil, nil, fmt.Errorf("%s: %w", contract, err)
+			}
+			lits := reasonPattern.FindStringSubmatch(strings.TrimSpace(args))
+			if lits == nil {
+				continue
+			}
+			var reason strings.Builder
+			for _, lit := range literalPattern.FindAllString(lits[1], -1) {
+				s, err := strconv.Unquote(lit)
+				if err != nil {
+					return nil, nil, fmt.Errorf("%s: invalid reason %s: %w", contract, lit, err)
+				}
+				reason.WriteString(s)
+			}
+			r := &Revert{Contract: contract, Reason: reason.String()}
+			for _, f := range functions {
+				if f[0] > call[0] {
+					break
+				}
+				if f[2] >= 0 {
+					r.Function = body[f[2]:f[3]]
+				} else {
+					r.Function = body[f[4]:f[5]]
+				}
+			}
+			out = append(out, r)
+		}
+	}
+	return out, names, nil
+}
+
+// callArgs returns the arguments of the call whose opening parenthesis
+// ends at start.
+func callArgs(src string, start int) (string, error) {
+	depth := 1
+	for i := start; i < len(src); i++ {
+		switch src[i] {
+		case '"':
+			for i++; i < len(src) && src[i] != '"'; i++ {
+				if src[i] == '\\' {
+					i++
+				}
+			}
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				return src[start:i], nil
+			}
+		}
+	}
+	return "", fmt.Errorf("unbalanced call at offset %d", start)
+}
+
+// Sentinel is a revert message and the reasons raising it.
+type Sentinel struct {
+	Name    string
+	Message string
+	Reverts []*Revert
+}
+
+// Sentinels groups reverts by message, sorted by name. It fails if two
+// messages map to the same name, which is then to be resolved in
+// sentinelNames.
+func Sentinels(reverts []*Revert) ([]*Sentinel, error) {
+	byMessage := make(map[string]*Sentinel)
+	byName := make(map[string]*Sentinel)
+	var out []*Sentinel
+	for _, r := range reverts {
+		s, ok := byMessage[r.Message]
+		if !ok {
+			s = &Sentinel{Name: SentinelName(r.Message), Message: r.Message}
+			if prev, ok := byName[s.Name]; ok {
+				return nil, fmt.Errorf("revert messages %q and %q both map to %s", prev.Message, s.Message, s.Name)
+			}
+			byMessage[r.Message], byName[s.Name] = s, s
+			out = append(out, s)
+		}
+		s.Reverts = append(s.Reverts, r)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out, nil
+}
+
+var revertsTemplate = template.Must(template.New("reverts").Parse(`// Code generated by bindgen - DO NOT EDIT.
+
+package reverts
+
+import "errors"
+
+// Sentinel errors, one per distinct revert message. Catalogued reverts
+// match the sentinel of their message with errors.Is.
+var (
+{{- range .Sentinels}}
+	{{.Name}} = errors.New({{printf "%q" .Message}})
+{{- end}}
+)
+
+// Catalogue maps the reason of every require and revert in the contracts to
+// where it is raised and its sentinel.
+var Catalogue = map[string]Reason{
+{{- range .Reverts}}
+	{{printf "%q" .Reason}}: {Contract: "{{.Contract}}", Function: "{{.Function}}", Err: {{index $.Names .Message}}},
+{{- end}}
+}
+`))
+
+// RevertsSource renders the revert catalogue of the reverts package.
+func RevertsSource(reverts []*Revert) (string, error) {
+	sentinels, err := Sentinels(reverts)
+	if err != nil {
+		return "", err
+	}
+	names := make(map[string]string, len(sentinels))
+	for _, s := range sentinels {
+		names[s.Message] = s.Name
+	}
+	var buf bytes.Buffer
+	err = revertsTemplate.Execute(&buf, struct {
+		Sentinels []*Sentinel
+		Reverts   []*Revert
+		Names     map[string]string
+	}{sentinels, reverts, names})
+	if err != nil {
+		return "", err
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return "", fmt.Errorf("failed to format reverts: %w", err)
+	}
+	return string(src), nil
+}
+
+// RevertDrift is a revert reason raised by the contracts but missing from
+// the catalogue, or catalogued but no longer raised.
+type RevertDrift struct {
+	Reason string
+	Added  bool
+}
+
+func (d RevertDrift) String() string {
+	if d.Added {
+		return fmt.Sprintf("+ revert %q", d.Reason)
+	}
+	return fmt.Sprintf("- revert %q", d.Reason)
+}
+
+// CheckReverts compares the reasons a revert catalogue was generated from
+// against those currently raised under sourceDir.
+func CheckReverts(sourceDir string, bound []string) ([]RevertDrift, error) {
+	reverts, err := ParseReverts(sourceDir)
+	if err != nil {
+		return nil, err
+	}
+	source := make(map[string]bool, len(reverts))
+	for _, r := range reverts {
+		source[r.Reason] = true
+	}
+	catalogued := make(map[string]bool, len(bound))
+	var drifts []RevertDrift
+	for _, reason := range bound {
+		catalogued[reason] = true
+		if !source[reason] {
+			drifts = append(drifts, RevertDrift{Reason: reason})
+		}
+	}
+	for _, r := range reverts {
+		if !catalogued[r.Reason] {
+			drifts = append(drifts, RevertDrift{Reason: r.Reason, Added: true})
+		}
+	}
+	sort.Slice(drifts, func(i, j int) bool { return drifts[i].Reason < drifts[j].Reason })
+	return drifts, nil
+}
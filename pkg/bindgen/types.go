@@ -0,0 +1,315 @@
+package bindgen
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	gotypes "go/types"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+const (
+	// DefaultTypesDir and DefaultTypesImportPath locate the package holding
+	// the canonical struct types.
+	DefaultTypesDir        = "pkg/types"
+	DefaultTypesImportPath = "github.com/Layr-Labs/eigenlayer-contracts/pkg/types"
+
+	// TypesFile is the name of the generated file declaring the canonical
+	// types, and ConvertersFile the name of the file in each binding package
+	// converting to and from them.
+	TypesFile      = "structs.go"
+	ConvertersFile = "types.go"
+)
+
+// CanonicalStruct is the single Go type generated for a Solidity struct that
+// abigen re-declares in every binding referencing it.
+type CanonicalStruct struct {
+	// Solidity is the qualified Solidity name, e.g. "IDelegationManager.Withdrawal".
+	Solidity string
+	// Bound is the name abigen gives the struct, e.g. "IDelegationManagerWithdrawal".
+	Bound string
+	// Name is the canonical Go name, e.g. "Withdrawal".
+	Name   string
+	Fields []CanonicalField
+	// Packages lists the binding packages declaring a copy of the struct.
+	Packages []string
+}
+
+// CanonicalField is a struct field, with nested struct types referring to
+// their canonical names.
+type CanonicalField struct {
+	Name string
+	Type string
+	// Nested is the canonical struct the field holds, directly or as a
+	// slice, if any.
+	Nested *CanonicalStruct
+	Slice  bool
+}
+
+// Flat reports whether the struct embeds no other struct, in which case the
+// binding copies convert to and from it with a plain Go conversion.
+func (s *CanonicalStruct) Flat() bool {
+	for _, f := range s.Fields {
+		if f.Nested != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// boundStruct is a struct declaration found in generated binding source.
+type boundStruct struct {
+	fields []boundField
+}
+
+type boundField struct {
+	name string
+	typ  ast.Expr
+}
+
+// boundStructs returns the user-defined struct declarations abigen emitted
+// into a binding's source, keyed by Go name.
+func boundStructs(src string) (map[string]boundStruct, error) {
+	file, err := parser.ParseFile(token.NewFileSet(), "", src, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+	out := make(map[string]boundStruct)
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.TYPE || gen.Doc == nil || !strings.Contains(gen.Doc.Text(), "user-defined struct") {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			ts := spec.(*ast.TypeSpec)
+			st, ok := ts.Type.(*ast.StructType)
+			if !ok {
+				continue
+			}
+			var s boundStruct
+			for _, f := range st.Fields.List {
+				for _, n := range f.Names {
+					s.fields = append(s.fields, boundField{name: n.Name, typ: f.Type})
+				}
+			}
+			out[ts.Name.Name] = s
+		}
+	}
+	return out, nil
+}
+
+// Canonicalize collects every Solidity struct used by the given bindings and
+// checks that all copies of a struct agree. Canonical names drop the
+// declaring contract unless two structs would then collide.
+func Canonicalize(bindings []Binding) ([]*CanonicalStruct, error) {
+	var (
+		bySolidity = make(map[string]*CanonicalStruct)
+		layouts    = make(map[string][]boundField)
+	)
+	for _, b := range bindings {
+		decls, err := boundStructs(b.Source)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse binding %s: %w", b.Name, err)
+		}
+		structs, err := Structs(b.ABI)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read structs of %s: %w", b.Name, err)
+		}
+		for solidity := range structs {
+			bound := strings.ReplaceAll(solidity, ".", "")
+			decl, ok := decls[bound]
+			if !ok {
+				return nil, fmt.Errorf("binding %s does not declare %s", b.Name, bound)
+			}
+			cs, ok := bySolidity[solidity]
+			if !ok {
+				cs = &CanonicalStruct{Solidity: solidity, Bound: bound}
+				bySolidity[solidity] = cs
+				layouts[solidity] = decl.fields
+			} else if !sameLayout(layouts[solidity], decl.fields) {
+				return nil, fmt.Errorf("binding %s declares %s with a different layout than %s", b.Name, bound, cs.Packages[0])
+			}
+			cs.Packages = append(cs.Packages, b.Name)
+		}
+	}
+
+	// Name every struct, falling back to the bound name on collisions.
+	byName := make(map[string][]*CanonicalStruct)
+	for solidity, cs := range bySolidity {
+		name := solidity[strings.LastIndexByte(solidity, '.')+1:]
+		byName[name] = append(byName[name], cs)
+	}
+	byBound := make(map[string]*CanonicalStruct)
+	for name, list := range byName {
+		for _, cs := range list {
+			cs.Name = name
+			if len(list) > 1 {
+				cs.Name = cs.Bound
+			}
+			byBound[cs.Bound] = cs
+		}
+	}
+
+	out := make([]*CanonicalStruct, 0, len(bySolidity))
+	for solidity, cs := range bySolidity {
+		for _, f := range layouts[solidity] {
+			field := CanonicalField{Name: f.name}
+			elem := f.typ
+			if arr, ok := elem.(*ast.ArrayType); ok && arr.Len == nil {
+				field.Slice = true
+				elem = arr.Elt
+			}
+			if ident, ok := elem.(*ast.Ident); ok && byBound[ident.Name] != nil {
+				field.Nested = byBound[ident.Name]
+				field.Type = field.Nested.Name
+				if field.Slice {
+					field.Type = "[]" + field.Type
+				}
+			} else {
+				field.Type = gotypes.ExprString(f.typ)
+				for bound := range byBound {
+					if strings.Contains(field.Type, bound) {
+						return nil, fmt.Errorf("unsupported nesting of %s in %s.%s", bound, cs.Bound, f.name)
+					}
+				}
+			}
+			cs.Fields = append(cs.Fields, field)
+		}
+		sort.Strings(cs.Packages)
+		out = append(out, cs)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out, nil
+}
+
+func sameLayout(a, b []boundField) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].name != b[i].name || gotypes.ExprString(a[i].typ) != gotypes.ExprString(b[i].typ) {
+			return false
+		}
+	}
+	return true
+}
+
+var typesTemplate = template.Must(template.New("types").Funcs(template.FuncMap{"join": strings.Join}).Parse(`// Code generated by bindgen - DO NOT EDIT.
+
+package types
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = big.NewInt
+	_ = common.Big1
+)
+{{range .}}
+// {{.Name}} mirrors the Solidity struct {{.Solidity}}, bound as
+// {{.Bound}} in {{join .Packages ", "}}.
+type {{.Name}} struct {
+{{- range .Fields}}
+	{{.Name}} {{.Type}}
+{{- end}}
+}
+{{end}}`))
+
+var convertersTemplate = template.Must(template.New("converters").Parse(`// Code generated by bindgen - DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+	"{{.ImportPath}}"
+)
+{{range .Structs}}
+// Canonical converts s to the shared types.{{.Name}}.
+func (s {{.Bound}}) Canonical() types.{{.Name}} {
+{{- if .Flat}}
+	return types.{{.Name}}(s)
+{{- else}}
+	return types.{{.Name}}{
+	{{- range .Fields}}
+		{{- if not .Nested}}
+		{{.Name}}: s.{{.Name}},
+		{{- else if .Slice}}
+		{{.Name}}: types.ConvertSlice(s.{{.Name}}, {{.Nested.Bound}}.Canonical),
+		{{- else}}
+		{{.Name}}: s.{{.Name}}.Canonical(),
+		{{- end}}
+	{{- end}}
+	}
+{{- end}}
+}
+
+// To{{.Bound}} converts the shared types.{{.Name}} to this binding's copy.
+func To{{.Bound}}(v types.{{.Name}}) {{.Bound}} {
+{{- if .Flat}}
+	return {{.Bound}}(v)
+{{- else}}
+	return {{.Bound}}{
+	{{- range .Fields}}
+		{{- if not .Nested}}
+		{{.Name}}: v.{{.Name}},
+		{{- else if .Slice}}
+		{{.Name}}: types.ConvertSlice(v.{{.Name}}, To{{.Nested.Bound}}),
+		{{- else}}
+		{{.Name}}: To{{.Nested.Bound}}(v.{{.Name}}),
+		{{- end}}
+	{{- end}}
+	}
+{{- end}}
+}
+{{end}}`))
+
+// Types renders the canonical types file.
+func Types(structs []*CanonicalStruct) (string, error) {
+	var buf bytes.Buffer
+	if err := typesTemplate.Execute(&buf, structs); err != nil {
+		return "", err
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return "", fmt.Errorf("failed to format canonical types: %w", err)
+	}
+	return string(src), nil
+}
+
+// Converters renders the file converting between the struct copies declared
+// by the named binding package and the canonical types imported from
+// typesImportPath. It returns "" if the package declares no structs.
+func Converters(pkg, typesImportPath string, structs []*CanonicalStruct) (string, error) {
+	var declared []*CanonicalStruct
+	for _, cs := range structs {
+		i := sort.SearchStrings(cs.Packages, pkg)
+		if i < len(cs.Packages) && cs.Packages[i] == pkg {
+			declared = append(declared, cs)
+		}
+	}
+	if len(declared) == 0 {
+		return "", nil
+	}
+	var buf bytes.Buffer
+	err := convertersTemplate.Execute(&buf, struct {
+		Package    string
+		ImportPath string
+		Structs    []*CanonicalStruct
+	}{pkg, typesImportPath, declared})
+	if err != nil {
+		return "", err
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return "", fmt.Errorf("failed to format converters for %s: %w", pkg, err)
+	}
+	return string(src), nil
+}
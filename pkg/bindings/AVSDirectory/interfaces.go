@@ -0,0 +1,76 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package AVSDirectory
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// AVSDirectoryReader is the read-only, constant method surface of AVSDirectory.
+type AVSDirectoryReader interface {
+	AvsOperatorStatus(opts *bind.CallOpts, arg0 common.Address, arg1 common.Address) (uint8, error)
+	CalculateOperatorAVSRegistrationDigestHash(opts *bind.CallOpts, operator common.Address, avs common.Address, salt [32]byte, expiry *big.Int) ([32]byte, error)
+	DOMAINTYPEHASH(opts *bind.CallOpts) ([32]byte, error)
+	Delegation(opts *bind.CallOpts) (common.Address, error)
+	DomainSeparator(opts *bind.CallOpts) ([32]byte, error)
+	OPERATORAVSREGISTRATIONTYPEHASH(opts *bind.CallOpts) ([32]byte, error)
+	OperatorSaltIsSpent(opts *bind.CallOpts, arg0 common.Address, arg1 [32]byte) (bool, error)
+	Owner(opts *bind.CallOpts) (common.Address, error)
+	Paused(opts *bind.CallOpts, index uint8) (bool, error)
+	Paused0(opts *bind.CallOpts) (*big.Int, error)
+	PauserRegistry(opts *bind.CallOpts) (common.Address, error)
+}
+
+// AVSDirectoryWriter is the transaction sending method surface of AVSDirectory.
+type AVSDirectoryWriter interface {
+	CancelSalt(opts *bind.TransactOpts, salt [32]byte) (*types.Transaction, error)
+	DeregisterOperatorFromAVS(opts *bind.TransactOpts, operator common.Address) (*types.Transaction, error)
+	Initialize(opts *bind.TransactOpts, initialOwner common.Address, _pauserRegistry common.Address, initialPausedStatus *big.Int) (*types.Transaction, error)
+	Pause(opts *bind.TransactOpts, newPausedStatus *big.Int) (*types.Transaction, error)
+	PauseAll(opts *bind.TransactOpts) (*types.Transaction, error)
+	RegisterOperatorToAVS(opts *bind.TransactOpts, operator common.Address, operatorSignature ISignatureUtilsSignatureWithSaltAndExpiry) (*types.Transaction, error)
+	RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error)
+	SetPauserRegistry(opts *bind.TransactOpts, newPauserRegistry common.Address) (*types.Transaction, error)
+	TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error)
+	Unpause(opts *bind.TransactOpts, newPausedStatus *big.Int) (*types.Transaction, error)
+	UpdateAVSMetadataURI(opts *bind.TransactOpts, metadataURI string) (*types.Transaction, error)
+}
+
+// AVSDirectoryEvents is the log filtering, watching and parsing surface of AVSDirectory.
+type AVSDirectoryEvents interface {
+	FilterAVSMetadataURIUpdated(opts *bind.FilterOpts, avs []common.Address) (*AVSDirectoryAVSMetadataURIUpdatedIterator, error)
+	FilterInitialized(opts *bind.FilterOpts) (*AVSDirectoryInitializedIterator, error)
+	FilterOperatorAVSRegistrationStatusUpdated(opts *bind.FilterOpts, operator []common.Address, avs []common.Address) (*AVSDirectoryOperatorAVSRegistrationStatusUpdatedIterator, error)
+	FilterOwnershipTransferred(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*AVSDirectoryOwnershipTransferredIterator, error)
+	FilterPaused(opts *bind.FilterOpts, account []common.Address) (*AVSDirectoryPausedIterator, error)
+	FilterPauserRegistrySet(opts *bind.FilterOpts) (*AVSDirectoryPauserRegistrySetIterator, error)
+	FilterUnpaused(opts *bind.FilterOpts, account []common.Address) (*AVSDirectoryUnpausedIterator, error)
+	ParseAVSMetadataURIUpdated(log types.Log) (*AVSDirectoryAVSMetadataURIUpdated, error)
+	ParseInitialized(log types.Log) (*AVSDirectoryInitialized, error)
+	ParseOperatorAVSRegistrationStatusUpdated(log types.Log) (*AVSDirectoryOperatorAVSRegistrationStatusUpdated, error)
+	ParseOwnershipTransferred(log types.Log) (*AVSDirectoryOwnershipTransferred, error)
+	ParsePaused(log types.Log) (*AVSDirectoryPaused, error)
+	ParsePauserRegistrySet(log types.Log) (*AVSDirectoryPauserRegistrySet, error)
+	ParseUnpaused(log types.Log) (*AVSDirectoryUnpaused, error)
+	WatchAVSMetadataURIUpdated(opts *bind.WatchOpts, sink chan<- *AVSDirectoryAVSMetadataURIUpdated, avs []common.Address) (event.Subscription, error)
+	WatchInitialized(opts *bind.WatchOpts, sink chan<- *AVSDirectoryInitialized) (event.Subscription, error)
+	WatchOperatorAVSRegistrationStatusUpdated(opts *bind.WatchOpts, sink chan<- *AVSDirectoryOperatorAVSRegistrationStatusUpdated, operator []common.Address, avs []common.Address) (event.Subscription, error)
+	WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *AVSDirectoryOwnershipTransferred, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error)
+	WatchPaused(opts *bind.WatchOpts, sink chan<- *AVSDirectoryPaused, account []common.Address) (event.Subscription, error)
+	WatchPauserRegistrySet(opts *bind.WatchOpts, sink chan<- *AVSDirectoryPauserRegistrySet) (event.Subscription, error)
+	WatchUnpaused(opts *bind.WatchOpts, sink chan<- *AVSDirectoryUnpaused, account []common.Address) (event.Subscription, error)
+}
+
+var (
+	_ AVSDirectoryReader = (*AVSDirectoryCaller)(nil)
+	_ AVSDirectoryWriter = (*AVSDirectoryTransactor)(nil)
+	_ AVSDirectoryEvents = (*AVSDirectoryFilterer)(nil)
+	_ AVSDirectoryReader = (*AVSDirectory)(nil)
+	_ AVSDirectoryWriter = (*AVSDirectory)(nil)
+	_ AVSDirectoryEvents = (*AVSDirectory)(nil)
+)
@@ -0,0 +1,17 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package AVSDirectory
+
+import (
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/types"
+)
+
+// Canonical converts s to the shared types.SignatureWithSaltAndExpiry.
+func (s ISignatureUtilsSignatureWithSaltAndExpiry) Canonical() types.SignatureWithSaltAndExpiry {
+	return types.SignatureWithSaltAndExpiry(s)
+}
+
+// ToISignatureUtilsSignatureWithSaltAndExpiry converts the shared types.SignatureWithSaltAndExpiry to this binding's copy.
+func ToISignatureUtilsSignatureWithSaltAndExpiry(v types.SignatureWithSaltAndExpiry) ISignatureUtilsSignatureWithSaltAndExpiry {
+	return ISignatureUtilsSignatureWithSaltAndExpiry(v)
+}
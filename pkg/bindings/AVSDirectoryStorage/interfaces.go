@@ -0,0 +1,48 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package AVSDirectoryStorage
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// AVSDirectoryStorageReader is the read-only, constant method surface of AVSDirectoryStorage.
+type AVSDirectoryStorageReader interface {
+	AvsOperatorStatus(opts *bind.CallOpts, arg0 common.Address, arg1 common.Address) (uint8, error)
+	CalculateOperatorAVSRegistrationDigestHash(opts *bind.CallOpts, operator common.Address, avs common.Address, salt [32]byte, expiry *big.Int) ([32]byte, error)
+	DOMAINTYPEHASH(opts *bind.CallOpts) ([32]byte, error)
+	Delegation(opts *bind.CallOpts) (common.Address, error)
+	OPERATORAVSREGISTRATIONTYPEHASH(opts *bind.CallOpts) ([32]byte, error)
+	OperatorSaltIsSpent(opts *bind.CallOpts, arg0 common.Address, arg1 [32]byte) (bool, error)
+}
+
+// AVSDirectoryStorageWriter is the transaction sending method surface of AVSDirectoryStorage.
+type AVSDirectoryStorageWriter interface {
+	DeregisterOperatorFromAVS(opts *bind.TransactOpts, operator common.Address) (*types.Transaction, error)
+	RegisterOperatorToAVS(opts *bind.TransactOpts, operator common.Address, operatorSignature ISignatureUtilsSignatureWithSaltAndExpiry) (*types.Transaction, error)
+	UpdateAVSMetadataURI(opts *bind.TransactOpts, metadataURI string) (*types.Transaction, error)
+}
+
+// AVSDirectoryStorageEvents is the log filtering, watching and parsing surface of AVSDirectoryStorage.
+type AVSDirectoryStorageEvents interface {
+	FilterAVSMetadataURIUpdated(opts *bind.FilterOpts, avs []common.Address) (*AVSDirectoryStorageAVSMetadataURIUpdatedIterator, error)
+	FilterOperatorAVSRegistrationStatusUpdated(opts *bind.FilterOpts, operator []common.Address, avs []common.Address) (*AVSDirectoryStorageOperatorAVSRegistrationStatusUpdatedIterator, error)
+	ParseAVSMetadataURIUpdated(log types.Log) (*AVSDirectoryStorageAVSMetadataURIUpdated, error)
+	ParseOperatorAVSRegistrationStatusUpdated(log types.Log) (*AVSDirectoryStorageOperatorAVSRegistrationStatusUpdated, error)
+	WatchAVSMetadataURIUpdated(opts *bind.WatchOpts, sink chan<- *AVSDirectoryStorageAVSMetadataURIUpdated, avs []common.Address) (event.Subscription, error)
+	WatchOperatorAVSRegistrationStatusUpdated(opts *bind.WatchOpts, sink chan<- *AVSDirectoryStorageOperatorAVSRegistrationStatusUpdated, operator []common.Address, avs []common.Address) (event.Subscription, error)
+}
+
+var (
+	_ AVSDirectoryStorageReader = (*AVSDirectoryStorageCaller)(nil)
+	_ AVSDirectoryStorageWriter = (*AVSDirectoryStorageTransactor)(nil)
+	_ AVSDirectoryStorageEvents = (*AVSDirectoryStorageFilterer)(nil)
+	_ AVSDirectoryStorageReader = (*AVSDirectoryStorage)(nil)
+	_ AVSDirectoryStorageWriter = (*AVSDirectoryStorage)(nil)
+	_ AVSDirectoryStorageEvents = (*AVSDirectoryStorage)(nil)
+)
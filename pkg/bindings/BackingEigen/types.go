@@ -0,0 +1,17 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package BackingEigen
+
+import (
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/types"
+)
+
+// Canonical converts s to the shared types.Checkpoint.
+func (s ERC20VotesUpgradeableCheckpoint) Canonical() types.Checkpoint {
+	return types.Checkpoint(s)
+}
+
+// ToERC20VotesUpgradeableCheckpoint converts the shared types.Checkpoint to this binding's copy.
+func ToERC20VotesUpgradeableCheckpoint(v types.Checkpoint) ERC20VotesUpgradeableCheckpoint {
+	return ERC20VotesUpgradeableCheckpoint(v)
+}
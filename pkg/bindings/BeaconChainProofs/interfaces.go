@@ -0,0 +1,24 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package BeaconChainProofs
+
+// BeaconChainProofsReader is the read-only, constant method surface of BeaconChainProofs.
+type BeaconChainProofsReader interface {
+}
+
+// BeaconChainProofsWriter is the transaction sending method surface of BeaconChainProofs.
+type BeaconChainProofsWriter interface {
+}
+
+// BeaconChainProofsEvents is the log filtering, watching and parsing surface of BeaconChainProofs.
+type BeaconChainProofsEvents interface {
+}
+
+var (
+	_ BeaconChainProofsReader = (*BeaconChainProofsCaller)(nil)
+	_ BeaconChainProofsWriter = (*BeaconChainProofsTransactor)(nil)
+	_ BeaconChainProofsEvents = (*BeaconChainProofsFilterer)(nil)
+	_ BeaconChainProofsReader = (*BeaconChainProofs)(nil)
+	_ BeaconChainProofsWriter = (*BeaconChainProofs)(nil)
+	_ BeaconChainProofsEvents = (*BeaconChainProofs)(nil)
+)
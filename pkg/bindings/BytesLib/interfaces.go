@@ -0,0 +1,24 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package BytesLib
+
+// BytesLibReader is the read-only, constant method surface of BytesLib.
+type BytesLibReader interface {
+}
+
+// BytesLibWriter is the transaction sending method surface of BytesLib.
+type BytesLibWriter interface {
+}
+
+// BytesLibEvents is the log filtering, watching and parsing surface of BytesLib.
+type BytesLibEvents interface {
+}
+
+var (
+	_ BytesLibReader = (*BytesLibCaller)(nil)
+	_ BytesLibWriter = (*BytesLibTransactor)(nil)
+	_ BytesLibEvents = (*BytesLibFilterer)(nil)
+	_ BytesLibReader = (*BytesLib)(nil)
+	_ BytesLibWriter = (*BytesLib)(nil)
+	_ BytesLibEvents = (*BytesLib)(nil)
+)
@@ -0,0 +1,81 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package DelayedWithdrawalRouter
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// DelayedWithdrawalRouterReader is the read-only, constant method surface of DelayedWithdrawalRouter.
+type DelayedWithdrawalRouterReader interface {
+	CanClaimDelayedWithdrawal(opts *bind.CallOpts, user common.Address, index *big.Int) (bool, error)
+	EigenPodManager(opts *bind.CallOpts) (common.Address, error)
+	GetClaimableUserDelayedWithdrawals(opts *bind.CallOpts, user common.Address) ([]IDelayedWithdrawalRouterDelayedWithdrawal, error)
+	GetUserDelayedWithdrawals(opts *bind.CallOpts, user common.Address) ([]IDelayedWithdrawalRouterDelayedWithdrawal, error)
+	MAXWITHDRAWALDELAYBLOCKS(opts *bind.CallOpts) (*big.Int, error)
+	Owner(opts *bind.CallOpts) (common.Address, error)
+	Paused(opts *bind.CallOpts, index uint8) (bool, error)
+	Paused0(opts *bind.CallOpts) (*big.Int, error)
+	PauserRegistry(opts *bind.CallOpts) (common.Address, error)
+	UserDelayedWithdrawalByIndex(opts *bind.CallOpts, user common.Address, index *big.Int) (IDelayedWithdrawalRouterDelayedWithdrawal, error)
+	UserWithdrawals(opts *bind.CallOpts, user common.Address) (IDelayedWithdrawalRouterUserDelayedWithdrawals, error)
+	UserWithdrawalsLength(opts *bind.CallOpts, user common.Address) (*big.Int, error)
+	WithdrawalDelayBlocks(opts *bind.CallOpts) (*big.Int, error)
+}
+
+// DelayedWithdrawalRouterWriter is the transaction sending method surface of DelayedWithdrawalRouter.
+type DelayedWithdrawalRouterWriter interface {
+	ClaimDelayedWithdrawals(opts *bind.TransactOpts, maxNumberOfDelayedWithdrawalsToClaim *big.Int) (*types.Transaction, error)
+	ClaimDelayedWithdrawals0(opts *bind.TransactOpts, recipient common.Address, maxNumberOfDelayedWithdrawalsToClaim *big.Int) (*types.Transaction, error)
+	CreateDelayedWithdrawal(opts *bind.TransactOpts, podOwner common.Address, recipient common.Address) (*types.Transaction, error)
+	Initialize(opts *bind.TransactOpts, initOwner common.Address, _pauserRegistry common.Address, initPausedStatus *big.Int, _withdrawalDelayBlocks *big.Int) (*types.Transaction, error)
+	Pause(opts *bind.TransactOpts, newPausedStatus *big.Int) (*types.Transaction, error)
+	PauseAll(opts *bind.TransactOpts) (*types.Transaction, error)
+	RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error)
+	SetPauserRegistry(opts *bind.TransactOpts, newPauserRegistry common.Address) (*types.Transaction, error)
+	SetWithdrawalDelayBlocks(opts *bind.TransactOpts, newValue *big.Int) (*types.Transaction, error)
+	TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error)
+	Unpause(opts *bind.TransactOpts, newPausedStatus *big.Int) (*types.Transaction, error)
+}
+
+// DelayedWithdrawalRouterEvents is the log filtering, watching and parsing surface of DelayedWithdrawalRouter.
+type DelayedWithdrawalRouterEvents interface {
+	FilterDelayedWithdrawalCreated(opts *bind.FilterOpts) (*DelayedWithdrawalRouterDelayedWithdrawalCreatedIterator, error)
+	FilterDelayedWithdrawalsClaimed(opts *bind.FilterOpts) (*DelayedWithdrawalRouterDelayedWithdrawalsClaimedIterator, error)
+	FilterInitialized(opts *bind.FilterOpts) (*DelayedWithdrawalRouterInitializedIterator, error)
+	FilterOwnershipTransferred(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*DelayedWithdrawalRouterOwnershipTransferredIterator, error)
+	FilterPaused(opts *bind.FilterOpts, account []common.Address) (*DelayedWithdrawalRouterPausedIterator, error)
+	FilterPauserRegistrySet(opts *bind.FilterOpts) (*DelayedWithdrawalRouterPauserRegistrySetIterator, error)
+	FilterUnpaused(opts *bind.FilterOpts, account []common.Address) (*DelayedWithdrawalRouterUnpausedIterator, error)
+	FilterWithdrawalDelayBlocksSet(opts *bind.FilterOpts) (*DelayedWithdrawalRouterWithdrawalDelayBlocksSetIterator, error)
+	ParseDelayedWithdrawalCreated(log types.Log) (*DelayedWithdrawalRouterDelayedWithdrawalCreated, error)
+	ParseDelayedWithdrawalsClaimed(log types.Log) (*DelayedWithdrawalRouterDelayedWithdrawalsClaimed, error)
+	ParseInitialized(log types.Log) (*DelayedWithdrawalRouterInitialized, error)
+	ParseOwnershipTransferred(log types.Log) (*DelayedWithdrawalRouterOwnershipTransferred, error)
+	ParsePaused(log types.Log) (*DelayedWithdrawalRouterPaused, error)
+	ParsePauserRegistrySet(log types.Log) (*DelayedWithdrawalRouterPauserRegistrySet, error)
+	ParseUnpaused(log types.Log) (*DelayedWithdrawalRouterUnpaused, error)
+	ParseWithdrawalDelayBlocksSet(log types.Log) (*DelayedWithdrawalRouterWithdrawalDelayBlocksSet, error)
+	WatchDelayedWithdrawalCreated(opts *bind.WatchOpts, sink chan<- *DelayedWithdrawalRouterDelayedWithdrawalCreated) (event.Subscription, error)
+	WatchDelayedWithdrawalsClaimed(opts *bind.WatchOpts, sink chan<- *DelayedWithdrawalRouterDelayedWithdrawalsClaimed) (event.Subscription, error)
+	WatchInitialized(opts *bind.WatchOpts, sink chan<- *DelayedWithdrawalRouterInitialized) (event.Subscription, error)
+	WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *DelayedWithdrawalRouterOwnershipTransferred, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error)
+	WatchPaused(opts *bind.WatchOpts, sink chan<- *DelayedWithdrawalRouterPaused, account []common.Address) (event.Subscription, error)
+	WatchPauserRegistrySet(opts *bind.WatchOpts, sink chan<- *DelayedWithdrawalRouterPauserRegistrySet) (event.Subscription, error)
+	WatchUnpaused(opts *bind.WatchOpts, sink chan<- *DelayedWithdrawalRouterUnpaused, account []common.Address) (event.Subscription, error)
+	WatchWithdrawalDelayBlocksSet(opts *bind.WatchOpts, sink chan<- *DelayedWithdrawalRouterWithdrawalDelayBlocksSet) (event.Subscription, error)
+}
+
+var (
+	_ DelayedWithdrawalRouterReader = (*DelayedWithdrawalRouterCaller)(nil)
+	_ DelayedWithdrawalRouterWriter = (*DelayedWithdrawalRouterTransactor)(nil)
+	_ DelayedWithdrawalRouterEvents = (*DelayedWithdrawalRouterFilterer)(nil)
+	_ DelayedWithdrawalRouterReader = (*DelayedWithdrawalRouter)(nil)
+	_ DelayedWithdrawalRouterWriter = (*DelayedWithdrawalRouter)(nil)
+	_ DelayedWithdrawalRouterEvents = (*DelayedWithdrawalRouter)(nil)
+)
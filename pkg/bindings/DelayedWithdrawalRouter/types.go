@@ -0,0 +1,33 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package DelayedWithdrawalRouter
+
+import (
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/types"
+)
+
+// Canonical converts s to the shared types.DelayedWithdrawal.
+func (s IDelayedWithdrawalRouterDelayedWithdrawal) Canonical() types.DelayedWithdrawal {
+	return types.DelayedWithdrawal(s)
+}
+
+// ToIDelayedWithdrawalRouterDelayedWithdrawal converts the shared types.DelayedWithdrawal to this binding's copy.
+func ToIDelayedWithdrawalRouterDelayedWithdrawal(v types.DelayedWithdrawal) IDelayedWithdrawalRouterDelayedWithdrawal {
+	return IDelayedWithdrawalRouterDelayedWithdrawal(v)
+}
+
+// Canonical converts s to the shared types.UserDelayedWithdrawals.
+func (s IDelayedWithdrawalRouterUserDelayedWithdrawals) Canonical() types.UserDelayedWithdrawals {
+	return types.UserDelayedWithdrawals{
+		DelayedWithdrawalsCompleted: s.DelayedWithdrawalsCompleted,
+		DelayedWithdrawals:          types.ConvertSlice(s.DelayedWithdrawals, IDelayedWithdrawalRouterDelayedWithdrawal.Canonical),
+	}
+}
+
+// ToIDelayedWithdrawalRouterUserDelayedWithdrawals converts the shared types.UserDelayedWithdrawals to this binding's copy.
+func ToIDelayedWithdrawalRouterUserDelayedWithdrawals(v types.UserDelayedWithdrawals) IDelayedWithdrawalRouterUserDelayedWithdrawals {
+	return IDelayedWithdrawalRouterUserDelayedWithdrawals{
+		DelayedWithdrawalsCompleted: v.DelayedWithdrawalsCompleted,
+		DelayedWithdrawals:          types.ConvertSlice(v.DelayedWithdrawals, ToIDelayedWithdrawalRouterDelayedWithdrawal),
+	}
+}
@@ -0,0 +1,138 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package DelegationManager
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// DelegationManagerReader is the read-only, constant method surface of DelegationManager.
+type DelegationManagerReader interface {
+	BeaconChainETHStrategy(opts *bind.CallOpts) (common.Address, error)
+	CalculateCurrentStakerDelegationDigestHash(opts *bind.CallOpts, staker common.Address, operator common.Address, expiry *big.Int) ([32]byte, error)
+	CalculateDelegationApprovalDigestHash(opts *bind.CallOpts, staker common.Address, operator common.Address, _delegationApprover common.Address, approverSalt [32]byte, expiry *big.Int) ([32]byte, error)
+	CalculateStakerDelegationDigestHash(opts *bind.CallOpts, staker common.Address, _stakerNonce *big.Int, operator common.Address, expiry *big.Int) ([32]byte, error)
+	CalculateWithdrawalRoot(opts *bind.CallOpts, withdrawal IDelegationManagerWithdrawal) ([32]byte, error)
+	CumulativeWithdrawalsQueued(opts *bind.CallOpts, arg0 common.Address) (*big.Int, error)
+	DELEGATIONAPPROVALTYPEHASH(opts *bind.CallOpts) ([32]byte, error)
+	DOMAINTYPEHASH(opts *bind.CallOpts) ([32]byte, error)
+	DelegatedTo(opts *bind.CallOpts, arg0 common.Address) (common.Address, error)
+	DelegationApprover(opts *bind.CallOpts, operator common.Address) (common.Address, error)
+	DelegationApproverSaltIsSpent(opts *bind.CallOpts, arg0 common.Address, arg1 [32]byte) (bool, error)
+	DomainSeparator(opts *bind.CallOpts) ([32]byte, error)
+	EigenPodManager(opts *bind.CallOpts) (common.Address, error)
+	GetDelegatableShares(opts *bind.CallOpts, staker common.Address) ([]common.Address, []*big.Int, error)
+	GetOperatorShares(opts *bind.CallOpts, operator common.Address, strategies []common.Address) ([]*big.Int, error)
+	GetWithdrawalDelay(opts *bind.CallOpts, strategies []common.Address) (*big.Int, error)
+	IsDelegated(opts *bind.CallOpts, staker common.Address) (bool, error)
+	IsOperator(opts *bind.CallOpts, operator common.Address) (bool, error)
+	MAXSTAKEROPTOUTWINDOWBLOCKS(opts *bind.CallOpts) (*big.Int, error)
+	MAXWITHDRAWALDELAYBLOCKS(opts *bind.CallOpts) (*big.Int, error)
+	MinWithdrawalDelayBlocks(opts *bind.CallOpts) (*big.Int, error)
+	OperatorDetails(opts *bind.CallOpts, operator common.Address) (IDelegationManagerOperatorDetails, error)
+	OperatorShares(opts *bind.CallOpts, arg0 common.Address, arg1 common.Address) (*big.Int, error)
+	Owner(opts *bind.CallOpts) (common.Address, error)
+	Paused(opts *bind.CallOpts, index uint8) (bool, error)
+	Paused0(opts *bind.CallOpts) (*big.Int, error)
+	PauserRegistry(opts *bind.CallOpts) (common.Address, error)
+	PendingWithdrawals(opts *bind.CallOpts, arg0 [32]byte) (bool, error)
+	STAKERDELEGATIONTYPEHASH(opts *bind.CallOpts) ([32]byte, error)
+	Slasher(opts *bind.CallOpts) (common.Address, error)
+	StakerNonce(opts *bind.CallOpts, arg0 common.Address) (*big.Int, error)
+	StakerOptOutWindowBlocks(opts *bind.CallOpts, operator common.Address) (*big.Int, error)
+	StrategyManager(opts *bind.CallOpts) (common.Address, error)
+	StrategyWithdrawalDelayBlocks(opts *bind.CallOpts, arg0 common.Address) (*big.Int, error)
+}
+
+// DelegationManagerWriter is the transaction sending method surface of DelegationManager.
+type DelegationManagerWriter interface {
+	CompleteQueuedWithdrawal(opts *bind.TransactOpts, withdrawal IDelegationManagerWithdrawal, tokens []common.Address, middlewareTimesIndex *big.Int, receiveAsTokens bool) (*types.Transaction, error)
+	CompleteQueuedWithdrawals(opts *bind.TransactOpts, withdrawals []IDelegationManagerWithdrawal, tokens [][]common.Address, middlewareTimesIndexes []*big.Int, receiveAsTokens []bool) (*types.Transaction, error)
+	DecreaseDelegatedShares(opts *bind.TransactOpts, staker common.Address, strategy common.Address, shares *big.Int) (*types.Transaction, error)
+	DelegateTo(opts *bind.TransactOpts, operator common.Address, approverSignatureAndExpiry ISignatureUtilsSignatureWithExpiry, approverSalt [32]byte) (*types.Transaction, error)
+	DelegateToBySignature(opts *bind.TransactOpts, staker common.Address, operator common.Address, stakerSignatureAndExpiry ISignatureUtilsSignatureWithExpiry, approverSignatureAndExpiry ISignatureUtilsSignatureWithExpiry, approverSalt [32]byte) (*types.Transaction, error)
+	IncreaseDelegatedShares(opts *bind.TransactOpts, staker common.Address, strategy common.Address, shares *big.Int) (*types.Transaction, error)
+	Initialize(opts *bind.TransactOpts, initialOwner common.Address, _pauserRegistry common.Address, initialPausedStatus *big.Int, _minWithdrawalDelayBlocks *big.Int, _strategies []common.Address, _withdrawalDelayBlocks []*big.Int) (*types.Transaction, error)
+	ModifyOperatorDetails(opts *bind.TransactOpts, newOperatorDetails IDelegationManagerOperatorDetails) (*types.Transaction, error)
+	Pause(opts *bind.TransactOpts, newPausedStatus *big.Int) (*types.Transaction, error)
+	PauseAll(opts *bind.TransactOpts) (*types.Transaction, error)
+	QueueWithdrawals(opts *bind.TransactOpts, queuedWithdrawalParams []IDelegationManagerQueuedWithdrawalParams) (*types.Transaction, error)
+	RegisterAsOperator(opts *bind.TransactOpts, registeringOperatorDetails IDelegationManagerOperatorDetails, metadataURI string) (*types.Transaction, error)
+	RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error)
+	SetMinWithdrawalDelayBlocks(opts *bind.TransactOpts, newMinWithdrawalDelayBlocks *big.Int) (*types.Transaction, error)
+	SetPauserRegistry(opts *bind.TransactOpts, newPauserRegistry common.Address) (*types.Transaction, error)
+	SetStrategyWithdrawalDelayBlocks(opts *bind.TransactOpts, strategies []common.Address, withdrawalDelayBlocks []*big.Int) (*types.Transaction, error)
+	TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error)
+	Undelegate(opts *bind.TransactOpts, staker common.Address) (*types.Transaction, error)
+	Unpause(opts *bind.TransactOpts, newPausedStatus *big.Int) (*types.Transaction, error)
+	UpdateOperatorMetadataURI(opts *bind.TransactOpts, metadataURI string) (*types.Transaction, error)
+}
+
+// DelegationManagerEvents is the log filtering, watching and parsing surface of DelegationManager.
+type DelegationManagerEvents interface {
+	FilterInitialized(opts *bind.FilterOpts) (*DelegationManagerInitializedIterator, error)
+	FilterMinWithdrawalDelayBlocksSet(opts *bind.FilterOpts) (*DelegationManagerMinWithdrawalDelayBlocksSetIterator, error)
+	FilterOperatorDetailsModified(opts *bind.FilterOpts, operator []common.Address) (*DelegationManagerOperatorDetailsModifiedIterator, error)
+	FilterOperatorMetadataURIUpdated(opts *bind.FilterOpts, operator []common.Address) (*DelegationManagerOperatorMetadataURIUpdatedIterator, error)
+	FilterOperatorRegistered(opts *bind.FilterOpts, operator []common.Address) (*DelegationManagerOperatorRegisteredIterator, error)
+	FilterOperatorSharesDecreased(opts *bind.FilterOpts, operator []common.Address) (*DelegationManagerOperatorSharesDecreasedIterator, error)
+	FilterOperatorSharesIncreased(opts *bind.FilterOpts, operator []common.Address) (*DelegationManagerOperatorSharesIncreasedIterator, error)
+	FilterOwnershipTransferred(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*DelegationManagerOwnershipTransferredIterator, error)
+	FilterPaused(opts *bind.FilterOpts, account []common.Address) (*DelegationManagerPausedIterator, error)
+	FilterPauserRegistrySet(opts *bind.FilterOpts) (*DelegationManagerPauserRegistrySetIterator, error)
+	FilterStakerDelegated(opts *bind.FilterOpts, staker []common.Address, operator []common.Address) (*DelegationManagerStakerDelegatedIterator, error)
+	FilterStakerForceUndelegated(opts *bind.FilterOpts, staker []common.Address, operator []common.Address) (*DelegationManagerStakerForceUndelegatedIterator, error)
+	FilterStakerUndelegated(opts *bind.FilterOpts, staker []common.Address, operator []common.Address) (*DelegationManagerStakerUndelegatedIterator, error)
+	FilterStrategyWithdrawalDelayBlocksSet(opts *bind.FilterOpts) (*DelegationManagerStrategyWithdrawalDelayBlocksSetIterator, error)
+	FilterUnpaused(opts *bind.FilterOpts, account []common.Address) (*DelegationManagerUnpausedIterator, error)
+	FilterWithdrawalCompleted(opts *bind.FilterOpts) (*DelegationManagerWithdrawalCompletedIterator, error)
+	FilterWithdrawalQueued(opts *bind.FilterOpts) (*DelegationManagerWithdrawalQueuedIterator, error)
+	ParseInitialized(log types.Log) (*DelegationManagerInitialized, error)
+	ParseMinWithdrawalDelayBlocksSet(log types.Log) (*DelegationManagerMinWithdrawalDelayBlocksSet, error)
+	ParseOperatorDetailsModified(log types.Log) (*DelegationManagerOperatorDetailsModified, error)
+	ParseOperatorMetadataURIUpdated(log types.Log) (*DelegationManagerOperatorMetadataURIUpdated, error)
+	ParseOperatorRegistered(log types.Log) (*DelegationManagerOperatorRegistered, error)
+	ParseOperatorSharesDecreased(log types.Log) (*DelegationManagerOperatorSharesDecreased, error)
+	ParseOperatorSharesIncreased(log types.Log) (*DelegationManagerOperatorSharesIncreased, error)
+	ParseOwnershipTransferred(log types.Log) (*DelegationManagerOwnershipTransferred, error)
+	ParsePaused(log types.Log) (*DelegationManagerPaused, error)
+	ParsePauserRegistrySet(log types.Log) (*DelegationManagerPauserRegistrySet, error)
+	ParseStakerDelegated(log types.Log) (*DelegationManagerStakerDelegated, error)
+	ParseStakerForceUndelegated(log types.Log) (*DelegationManagerStakerForceUndelegated, error)
+	ParseStakerUndelegated(log types.Log) (*DelegationManagerStakerUndelegated, error)
+	ParseStrategyWithdrawalDelayBlocksSet(log types.Log) (*DelegationManagerStrategyWithdrawalDelayBlocksSet, error)
+	ParseUnpaused(log types.Log) (*DelegationManagerUnpaused, error)
+	ParseWithdrawalCompleted(log types.Log) (*DelegationManagerWithdrawalCompleted, error)
+	ParseWithdrawalQueued(log types.Log) (*DelegationManagerWithdrawalQueued, error)
+	WatchInitialized(opts *bind.WatchOpts, sink chan<- *DelegationManagerInitialized) (event.Subscription, error)
+	WatchMinWithdrawalDelayBlocksSet(opts *bind.WatchOpts, sink chan<- *DelegationManagerMinWithdrawalDelayBlocksSet) (event.Subscription, error)
+	WatchOperatorDetailsModified(opts *bind.WatchOpts, sink chan<- *DelegationManagerOperatorDetailsModified, operator []common.Address) (event.Subscription, error)
+	WatchOperatorMetadataURIUpdated(opts *bind.WatchOpts, sink chan<- *DelegationManagerOperatorMetadataURIUpdated, operator []common.Address) (event.Subscription, error)
+	WatchOperatorRegistered(opts *bind.WatchOpts, sink chan<- *DelegationManagerOperatorRegistered, operator []common.Address) (event.Subscription, error)
+	WatchOperatorSharesDecreased(opts *bind.WatchOpts, sink chan<- *DelegationManagerOperatorSharesDecreased, operator []common.Address) (event.Subscription, error)
+	WatchOperatorSharesIncreased(opts *bind.WatchOpts, sink chan<- *DelegationManagerOperatorSharesIncreased, operator []common.Address) (event.Subscription, error)
+	WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *DelegationManagerOwnershipTransferred, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error)
+	WatchPaused(opts *bind.WatchOpts, sink chan<- *DelegationManagerPaused, account []common.Address) (event.Subscription, error)
+	WatchPauserRegistrySet(opts *bind.WatchOpts, sink chan<- *DelegationManagerPauserRegistrySet) (event.Subscription, error)
+	WatchStakerDelegated(opts *bind.WatchOpts, sink chan<- *DelegationManagerStakerDelegated, staker []common.Address, operator []common.Address) (event.Subscription, error)
+	WatchStakerForceUndelegated(opts *bind.WatchOpts, sink chan<- *DelegationManagerStakerForceUndelegated, staker []common.Address, operator []common.Address) (event.Subscription, error)
+	WatchStakerUndelegated(opts *bind.WatchOpts, sink chan<- *DelegationManagerStakerUndelegated, staker []common.Address, operator []common.Address) (event.Subscription, error)
+	WatchStrategyWithdrawalDelayBlocksSet(opts *bind.WatchOpts, sink chan<- *DelegationManagerStrategyWithdrawalDelayBlocksSet) (event.Subscription, error)
+	WatchUnpaused(opts *bind.WatchOpts, sink chan<- *DelegationManagerUnpaused, account []common.Address) (event.Subscription, error)
+	WatchWithdrawalCompleted(opts *bind.WatchOpts, sink chan<- *DelegationManagerWithdrawalCompleted) (event.Subscription, error)
+	WatchWithdrawalQueued(opts *bind.WatchOpts, sink chan<- *DelegationManagerWithdrawalQueued) (event.Subscription, error)
+}
+
+var (
+	_ DelegationManagerReader = (*DelegationManagerCaller)(nil)
+	_ DelegationManagerWriter = (*DelegationManagerTransactor)(nil)
+	_ DelegationManagerEvents = (*DelegationManagerFilterer)(nil)
+	_ DelegationManagerReader = (*DelegationManager)(nil)
+	_ DelegationManagerWriter = (*DelegationManager)(nil)
+	_ DelegationManagerEvents = (*DelegationManager)(nil)
+)
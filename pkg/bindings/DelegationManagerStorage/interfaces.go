@@ -0,0 +1,108 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package DelegationManagerStorage
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// DelegationManagerStorageReader is the read-only, constant method surface of DelegationManagerStorage.
+type DelegationManagerStorageReader interface {
+	BeaconChainETHStrategy(opts *bind.CallOpts) (common.Address, error)
+	CalculateCurrentStakerDelegationDigestHash(opts *bind.CallOpts, staker common.Address, operator common.Address, expiry *big.Int) ([32]byte, error)
+	CalculateDelegationApprovalDigestHash(opts *bind.CallOpts, staker common.Address, operator common.Address, _delegationApprover common.Address, approverSalt [32]byte, expiry *big.Int) ([32]byte, error)
+	CalculateStakerDelegationDigestHash(opts *bind.CallOpts, staker common.Address, _stakerNonce *big.Int, operator common.Address, expiry *big.Int) ([32]byte, error)
+	CalculateWithdrawalRoot(opts *bind.CallOpts, withdrawal IDelegationManagerWithdrawal) ([32]byte, error)
+	CumulativeWithdrawalsQueued(opts *bind.CallOpts, arg0 common.Address) (*big.Int, error)
+	DELEGATIONAPPROVALTYPEHASH(opts *bind.CallOpts) ([32]byte, error)
+	DOMAINTYPEHASH(opts *bind.CallOpts) ([32]byte, error)
+	DelegatedTo(opts *bind.CallOpts, arg0 common.Address) (common.Address, error)
+	DelegationApprover(opts *bind.CallOpts, operator common.Address) (common.Address, error)
+	DelegationApproverSaltIsSpent(opts *bind.CallOpts, arg0 common.Address, arg1 [32]byte) (bool, error)
+	DomainSeparator(opts *bind.CallOpts) ([32]byte, error)
+	EigenPodManager(opts *bind.CallOpts) (common.Address, error)
+	GetOperatorShares(opts *bind.CallOpts, operator common.Address, strategies []common.Address) ([]*big.Int, error)
+	GetWithdrawalDelay(opts *bind.CallOpts, strategies []common.Address) (*big.Int, error)
+	IsDelegated(opts *bind.CallOpts, staker common.Address) (bool, error)
+	IsOperator(opts *bind.CallOpts, operator common.Address) (bool, error)
+	MAXWITHDRAWALDELAYBLOCKS(opts *bind.CallOpts) (*big.Int, error)
+	MinWithdrawalDelayBlocks(opts *bind.CallOpts) (*big.Int, error)
+	OperatorDetails(opts *bind.CallOpts, operator common.Address) (IDelegationManagerOperatorDetails, error)
+	OperatorShares(opts *bind.CallOpts, arg0 common.Address, arg1 common.Address) (*big.Int, error)
+	PendingWithdrawals(opts *bind.CallOpts, arg0 [32]byte) (bool, error)
+	STAKERDELEGATIONTYPEHASH(opts *bind.CallOpts) ([32]byte, error)
+	Slasher(opts *bind.CallOpts) (common.Address, error)
+	StakerNonce(opts *bind.CallOpts, arg0 common.Address) (*big.Int, error)
+	StakerOptOutWindowBlocks(opts *bind.CallOpts, operator common.Address) (*big.Int, error)
+	StrategyManager(opts *bind.CallOpts) (common.Address, error)
+	StrategyWithdrawalDelayBlocks(opts *bind.CallOpts, arg0 common.Address) (*big.Int, error)
+}
+
+// DelegationManagerStorageWriter is the transaction sending method surface of DelegationManagerStorage.
+type DelegationManagerStorageWriter interface {
+	CompleteQueuedWithdrawal(opts *bind.TransactOpts, withdrawal IDelegationManagerWithdrawal, tokens []common.Address, middlewareTimesIndex *big.Int, receiveAsTokens bool) (*types.Transaction, error)
+	CompleteQueuedWithdrawals(opts *bind.TransactOpts, withdrawals []IDelegationManagerWithdrawal, tokens [][]common.Address, middlewareTimesIndexes []*big.Int, receiveAsTokens []bool) (*types.Transaction, error)
+	DecreaseDelegatedShares(opts *bind.TransactOpts, staker common.Address, strategy common.Address, shares *big.Int) (*types.Transaction, error)
+	DelegateTo(opts *bind.TransactOpts, operator common.Address, approverSignatureAndExpiry ISignatureUtilsSignatureWithExpiry, approverSalt [32]byte) (*types.Transaction, error)
+	DelegateToBySignature(opts *bind.TransactOpts, staker common.Address, operator common.Address, stakerSignatureAndExpiry ISignatureUtilsSignatureWithExpiry, approverSignatureAndExpiry ISignatureUtilsSignatureWithExpiry, approverSalt [32]byte) (*types.Transaction, error)
+	IncreaseDelegatedShares(opts *bind.TransactOpts, staker common.Address, strategy common.Address, shares *big.Int) (*types.Transaction, error)
+	ModifyOperatorDetails(opts *bind.TransactOpts, newOperatorDetails IDelegationManagerOperatorDetails) (*types.Transaction, error)
+	QueueWithdrawals(opts *bind.TransactOpts, queuedWithdrawalParams []IDelegationManagerQueuedWithdrawalParams) (*types.Transaction, error)
+	RegisterAsOperator(opts *bind.TransactOpts, registeringOperatorDetails IDelegationManagerOperatorDetails, metadataURI string) (*types.Transaction, error)
+	Undelegate(opts *bind.TransactOpts, staker common.Address) (*types.Transaction, error)
+	UpdateOperatorMetadataURI(opts *bind.TransactOpts, metadataURI string) (*types.Transaction, error)
+}
+
+// DelegationManagerStorageEvents is the log filtering, watching and parsing surface of DelegationManagerStorage.
+type DelegationManagerStorageEvents interface {
+	FilterMinWithdrawalDelayBlocksSet(opts *bind.FilterOpts) (*DelegationManagerStorageMinWithdrawalDelayBlocksSetIterator, error)
+	FilterOperatorDetailsModified(opts *bind.FilterOpts, operator []common.Address) (*DelegationManagerStorageOperatorDetailsModifiedIterator, error)
+	FilterOperatorMetadataURIUpdated(opts *bind.FilterOpts, operator []common.Address) (*DelegationManagerStorageOperatorMetadataURIUpdatedIterator, error)
+	FilterOperatorRegistered(opts *bind.FilterOpts, operator []common.Address) (*DelegationManagerStorageOperatorRegisteredIterator, error)
+	FilterOperatorSharesDecreased(opts *bind.FilterOpts, operator []common.Address) (*DelegationManagerStorageOperatorSharesDecreasedIterator, error)
+	FilterOperatorSharesIncreased(opts *bind.FilterOpts, operator []common.Address) (*DelegationManagerStorageOperatorSharesIncreasedIterator, error)
+	FilterStakerDelegated(opts *bind.FilterOpts, staker []common.Address, operator []common.Address) (*DelegationManagerStorageStakerDelegatedIterator, error)
+	FilterStakerForceUndelegated(opts *bind.FilterOpts, staker []common.Address, operator []common.Address) (*DelegationManagerStorageStakerForceUndelegatedIterator, error)
+	FilterStakerUndelegated(opts *bind.FilterOpts, staker []common.Address, operator []common.Address) (*DelegationManagerStorageStakerUndelegatedIterator, error)
+	FilterStrategyWithdrawalDelayBlocksSet(opts *bind.FilterOpts) (*DelegationManagerStorageStrategyWithdrawalDelayBlocksSetIterator, error)
+	FilterWithdrawalCompleted(opts *bind.FilterOpts) (*DelegationManagerStorageWithdrawalCompletedIterator, error)
+	FilterWithdrawalQueued(opts *bind.FilterOpts) (*DelegationManagerStorageWithdrawalQueuedIterator, error)
+	ParseMinWithdrawalDelayBlocksSet(log types.Log) (*DelegationManagerStorageMinWithdrawalDelayBlocksSet, error)
+	ParseOperatorDetailsModified(log types.Log) (*DelegationManagerStorageOperatorDetailsModified, error)
+	ParseOperatorMetadataURIUpdated(log types.Log) (*DelegationManagerStorageOperatorMetadataURIUpdated, error)
+	ParseOperatorRegistered(log types.Log) (*DelegationManagerStorageOperatorRegistered, error)
+	ParseOperatorSharesDecreased(log types.Log) (*DelegationManagerStorageOperatorSharesDecreased, error)
+	ParseOperatorSharesIncreased(log types.Log) (*DelegationManagerStorageOperatorSharesIncreased, error)
+	ParseStakerDelegated(log types.Log) (*DelegationManagerStorageStakerDelegated, error)
+	ParseStakerForceUndelegated(log types.Log) (*DelegationManagerStorageStakerForceUndelegated, error)
+	ParseStakerUndelegated(log types.Log) (*DelegationManagerStorageStakerUndelegated, error)
+	ParseStrategyWithdrawalDelayBlocksSet(log types.Log) (*DelegationManagerStorageStrategyWithdrawalDelayBlocksSet, error)
+	ParseWithdrawalCompleted(log types.Log) (*DelegationManagerStorageWithdrawalCompleted, error)
+	ParseWithdrawalQueued(log types.Log) (*DelegationManagerStorageWithdrawalQueued, error)
+	WatchMinWithdrawalDelayBlocksSet(opts *bind.WatchOpts, sink chan<- *DelegationManagerStorageMinWithdrawalDelayBlocksSet) (event.Subscription, error)
+	WatchOperatorDetailsModified(opts *bind.WatchOpts, sink chan<- *DelegationManagerStorageOperatorDetailsModified, operator []common.Address) (event.Subscription, error)
+	WatchOperatorMetadataURIUpdated(opts *bind.WatchOpts, sink chan<- *DelegationManagerStorageOperatorMetadataURIUpdated, operator []common.Address) (event.Subscription, error)
+	WatchOperatorRegistered(opts *bind.WatchOpts, sink chan<- *DelegationManagerStorageOperatorRegistered, operator []common.Address) (event.Subscription, error)
+	WatchOperatorSharesDecreased(opts *bind.WatchOpts, sink chan<- *DelegationManagerStorageOperatorSharesDecreased, operator []common.Address) (event.Subscription, error)
+	WatchOperatorSharesIncreased(opts *bind.WatchOpts, sink chan<- *DelegationManagerStorageOperatorSharesIncreased, operator []common.Address) (event.Subscription, error)
+	WatchStakerDelegated(opts *bind.WatchOpts, sink chan<- *DelegationManagerStorageStakerDelegated, staker []common.Address, operator []common.Address) (event.Subscription, error)
+	WatchStakerForceUndelegated(opts *bind.WatchOpts, sink chan<- *DelegationManagerStorageStakerForceUndelegated, staker []common.Address, operator []common.Address) (event.Subscription, error)
+	WatchStakerUndelegated(opts *bind.WatchOpts, sink chan<- *DelegationManagerStorageStakerUndelegated, staker []common.Address, operator []common.Address) (event.Subscription, error)
+	WatchStrategyWithdrawalDelayBlocksSet(opts *bind.WatchOpts, sink chan<- *DelegationManagerStorageStrategyWithdrawalDelayBlocksSet) (event.Subscription, error)
+	WatchWithdrawalCompleted(opts *bind.WatchOpts, sink chan<- *DelegationManagerStorageWithdrawalCompleted) (event.Subscription, error)
+	WatchWithdrawalQueued(opts *bind.WatchOpts, sink chan<- *DelegationManagerStorageWithdrawalQueued) (event.Subscription, error)
+}
+
+var (
+	_ DelegationManagerStorageReader = (*DelegationManagerStorageCaller)(nil)
+	_ DelegationManagerStorageWriter = (*DelegationManagerStorageTransactor)(nil)
+	_ DelegationManagerStorageEvents = (*DelegationManagerStorageFilterer)(nil)
+	_ DelegationManagerStorageReader = (*DelegationManagerStorage)(nil)
+	_ DelegationManagerStorageWriter = (*DelegationManagerStorage)(nil)
+	_ DelegationManagerStorageEvents = (*DelegationManagerStorage)(nil)
+)
@@ -0,0 +1,47 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package DelegationManagerStorage
+
+import (
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/types"
+)
+
+// Canonical converts s to the shared types.OperatorDetails.
+func (s IDelegationManagerOperatorDetails) Canonical() types.OperatorDetails {
+	return types.OperatorDetails(s)
+}
+
+// ToIDelegationManagerOperatorDetails converts the shared types.OperatorDetails to this binding's copy.
+func ToIDelegationManagerOperatorDetails(v types.OperatorDetails) IDelegationManagerOperatorDetails {
+	return IDelegationManagerOperatorDetails(v)
+}
+
+// Canonical converts s to the shared types.QueuedWithdrawalParams.
+func (s IDelegationManagerQueuedWithdrawalParams) Canonical() types.QueuedWithdrawalParams {
+	return types.QueuedWithdrawalParams(s)
+}
+
+// ToIDelegationManagerQueuedWithdrawalParams converts the shared types.QueuedWithdrawalParams to this binding's copy.
+func ToIDelegationManagerQueuedWithdrawalParams(v types.QueuedWithdrawalParams) IDelegationManagerQueuedWithdrawalParams {
+	return IDelegationManagerQueuedWithdrawalParams(v)
+}
+
+// Canonical converts s to the shared types.SignatureWithExpiry.
+func (s ISignatureUtilsSignatureWithExpiry) Canonical() types.SignatureWithExpiry {
+	return types.SignatureWithExpiry(s)
+}
+
+// ToISignatureUtilsSignatureWithExpiry converts the shared types.SignatureWithExpiry to this binding's copy.
+func ToISignatureUtilsSignatureWithExpiry(v types.SignatureWithExpiry) ISignatureUtilsSignatureWithExpiry {
+	return ISignatureUtilsSignatureWithExpiry(v)
+}
+
+// Canonical converts s to the shared types.Withdrawal.
+func (s IDelegationManagerWithdrawal) Canonical() types.Withdrawal {
+	return types.Withdrawal(s)
+}
+
+// ToIDelegationManagerWithdrawal converts the shared types.Withdrawal to this binding's copy.
+func ToIDelegationManagerWithdrawal(v types.Withdrawal) IDelegationManagerWithdrawal {
+	return IDelegationManagerWithdrawal(v)
+}
@@ -0,0 +1,24 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package EIP1271SignatureUtils
+
+// EIP1271SignatureUtilsReader is the read-only, constant method surface of EIP1271SignatureUtils.
+type EIP1271SignatureUtilsReader interface {
+}
+
+// EIP1271SignatureUtilsWriter is the transaction sending method surface of EIP1271SignatureUtils.
+type EIP1271SignatureUtilsWriter interface {
+}
+
+// EIP1271SignatureUtilsEvents is the log filtering, watching and parsing surface of EIP1271SignatureUtils.
+type EIP1271SignatureUtilsEvents interface {
+}
+
+var (
+	_ EIP1271SignatureUtilsReader = (*EIP1271SignatureUtilsCaller)(nil)
+	_ EIP1271SignatureUtilsWriter = (*EIP1271SignatureUtilsTransactor)(nil)
+	_ EIP1271SignatureUtilsEvents = (*EIP1271SignatureUtilsFilterer)(nil)
+	_ EIP1271SignatureUtilsReader = (*EIP1271SignatureUtils)(nil)
+	_ EIP1271SignatureUtilsWriter = (*EIP1271SignatureUtils)(nil)
+	_ EIP1271SignatureUtilsEvents = (*EIP1271SignatureUtils)(nil)
+)
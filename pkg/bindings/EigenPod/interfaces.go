@@ -0,0 +1,90 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package EigenPod
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// EigenPodReader is the read-only, constant method surface of EigenPod.
+type EigenPodReader interface {
+	DelayedWithdrawalRouter(opts *bind.CallOpts) (common.Address, error)
+	EigenPodManager(opts *bind.CallOpts) (common.Address, error)
+	EthPOS(opts *bind.CallOpts) (common.Address, error)
+	GENESISTIME(opts *bind.CallOpts) (uint64, error)
+	HasRestaked(opts *bind.CallOpts) (bool, error)
+	MAXRESTAKEDBALANCEGWEIPERVALIDATOR(opts *bind.CallOpts) (uint64, error)
+	MostRecentWithdrawalTimestamp(opts *bind.CallOpts) (uint64, error)
+	NonBeaconChainETHBalanceWei(opts *bind.CallOpts) (*big.Int, error)
+	PodOwner(opts *bind.CallOpts) (common.Address, error)
+	ProvenWithdrawal(opts *bind.CallOpts, arg0 [32]byte, arg1 uint64) (bool, error)
+	SumOfPartialWithdrawalsClaimedGwei(opts *bind.CallOpts) (uint64, error)
+	ValidatorPubkeyHashToInfo(opts *bind.CallOpts, validatorPubkeyHash [32]byte) (IEigenPodValidatorInfo, error)
+	ValidatorPubkeyToInfo(opts *bind.CallOpts, validatorPubkey []byte) (IEigenPodValidatorInfo, error)
+	ValidatorStatus(opts *bind.CallOpts, validatorPubkey []byte) (uint8, error)
+	ValidatorStatus0(opts *bind.CallOpts, pubkeyHash [32]byte) (uint8, error)
+	WithdrawableRestakedExecutionLayerGwei(opts *bind.CallOpts) (uint64, error)
+}
+
+// EigenPodWriter is the transaction sending method surface of EigenPod.
+type EigenPodWriter interface {
+	ActivateRestaking(opts *bind.TransactOpts) (*types.Transaction, error)
+	Initialize(opts *bind.TransactOpts, _podOwner common.Address) (*types.Transaction, error)
+	Receive(opts *bind.TransactOpts) (*types.Transaction, error)
+	RecoverTokens(opts *bind.TransactOpts, tokenList []common.Address, amountsToWithdraw []*big.Int, recipient common.Address) (*types.Transaction, error)
+	Stake(opts *bind.TransactOpts, pubkey []byte, signature []byte, depositDataRoot [32]byte) (*types.Transaction, error)
+	VerifyAndProcessWithdrawals(opts *bind.TransactOpts, oracleTimestamp uint64, stateRootProof BeaconChainProofsStateRootProof, withdrawalProofs []BeaconChainProofsWithdrawalProof, validatorFieldsProofs [][]byte, validatorFields [][][32]byte, withdrawalFields [][][32]byte) (*types.Transaction, error)
+	VerifyBalanceUpdates(opts *bind.TransactOpts, oracleTimestamp uint64, validatorIndices []*big.Int, stateRootProof BeaconChainProofsStateRootProof, validatorFieldsProofs [][]byte, validatorFields [][][32]byte) (*types.Transaction, error)
+	VerifyWithdrawalCredentials(opts *bind.TransactOpts, oracleTimestamp uint64, stateRootProof BeaconChainProofsStateRootProof, validatorIndices []*big.Int, validatorFieldsProofs [][]byte, validatorFields [][][32]byte) (*types.Transaction, error)
+	WithdrawBeforeRestaking(opts *bind.TransactOpts) (*types.Transaction, error)
+	WithdrawNonBeaconChainETHBalanceWei(opts *bind.TransactOpts, recipient common.Address, amountToWithdraw *big.Int) (*types.Transaction, error)
+	WithdrawRestakedBeaconChainETH(opts *bind.TransactOpts, recipient common.Address, amountWei *big.Int) (*types.Transaction, error)
+}
+
+// EigenPodEvents is the log filtering, watching and parsing surface of EigenPod.
+type EigenPodEvents interface {
+	FilterEigenPodStaked(opts *bind.FilterOpts) (*EigenPodEigenPodStakedIterator, error)
+	FilterFullWithdrawalRedeemed(opts *bind.FilterOpts, recipient []common.Address) (*EigenPodFullWithdrawalRedeemedIterator, error)
+	FilterInitialized(opts *bind.FilterOpts) (*EigenPodInitializedIterator, error)
+	FilterNonBeaconChainETHReceived(opts *bind.FilterOpts) (*EigenPodNonBeaconChainETHReceivedIterator, error)
+	FilterNonBeaconChainETHWithdrawn(opts *bind.FilterOpts, recipient []common.Address) (*EigenPodNonBeaconChainETHWithdrawnIterator, error)
+	FilterPartialWithdrawalRedeemed(opts *bind.FilterOpts, recipient []common.Address) (*EigenPodPartialWithdrawalRedeemedIterator, error)
+	FilterRestakedBeaconChainETHWithdrawn(opts *bind.FilterOpts, recipient []common.Address) (*EigenPodRestakedBeaconChainETHWithdrawnIterator, error)
+	FilterRestakingActivated(opts *bind.FilterOpts, podOwner []common.Address) (*EigenPodRestakingActivatedIterator, error)
+	FilterValidatorBalanceUpdated(opts *bind.FilterOpts) (*EigenPodValidatorBalanceUpdatedIterator, error)
+	FilterValidatorRestaked(opts *bind.FilterOpts) (*EigenPodValidatorRestakedIterator, error)
+	ParseEigenPodStaked(log types.Log) (*EigenPodEigenPodStaked, error)
+	ParseFullWithdrawalRedeemed(log types.Log) (*EigenPodFullWithdrawalRedeemed, error)
+	ParseInitialized(log types.Log) (*EigenPodInitialized, error)
+	ParseNonBeaconChainETHReceived(log types.Log) (*EigenPodNonBeaconChainETHReceived, error)
+	ParseNonBeaconChainETHWithdrawn(log types.Log) (*EigenPodNonBeaconChainETHWithdrawn, error)
+	ParsePartialWithdrawalRedeemed(log types.Log) (*EigenPodPartialWithdrawalRedeemed, error)
+	ParseRestakedBeaconChainETHWithdrawn(log types.Log) (*EigenPodRestakedBeaconChainETHWithdrawn, error)
+	ParseRestakingActivated(log types.Log) (*EigenPodRestakingActivated, error)
+	ParseValidatorBalanceUpdated(log types.Log) (*EigenPodValidatorBalanceUpdated, error)
+	ParseValidatorRestaked(log types.Log) (*EigenPodValidatorRestaked, error)
+	WatchEigenPodStaked(opts *bind.WatchOpts, sink chan<- *EigenPodEigenPodStaked) (event.Subscription, error)
+	WatchFullWithdrawalRedeemed(opts *bind.WatchOpts, sink chan<- *EigenPodFullWithdrawalRedeemed, recipient []common.Address) (event.Subscription, error)
+	WatchInitialized(opts *bind.WatchOpts, sink chan<- *EigenPodInitialized) (event.Subscription, error)
+	WatchNonBeaconChainETHReceived(opts *bind.WatchOpts, sink chan<- *EigenPodNonBeaconChainETHReceived) (event.Subscription, error)
+	WatchNonBeaconChainETHWithdrawn(opts *bind.WatchOpts, sink chan<- *EigenPodNonBeaconChainETHWithdrawn, recipient []common.Address) (event.Subscription, error)
+	WatchPartialWithdrawalRedeemed(opts *bind.WatchOpts, sink chan<- *EigenPodPartialWithdrawalRedeemed, recipient []common.Address) (event.Subscription, error)
+	WatchRestakedBeaconChainETHWithdrawn(opts *bind.WatchOpts, sink chan<- *EigenPodRestakedBeaconChainETHWithdrawn, recipient []common.Address) (event.Subscription, error)
+	WatchRestakingActivated(opts *bind.WatchOpts, sink chan<- *EigenPodRestakingActivated, podOwner []common.Address) (event.Subscription, error)
+	WatchValidatorBalanceUpdated(opts *bind.WatchOpts, sink chan<- *EigenPodValidatorBalanceUpdated) (event.Subscription, error)
+	WatchValidatorRestaked(opts *bind.WatchOpts, sink chan<- *EigenPodValidatorRestaked) (event.Subscription, error)
+}
+
+var (
+	_ EigenPodReader = (*EigenPodCaller)(nil)
+	_ EigenPodWriter = (*EigenPodTransactor)(nil)
+	_ EigenPodEvents = (*EigenPodFilterer)(nil)
+	_ EigenPodReader = (*EigenPod)(nil)
+	_ EigenPodWriter = (*EigenPod)(nil)
+	_ EigenPodEvents = (*EigenPod)(nil)
+)
@@ -0,0 +1,37 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package EigenPod
+
+import (
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/types"
+)
+
+// Canonical converts s to the shared types.StateRootProof.
+func (s BeaconChainProofsStateRootProof) Canonical() types.StateRootProof {
+	return types.StateRootProof(s)
+}
+
+// ToBeaconChainProofsStateRootProof converts the shared types.StateRootProof to this binding's copy.
+func ToBeaconChainProofsStateRootProof(v types.StateRootProof) BeaconChainProofsStateRootProof {
+	return BeaconChainProofsStateRootProof(v)
+}
+
+// Canonical converts s to the shared types.ValidatorInfo.
+func (s IEigenPodValidatorInfo) Canonical() types.ValidatorInfo {
+	return types.ValidatorInfo(s)
+}
+
+// ToIEigenPodValidatorInfo converts the shared types.ValidatorInfo to this binding's copy.
+func ToIEigenPodValidatorInfo(v types.ValidatorInfo) IEigenPodValidatorInfo {
+	return IEigenPodValidatorInfo(v)
+}
+
+// Canonical converts s to the shared types.WithdrawalProof.
+func (s BeaconChainProofsWithdrawalProof) Canonical() types.WithdrawalProof {
+	return types.WithdrawalProof(s)
+}
+
+// ToBeaconChainProofsWithdrawalProof converts the shared types.WithdrawalProof to this binding's copy.
+func ToBeaconChainProofsWithdrawalProof(v types.WithdrawalProof) BeaconChainProofsWithdrawalProof {
+	return BeaconChainProofsWithdrawalProof(v)
+}
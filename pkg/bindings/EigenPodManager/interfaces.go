@@ -0,0 +1,99 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package EigenPodManager
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// EigenPodManagerReader is the read-only, constant method surface of EigenPodManager.
+type EigenPodManagerReader interface {
+	BeaconChainETHStrategy(opts *bind.CallOpts) (common.Address, error)
+	BeaconChainOracle(opts *bind.CallOpts) (common.Address, error)
+	DelegationManager(opts *bind.CallOpts) (common.Address, error)
+	DenebForkTimestamp(opts *bind.CallOpts) (uint64, error)
+	EigenPodBeacon(opts *bind.CallOpts) (common.Address, error)
+	EthPOS(opts *bind.CallOpts) (common.Address, error)
+	GetBlockRootAtTimestamp(opts *bind.CallOpts, timestamp uint64) ([32]byte, error)
+	GetPod(opts *bind.CallOpts, podOwner common.Address) (common.Address, error)
+	HasPod(opts *bind.CallOpts, podOwner common.Address) (bool, error)
+	NumPods(opts *bind.CallOpts) (*big.Int, error)
+	Owner(opts *bind.CallOpts) (common.Address, error)
+	OwnerToPod(opts *bind.CallOpts, arg0 common.Address) (common.Address, error)
+	Paused(opts *bind.CallOpts, index uint8) (bool, error)
+	Paused0(opts *bind.CallOpts) (*big.Int, error)
+	PauserRegistry(opts *bind.CallOpts) (common.Address, error)
+	PodOwnerShares(opts *bind.CallOpts, arg0 common.Address) (*big.Int, error)
+	Slasher(opts *bind.CallOpts) (common.Address, error)
+	StrategyManager(opts *bind.CallOpts) (common.Address, error)
+}
+
+// EigenPodManagerWriter is the transaction sending method surface of EigenPodManager.
+type EigenPodManagerWriter interface {
+	AddShares(opts *bind.TransactOpts, podOwner common.Address, shares *big.Int) (*types.Transaction, error)
+	CreatePod(opts *bind.TransactOpts) (*types.Transaction, error)
+	Initialize(opts *bind.TransactOpts, _beaconChainOracle common.Address, initialOwner common.Address, _pauserRegistry common.Address, _initPausedStatus *big.Int) (*types.Transaction, error)
+	Pause(opts *bind.TransactOpts, newPausedStatus *big.Int) (*types.Transaction, error)
+	PauseAll(opts *bind.TransactOpts) (*types.Transaction, error)
+	RecordBeaconChainETHBalanceUpdate(opts *bind.TransactOpts, podOwner common.Address, sharesDelta *big.Int) (*types.Transaction, error)
+	RemoveShares(opts *bind.TransactOpts, podOwner common.Address, shares *big.Int) (*types.Transaction, error)
+	RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error)
+	SetDenebForkTimestamp(opts *bind.TransactOpts, newDenebForkTimestamp uint64) (*types.Transaction, error)
+	SetPauserRegistry(opts *bind.TransactOpts, newPauserRegistry common.Address) (*types.Transaction, error)
+	Stake(opts *bind.TransactOpts, pubkey []byte, signature []byte, depositDataRoot [32]byte) (*types.Transaction, error)
+	TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error)
+	Unpause(opts *bind.TransactOpts, newPausedStatus *big.Int) (*types.Transaction, error)
+	UpdateBeaconChainOracle(opts *bind.TransactOpts, newBeaconChainOracle common.Address) (*types.Transaction, error)
+	WithdrawSharesAsTokens(opts *bind.TransactOpts, podOwner common.Address, destination common.Address, shares *big.Int) (*types.Transaction, error)
+}
+
+// EigenPodManagerEvents is the log filtering, watching and parsing surface of EigenPodManager.
+type EigenPodManagerEvents interface {
+	FilterBeaconChainETHDeposited(opts *bind.FilterOpts, podOwner []common.Address) (*EigenPodManagerBeaconChainETHDepositedIterator, error)
+	FilterBeaconChainETHWithdrawalCompleted(opts *bind.FilterOpts, podOwner []common.Address) (*EigenPodManagerBeaconChainETHWithdrawalCompletedIterator, error)
+	FilterBeaconOracleUpdated(opts *bind.FilterOpts, newOracleAddress []common.Address) (*EigenPodManagerBeaconOracleUpdatedIterator, error)
+	FilterDenebForkTimestampUpdated(opts *bind.FilterOpts) (*EigenPodManagerDenebForkTimestampUpdatedIterator, error)
+	FilterInitialized(opts *bind.FilterOpts) (*EigenPodManagerInitializedIterator, error)
+	FilterOwnershipTransferred(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*EigenPodManagerOwnershipTransferredIterator, error)
+	FilterPaused(opts *bind.FilterOpts, account []common.Address) (*EigenPodManagerPausedIterator, error)
+	FilterPauserRegistrySet(opts *bind.FilterOpts) (*EigenPodManagerPauserRegistrySetIterator, error)
+	FilterPodDeployed(opts *bind.FilterOpts, eigenPod []common.Address, podOwner []common.Address) (*EigenPodManagerPodDeployedIterator, error)
+	FilterPodSharesUpdated(opts *bind.FilterOpts, podOwner []common.Address) (*EigenPodManagerPodSharesUpdatedIterator, error)
+	FilterUnpaused(opts *bind.FilterOpts, account []common.Address) (*EigenPodManagerUnpausedIterator, error)
+	ParseBeaconChainETHDeposited(log types.Log) (*EigenPodManagerBeaconChainETHDeposited, error)
+	ParseBeaconChainETHWithdrawalCompleted(log types.Log) (*EigenPodManagerBeaconChainETHWithdrawalCompleted, error)
+	ParseBeaconOracleUpdated(log types.Log) (*EigenPodManagerBeaconOracleUpdated, error)
+	ParseDenebForkTimestampUpdated(log types.Log) (*EigenPodManagerDenebForkTimestampUpdated, error)
+	ParseInitialized(log types.Log) (*EigenPodManagerInitialized, error)
+	ParseOwnershipTransferred(log types.Log) (*EigenPodManagerOwnershipTransferred, error)
+	ParsePaused(log types.Log) (*EigenPodManagerPaused, error)
+	ParsePauserRegistrySet(log types.Log) (*EigenPodManagerPauserRegistrySet, error)
+	ParsePodDeployed(log types.Log) (*EigenPodManagerPodDeployed, error)
+	ParsePodSharesUpdated(log types.Log) (*EigenPodManagerPodSharesUpdated, error)
+	ParseUnpaused(log types.Log) (*EigenPodManagerUnpaused, error)
+	WatchBeaconChainETHDeposited(opts *bind.WatchOpts, sink chan<- *EigenPodManagerBeaconChainETHDeposited, podOwner []common.Address) (event.Subscription, error)
+	WatchBeaconChainETHWithdrawalCompleted(opts *bind.WatchOpts, sink chan<- *EigenPodManagerBeaconChainETHWithdrawalCompleted, podOwner []common.Address) (event.Subscription, error)
+	WatchBeaconOracleUpdated(opts *bind.WatchOpts, sink chan<- *EigenPodManagerBeaconOracleUpdated, newOracleAddress []common.Address) (event.Subscription, error)
+	WatchDenebForkTimestampUpdated(opts *bind.WatchOpts, sink chan<- *EigenPodManagerDenebForkTimestampUpdated) (event.Subscription, error)
+	WatchInitialized(opts *bind.WatchOpts, sink chan<- *EigenPodManagerInitialized) (event.Subscription, error)
+	WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *EigenPodManagerOwnershipTransferred, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error)
+	WatchPaused(opts *bind.WatchOpts, sink chan<- *EigenPodManagerPaused, account []common.Address) (event.Subscription, error)
+	WatchPauserRegistrySet(opts *bind.WatchOpts, sink chan<- *EigenPodManagerPauserRegistrySet) (event.Subscription, error)
+	WatchPodDeployed(opts *bind.WatchOpts, sink chan<- *EigenPodManagerPodDeployed, eigenPod []common.Address, podOwner []common.Address) (event.Subscription, error)
+	WatchPodSharesUpdated(opts *bind.WatchOpts, sink chan<- *EigenPodManagerPodSharesUpdated, podOwner []common.Address) (event.Subscription, error)
+	WatchUnpaused(opts *bind.WatchOpts, sink chan<- *EigenPodManagerUnpaused, account []common.Address) (event.Subscription, error)
+}
+
+var (
+	_ EigenPodManagerReader = (*EigenPodManagerCaller)(nil)
+	_ EigenPodManagerWriter = (*EigenPodManagerTransactor)(nil)
+	_ EigenPodManagerEvents = (*EigenPodManagerFilterer)(nil)
+	_ EigenPodManagerReader = (*EigenPodManager)(nil)
+	_ EigenPodManagerWriter = (*EigenPodManager)(nil)
+	_ EigenPodManagerEvents = (*EigenPodManager)(nil)
+)
@@ -0,0 +1,89 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package EigenPodManagerStorage
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// EigenPodManagerStorageReader is the read-only, constant method surface of EigenPodManagerStorage.
+type EigenPodManagerStorageReader interface {
+	BeaconChainETHStrategy(opts *bind.CallOpts) (common.Address, error)
+	BeaconChainOracle(opts *bind.CallOpts) (common.Address, error)
+	DelegationManager(opts *bind.CallOpts) (common.Address, error)
+	DenebForkTimestamp(opts *bind.CallOpts) (uint64, error)
+	EigenPodBeacon(opts *bind.CallOpts) (common.Address, error)
+	EthPOS(opts *bind.CallOpts) (common.Address, error)
+	GetBlockRootAtTimestamp(opts *bind.CallOpts, timestamp uint64) ([32]byte, error)
+	GetPod(opts *bind.CallOpts, podOwner common.Address) (common.Address, error)
+	HasPod(opts *bind.CallOpts, podOwner common.Address) (bool, error)
+	NumPods(opts *bind.CallOpts) (*big.Int, error)
+	OwnerToPod(opts *bind.CallOpts, arg0 common.Address) (common.Address, error)
+	Paused(opts *bind.CallOpts, index uint8) (bool, error)
+	Paused0(opts *bind.CallOpts) (*big.Int, error)
+	PauserRegistry(opts *bind.CallOpts) (common.Address, error)
+	PodOwnerShares(opts *bind.CallOpts, arg0 common.Address) (*big.Int, error)
+	Slasher(opts *bind.CallOpts) (common.Address, error)
+	StrategyManager(opts *bind.CallOpts) (common.Address, error)
+}
+
+// EigenPodManagerStorageWriter is the transaction sending method surface of EigenPodManagerStorage.
+type EigenPodManagerStorageWriter interface {
+	AddShares(opts *bind.TransactOpts, podOwner common.Address, shares *big.Int) (*types.Transaction, error)
+	CreatePod(opts *bind.TransactOpts) (*types.Transaction, error)
+	Pause(opts *bind.TransactOpts, newPausedStatus *big.Int) (*types.Transaction, error)
+	PauseAll(opts *bind.TransactOpts) (*types.Transaction, error)
+	RecordBeaconChainETHBalanceUpdate(opts *bind.TransactOpts, podOwner common.Address, sharesDelta *big.Int) (*types.Transaction, error)
+	RemoveShares(opts *bind.TransactOpts, podOwner common.Address, shares *big.Int) (*types.Transaction, error)
+	SetDenebForkTimestamp(opts *bind.TransactOpts, newDenebForkTimestamp uint64) (*types.Transaction, error)
+	SetPauserRegistry(opts *bind.TransactOpts, newPauserRegistry common.Address) (*types.Transaction, error)
+	Stake(opts *bind.TransactOpts, pubkey []byte, signature []byte, depositDataRoot [32]byte) (*types.Transaction, error)
+	Unpause(opts *bind.TransactOpts, newPausedStatus *big.Int) (*types.Transaction, error)
+	UpdateBeaconChainOracle(opts *bind.TransactOpts, newBeaconChainOracle common.Address) (*types.Transaction, error)
+	WithdrawSharesAsTokens(opts *bind.TransactOpts, podOwner common.Address, destination common.Address, shares *big.Int) (*types.Transaction, error)
+}
+
+// EigenPodManagerStorageEvents is the log filtering, watching and parsing surface of EigenPodManagerStorage.
+type EigenPodManagerStorageEvents interface {
+	FilterBeaconChainETHDeposited(opts *bind.FilterOpts, podOwner []common.Address) (*EigenPodManagerStorageBeaconChainETHDepositedIterator, error)
+	FilterBeaconChainETHWithdrawalCompleted(opts *bind.FilterOpts, podOwner []common.Address) (*EigenPodManagerStorageBeaconChainETHWithdrawalCompletedIterator, error)
+	FilterBeaconOracleUpdated(opts *bind.FilterOpts, newOracleAddress []common.Address) (*EigenPodManagerStorageBeaconOracleUpdatedIterator, error)
+	FilterDenebForkTimestampUpdated(opts *bind.FilterOpts) (*EigenPodManagerStorageDenebForkTimestampUpdatedIterator, error)
+	FilterPaused(opts *bind.FilterOpts, account []common.Address) (*EigenPodManagerStoragePausedIterator, error)
+	FilterPauserRegistrySet(opts *bind.FilterOpts) (*EigenPodManagerStoragePauserRegistrySetIterator, error)
+	FilterPodDeployed(opts *bind.FilterOpts, eigenPod []common.Address, podOwner []common.Address) (*EigenPodManagerStoragePodDeployedIterator, error)
+	FilterPodSharesUpdated(opts *bind.FilterOpts, podOwner []common.Address) (*EigenPodManagerStoragePodSharesUpdatedIterator, error)
+	FilterUnpaused(opts *bind.FilterOpts, account []common.Address) (*EigenPodManagerStorageUnpausedIterator, error)
+	ParseBeaconChainETHDeposited(log types.Log) (*EigenPodManagerStorageBeaconChainETHDeposited, error)
+	ParseBeaconChainETHWithdrawalCompleted(log types.Log) (*EigenPodManagerStorageBeaconChainETHWithdrawalCompleted, error)
+	ParseBeaconOracleUpdated(log types.Log) (*EigenPodManagerStorageBeaconOracleUpdated, error)
+	ParseDenebForkTimestampUpdated(log types.Log) (*EigenPodManagerStorageDenebForkTimestampUpdated, error)
+	ParsePaused(log types.Log) (*EigenPodManagerStoragePaused, error)
+	ParsePauserRegistrySet(log types.Log) (*EigenPodManagerStoragePauserRegistrySet, error)
+	ParsePodDeployed(log types.Log) (*EigenPodManagerStoragePodDeployed, error)
+	ParsePodSharesUpdated(log types.Log) (*EigenPodManagerStoragePodSharesUpdated, error)
+	ParseUnpaused(log types.Log) (*EigenPodManagerStorageUnpaused, error)
+	WatchBeaconChainETHDeposited(opts *bind.WatchOpts, sink chan<- *EigenPodManagerStorageBeaconChainETHDeposited, podOwner []common.Address) (event.Subscription, error)
+	WatchBeaconChainETHWithdrawalCompleted(opts *bind.WatchOpts, sink chan<- *EigenPodManagerStorageBeaconChainETHWithdrawalCompleted, podOwner []common.Address) (event.Subscription, error)
+	WatchBeaconOracleUpdated(opts *bind.WatchOpts, sink chan<- *EigenPodManagerStorageBeaconOracleUpdated, newOracleAddress []common.Address) (event.Subscription, error)
+	WatchDenebForkTimestampUpdated(opts *bind.WatchOpts, sink chan<- *EigenPodManagerStorageDenebForkTimestampUpdated) (event.Subscription, error)
+	WatchPaused(opts *bind.WatchOpts, sink chan<- *EigenPodManagerStoragePaused, account []common.Address) (event.Subscription, error)
+	WatchPauserRegistrySet(opts *bind.WatchOpts, sink chan<- *EigenPodManagerStoragePauserRegistrySet) (event.Subscription, error)
+	WatchPodDeployed(opts *bind.WatchOpts, sink chan<- *EigenPodManagerStoragePodDeployed, eigenPod []common.Address, podOwner []common.Address) (event.Subscription, error)
+	WatchPodSharesUpdated(opts *bind.WatchOpts, sink chan<- *EigenPodManagerStoragePodSharesUpdated, podOwner []common.Address) (event.Subscription, error)
+	WatchUnpaused(opts *bind.WatchOpts, sink chan<- *EigenPodManagerStorageUnpaused, account []common.Address) (event.Subscription, error)
+}
+
+var (
+	_ EigenPodManagerStorageReader = (*EigenPodManagerStorageCaller)(nil)
+	_ EigenPodManagerStorageWriter = (*EigenPodManagerStorageTransactor)(nil)
+	_ EigenPodManagerStorageEvents = (*EigenPodManagerStorageFilterer)(nil)
+	_ EigenPodManagerStorageReader = (*EigenPodManagerStorage)(nil)
+	_ EigenPodManagerStorageWriter = (*EigenPodManagerStorage)(nil)
+	_ EigenPodManagerStorageEvents = (*EigenPodManagerStorage)(nil)
+)
@@ -0,0 +1,24 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package EigenPodPausingConstants
+
+// EigenPodPausingConstantsReader is the read-only, constant method surface of EigenPodPausingConstants.
+type EigenPodPausingConstantsReader interface {
+}
+
+// EigenPodPausingConstantsWriter is the transaction sending method surface of EigenPodPausingConstants.
+type EigenPodPausingConstantsWriter interface {
+}
+
+// EigenPodPausingConstantsEvents is the log filtering, watching and parsing surface of EigenPodPausingConstants.
+type EigenPodPausingConstantsEvents interface {
+}
+
+var (
+	_ EigenPodPausingConstantsReader = (*EigenPodPausingConstantsCaller)(nil)
+	_ EigenPodPausingConstantsWriter = (*EigenPodPausingConstantsTransactor)(nil)
+	_ EigenPodPausingConstantsEvents = (*EigenPodPausingConstantsFilterer)(nil)
+	_ EigenPodPausingConstantsReader = (*EigenPodPausingConstants)(nil)
+	_ EigenPodPausingConstantsWriter = (*EigenPodPausingConstants)(nil)
+	_ EigenPodPausingConstantsEvents = (*EigenPodPausingConstants)(nil)
+)
@@ -0,0 +1,68 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package EigenStrategy
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// EigenStrategyReader is the read-only, constant method surface of EigenStrategy.
+type EigenStrategyReader interface {
+	EIGEN(opts *bind.CallOpts) (common.Address, error)
+	Explanation(opts *bind.CallOpts) (string, error)
+	Paused(opts *bind.CallOpts, index uint8) (bool, error)
+	Paused0(opts *bind.CallOpts) (*big.Int, error)
+	PauserRegistry(opts *bind.CallOpts) (common.Address, error)
+	Shares(opts *bind.CallOpts, user common.Address) (*big.Int, error)
+	SharesToUnderlying(opts *bind.CallOpts, amountShares *big.Int) (*big.Int, error)
+	SharesToUnderlyingView(opts *bind.CallOpts, amountShares *big.Int) (*big.Int, error)
+	StrategyManager(opts *bind.CallOpts) (common.Address, error)
+	TotalShares(opts *bind.CallOpts) (*big.Int, error)
+	UnderlyingToShares(opts *bind.CallOpts, amountUnderlying *big.Int) (*big.Int, error)
+	UnderlyingToSharesView(opts *bind.CallOpts, amountUnderlying *big.Int) (*big.Int, error)
+	UnderlyingToken(opts *bind.CallOpts) (common.Address, error)
+	UserUnderlyingView(opts *bind.CallOpts, user common.Address) (*big.Int, error)
+}
+
+// EigenStrategyWriter is the transaction sending method surface of EigenStrategy.
+type EigenStrategyWriter interface {
+	Deposit(opts *bind.TransactOpts, token common.Address, amount *big.Int) (*types.Transaction, error)
+	Initialize(opts *bind.TransactOpts, _underlyingToken common.Address, _pauserRegistry common.Address) (*types.Transaction, error)
+	Initialize0(opts *bind.TransactOpts, _EIGEN common.Address, _bEIGEN common.Address, _pauserRegistry common.Address) (*types.Transaction, error)
+	Pause(opts *bind.TransactOpts, newPausedStatus *big.Int) (*types.Transaction, error)
+	PauseAll(opts *bind.TransactOpts) (*types.Transaction, error)
+	SetPauserRegistry(opts *bind.TransactOpts, newPauserRegistry common.Address) (*types.Transaction, error)
+	Unpause(opts *bind.TransactOpts, newPausedStatus *big.Int) (*types.Transaction, error)
+	UserUnderlying(opts *bind.TransactOpts, user common.Address) (*types.Transaction, error)
+	Withdraw(opts *bind.TransactOpts, recipient common.Address, token common.Address, amountShares *big.Int) (*types.Transaction, error)
+}
+
+// EigenStrategyEvents is the log filtering, watching and parsing surface of EigenStrategy.
+type EigenStrategyEvents interface {
+	FilterInitialized(opts *bind.FilterOpts) (*EigenStrategyInitializedIterator, error)
+	FilterPaused(opts *bind.FilterOpts, account []common.Address) (*EigenStrategyPausedIterator, error)
+	FilterPauserRegistrySet(opts *bind.FilterOpts) (*EigenStrategyPauserRegistrySetIterator, error)
+	FilterUnpaused(opts *bind.FilterOpts, account []common.Address) (*EigenStrategyUnpausedIterator, error)
+	ParseInitialized(log types.Log) (*EigenStrategyInitialized, error)
+	ParsePaused(log types.Log) (*EigenStrategyPaused, error)
+	ParsePauserRegistrySet(log types.Log) (*EigenStrategyPauserRegistrySet, error)
+	ParseUnpaused(log types.Log) (*EigenStrategyUnpaused, error)
+	WatchInitialized(opts *bind.WatchOpts, sink chan<- *EigenStrategyInitialized) (event.Subscription, error)
+	WatchPaused(opts *bind.WatchOpts, sink chan<- *EigenStrategyPaused, account []common.Address) (event.Subscription, error)
+	WatchPauserRegistrySet(opts *bind.WatchOpts, sink chan<- *EigenStrategyPauserRegistrySet) (event.Subscription, error)
+	WatchUnpaused(opts *bind.WatchOpts, sink chan<- *EigenStrategyUnpaused, account []common.Address) (event.Subscription, error)
+}
+
+var (
+	_ EigenStrategyReader = (*EigenStrategyCaller)(nil)
+	_ EigenStrategyWriter = (*EigenStrategyTransactor)(nil)
+	_ EigenStrategyEvents = (*EigenStrategyFilterer)(nil)
+	_ EigenStrategyReader = (*EigenStrategy)(nil)
+	_ EigenStrategyWriter = (*EigenStrategy)(nil)
+	_ EigenStrategyEvents = (*EigenStrategy)(nil)
+)
@@ -0,0 +1,24 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package Endian
+
+// EndianReader is the read-only, constant method surface of Endian.
+type EndianReader interface {
+}
+
+// EndianWriter is the transaction sending method surface of Endian.
+type EndianWriter interface {
+}
+
+// EndianEvents is the log filtering, watching and parsing surface of Endian.
+type EndianEvents interface {
+}
+
+var (
+	_ EndianReader = (*EndianCaller)(nil)
+	_ EndianWriter = (*EndianTransactor)(nil)
+	_ EndianEvents = (*EndianFilterer)(nil)
+	_ EndianReader = (*Endian)(nil)
+	_ EndianWriter = (*Endian)(nil)
+	_ EndianEvents = (*Endian)(nil)
+)
@@ -0,0 +1,45 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package IAVSDirectory
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// IAVSDirectoryReader is the read-only, constant method surface of IAVSDirectory.
+type IAVSDirectoryReader interface {
+	CalculateOperatorAVSRegistrationDigestHash(opts *bind.CallOpts, operator common.Address, avs common.Address, salt [32]byte, expiry *big.Int) ([32]byte, error)
+	OPERATORAVSREGISTRATIONTYPEHASH(opts *bind.CallOpts) ([32]byte, error)
+	OperatorSaltIsSpent(opts *bind.CallOpts, operator common.Address, salt [32]byte) (bool, error)
+}
+
+// IAVSDirectoryWriter is the transaction sending method surface of IAVSDirectory.
+type IAVSDirectoryWriter interface {
+	DeregisterOperatorFromAVS(opts *bind.TransactOpts, operator common.Address) (*types.Transaction, error)
+	RegisterOperatorToAVS(opts *bind.TransactOpts, operator common.Address, operatorSignature ISignatureUtilsSignatureWithSaltAndExpiry) (*types.Transaction, error)
+	UpdateAVSMetadataURI(opts *bind.TransactOpts, metadataURI string) (*types.Transaction, error)
+}
+
+// IAVSDirectoryEvents is the log filtering, watching and parsing surface of IAVSDirectory.
+type IAVSDirectoryEvents interface {
+	FilterAVSMetadataURIUpdated(opts *bind.FilterOpts, avs []common.Address) (*IAVSDirectoryAVSMetadataURIUpdatedIterator, error)
+	FilterOperatorAVSRegistrationStatusUpdated(opts *bind.FilterOpts, operator []common.Address, avs []common.Address) (*IAVSDirectoryOperatorAVSRegistrationStatusUpdatedIterator, error)
+	ParseAVSMetadataURIUpdated(log types.Log) (*IAVSDirectoryAVSMetadataURIUpdated, error)
+	ParseOperatorAVSRegistrationStatusUpdated(log types.Log) (*IAVSDirectoryOperatorAVSRegistrationStatusUpdated, error)
+	WatchAVSMetadataURIUpdated(opts *bind.WatchOpts, sink chan<- *IAVSDirectoryAVSMetadataURIUpdated, avs []common.Address) (event.Subscription, error)
+	WatchOperatorAVSRegistrationStatusUpdated(opts *bind.WatchOpts, sink chan<- *IAVSDirectoryOperatorAVSRegistrationStatusUpdated, operator []common.Address, avs []common.Address) (event.Subscription, error)
+}
+
+var (
+	_ IAVSDirectoryReader = (*IAVSDirectoryCaller)(nil)
+	_ IAVSDirectoryWriter = (*IAVSDirectoryTransactor)(nil)
+	_ IAVSDirectoryEvents = (*IAVSDirectoryFilterer)(nil)
+	_ IAVSDirectoryReader = (*IAVSDirectory)(nil)
+	_ IAVSDirectoryWriter = (*IAVSDirectory)(nil)
+	_ IAVSDirectoryEvents = (*IAVSDirectory)(nil)
+)
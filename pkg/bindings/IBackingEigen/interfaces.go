@@ -0,0 +1,50 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package IBackingEigen
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// IBackingEigenReader is the read-only, constant method surface of IBackingEigen.
+type IBackingEigenReader interface {
+	Allowance(opts *bind.CallOpts, owner common.Address, spender common.Address) (*big.Int, error)
+	BalanceOf(opts *bind.CallOpts, account common.Address) (*big.Int, error)
+	CLOCKMODE(opts *bind.CallOpts) (string, error)
+	Clock(opts *bind.CallOpts) (*big.Int, error)
+	TotalSupply(opts *bind.CallOpts) (*big.Int, error)
+}
+
+// IBackingEigenWriter is the transaction sending method surface of IBackingEigen.
+type IBackingEigenWriter interface {
+	Approve(opts *bind.TransactOpts, spender common.Address, amount *big.Int) (*types.Transaction, error)
+	DisableTransferRestrictions(opts *bind.TransactOpts) (*types.Transaction, error)
+	SetAllowedFrom(opts *bind.TransactOpts, from common.Address, isAllowedFrom bool) (*types.Transaction, error)
+	SetAllowedTo(opts *bind.TransactOpts, to common.Address, isAllowedTo bool) (*types.Transaction, error)
+	Transfer(opts *bind.TransactOpts, to common.Address, amount *big.Int) (*types.Transaction, error)
+	TransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, amount *big.Int) (*types.Transaction, error)
+}
+
+// IBackingEigenEvents is the log filtering, watching and parsing surface of IBackingEigen.
+type IBackingEigenEvents interface {
+	FilterApproval(opts *bind.FilterOpts, owner []common.Address, spender []common.Address) (*IBackingEigenApprovalIterator, error)
+	FilterTransfer(opts *bind.FilterOpts, from []common.Address, to []common.Address) (*IBackingEigenTransferIterator, error)
+	ParseApproval(log types.Log) (*IBackingEigenApproval, error)
+	ParseTransfer(log types.Log) (*IBackingEigenTransfer, error)
+	WatchApproval(opts *bind.WatchOpts, sink chan<- *IBackingEigenApproval, owner []common.Address, spender []common.Address) (event.Subscription, error)
+	WatchTransfer(opts *bind.WatchOpts, sink chan<- *IBackingEigenTransfer, from []common.Address, to []common.Address) (event.Subscription, error)
+}
+
+var (
+	_ IBackingEigenReader = (*IBackingEigenCaller)(nil)
+	_ IBackingEigenWriter = (*IBackingEigenTransactor)(nil)
+	_ IBackingEigenEvents = (*IBackingEigenFilterer)(nil)
+	_ IBackingEigenReader = (*IBackingEigen)(nil)
+	_ IBackingEigenWriter = (*IBackingEigen)(nil)
+	_ IBackingEigenEvents = (*IBackingEigen)(nil)
+)
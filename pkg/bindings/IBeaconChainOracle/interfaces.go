@@ -0,0 +1,31 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package IBeaconChainOracle
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+)
+
+// IBeaconChainOracleReader is the read-only, constant method surface of IBeaconChainOracle.
+type IBeaconChainOracleReader interface {
+	TimestampToBlockRoot(opts *bind.CallOpts, timestamp *big.Int) ([32]byte, error)
+}
+
+// IBeaconChainOracleWriter is the transaction sending method surface of IBeaconChainOracle.
+type IBeaconChainOracleWriter interface {
+}
+
+// IBeaconChainOracleEvents is the log filtering, watching and parsing surface of IBeaconChainOracle.
+type IBeaconChainOracleEvents interface {
+}
+
+var (
+	_ IBeaconChainOracleReader = (*IBeaconChainOracleCaller)(nil)
+	_ IBeaconChainOracleWriter = (*IBeaconChainOracleTransactor)(nil)
+	_ IBeaconChainOracleEvents = (*IBeaconChainOracleFilterer)(nil)
+	_ IBeaconChainOracleReader = (*IBeaconChainOracle)(nil)
+	_ IBeaconChainOracleWriter = (*IBeaconChainOracle)(nil)
+	_ IBeaconChainOracleEvents = (*IBeaconChainOracle)(nil)
+)
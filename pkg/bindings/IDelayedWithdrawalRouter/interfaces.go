@@ -0,0 +1,53 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package IDelayedWithdrawalRouter
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// IDelayedWithdrawalRouterReader is the read-only, constant method surface of IDelayedWithdrawalRouter.
+type IDelayedWithdrawalRouterReader interface {
+	CanClaimDelayedWithdrawal(opts *bind.CallOpts, user common.Address, index *big.Int) (bool, error)
+	GetClaimableUserDelayedWithdrawals(opts *bind.CallOpts, user common.Address) ([]IDelayedWithdrawalRouterDelayedWithdrawal, error)
+	GetUserDelayedWithdrawals(opts *bind.CallOpts, user common.Address) ([]IDelayedWithdrawalRouterDelayedWithdrawal, error)
+	UserDelayedWithdrawalByIndex(opts *bind.CallOpts, user common.Address, index *big.Int) (IDelayedWithdrawalRouterDelayedWithdrawal, error)
+	UserWithdrawals(opts *bind.CallOpts, user common.Address) (IDelayedWithdrawalRouterUserDelayedWithdrawals, error)
+	UserWithdrawalsLength(opts *bind.CallOpts, user common.Address) (*big.Int, error)
+	WithdrawalDelayBlocks(opts *bind.CallOpts) (*big.Int, error)
+}
+
+// IDelayedWithdrawalRouterWriter is the transaction sending method surface of IDelayedWithdrawalRouter.
+type IDelayedWithdrawalRouterWriter interface {
+	ClaimDelayedWithdrawals(opts *bind.TransactOpts, maxNumberOfWithdrawalsToClaim *big.Int) (*types.Transaction, error)
+	ClaimDelayedWithdrawals0(opts *bind.TransactOpts, recipient common.Address, maxNumberOfWithdrawalsToClaim *big.Int) (*types.Transaction, error)
+	CreateDelayedWithdrawal(opts *bind.TransactOpts, podOwner common.Address, recipient common.Address) (*types.Transaction, error)
+	SetWithdrawalDelayBlocks(opts *bind.TransactOpts, newValue *big.Int) (*types.Transaction, error)
+}
+
+// IDelayedWithdrawalRouterEvents is the log filtering, watching and parsing surface of IDelayedWithdrawalRouter.
+type IDelayedWithdrawalRouterEvents interface {
+	FilterDelayedWithdrawalCreated(opts *bind.FilterOpts) (*IDelayedWithdrawalRouterDelayedWithdrawalCreatedIterator, error)
+	FilterDelayedWithdrawalsClaimed(opts *bind.FilterOpts) (*IDelayedWithdrawalRouterDelayedWithdrawalsClaimedIterator, error)
+	FilterWithdrawalDelayBlocksSet(opts *bind.FilterOpts) (*IDelayedWithdrawalRouterWithdrawalDelayBlocksSetIterator, error)
+	ParseDelayedWithdrawalCreated(log types.Log) (*IDelayedWithdrawalRouterDelayedWithdrawalCreated, error)
+	ParseDelayedWithdrawalsClaimed(log types.Log) (*IDelayedWithdrawalRouterDelayedWithdrawalsClaimed, error)
+	ParseWithdrawalDelayBlocksSet(log types.Log) (*IDelayedWithdrawalRouterWithdrawalDelayBlocksSet, error)
+	WatchDelayedWithdrawalCreated(opts *bind.WatchOpts, sink chan<- *IDelayedWithdrawalRouterDelayedWithdrawalCreated) (event.Subscription, error)
+	WatchDelayedWithdrawalsClaimed(opts *bind.WatchOpts, sink chan<- *IDelayedWithdrawalRouterDelayedWithdrawalsClaimed) (event.Subscription, error)
+	WatchWithdrawalDelayBlocksSet(opts *bind.WatchOpts, sink chan<- *IDelayedWithdrawalRouterWithdrawalDelayBlocksSet) (event.Subscription, error)
+}
+
+var (
+	_ IDelayedWithdrawalRouterReader = (*IDelayedWithdrawalRouterCaller)(nil)
+	_ IDelayedWithdrawalRouterWriter = (*IDelayedWithdrawalRouterTransactor)(nil)
+	_ IDelayedWithdrawalRouterEvents = (*IDelayedWithdrawalRouterFilterer)(nil)
+	_ IDelayedWithdrawalRouterReader = (*IDelayedWithdrawalRouter)(nil)
+	_ IDelayedWithdrawalRouterWriter = (*IDelayedWithdrawalRouter)(nil)
+	_ IDelayedWithdrawalRouterEvents = (*IDelayedWithdrawalRouter)(nil)
+)
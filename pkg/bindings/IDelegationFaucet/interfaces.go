@@ -0,0 +1,39 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package IDelegationFaucet
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// IDelegationFaucetReader is the read-only, constant method surface of IDelegationFaucet.
+type IDelegationFaucetReader interface {
+}
+
+// IDelegationFaucetWriter is the transaction sending method surface of IDelegationFaucet.
+type IDelegationFaucetWriter interface {
+	CallAddress(opts *bind.TransactOpts, to common.Address, data []byte) (*types.Transaction, error)
+	CompleteQueuedWithdrawal(opts *bind.TransactOpts, staker common.Address, queuedWithdrawal IDelegationManagerWithdrawal, tokens []common.Address, middlewareTimesIndex *big.Int, receiveAsTokens bool) (*types.Transaction, error)
+	DepositIntoStrategy(opts *bind.TransactOpts, staker common.Address, strategy common.Address, token common.Address, amount *big.Int) (*types.Transaction, error)
+	GetStaker(opts *bind.TransactOpts, operator common.Address) (*types.Transaction, error)
+	MintDepositAndDelegate(opts *bind.TransactOpts, _operator common.Address, approverSignatureAndExpiry ISignatureUtilsSignatureWithExpiry, approverSalt [32]byte, _depositAmount *big.Int) (*types.Transaction, error)
+	QueueWithdrawal(opts *bind.TransactOpts, staker common.Address, queuedWithdrawalParams []IDelegationManagerQueuedWithdrawalParams) (*types.Transaction, error)
+	Transfer(opts *bind.TransactOpts, staker common.Address, token common.Address, to common.Address, amount *big.Int) (*types.Transaction, error)
+}
+
+// IDelegationFaucetEvents is the log filtering, watching and parsing surface of IDelegationFaucet.
+type IDelegationFaucetEvents interface {
+}
+
+var (
+	_ IDelegationFaucetReader = (*IDelegationFaucetCaller)(nil)
+	_ IDelegationFaucetWriter = (*IDelegationFaucetTransactor)(nil)
+	_ IDelegationFaucetEvents = (*IDelegationFaucetFilterer)(nil)
+	_ IDelegationFaucetReader = (*IDelegationFaucet)(nil)
+	_ IDelegationFaucetWriter = (*IDelegationFaucet)(nil)
+	_ IDelegationFaucetEvents = (*IDelegationFaucet)(nil)
+)
@@ -0,0 +1,103 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package IDelegationManager
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// IDelegationManagerReader is the read-only, constant method surface of IDelegationManager.
+type IDelegationManagerReader interface {
+	BeaconChainETHStrategy(opts *bind.CallOpts) (common.Address, error)
+	CalculateCurrentStakerDelegationDigestHash(opts *bind.CallOpts, staker common.Address, operator common.Address, expiry *big.Int) ([32]byte, error)
+	CalculateDelegationApprovalDigestHash(opts *bind.CallOpts, staker common.Address, operator common.Address, _delegationApprover common.Address, approverSalt [32]byte, expiry *big.Int) ([32]byte, error)
+	CalculateStakerDelegationDigestHash(opts *bind.CallOpts, staker common.Address, _stakerNonce *big.Int, operator common.Address, expiry *big.Int) ([32]byte, error)
+	CalculateWithdrawalRoot(opts *bind.CallOpts, withdrawal IDelegationManagerWithdrawal) ([32]byte, error)
+	CumulativeWithdrawalsQueued(opts *bind.CallOpts, staker common.Address) (*big.Int, error)
+	DELEGATIONAPPROVALTYPEHASH(opts *bind.CallOpts) ([32]byte, error)
+	DOMAINTYPEHASH(opts *bind.CallOpts) ([32]byte, error)
+	DelegatedTo(opts *bind.CallOpts, staker common.Address) (common.Address, error)
+	DelegationApprover(opts *bind.CallOpts, operator common.Address) (common.Address, error)
+	DelegationApproverSaltIsSpent(opts *bind.CallOpts, _delegationApprover common.Address, salt [32]byte) (bool, error)
+	DomainSeparator(opts *bind.CallOpts) ([32]byte, error)
+	GetOperatorShares(opts *bind.CallOpts, operator common.Address, strategies []common.Address) ([]*big.Int, error)
+	GetWithdrawalDelay(opts *bind.CallOpts, strategies []common.Address) (*big.Int, error)
+	IsDelegated(opts *bind.CallOpts, staker common.Address) (bool, error)
+	IsOperator(opts *bind.CallOpts, operator common.Address) (bool, error)
+	MinWithdrawalDelayBlocks(opts *bind.CallOpts) (*big.Int, error)
+	OperatorDetails(opts *bind.CallOpts, operator common.Address) (IDelegationManagerOperatorDetails, error)
+	OperatorShares(opts *bind.CallOpts, operator common.Address, strategy common.Address) (*big.Int, error)
+	STAKERDELEGATIONTYPEHASH(opts *bind.CallOpts) ([32]byte, error)
+	StakerNonce(opts *bind.CallOpts, staker common.Address) (*big.Int, error)
+	StakerOptOutWindowBlocks(opts *bind.CallOpts, operator common.Address) (*big.Int, error)
+	StrategyWithdrawalDelayBlocks(opts *bind.CallOpts, strategy common.Address) (*big.Int, error)
+}
+
+// IDelegationManagerWriter is the transaction sending method surface of IDelegationManager.
+type IDelegationManagerWriter interface {
+	CompleteQueuedWithdrawal(opts *bind.TransactOpts, withdrawal IDelegationManagerWithdrawal, tokens []common.Address, middlewareTimesIndex *big.Int, receiveAsTokens bool) (*types.Transaction, error)
+	CompleteQueuedWithdrawals(opts *bind.TransactOpts, withdrawals []IDelegationManagerWithdrawal, tokens [][]common.Address, middlewareTimesIndexes []*big.Int, receiveAsTokens []bool) (*types.Transaction, error)
+	DecreaseDelegatedShares(opts *bind.TransactOpts, staker common.Address, strategy common.Address, shares *big.Int) (*types.Transaction, error)
+	DelegateTo(opts *bind.TransactOpts, operator common.Address, approverSignatureAndExpiry ISignatureUtilsSignatureWithExpiry, approverSalt [32]byte) (*types.Transaction, error)
+	DelegateToBySignature(opts *bind.TransactOpts, staker common.Address, operator common.Address, stakerSignatureAndExpiry ISignatureUtilsSignatureWithExpiry, approverSignatureAndExpiry ISignatureUtilsSignatureWithExpiry, approverSalt [32]byte) (*types.Transaction, error)
+	IncreaseDelegatedShares(opts *bind.TransactOpts, staker common.Address, strategy common.Address, shares *big.Int) (*types.Transaction, error)
+	ModifyOperatorDetails(opts *bind.TransactOpts, newOperatorDetails IDelegationManagerOperatorDetails) (*types.Transaction, error)
+	QueueWithdrawals(opts *bind.TransactOpts, queuedWithdrawalParams []IDelegationManagerQueuedWithdrawalParams) (*types.Transaction, error)
+	RegisterAsOperator(opts *bind.TransactOpts, registeringOperatorDetails IDelegationManagerOperatorDetails, metadataURI string) (*types.Transaction, error)
+	Undelegate(opts *bind.TransactOpts, staker common.Address) (*types.Transaction, error)
+	UpdateOperatorMetadataURI(opts *bind.TransactOpts, metadataURI string) (*types.Transaction, error)
+}
+
+// IDelegationManagerEvents is the log filtering, watching and parsing surface of IDelegationManager.
+type IDelegationManagerEvents interface {
+	FilterMinWithdrawalDelayBlocksSet(opts *bind.FilterOpts) (*IDelegationManagerMinWithdrawalDelayBlocksSetIterator, error)
+	FilterOperatorDetailsModified(opts *bind.FilterOpts, operator []common.Address) (*IDelegationManagerOperatorDetailsModifiedIterator, error)
+	FilterOperatorMetadataURIUpdated(opts *bind.FilterOpts, operator []common.Address) (*IDelegationManagerOperatorMetadataURIUpdatedIterator, error)
+	FilterOperatorRegistered(opts *bind.FilterOpts, operator []common.Address) (*IDelegationManagerOperatorRegisteredIterator, error)
+	FilterOperatorSharesDecreased(opts *bind.FilterOpts, operator []common.Address) (*IDelegationManagerOperatorSharesDecreasedIterator, error)
+	FilterOperatorSharesIncreased(opts *bind.FilterOpts, operator []common.Address) (*IDelegationManagerOperatorSharesIncreasedIterator, error)
+	FilterStakerDelegated(opts *bind.FilterOpts, staker []common.Address, operator []common.Address) (*IDelegationManagerStakerDelegatedIterator, error)
+	FilterStakerForceUndelegated(opts *bind.FilterOpts, staker []common.Address, operator []common.Address) (*IDelegationManagerStakerForceUndelegatedIterator, error)
+	FilterStakerUndelegated(opts *bind.FilterOpts, staker []common.Address, operator []common.Address) (*IDelegationManagerStakerUndelegatedIterator, error)
+	FilterStrategyWithdrawalDelayBlocksSet(opts *bind.FilterOpts) (*IDelegationManagerStrategyWithdrawalDelayBlocksSetIterator, error)
+	FilterWithdrawalCompleted(opts *bind.FilterOpts) (*IDelegationManagerWithdrawalCompletedIterator, error)
+	FilterWithdrawalQueued(opts *bind.FilterOpts) (*IDelegationManagerWithdrawalQueuedIterator, error)
+	ParseMinWithdrawalDelayBlocksSet(log types.Log) (*IDelegationManagerMinWithdrawalDelayBlocksSet, error)
+	ParseOperatorDetailsModified(log types.Log) (*IDelegationManagerOperatorDetailsModified, error)
+	ParseOperatorMetadataURIUpdated(log types.Log) (*IDelegationManagerOperatorMetadataURIUpdated, error)
+	ParseOperatorRegistered(log types.Log) (*IDelegationManagerOperatorRegistered, error)
+	ParseOperatorSharesDecreased(log types.Log) (*IDelegationManagerOperatorSharesDecreased, error)
+	ParseOperatorSharesIncreased(log types.Log) (*IDelegationManagerOperatorSharesIncreased, error)
+	ParseStakerDelegated(log types.Log) (*IDelegationManagerStakerDelegated, error)
+	ParseStakerForceUndelegated(log types.Log) (*IDelegationManagerStakerForceUndelegated, error)
+	ParseStakerUndelegated(log types.Log) (*IDelegationManagerStakerUndelegated, error)
+	ParseStrategyWithdrawalDelayBlocksSet(log types.Log) (*IDelegationManagerStrategyWithdrawalDelayBlocksSet, error)
+	ParseWithdrawalCompleted(log types.Log) (*IDelegationManagerWithdrawalCompleted, error)
+	ParseWithdrawalQueued(log types.Log) (*IDelegationManagerWithdrawalQueued, error)
+	WatchMinWithdrawalDelayBlocksSet(opts *bind.WatchOpts, sink chan<- *IDelegationManagerMinWithdrawalDelayBlocksSet) (event.Subscription, error)
+	WatchOperatorDetailsModified(opts *bind.WatchOpts, sink chan<- *IDelegationManagerOperatorDetailsModified, operator []common.Address) (event.Subscription, error)
+	WatchOperatorMetadataURIUpdated(opts *bind.WatchOpts, sink chan<- *IDelegationManagerOperatorMetadataURIUpdated, operator []common.Address) (event.Subscription, error)
+	WatchOperatorRegistered(opts *bind.WatchOpts, sink chan<- *IDelegationManagerOperatorRegistered, operator []common.Address) (event.Subscription, error)
+	WatchOperatorSharesDecreased(opts *bind.WatchOpts, sink chan<- *IDelegationManagerOperatorSharesDecreased, operator []common.Address) (event.Subscription, error)
+	WatchOperatorSharesIncreased(opts *bind.WatchOpts, sink chan<- *IDelegationManagerOperatorSharesIncreased, operator []common.Address) (event.Subscription, error)
+	WatchStakerDelegated(opts *bind.WatchOpts, sink chan<- *IDelegationManagerStakerDelegated, staker []common.Address, operator []common.Address) (event.Subscription, error)
+	WatchStakerForceUndelegated(opts *bind.WatchOpts, sink chan<- *IDelegationManagerStakerForceUndelegated, staker []common.Address, operator []common.Address) (event.Subscription, error)
+	WatchStakerUndelegated(opts *bind.WatchOpts, sink chan<- *IDelegationManagerStakerUndelegated, staker []common.Address, operator []common.Address) (event.Subscription, error)
+	WatchStrategyWithdrawalDelayBlocksSet(opts *bind.WatchOpts, sink chan<- *IDelegationManagerStrategyWithdrawalDelayBlocksSet) (event.Subscription, error)
+	WatchWithdrawalCompleted(opts *bind.WatchOpts, sink chan<- *IDelegationManagerWithdrawalCompleted) (event.Subscription, error)
+	WatchWithdrawalQueued(opts *bind.WatchOpts, sink chan<- *IDelegationManagerWithdrawalQueued) (event.Subscription, error)
+}
+
+var (
+	_ IDelegationManagerReader = (*IDelegationManagerCaller)(nil)
+	_ IDelegationManagerWriter = (*IDelegationManagerTransactor)(nil)
+	_ IDelegationManagerEvents = (*IDelegationManagerFilterer)(nil)
+	_ IDelegationManagerReader = (*IDelegationManager)(nil)
+	_ IDelegationManagerWriter = (*IDelegationManager)(nil)
+	_ IDelegationManagerEvents = (*IDelegationManager)(nil)
+)
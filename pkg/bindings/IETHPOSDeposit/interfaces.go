@@ -0,0 +1,36 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package IETHPOSDeposit
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// IETHPOSDepositReader is the read-only, constant method surface of IETHPOSDeposit.
+type IETHPOSDepositReader interface {
+	GetDepositCount(opts *bind.CallOpts) ([]byte, error)
+	GetDepositRoot(opts *bind.CallOpts) ([32]byte, error)
+}
+
+// IETHPOSDepositWriter is the transaction sending method surface of IETHPOSDeposit.
+type IETHPOSDepositWriter interface {
+	Deposit(opts *bind.TransactOpts, pubkey []byte, withdrawal_credentials []byte, signature []byte, deposit_data_root [32]byte) (*types.Transaction, error)
+}
+
+// IETHPOSDepositEvents is the log filtering, watching and parsing surface of IETHPOSDeposit.
+type IETHPOSDepositEvents interface {
+	FilterDepositEvent(opts *bind.FilterOpts) (*IETHPOSDepositDepositEventIterator, error)
+	ParseDepositEvent(log types.Log) (*IETHPOSDepositDepositEvent, error)
+	WatchDepositEvent(opts *bind.WatchOpts, sink chan<- *IETHPOSDepositDepositEvent) (event.Subscription, error)
+}
+
+var (
+	_ IETHPOSDepositReader = (*IETHPOSDepositCaller)(nil)
+	_ IETHPOSDepositWriter = (*IETHPOSDepositTransactor)(nil)
+	_ IETHPOSDepositEvents = (*IETHPOSDepositFilterer)(nil)
+	_ IETHPOSDepositReader = (*IETHPOSDeposit)(nil)
+	_ IETHPOSDepositWriter = (*IETHPOSDeposit)(nil)
+	_ IETHPOSDepositEvents = (*IETHPOSDeposit)(nil)
+)
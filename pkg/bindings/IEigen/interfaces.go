@@ -0,0 +1,53 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package IEigen
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// IEigenReader is the read-only, constant method surface of IEigen.
+type IEigenReader interface {
+	Allowance(opts *bind.CallOpts, owner common.Address, spender common.Address) (*big.Int, error)
+	BalanceOf(opts *bind.CallOpts, account common.Address) (*big.Int, error)
+	CLOCKMODE(opts *bind.CallOpts) (string, error)
+	Clock(opts *bind.CallOpts) (*big.Int, error)
+	TotalSupply(opts *bind.CallOpts) (*big.Int, error)
+}
+
+// IEigenWriter is the transaction sending method surface of IEigen.
+type IEigenWriter interface {
+	Approve(opts *bind.TransactOpts, spender common.Address, amount *big.Int) (*types.Transaction, error)
+	DisableTransferRestrictions(opts *bind.TransactOpts) (*types.Transaction, error)
+	Mint(opts *bind.TransactOpts) (*types.Transaction, error)
+	SetAllowedFrom(opts *bind.TransactOpts, from common.Address, isAllowedFrom bool) (*types.Transaction, error)
+	SetAllowedTo(opts *bind.TransactOpts, to common.Address, isAllowedTo bool) (*types.Transaction, error)
+	Transfer(opts *bind.TransactOpts, to common.Address, amount *big.Int) (*types.Transaction, error)
+	TransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, amount *big.Int) (*types.Transaction, error)
+	Unwrap(opts *bind.TransactOpts, amount *big.Int) (*types.Transaction, error)
+	Wrap(opts *bind.TransactOpts, amount *big.Int) (*types.Transaction, error)
+}
+
+// IEigenEvents is the log filtering, watching and parsing surface of IEigen.
+type IEigenEvents interface {
+	FilterApproval(opts *bind.FilterOpts, owner []common.Address, spender []common.Address) (*IEigenApprovalIterator, error)
+	FilterTransfer(opts *bind.FilterOpts, from []common.Address, to []common.Address) (*IEigenTransferIterator, error)
+	ParseApproval(log types.Log) (*IEigenApproval, error)
+	ParseTransfer(log types.Log) (*IEigenTransfer, error)
+	WatchApproval(opts *bind.WatchOpts, sink chan<- *IEigenApproval, owner []common.Address, spender []common.Address) (event.Subscription, error)
+	WatchTransfer(opts *bind.WatchOpts, sink chan<- *IEigenTransfer, from []common.Address, to []common.Address) (event.Subscription, error)
+}
+
+var (
+	_ IEigenReader = (*IEigenCaller)(nil)
+	_ IEigenWriter = (*IEigenTransactor)(nil)
+	_ IEigenEvents = (*IEigenFilterer)(nil)
+	_ IEigenReader = (*IEigen)(nil)
+	_ IEigenWriter = (*IEigen)(nil)
+	_ IEigenEvents = (*IEigen)(nil)
+)
@@ -0,0 +1,82 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package IEigenPod
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// IEigenPodReader is the read-only, constant method surface of IEigenPod.
+type IEigenPodReader interface {
+	EigenPodManager(opts *bind.CallOpts) (common.Address, error)
+	HasRestaked(opts *bind.CallOpts) (bool, error)
+	MAXRESTAKEDBALANCEGWEIPERVALIDATOR(opts *bind.CallOpts) (uint64, error)
+	MostRecentWithdrawalTimestamp(opts *bind.CallOpts) (uint64, error)
+	NonBeaconChainETHBalanceWei(opts *bind.CallOpts) (*big.Int, error)
+	PodOwner(opts *bind.CallOpts) (common.Address, error)
+	ProvenWithdrawal(opts *bind.CallOpts, validatorPubkeyHash [32]byte, slot uint64) (bool, error)
+	ValidatorPubkeyHashToInfo(opts *bind.CallOpts, validatorPubkeyHash [32]byte) (IEigenPodValidatorInfo, error)
+	ValidatorPubkeyToInfo(opts *bind.CallOpts, validatorPubkey []byte) (IEigenPodValidatorInfo, error)
+	ValidatorStatus(opts *bind.CallOpts, validatorPubkey []byte) (uint8, error)
+	ValidatorStatus0(opts *bind.CallOpts, pubkeyHash [32]byte) (uint8, error)
+	WithdrawableRestakedExecutionLayerGwei(opts *bind.CallOpts) (uint64, error)
+}
+
+// IEigenPodWriter is the transaction sending method surface of IEigenPod.
+type IEigenPodWriter interface {
+	ActivateRestaking(opts *bind.TransactOpts) (*types.Transaction, error)
+	Initialize(opts *bind.TransactOpts, owner common.Address) (*types.Transaction, error)
+	RecoverTokens(opts *bind.TransactOpts, tokenList []common.Address, amountsToWithdraw []*big.Int, recipient common.Address) (*types.Transaction, error)
+	Stake(opts *bind.TransactOpts, pubkey []byte, signature []byte, depositDataRoot [32]byte) (*types.Transaction, error)
+	VerifyAndProcessWithdrawals(opts *bind.TransactOpts, oracleTimestamp uint64, stateRootProof BeaconChainProofsStateRootProof, withdrawalProofs []BeaconChainProofsWithdrawalProof, validatorFieldsProofs [][]byte, validatorFields [][][32]byte, withdrawalFields [][][32]byte) (*types.Transaction, error)
+	VerifyBalanceUpdates(opts *bind.TransactOpts, oracleTimestamp uint64, validatorIndices []*big.Int, stateRootProof BeaconChainProofsStateRootProof, validatorFieldsProofs [][]byte, validatorFields [][][32]byte) (*types.Transaction, error)
+	VerifyWithdrawalCredentials(opts *bind.TransactOpts, oracleTimestamp uint64, stateRootProof BeaconChainProofsStateRootProof, validatorIndices []*big.Int, withdrawalCredentialProofs [][]byte, validatorFields [][][32]byte) (*types.Transaction, error)
+	WithdrawBeforeRestaking(opts *bind.TransactOpts) (*types.Transaction, error)
+	WithdrawNonBeaconChainETHBalanceWei(opts *bind.TransactOpts, recipient common.Address, amountToWithdraw *big.Int) (*types.Transaction, error)
+	WithdrawRestakedBeaconChainETH(opts *bind.TransactOpts, recipient common.Address, amount *big.Int) (*types.Transaction, error)
+}
+
+// IEigenPodEvents is the log filtering, watching and parsing surface of IEigenPod.
+type IEigenPodEvents interface {
+	FilterEigenPodStaked(opts *bind.FilterOpts) (*IEigenPodEigenPodStakedIterator, error)
+	FilterFullWithdrawalRedeemed(opts *bind.FilterOpts, recipient []common.Address) (*IEigenPodFullWithdrawalRedeemedIterator, error)
+	FilterNonBeaconChainETHReceived(opts *bind.FilterOpts) (*IEigenPodNonBeaconChainETHReceivedIterator, error)
+	FilterNonBeaconChainETHWithdrawn(opts *bind.FilterOpts, recipient []common.Address) (*IEigenPodNonBeaconChainETHWithdrawnIterator, error)
+	FilterPartialWithdrawalRedeemed(opts *bind.FilterOpts, recipient []common.Address) (*IEigenPodPartialWithdrawalRedeemedIterator, error)
+	FilterRestakedBeaconChainETHWithdrawn(opts *bind.FilterOpts, recipient []common.Address) (*IEigenPodRestakedBeaconChainETHWithdrawnIterator, error)
+	FilterRestakingActivated(opts *bind.FilterOpts, podOwner []common.Address) (*IEigenPodRestakingActivatedIterator, error)
+	FilterValidatorBalanceUpdated(opts *bind.FilterOpts) (*IEigenPodValidatorBalanceUpdatedIterator, error)
+	FilterValidatorRestaked(opts *bind.FilterOpts) (*IEigenPodValidatorRestakedIterator, error)
+	ParseEigenPodStaked(log types.Log) (*IEigenPodEigenPodStaked, error)
+	ParseFullWithdrawalRedeemed(log types.Log) (*IEigenPodFullWithdrawalRedeemed, error)
+	ParseNonBeaconChainETHReceived(log types.Log) (*IEigenPodNonBeaconChainETHReceived, error)
+	ParseNonBeaconChainETHWithdrawn(log types.Log) (*IEigenPodNonBeaconChainETHWithdrawn, error)
+	ParsePartialWithdrawalRedeemed(log types.Log) (*IEigenPodPartialWithdrawalRedeemed, error)
+	ParseRestakedBeaconChainETHWithdrawn(log types.Log) (*IEigenPodRestakedBeaconChainETHWithdrawn, error)
+	ParseRestakingActivated(log types.Log) (*IEigenPodRestakingActivated, error)
+	ParseValidatorBalanceUpdated(log types.Log) (*IEigenPodValidatorBalanceUpdated, error)
+	ParseValidatorRestaked(log types.Log) (*IEigenPodValidatorRestaked, error)
+	WatchEigenPodStaked(opts *bind.WatchOpts, sink chan<- *IEigenPodEigenPodStaked) (event.Subscription, error)
+	WatchFullWithdrawalRedeemed(opts *bind.WatchOpts, sink chan<- *IEigenPodFullWithdrawalRedeemed, recipient []common.Address) (event.Subscription, error)
+	WatchNonBeaconChainETHReceived(opts *bind.WatchOpts, sink chan<- *IEigenPodNonBeaconChainETHReceived) (event.Subscription, error)
+	WatchNonBeaconChainETHWithdrawn(opts *bind.WatchOpts, sink chan<- *IEigenPodNonBeaconChainETHWithdrawn, recipient []common.Address) (event.Subscription, error)
+	WatchPartialWithdrawalRedeemed(opts *bind.WatchOpts, sink chan<- *IEigenPodPartialWithdrawalRedeemed, recipient []common.Address) (event.Subscription, error)
+	WatchRestakedBeaconChainETHWithdrawn(opts *bind.WatchOpts, sink chan<- *IEigenPodRestakedBeaconChainETHWithdrawn, recipient []common.Address) (event.Subscription, error)
+	WatchRestakingActivated(opts *bind.WatchOpts, sink chan<- *IEigenPodRestakingActivated, podOwner []common.Address) (event.Subscription, error)
+	WatchValidatorBalanceUpdated(opts *bind.WatchOpts, sink chan<- *IEigenPodValidatorBalanceUpdated) (event.Subscription, error)
+	WatchValidatorRestaked(opts *bind.WatchOpts, sink chan<- *IEigenPodValidatorRestaked) (event.Subscription, error)
+}
+
+var (
+	_ IEigenPodReader = (*IEigenPodCaller)(nil)
+	_ IEigenPodWriter = (*IEigenPodTransactor)(nil)
+	_ IEigenPodEvents = (*IEigenPodFilterer)(nil)
+	_ IEigenPodReader = (*IEigenPod)(nil)
+	_ IEigenPodWriter = (*IEigenPod)(nil)
+	_ IEigenPodEvents = (*IEigenPod)(nil)
+)
@@ -0,0 +1,88 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package IEigenPodManager
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// IEigenPodManagerReader is the read-only, constant method surface of IEigenPodManager.
+type IEigenPodManagerReader interface {
+	BeaconChainETHStrategy(opts *bind.CallOpts) (common.Address, error)
+	BeaconChainOracle(opts *bind.CallOpts) (common.Address, error)
+	DenebForkTimestamp(opts *bind.CallOpts) (uint64, error)
+	EigenPodBeacon(opts *bind.CallOpts) (common.Address, error)
+	EthPOS(opts *bind.CallOpts) (common.Address, error)
+	GetBlockRootAtTimestamp(opts *bind.CallOpts, timestamp uint64) ([32]byte, error)
+	GetPod(opts *bind.CallOpts, podOwner common.Address) (common.Address, error)
+	HasPod(opts *bind.CallOpts, podOwner common.Address) (bool, error)
+	NumPods(opts *bind.CallOpts) (*big.Int, error)
+	OwnerToPod(opts *bind.CallOpts, podOwner common.Address) (common.Address, error)
+	Paused(opts *bind.CallOpts, index uint8) (bool, error)
+	Paused0(opts *bind.CallOpts) (*big.Int, error)
+	PauserRegistry(opts *bind.CallOpts) (common.Address, error)
+	PodOwnerShares(opts *bind.CallOpts, podOwner common.Address) (*big.Int, error)
+	Slasher(opts *bind.CallOpts) (common.Address, error)
+	StrategyManager(opts *bind.CallOpts) (common.Address, error)
+}
+
+// IEigenPodManagerWriter is the transaction sending method surface of IEigenPodManager.
+type IEigenPodManagerWriter interface {
+	AddShares(opts *bind.TransactOpts, podOwner common.Address, shares *big.Int) (*types.Transaction, error)
+	CreatePod(opts *bind.TransactOpts) (*types.Transaction, error)
+	Pause(opts *bind.TransactOpts, newPausedStatus *big.Int) (*types.Transaction, error)
+	PauseAll(opts *bind.TransactOpts) (*types.Transaction, error)
+	RecordBeaconChainETHBalanceUpdate(opts *bind.TransactOpts, podOwner common.Address, sharesDelta *big.Int) (*types.Transaction, error)
+	RemoveShares(opts *bind.TransactOpts, podOwner common.Address, shares *big.Int) (*types.Transaction, error)
+	SetDenebForkTimestamp(opts *bind.TransactOpts, newDenebForkTimestamp uint64) (*types.Transaction, error)
+	SetPauserRegistry(opts *bind.TransactOpts, newPauserRegistry common.Address) (*types.Transaction, error)
+	Stake(opts *bind.TransactOpts, pubkey []byte, signature []byte, depositDataRoot [32]byte) (*types.Transaction, error)
+	Unpause(opts *bind.TransactOpts, newPausedStatus *big.Int) (*types.Transaction, error)
+	UpdateBeaconChainOracle(opts *bind.TransactOpts, newBeaconChainOracle common.Address) (*types.Transaction, error)
+	WithdrawSharesAsTokens(opts *bind.TransactOpts, podOwner common.Address, destination common.Address, shares *big.Int) (*types.Transaction, error)
+}
+
+// IEigenPodManagerEvents is the log filtering, watching and parsing surface of IEigenPodManager.
+type IEigenPodManagerEvents interface {
+	FilterBeaconChainETHDeposited(opts *bind.FilterOpts, podOwner []common.Address) (*IEigenPodManagerBeaconChainETHDepositedIterator, error)
+	FilterBeaconChainETHWithdrawalCompleted(opts *bind.FilterOpts, podOwner []common.Address) (*IEigenPodManagerBeaconChainETHWithdrawalCompletedIterator, error)
+	FilterBeaconOracleUpdated(opts *bind.FilterOpts, newOracleAddress []common.Address) (*IEigenPodManagerBeaconOracleUpdatedIterator, error)
+	FilterDenebForkTimestampUpdated(opts *bind.FilterOpts) (*IEigenPodManagerDenebForkTimestampUpdatedIterator, error)
+	FilterPaused(opts *bind.FilterOpts, account []common.Address) (*IEigenPodManagerPausedIterator, error)
+	FilterPauserRegistrySet(opts *bind.FilterOpts) (*IEigenPodManagerPauserRegistrySetIterator, error)
+	FilterPodDeployed(opts *bind.FilterOpts, eigenPod []common.Address, podOwner []common.Address) (*IEigenPodManagerPodDeployedIterator, error)
+	FilterPodSharesUpdated(opts *bind.FilterOpts, podOwner []common.Address) (*IEigenPodManagerPodSharesUpdatedIterator, error)
+	FilterUnpaused(opts *bind.FilterOpts, account []common.Address) (*IEigenPodManagerUnpausedIterator, error)
+	ParseBeaconChainETHDeposited(log types.Log) (*IEigenPodManagerBeaconChainETHDeposited, error)
+	ParseBeaconChainETHWithdrawalCompleted(log types.Log) (*IEigenPodManagerBeaconChainETHWithdrawalCompleted, error)
+	ParseBeaconOracleUpdated(log types.Log) (*IEigenPodManagerBeaconOracleUpdated, error)
+	ParseDenebForkTimestampUpdated(log types.Log) (*IEigenPodManagerDenebForkTimestampUpdated, error)
+	ParsePaused(log types.Log) (*IEigenPodManagerPaused, error)
+	ParsePauserRegistrySet(log types.Log) (*IEigenPodManagerPauserRegistrySet, error)
+	ParsePodDeployed(log types.Log) (*IEigenPodManagerPodDeployed, error)
+	ParsePodSharesUpdated(log types.Log) (*IEigenPodManagerPodSharesUpdated, error)
+	ParseUnpaused(log types.Log) (*IEigenPodManagerUnpaused, error)
+	WatchBeaconChainETHDeposited(opts *bind.WatchOpts, sink chan<- *IEigenPodManagerBeaconChainETHDeposited, podOwner []common.Address) (event.Subscription, error)
+	WatchBeaconChainETHWithdrawalCompleted(opts *bind.WatchOpts, sink chan<- *IEigenPodManagerBeaconChainETHWithdrawalCompleted, podOwner []common.Address) (event.Subscription, error)
+	WatchBeaconOracleUpdated(opts *bind.WatchOpts, sink chan<- *IEigenPodManagerBeaconOracleUpdated, newOracleAddress []common.Address) (event.Subscription, error)
+	WatchDenebForkTimestampUpdated(opts *bind.WatchOpts, sink chan<- *IEigenPodManagerDenebForkTimestampUpdated) (event.Subscription, error)
+	WatchPaused(opts *bind.WatchOpts, sink chan<- *IEigenPodManagerPaused, account []common.Address) (event.Subscription, error)
+	WatchPauserRegistrySet(opts *bind.WatchOpts, sink chan<- *IEigenPodManagerPauserRegistrySet) (event.Subscription, error)
+	WatchPodDeployed(opts *bind.WatchOpts, sink chan<- *IEigenPodManagerPodDeployed, eigenPod []common.Address, podOwner []common.Address) (event.Subscription, error)
+	WatchPodSharesUpdated(opts *bind.WatchOpts, sink chan<- *IEigenPodManagerPodSharesUpdated, podOwner []common.Address) (event.Subscription, error)
+	WatchUnpaused(opts *bind.WatchOpts, sink chan<- *IEigenPodManagerUnpaused, account []common.Address) (event.Subscription, error)
+}
+
+var (
+	_ IEigenPodManagerReader = (*IEigenPodManagerCaller)(nil)
+	_ IEigenPodManagerWriter = (*IEigenPodManagerTransactor)(nil)
+	_ IEigenPodManagerEvents = (*IEigenPodManagerFilterer)(nil)
+	_ IEigenPodManagerReader = (*IEigenPodManager)(nil)
+	_ IEigenPodManagerWriter = (*IEigenPodManager)(nil)
+	_ IEigenPodManagerEvents = (*IEigenPodManager)(nil)
+)
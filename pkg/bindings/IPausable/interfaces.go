@@ -0,0 +1,49 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package IPausable
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// IPausableReader is the read-only, constant method surface of IPausable.
+type IPausableReader interface {
+	Paused(opts *bind.CallOpts, index uint8) (bool, error)
+	Paused0(opts *bind.CallOpts) (*big.Int, error)
+	PauserRegistry(opts *bind.CallOpts) (common.Address, error)
+}
+
+// IPausableWriter is the transaction sending method surface of IPausable.
+type IPausableWriter interface {
+	Pause(opts *bind.TransactOpts, newPausedStatus *big.Int) (*types.Transaction, error)
+	PauseAll(opts *bind.TransactOpts) (*types.Transaction, error)
+	SetPauserRegistry(opts *bind.TransactOpts, newPauserRegistry common.Address) (*types.Transaction, error)
+	Unpause(opts *bind.TransactOpts, newPausedStatus *big.Int) (*types.Transaction, error)
+}
+
+// IPausableEvents is the log filtering, watching and parsing surface of IPausable.
+type IPausableEvents interface {
+	FilterPaused(opts *bind.FilterOpts, account []common.Address) (*IPausablePausedIterator, error)
+	FilterPauserRegistrySet(opts *bind.FilterOpts) (*IPausablePauserRegistrySetIterator, error)
+	FilterUnpaused(opts *bind.FilterOpts, account []common.Address) (*IPausableUnpausedIterator, error)
+	ParsePaused(log types.Log) (*IPausablePaused, error)
+	ParsePauserRegistrySet(log types.Log) (*IPausablePauserRegistrySet, error)
+	ParseUnpaused(log types.Log) (*IPausableUnpaused, error)
+	WatchPaused(opts *bind.WatchOpts, sink chan<- *IPausablePaused, account []common.Address) (event.Subscription, error)
+	WatchPauserRegistrySet(opts *bind.WatchOpts, sink chan<- *IPausablePauserRegistrySet) (event.Subscription, error)
+	WatchUnpaused(opts *bind.WatchOpts, sink chan<- *IPausableUnpaused, account []common.Address) (event.Subscription, error)
+}
+
+var (
+	_ IPausableReader = (*IPausableCaller)(nil)
+	_ IPausableWriter = (*IPausableTransactor)(nil)
+	_ IPausableEvents = (*IPausableFilterer)(nil)
+	_ IPausableReader = (*IPausable)(nil)
+	_ IPausableWriter = (*IPausable)(nil)
+	_ IPausableEvents = (*IPausable)(nil)
+)
@@ -0,0 +1,39 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package IPauserRegistry
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// IPauserRegistryReader is the read-only, constant method surface of IPauserRegistry.
+type IPauserRegistryReader interface {
+	IsPauser(opts *bind.CallOpts, pauser common.Address) (bool, error)
+	Unpauser(opts *bind.CallOpts) (common.Address, error)
+}
+
+// IPauserRegistryWriter is the transaction sending method surface of IPauserRegistry.
+type IPauserRegistryWriter interface {
+}
+
+// IPauserRegistryEvents is the log filtering, watching and parsing surface of IPauserRegistry.
+type IPauserRegistryEvents interface {
+	FilterPauserStatusChanged(opts *bind.FilterOpts) (*IPauserRegistryPauserStatusChangedIterator, error)
+	FilterUnpauserChanged(opts *bind.FilterOpts) (*IPauserRegistryUnpauserChangedIterator, error)
+	ParsePauserStatusChanged(log types.Log) (*IPauserRegistryPauserStatusChanged, error)
+	ParseUnpauserChanged(log types.Log) (*IPauserRegistryUnpauserChanged, error)
+	WatchPauserStatusChanged(opts *bind.WatchOpts, sink chan<- *IPauserRegistryPauserStatusChanged) (event.Subscription, error)
+	WatchUnpauserChanged(opts *bind.WatchOpts, sink chan<- *IPauserRegistryUnpauserChanged) (event.Subscription, error)
+}
+
+var (
+	_ IPauserRegistryReader = (*IPauserRegistryCaller)(nil)
+	_ IPauserRegistryWriter = (*IPauserRegistryTransactor)(nil)
+	_ IPauserRegistryEvents = (*IPauserRegistryFilterer)(nil)
+	_ IPauserRegistryReader = (*IPauserRegistry)(nil)
+	_ IPauserRegistryWriter = (*IPauserRegistry)(nil)
+	_ IPauserRegistryEvents = (*IPauserRegistry)(nil)
+)
@@ -0,0 +1,92 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package IRewardsCoordinator
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// IRewardsCoordinatorReader is the read-only, constant method surface of IRewardsCoordinator.
+type IRewardsCoordinatorReader interface {
+	ActivationDelay(opts *bind.CallOpts) (uint32, error)
+	CALCULATIONINTERVALSECONDS(opts *bind.CallOpts) (uint32, error)
+	CalculateEarnerLeafHash(opts *bind.CallOpts, leaf IRewardsCoordinatorEarnerTreeMerkleLeaf) ([32]byte, error)
+	CalculateTokenLeafHash(opts *bind.CallOpts, leaf IRewardsCoordinatorTokenTreeMerkleLeaf) ([32]byte, error)
+	CheckClaim(opts *bind.CallOpts, claim IRewardsCoordinatorRewardsMerkleClaim) (bool, error)
+	ClaimerFor(opts *bind.CallOpts, earner common.Address) (common.Address, error)
+	CumulativeClaimed(opts *bind.CallOpts, claimer common.Address, token common.Address) (*big.Int, error)
+	CurrRewardsCalculationEndTimestamp(opts *bind.CallOpts) (uint32, error)
+	GENESISREWARDSTIMESTAMP(opts *bind.CallOpts) (uint32, error)
+	GetCurrentDistributionRoot(opts *bind.CallOpts) (IRewardsCoordinatorDistributionRoot, error)
+	GetDistributionRootAtIndex(opts *bind.CallOpts, index *big.Int) (IRewardsCoordinatorDistributionRoot, error)
+	GetDistributionRootsLength(opts *bind.CallOpts) (*big.Int, error)
+	GetRootIndexFromHash(opts *bind.CallOpts, rootHash [32]byte) (uint32, error)
+	GlobalOperatorCommissionBips(opts *bind.CallOpts) (uint16, error)
+	MAXFUTURELENGTH(opts *bind.CallOpts) (uint32, error)
+	MAXRETROACTIVELENGTH(opts *bind.CallOpts) (uint32, error)
+	MAXREWARDSDURATION(opts *bind.CallOpts) (uint32, error)
+	OperatorCommissionBips(opts *bind.CallOpts, operator common.Address, avs common.Address) (uint16, error)
+	RewardsUpdater(opts *bind.CallOpts) (common.Address, error)
+}
+
+// IRewardsCoordinatorWriter is the transaction sending method surface of IRewardsCoordinator.
+type IRewardsCoordinatorWriter interface {
+	CreateAVSRewardsSubmission(opts *bind.TransactOpts, rewardsSubmissions []IRewardsCoordinatorRewardsSubmission) (*types.Transaction, error)
+	CreateRewardsForAllSubmission(opts *bind.TransactOpts, rewardsSubmission []IRewardsCoordinatorRewardsSubmission) (*types.Transaction, error)
+	DisableRoot(opts *bind.TransactOpts, rootIndex uint32) (*types.Transaction, error)
+	ProcessClaim(opts *bind.TransactOpts, claim IRewardsCoordinatorRewardsMerkleClaim, recipient common.Address) (*types.Transaction, error)
+	SetActivationDelay(opts *bind.TransactOpts, _activationDelay uint32) (*types.Transaction, error)
+	SetClaimerFor(opts *bind.TransactOpts, claimer common.Address) (*types.Transaction, error)
+	SetGlobalOperatorCommission(opts *bind.TransactOpts, _globalCommissionBips uint16) (*types.Transaction, error)
+	SetRewardsForAllSubmitter(opts *bind.TransactOpts, _submitter common.Address, _newValue bool) (*types.Transaction, error)
+	SetRewardsUpdater(opts *bind.TransactOpts, _rewardsUpdater common.Address) (*types.Transaction, error)
+	SubmitRoot(opts *bind.TransactOpts, root [32]byte, rewardsCalculationEndTimestamp uint32) (*types.Transaction, error)
+}
+
+// IRewardsCoordinatorEvents is the log filtering, watching and parsing surface of IRewardsCoordinator.
+type IRewardsCoordinatorEvents interface {
+	FilterAVSRewardsSubmissionCreated(opts *bind.FilterOpts, avs []common.Address, submissionNonce []*big.Int, rewardsSubmissionHash [][32]byte) (*IRewardsCoordinatorAVSRewardsSubmissionCreatedIterator, error)
+	FilterActivationDelaySet(opts *bind.FilterOpts) (*IRewardsCoordinatorActivationDelaySetIterator, error)
+	FilterClaimerForSet(opts *bind.FilterOpts, earner []common.Address, oldClaimer []common.Address, claimer []common.Address) (*IRewardsCoordinatorClaimerForSetIterator, error)
+	FilterDistributionRootDisabled(opts *bind.FilterOpts, rootIndex []uint32) (*IRewardsCoordinatorDistributionRootDisabledIterator, error)
+	FilterDistributionRootSubmitted(opts *bind.FilterOpts, rootIndex []uint32, root [][32]byte, rewardsCalculationEndTimestamp []uint32) (*IRewardsCoordinatorDistributionRootSubmittedIterator, error)
+	FilterGlobalCommissionBipsSet(opts *bind.FilterOpts) (*IRewardsCoordinatorGlobalCommissionBipsSetIterator, error)
+	FilterRewardsClaimed(opts *bind.FilterOpts, earner []common.Address, claimer []common.Address, recipient []common.Address) (*IRewardsCoordinatorRewardsClaimedIterator, error)
+	FilterRewardsForAllSubmitterSet(opts *bind.FilterOpts, rewardsForAllSubmitter []common.Address, oldValue []bool, newValue []bool) (*IRewardsCoordinatorRewardsForAllSubmitterSetIterator, error)
+	FilterRewardsSubmissionForAllCreated(opts *bind.FilterOpts, submitter []common.Address, submissionNonce []*big.Int, rewardsSubmissionHash [][32]byte) (*IRewardsCoordinatorRewardsSubmissionForAllCreatedIterator, error)
+	FilterRewardsUpdaterSet(opts *bind.FilterOpts, oldRewardsUpdater []common.Address, newRewardsUpdater []common.Address) (*IRewardsCoordinatorRewardsUpdaterSetIterator, error)
+	ParseAVSRewardsSubmissionCreated(log types.Log) (*IRewardsCoordinatorAVSRewardsSubmissionCreated, error)
+	ParseActivationDelaySet(log types.Log) (*IRewardsCoordinatorActivationDelaySet, error)
+	ParseClaimerForSet(log types.Log) (*IRewardsCoordinatorClaimerForSet, error)
+	ParseDistributionRootDisabled(log types.Log) (*IRewardsCoordinatorDistributionRootDisabled, error)
+	ParseDistributionRootSubmitted(log types.Log) (*IRewardsCoordinatorDistributionRootSubmitted, error)
+	ParseGlobalCommissionBipsSet(log types.Log) (*IRewardsCoordinatorGlobalCommissionBipsSet, error)
+	ParseRewardsClaimed(log types.Log) (*IRewardsCoordinatorRewardsClaimed, error)
+	ParseRewardsForAllSubmitterSet(log types.Log) (*IRewardsCoordinatorRewardsForAllSubmitterSet, error)
+	ParseRewardsSubmissionForAllCreated(log types.Log) (*IRewardsCoordinatorRewardsSubmissionForAllCreated, error)
+	ParseRewardsUpdaterSet(log types.Log) (*IRewardsCoordinatorRewardsUpdaterSet, error)
+	WatchAVSRewardsSubmissionCreated(opts *bind.WatchOpts, sink chan<- *IRewardsCoordinatorAVSRewardsSubmissionCreated, avs []common.Address, submissionNonce []*big.Int, rewardsSubmissionHash [][32]byte) (event.Subscription, error)
+	WatchActivationDelaySet(opts *bind.WatchOpts, sink chan<- *IRewardsCoordinatorActivationDelaySet) (event.Subscription, error)
+	WatchClaimerForSet(opts *bind.WatchOpts, sink chan<- *IRewardsCoordinatorClaimerForSet, earner []common.Address, oldClaimer []common.Address, claimer []common.Address) (event.Subscription, error)
+	WatchDistributionRootDisabled(opts *bind.WatchOpts, sink chan<- *IRewardsCoordinatorDistributionRootDisabled, rootIndex []uint32) (event.Subscription, error)
+	WatchDistributionRootSubmitted(opts *bind.WatchOpts, sink chan<- *IRewardsCoordinatorDistributionRootSubmitted, rootIndex []uint32, root [][32]byte, rewardsCalculationEndTimestamp []uint32) (event.Subscription, error)
+	WatchGlobalCommissionBipsSet(opts *bind.WatchOpts, sink chan<- *IRewardsCoordinatorGlobalCommissionBipsSet) (event.Subscription, error)
+	WatchRewardsClaimed(opts *bind.WatchOpts, sink chan<- *IRewardsCoordinatorRewardsClaimed, earner []common.Address, claimer []common.Address, recipient []common.Address) (event.Subscription, error)
+	WatchRewardsForAllSubmitterSet(opts *bind.WatchOpts, sink chan<- *IRewardsCoordinatorRewardsForAllSubmitterSet, rewardsForAllSubmitter []common.Address, oldValue []bool, newValue []bool) (event.Subscription, error)
+	WatchRewardsSubmissionForAllCreated(opts *bind.WatchOpts, sink chan<- *IRewardsCoordinatorRewardsSubmissionForAllCreated, submitter []common.Address, submissionNonce []*big.Int, rewardsSubmissionHash [][32]byte) (event.Subscription, error)
+	WatchRewardsUpdaterSet(opts *bind.WatchOpts, sink chan<- *IRewardsCoordinatorRewardsUpdaterSet, oldRewardsUpdater []common.Address, newRewardsUpdater []common.Address) (event.Subscription, error)
+}
+
+var (
+	_ IRewardsCoordinatorReader = (*IRewardsCoordinatorCaller)(nil)
+	_ IRewardsCoordinatorWriter = (*IRewardsCoordinatorTransactor)(nil)
+	_ IRewardsCoordinatorEvents = (*IRewardsCoordinatorFilterer)(nil)
+	_ IRewardsCoordinatorReader = (*IRewardsCoordinator)(nil)
+	_ IRewardsCoordinatorWriter = (*IRewardsCoordinator)(nil)
+	_ IRewardsCoordinatorEvents = (*IRewardsCoordinator)(nil)
+)
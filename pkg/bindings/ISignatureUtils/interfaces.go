@@ -0,0 +1,24 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package ISignatureUtils
+
+// ISignatureUtilsReader is the read-only, constant method surface of ISignatureUtils.
+type ISignatureUtilsReader interface {
+}
+
+// ISignatureUtilsWriter is the transaction sending method surface of ISignatureUtils.
+type ISignatureUtilsWriter interface {
+}
+
+// ISignatureUtilsEvents is the log filtering, watching and parsing surface of ISignatureUtils.
+type ISignatureUtilsEvents interface {
+}
+
+var (
+	_ ISignatureUtilsReader = (*ISignatureUtilsCaller)(nil)
+	_ ISignatureUtilsWriter = (*ISignatureUtilsTransactor)(nil)
+	_ ISignatureUtilsEvents = (*ISignatureUtilsFilterer)(nil)
+	_ ISignatureUtilsReader = (*ISignatureUtils)(nil)
+	_ ISignatureUtilsWriter = (*ISignatureUtils)(nil)
+	_ ISignatureUtilsEvents = (*ISignatureUtils)(nil)
+)
@@ -0,0 +1,68 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package ISlasher
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// ISlasherReader is the read-only, constant method surface of ISlasher.
+type ISlasherReader interface {
+	CanSlash(opts *bind.CallOpts, toBeSlashed common.Address, slashingContract common.Address) (bool, error)
+	ContractCanSlashOperatorUntilBlock(opts *bind.CallOpts, operator common.Address, serviceContract common.Address) (uint32, error)
+	Delegation(opts *bind.CallOpts) (common.Address, error)
+	GetCorrectValueForInsertAfter(opts *bind.CallOpts, operator common.Address, updateBlock uint32) (*big.Int, error)
+	GetMiddlewareTimesIndexServeUntilBlock(opts *bind.CallOpts, operator common.Address, index uint32) (uint32, error)
+	GetMiddlewareTimesIndexStalestUpdateBlock(opts *bind.CallOpts, operator common.Address, index uint32) (uint32, error)
+	IsFrozen(opts *bind.CallOpts, staker common.Address) (bool, error)
+	LatestUpdateBlock(opts *bind.CallOpts, operator common.Address, serviceContract common.Address) (uint32, error)
+	MiddlewareTimesLength(opts *bind.CallOpts, operator common.Address) (*big.Int, error)
+	OperatorToMiddlewareTimes(opts *bind.CallOpts, operator common.Address, arrayIndex *big.Int) (ISlasherMiddlewareTimes, error)
+	OperatorWhitelistedContractsLinkedListEntry(opts *bind.CallOpts, operator common.Address, node common.Address) (bool, *big.Int, *big.Int, error)
+	OperatorWhitelistedContractsLinkedListSize(opts *bind.CallOpts, operator common.Address) (*big.Int, error)
+	StrategyManager(opts *bind.CallOpts) (common.Address, error)
+}
+
+// ISlasherWriter is the transaction sending method surface of ISlasher.
+type ISlasherWriter interface {
+	CanWithdraw(opts *bind.TransactOpts, operator common.Address, withdrawalStartBlock uint32, middlewareTimesIndex *big.Int) (*types.Transaction, error)
+	FreezeOperator(opts *bind.TransactOpts, toBeFrozen common.Address) (*types.Transaction, error)
+	OptIntoSlashing(opts *bind.TransactOpts, contractAddress common.Address) (*types.Transaction, error)
+	RecordFirstStakeUpdate(opts *bind.TransactOpts, operator common.Address, serveUntilBlock uint32) (*types.Transaction, error)
+	RecordLastStakeUpdateAndRevokeSlashingAbility(opts *bind.TransactOpts, operator common.Address, serveUntilBlock uint32) (*types.Transaction, error)
+	RecordStakeUpdate(opts *bind.TransactOpts, operator common.Address, updateBlock uint32, serveUntilBlock uint32, insertAfter *big.Int) (*types.Transaction, error)
+	ResetFrozenStatus(opts *bind.TransactOpts, frozenAddresses []common.Address) (*types.Transaction, error)
+}
+
+// ISlasherEvents is the log filtering, watching and parsing surface of ISlasher.
+type ISlasherEvents interface {
+	FilterFrozenStatusReset(opts *bind.FilterOpts, previouslySlashedAddress []common.Address) (*ISlasherFrozenStatusResetIterator, error)
+	FilterMiddlewareTimesAdded(opts *bind.FilterOpts) (*ISlasherMiddlewareTimesAddedIterator, error)
+	FilterOperatorFrozen(opts *bind.FilterOpts, slashedOperator []common.Address, slashingContract []common.Address) (*ISlasherOperatorFrozenIterator, error)
+	FilterOptedIntoSlashing(opts *bind.FilterOpts, operator []common.Address, contractAddress []common.Address) (*ISlasherOptedIntoSlashingIterator, error)
+	FilterSlashingAbilityRevoked(opts *bind.FilterOpts, operator []common.Address, contractAddress []common.Address) (*ISlasherSlashingAbilityRevokedIterator, error)
+	ParseFrozenStatusReset(log types.Log) (*ISlasherFrozenStatusReset, error)
+	ParseMiddlewareTimesAdded(log types.Log) (*ISlasherMiddlewareTimesAdded, error)
+	ParseOperatorFrozen(log types.Log) (*ISlasherOperatorFrozen, error)
+	ParseOptedIntoSlashing(log types.Log) (*ISlasherOptedIntoSlashing, error)
+	ParseSlashingAbilityRevoked(log types.Log) (*ISlasherSlashingAbilityRevoked, error)
+	WatchFrozenStatusReset(opts *bind.WatchOpts, sink chan<- *ISlasherFrozenStatusReset, previouslySlashedAddress []common.Address) (event.Subscription, error)
+	WatchMiddlewareTimesAdded(opts *bind.WatchOpts, sink chan<- *ISlasherMiddlewareTimesAdded) (event.Subscription, error)
+	WatchOperatorFrozen(opts *bind.WatchOpts, sink chan<- *ISlasherOperatorFrozen, slashedOperator []common.Address, slashingContract []common.Address) (event.Subscription, error)
+	WatchOptedIntoSlashing(opts *bind.WatchOpts, sink chan<- *ISlasherOptedIntoSlashing, operator []common.Address, contractAddress []common.Address) (event.Subscription, error)
+	WatchSlashingAbilityRevoked(opts *bind.WatchOpts, sink chan<- *ISlasherSlashingAbilityRevoked, operator []common.Address, contractAddress []common.Address) (event.Subscription, error)
+}
+
+var (
+	_ ISlasherReader = (*ISlasherCaller)(nil)
+	_ ISlasherWriter = (*ISlasherTransactor)(nil)
+	_ ISlasherEvents = (*ISlasherFilterer)(nil)
+	_ ISlasherReader = (*ISlasher)(nil)
+	_ ISlasherWriter = (*ISlasher)(nil)
+	_ ISlasherEvents = (*ISlasher)(nil)
+)
@@ -0,0 +1,17 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package ISlasher
+
+import (
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/types"
+)
+
+// Canonical converts s to the shared types.MiddlewareTimes.
+func (s ISlasherMiddlewareTimes) Canonical() types.MiddlewareTimes {
+	return types.MiddlewareTimes(s)
+}
+
+// ToISlasherMiddlewareTimes converts the shared types.MiddlewareTimes to this binding's copy.
+func ToISlasherMiddlewareTimes(v types.MiddlewareTimes) ISlasherMiddlewareTimes {
+	return ISlasherMiddlewareTimes(v)
+}
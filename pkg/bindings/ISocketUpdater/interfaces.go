@@ -0,0 +1,34 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package ISocketUpdater
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// ISocketUpdaterReader is the read-only, constant method surface of ISocketUpdater.
+type ISocketUpdaterReader interface {
+}
+
+// ISocketUpdaterWriter is the transaction sending method surface of ISocketUpdater.
+type ISocketUpdaterWriter interface {
+	UpdateSocket(opts *bind.TransactOpts, socket string) (*types.Transaction, error)
+}
+
+// ISocketUpdaterEvents is the log filtering, watching and parsing surface of ISocketUpdater.
+type ISocketUpdaterEvents interface {
+	FilterOperatorSocketUpdate(opts *bind.FilterOpts, operatorId [][32]byte) (*ISocketUpdaterOperatorSocketUpdateIterator, error)
+	ParseOperatorSocketUpdate(log types.Log) (*ISocketUpdaterOperatorSocketUpdate, error)
+	WatchOperatorSocketUpdate(opts *bind.WatchOpts, sink chan<- *ISocketUpdaterOperatorSocketUpdate, operatorId [][32]byte) (event.Subscription, error)
+}
+
+var (
+	_ ISocketUpdaterReader = (*ISocketUpdaterCaller)(nil)
+	_ ISocketUpdaterWriter = (*ISocketUpdaterTransactor)(nil)
+	_ ISocketUpdaterEvents = (*ISocketUpdaterFilterer)(nil)
+	_ ISocketUpdaterReader = (*ISocketUpdater)(nil)
+	_ ISocketUpdaterWriter = (*ISocketUpdater)(nil)
+	_ ISocketUpdaterEvents = (*ISocketUpdater)(nil)
+)
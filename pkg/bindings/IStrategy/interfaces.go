@@ -0,0 +1,44 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package IStrategy
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// IStrategyReader is the read-only, constant method surface of IStrategy.
+type IStrategyReader interface {
+	Explanation(opts *bind.CallOpts) (string, error)
+	Shares(opts *bind.CallOpts, user common.Address) (*big.Int, error)
+	SharesToUnderlyingView(opts *bind.CallOpts, amountShares *big.Int) (*big.Int, error)
+	TotalShares(opts *bind.CallOpts) (*big.Int, error)
+	UnderlyingToSharesView(opts *bind.CallOpts, amountUnderlying *big.Int) (*big.Int, error)
+	UnderlyingToken(opts *bind.CallOpts) (common.Address, error)
+	UserUnderlyingView(opts *bind.CallOpts, user common.Address) (*big.Int, error)
+}
+
+// IStrategyWriter is the transaction sending method surface of IStrategy.
+type IStrategyWriter interface {
+	Deposit(opts *bind.TransactOpts, token common.Address, amount *big.Int) (*types.Transaction, error)
+	SharesToUnderlying(opts *bind.TransactOpts, amountShares *big.Int) (*types.Transaction, error)
+	UnderlyingToShares(opts *bind.TransactOpts, amountUnderlying *big.Int) (*types.Transaction, error)
+	UserUnderlying(opts *bind.TransactOpts, user common.Address) (*types.Transaction, error)
+	Withdraw(opts *bind.TransactOpts, recipient common.Address, token common.Address, amountShares *big.Int) (*types.Transaction, error)
+}
+
+// IStrategyEvents is the log filtering, watching and parsing surface of IStrategy.
+type IStrategyEvents interface {
+}
+
+var (
+	_ IStrategyReader = (*IStrategyCaller)(nil)
+	_ IStrategyWriter = (*IStrategyTransactor)(nil)
+	_ IStrategyEvents = (*IStrategyFilterer)(nil)
+	_ IStrategyReader = (*IStrategy)(nil)
+	_ IStrategyWriter = (*IStrategy)(nil)
+	_ IStrategyEvents = (*IStrategy)(nil)
+)
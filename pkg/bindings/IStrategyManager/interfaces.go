@@ -0,0 +1,64 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package IStrategyManager
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// IStrategyManagerReader is the read-only, constant method surface of IStrategyManager.
+type IStrategyManagerReader interface {
+	Delegation(opts *bind.CallOpts) (common.Address, error)
+	EigenPodManager(opts *bind.CallOpts) (common.Address, error)
+	GetDeposits(opts *bind.CallOpts, staker common.Address) ([]common.Address, []*big.Int, error)
+	Slasher(opts *bind.CallOpts) (common.Address, error)
+	StakerStrategyListLength(opts *bind.CallOpts, staker common.Address) (*big.Int, error)
+	StakerStrategyShares(opts *bind.CallOpts, user common.Address, strategy common.Address) (*big.Int, error)
+	StrategyIsWhitelistedForDeposit(opts *bind.CallOpts, strategy common.Address) (bool, error)
+	StrategyWhitelister(opts *bind.CallOpts) (common.Address, error)
+	ThirdPartyTransfersForbidden(opts *bind.CallOpts, strategy common.Address) (bool, error)
+}
+
+// IStrategyManagerWriter is the transaction sending method surface of IStrategyManager.
+type IStrategyManagerWriter interface {
+	AddShares(opts *bind.TransactOpts, staker common.Address, token common.Address, strategy common.Address, shares *big.Int) (*types.Transaction, error)
+	AddStrategiesToDepositWhitelist(opts *bind.TransactOpts, strategiesToWhitelist []common.Address, thirdPartyTransfersForbiddenValues []bool) (*types.Transaction, error)
+	DepositIntoStrategy(opts *bind.TransactOpts, strategy common.Address, token common.Address, amount *big.Int) (*types.Transaction, error)
+	DepositIntoStrategyWithSignature(opts *bind.TransactOpts, strategy common.Address, token common.Address, amount *big.Int, staker common.Address, expiry *big.Int, signature []byte) (*types.Transaction, error)
+	RemoveShares(opts *bind.TransactOpts, staker common.Address, strategy common.Address, shares *big.Int) (*types.Transaction, error)
+	RemoveStrategiesFromDepositWhitelist(opts *bind.TransactOpts, strategiesToRemoveFromWhitelist []common.Address) (*types.Transaction, error)
+	WithdrawSharesAsTokens(opts *bind.TransactOpts, recipient common.Address, strategy common.Address, shares *big.Int, token common.Address) (*types.Transaction, error)
+}
+
+// IStrategyManagerEvents is the log filtering, watching and parsing surface of IStrategyManager.
+type IStrategyManagerEvents interface {
+	FilterDeposit(opts *bind.FilterOpts) (*IStrategyManagerDepositIterator, error)
+	FilterStrategyAddedToDepositWhitelist(opts *bind.FilterOpts) (*IStrategyManagerStrategyAddedToDepositWhitelistIterator, error)
+	FilterStrategyRemovedFromDepositWhitelist(opts *bind.FilterOpts) (*IStrategyManagerStrategyRemovedFromDepositWhitelistIterator, error)
+	FilterStrategyWhitelisterChanged(opts *bind.FilterOpts) (*IStrategyManagerStrategyWhitelisterChangedIterator, error)
+	FilterUpdatedThirdPartyTransfersForbidden(opts *bind.FilterOpts) (*IStrategyManagerUpdatedThirdPartyTransfersForbiddenIterator, error)
+	ParseDeposit(log types.Log) (*IStrategyManagerDeposit, error)
+	ParseStrategyAddedToDepositWhitelist(log types.Log) (*IStrategyManagerStrategyAddedToDepositWhitelist, error)
+	ParseStrategyRemovedFromDepositWhitelist(log types.Log) (*IStrategyManagerStrategyRemovedFromDepositWhitelist, error)
+	ParseStrategyWhitelisterChanged(log types.Log) (*IStrategyManagerStrategyWhitelisterChanged, error)
+	ParseUpdatedThirdPartyTransfersForbidden(log types.Log) (*IStrategyManagerUpdatedThirdPartyTransfersForbidden, error)
+	WatchDeposit(opts *bind.WatchOpts, sink chan<- *IStrategyManagerDeposit) (event.Subscription, error)
+	WatchStrategyAddedToDepositWhitelist(opts *bind.WatchOpts, sink chan<- *IStrategyManagerStrategyAddedToDepositWhitelist) (event.Subscription, error)
+	WatchStrategyRemovedFromDepositWhitelist(opts *bind.WatchOpts, sink chan<- *IStrategyManagerStrategyRemovedFromDepositWhitelist) (event.Subscription, error)
+	WatchStrategyWhitelisterChanged(opts *bind.WatchOpts, sink chan<- *IStrategyManagerStrategyWhitelisterChanged) (event.Subscription, error)
+	WatchUpdatedThirdPartyTransfersForbidden(opts *bind.WatchOpts, sink chan<- *IStrategyManagerUpdatedThirdPartyTransfersForbidden) (event.Subscription, error)
+}
+
+var (
+	_ IStrategyManagerReader = (*IStrategyManagerCaller)(nil)
+	_ IStrategyManagerWriter = (*IStrategyManagerTransactor)(nil)
+	_ IStrategyManagerEvents = (*IStrategyManagerFilterer)(nil)
+	_ IStrategyManagerReader = (*IStrategyManager)(nil)
+	_ IStrategyManagerWriter = (*IStrategyManager)(nil)
+	_ IStrategyManagerEvents = (*IStrategyManager)(nil)
+)
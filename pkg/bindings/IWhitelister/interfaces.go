@@ -0,0 +1,39 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package IWhitelister
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// IWhitelisterReader is the read-only, constant method surface of IWhitelister.
+type IWhitelisterReader interface {
+}
+
+// IWhitelisterWriter is the transaction sending method surface of IWhitelister.
+type IWhitelisterWriter interface {
+	CallAddress(opts *bind.TransactOpts, to common.Address, data []byte) (*types.Transaction, error)
+	CompleteQueuedWithdrawal(opts *bind.TransactOpts, staker common.Address, queuedWithdrawal IDelegationManagerWithdrawal, tokens []common.Address, middlewareTimesIndex *big.Int, receiveAsTokens bool) (*types.Transaction, error)
+	DepositIntoStrategy(opts *bind.TransactOpts, staker common.Address, strategy common.Address, token common.Address, amount *big.Int) (*types.Transaction, error)
+	GetStaker(opts *bind.TransactOpts, operator common.Address) (*types.Transaction, error)
+	QueueWithdrawal(opts *bind.TransactOpts, staker common.Address, queuedWithdrawalParams []IDelegationManagerQueuedWithdrawalParams) (*types.Transaction, error)
+	Transfer(opts *bind.TransactOpts, staker common.Address, token common.Address, to common.Address, amount *big.Int) (*types.Transaction, error)
+	Whitelist(opts *bind.TransactOpts, operator common.Address) (*types.Transaction, error)
+}
+
+// IWhitelisterEvents is the log filtering, watching and parsing surface of IWhitelister.
+type IWhitelisterEvents interface {
+}
+
+var (
+	_ IWhitelisterReader = (*IWhitelisterCaller)(nil)
+	_ IWhitelisterWriter = (*IWhitelisterTransactor)(nil)
+	_ IWhitelisterEvents = (*IWhitelisterFilterer)(nil)
+	_ IWhitelisterReader = (*IWhitelister)(nil)
+	_ IWhitelisterWriter = (*IWhitelister)(nil)
+	_ IWhitelisterEvents = (*IWhitelister)(nil)
+)
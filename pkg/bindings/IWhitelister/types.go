@@ -0,0 +1,27 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package IWhitelister
+
+import (
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/types"
+)
+
+// Canonical converts s to the shared types.QueuedWithdrawalParams.
+func (s IDelegationManagerQueuedWithdrawalParams) Canonical() types.QueuedWithdrawalParams {
+	return types.QueuedWithdrawalParams(s)
+}
+
+// ToIDelegationManagerQueuedWithdrawalParams converts the shared types.QueuedWithdrawalParams to this binding's copy.
+func ToIDelegationManagerQueuedWithdrawalParams(v types.QueuedWithdrawalParams) IDelegationManagerQueuedWithdrawalParams {
+	return IDelegationManagerQueuedWithdrawalParams(v)
+}
+
+// Canonical converts s to the shared types.Withdrawal.
+func (s IDelegationManagerWithdrawal) Canonical() types.Withdrawal {
+	return types.Withdrawal(s)
+}
+
+// ToIDelegationManagerWithdrawal converts the shared types.Withdrawal to this binding's copy.
+func ToIDelegationManagerWithdrawal(v types.Withdrawal) IDelegationManagerWithdrawal {
+	return IDelegationManagerWithdrawal(v)
+}
@@ -0,0 +1,24 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package Merkle
+
+// MerkleReader is the read-only, constant method surface of Merkle.
+type MerkleReader interface {
+}
+
+// MerkleWriter is the transaction sending method surface of Merkle.
+type MerkleWriter interface {
+}
+
+// MerkleEvents is the log filtering, watching and parsing surface of Merkle.
+type MerkleEvents interface {
+}
+
+var (
+	_ MerkleReader = (*MerkleCaller)(nil)
+	_ MerkleWriter = (*MerkleTransactor)(nil)
+	_ MerkleEvents = (*MerkleFilterer)(nil)
+	_ MerkleReader = (*Merkle)(nil)
+	_ MerkleWriter = (*Merkle)(nil)
+	_ MerkleEvents = (*Merkle)(nil)
+)
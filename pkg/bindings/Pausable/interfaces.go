@@ -0,0 +1,49 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package Pausable
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// PausableReader is the read-only, constant method surface of Pausable.
+type PausableReader interface {
+	Paused(opts *bind.CallOpts, index uint8) (bool, error)
+	Paused0(opts *bind.CallOpts) (*big.Int, error)
+	PauserRegistry(opts *bind.CallOpts) (common.Address, error)
+}
+
+// PausableWriter is the transaction sending method surface of Pausable.
+type PausableWriter interface {
+	Pause(opts *bind.TransactOpts, newPausedStatus *big.Int) (*types.Transaction, error)
+	PauseAll(opts *bind.TransactOpts) (*types.Transaction, error)
+	SetPauserRegistry(opts *bind.TransactOpts, newPauserRegistry common.Address) (*types.Transaction, error)
+	Unpause(opts *bind.TransactOpts, newPausedStatus *big.Int) (*types.Transaction, error)
+}
+
+// PausableEvents is the log filtering, watching and parsing surface of Pausable.
+type PausableEvents interface {
+	FilterPaused(opts *bind.FilterOpts, account []common.Address) (*PausablePausedIterator, error)
+	FilterPauserRegistrySet(opts *bind.FilterOpts) (*PausablePauserRegistrySetIterator, error)
+	FilterUnpaused(opts *bind.FilterOpts, account []common.Address) (*PausableUnpausedIterator, error)
+	ParsePaused(log types.Log) (*PausablePaused, error)
+	ParsePauserRegistrySet(log types.Log) (*PausablePauserRegistrySet, error)
+	ParseUnpaused(log types.Log) (*PausableUnpaused, error)
+	WatchPaused(opts *bind.WatchOpts, sink chan<- *PausablePaused, account []common.Address) (event.Subscription, error)
+	WatchPauserRegistrySet(opts *bind.WatchOpts, sink chan<- *PausablePauserRegistrySet) (event.Subscription, error)
+	WatchUnpaused(opts *bind.WatchOpts, sink chan<- *PausableUnpaused, account []common.Address) (event.Subscription, error)
+}
+
+var (
+	_ PausableReader = (*PausableCaller)(nil)
+	_ PausableWriter = (*PausableTransactor)(nil)
+	_ PausableEvents = (*PausableFilterer)(nil)
+	_ PausableReader = (*Pausable)(nil)
+	_ PausableWriter = (*Pausable)(nil)
+	_ PausableEvents = (*Pausable)(nil)
+)
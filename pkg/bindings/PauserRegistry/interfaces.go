@@ -0,0 +1,41 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package PauserRegistry
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// PauserRegistryReader is the read-only, constant method surface of PauserRegistry.
+type PauserRegistryReader interface {
+	IsPauser(opts *bind.CallOpts, arg0 common.Address) (bool, error)
+	Unpauser(opts *bind.CallOpts) (common.Address, error)
+}
+
+// PauserRegistryWriter is the transaction sending method surface of PauserRegistry.
+type PauserRegistryWriter interface {
+	SetIsPauser(opts *bind.TransactOpts, newPauser common.Address, canPause bool) (*types.Transaction, error)
+	SetUnpauser(opts *bind.TransactOpts, newUnpauser common.Address) (*types.Transaction, error)
+}
+
+// PauserRegistryEvents is the log filtering, watching and parsing surface of PauserRegistry.
+type PauserRegistryEvents interface {
+	FilterPauserStatusChanged(opts *bind.FilterOpts) (*PauserRegistryPauserStatusChangedIterator, error)
+	FilterUnpauserChanged(opts *bind.FilterOpts) (*PauserRegistryUnpauserChangedIterator, error)
+	ParsePauserStatusChanged(log types.Log) (*PauserRegistryPauserStatusChanged, error)
+	ParseUnpauserChanged(log types.Log) (*PauserRegistryUnpauserChanged, error)
+	WatchPauserStatusChanged(opts *bind.WatchOpts, sink chan<- *PauserRegistryPauserStatusChanged) (event.Subscription, error)
+	WatchUnpauserChanged(opts *bind.WatchOpts, sink chan<- *PauserRegistryUnpauserChanged) (event.Subscription, error)
+}
+
+var (
+	_ PauserRegistryReader = (*PauserRegistryCaller)(nil)
+	_ PauserRegistryWriter = (*PauserRegistryTransactor)(nil)
+	_ PauserRegistryEvents = (*PauserRegistryFilterer)(nil)
+	_ PauserRegistryReader = (*PauserRegistry)(nil)
+	_ PauserRegistryWriter = (*PauserRegistry)(nil)
+	_ PauserRegistryEvents = (*PauserRegistry)(nil)
+)
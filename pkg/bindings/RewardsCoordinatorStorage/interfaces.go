@@ -0,0 +1,98 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package RewardsCoordinatorStorage
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// RewardsCoordinatorStorageReader is the read-only, constant method surface of RewardsCoordinatorStorage.
+type RewardsCoordinatorStorageReader interface {
+	ActivationDelay(opts *bind.CallOpts) (uint32, error)
+	CALCULATIONINTERVALSECONDS(opts *bind.CallOpts) (uint32, error)
+	CalculateEarnerLeafHash(opts *bind.CallOpts, leaf IRewardsCoordinatorEarnerTreeMerkleLeaf) ([32]byte, error)
+	CalculateTokenLeafHash(opts *bind.CallOpts, leaf IRewardsCoordinatorTokenTreeMerkleLeaf) ([32]byte, error)
+	CheckClaim(opts *bind.CallOpts, claim IRewardsCoordinatorRewardsMerkleClaim) (bool, error)
+	ClaimerFor(opts *bind.CallOpts, arg0 common.Address) (common.Address, error)
+	CumulativeClaimed(opts *bind.CallOpts, arg0 common.Address, arg1 common.Address) (*big.Int, error)
+	CurrRewardsCalculationEndTimestamp(opts *bind.CallOpts) (uint32, error)
+	DelegationManager(opts *bind.CallOpts) (common.Address, error)
+	GENESISREWARDSTIMESTAMP(opts *bind.CallOpts) (uint32, error)
+	GetCurrentDistributionRoot(opts *bind.CallOpts) (IRewardsCoordinatorDistributionRoot, error)
+	GetDistributionRootAtIndex(opts *bind.CallOpts, index *big.Int) (IRewardsCoordinatorDistributionRoot, error)
+	GetDistributionRootsLength(opts *bind.CallOpts) (*big.Int, error)
+	GetRootIndexFromHash(opts *bind.CallOpts, rootHash [32]byte) (uint32, error)
+	GlobalOperatorCommissionBips(opts *bind.CallOpts) (uint16, error)
+	IsAVSRewardsSubmissionHash(opts *bind.CallOpts, arg0 common.Address, arg1 [32]byte) (bool, error)
+	IsRewardsForAllSubmitter(opts *bind.CallOpts, arg0 common.Address) (bool, error)
+	IsRewardsSubmissionForAllHash(opts *bind.CallOpts, arg0 common.Address, arg1 [32]byte) (bool, error)
+	MAXFUTURELENGTH(opts *bind.CallOpts) (uint32, error)
+	MAXRETROACTIVELENGTH(opts *bind.CallOpts) (uint32, error)
+	MAXREWARDSDURATION(opts *bind.CallOpts) (uint32, error)
+	OperatorCommissionBips(opts *bind.CallOpts, operator common.Address, avs common.Address) (uint16, error)
+	RewardsUpdater(opts *bind.CallOpts) (common.Address, error)
+	StrategyManager(opts *bind.CallOpts) (common.Address, error)
+	SubmissionNonce(opts *bind.CallOpts, arg0 common.Address) (*big.Int, error)
+}
+
+// RewardsCoordinatorStorageWriter is the transaction sending method surface of RewardsCoordinatorStorage.
+type RewardsCoordinatorStorageWriter interface {
+	CreateAVSRewardsSubmission(opts *bind.TransactOpts, rewardsSubmissions []IRewardsCoordinatorRewardsSubmission) (*types.Transaction, error)
+	CreateRewardsForAllSubmission(opts *bind.TransactOpts, rewardsSubmission []IRewardsCoordinatorRewardsSubmission) (*types.Transaction, error)
+	DisableRoot(opts *bind.TransactOpts, rootIndex uint32) (*types.Transaction, error)
+	ProcessClaim(opts *bind.TransactOpts, claim IRewardsCoordinatorRewardsMerkleClaim, recipient common.Address) (*types.Transaction, error)
+	SetActivationDelay(opts *bind.TransactOpts, _activationDelay uint32) (*types.Transaction, error)
+	SetClaimerFor(opts *bind.TransactOpts, claimer common.Address) (*types.Transaction, error)
+	SetGlobalOperatorCommission(opts *bind.TransactOpts, _globalCommissionBips uint16) (*types.Transaction, error)
+	SetRewardsForAllSubmitter(opts *bind.TransactOpts, _submitter common.Address, _newValue bool) (*types.Transaction, error)
+	SetRewardsUpdater(opts *bind.TransactOpts, _rewardsUpdater common.Address) (*types.Transaction, error)
+	SubmitRoot(opts *bind.TransactOpts, root [32]byte, rewardsCalculationEndTimestamp uint32) (*types.Transaction, error)
+}
+
+// RewardsCoordinatorStorageEvents is the log filtering, watching and parsing surface of RewardsCoordinatorStorage.
+type RewardsCoordinatorStorageEvents interface {
+	FilterAVSRewardsSubmissionCreated(opts *bind.FilterOpts, avs []common.Address, submissionNonce []*big.Int, rewardsSubmissionHash [][32]byte) (*RewardsCoordinatorStorageAVSRewardsSubmissionCreatedIterator, error)
+	FilterActivationDelaySet(opts *bind.FilterOpts) (*RewardsCoordinatorStorageActivationDelaySetIterator, error)
+	FilterClaimerForSet(opts *bind.FilterOpts, earner []common.Address, oldClaimer []common.Address, claimer []common.Address) (*RewardsCoordinatorStorageClaimerForSetIterator, error)
+	FilterDistributionRootDisabled(opts *bind.FilterOpts, rootIndex []uint32) (*RewardsCoordinatorStorageDistributionRootDisabledIterator, error)
+	FilterDistributionRootSubmitted(opts *bind.FilterOpts, rootIndex []uint32, root [][32]byte, rewardsCalculationEndTimestamp []uint32) (*RewardsCoordinatorStorageDistributionRootSubmittedIterator, error)
+	FilterGlobalCommissionBipsSet(opts *bind.FilterOpts) (*RewardsCoordinatorStorageGlobalCommissionBipsSetIterator, error)
+	FilterRewardsClaimed(opts *bind.FilterOpts, earner []common.Address, claimer []common.Address, recipient []common.Address) (*RewardsCoordinatorStorageRewardsClaimedIterator, error)
+	FilterRewardsForAllSubmitterSet(opts *bind.FilterOpts, rewardsForAllSubmitter []common.Address, oldValue []bool, newValue []bool) (*RewardsCoordinatorStorageRewardsForAllSubmitterSetIterator, error)
+	FilterRewardsSubmissionForAllCreated(opts *bind.FilterOpts, submitter []common.Address, submissionNonce []*big.Int, rewardsSubmissionHash [][32]byte) (*RewardsCoordinatorStorageRewardsSubmissionForAllCreatedIterator, error)
+	FilterRewardsUpdaterSet(opts *bind.FilterOpts, oldRewardsUpdater []common.Address, newRewardsUpdater []common.Address) (*RewardsCoordinatorStorageRewardsUpdaterSetIterator, error)
+	ParseAVSRewardsSubmissionCreated(log types.Log) (*RewardsCoordinatorStorageAVSRewardsSubmissionCreated, error)
+	ParseActivationDelaySet(log types.Log) (*RewardsCoordinatorStorageActivationDelaySet, error)
+	ParseClaimerForSet(log types.Log) (*RewardsCoordinatorStorageClaimerForSet, error)
+	ParseDistributionRootDisabled(log types.Log) (*RewardsCoordinatorStorageDistributionRootDisabled, error)
+	ParseDistributionRootSubmitted(log types.Log) (*RewardsCoordinatorStorageDistributionRootSubmitted, error)
+	ParseGlobalCommissionBipsSet(log types.Log) (*RewardsCoordinatorStorageGlobalCommissionBipsSet, error)
+	ParseRewardsClaimed(log types.Log) (*RewardsCoordinatorStorageRewardsClaimed, error)
+	ParseRewardsForAllSubmitterSet(log types.Log) (*RewardsCoordinatorStorageRewardsForAllSubmitterSet, error)
+	ParseRewardsSubmissionForAllCreated(log types.Log) (*RewardsCoordinatorStorageRewardsSubmissionForAllCreated, error)
+	ParseRewardsUpdaterSet(log types.Log) (*RewardsCoordinatorStorageRewardsUpdaterSet, error)
+	WatchAVSRewardsSubmissionCreated(opts *bind.WatchOpts, sink chan<- *RewardsCoordinatorStorageAVSRewardsSubmissionCreated, avs []common.Address, submissionNonce []*big.Int, rewardsSubmissionHash [][32]byte) (event.Subscription, error)
+	WatchActivationDelaySet(opts *bind.WatchOpts, sink chan<- *RewardsCoordinatorStorageActivationDelaySet) (event.Subscription, error)
+	WatchClaimerForSet(opts *bind.WatchOpts, sink chan<- *RewardsCoordinatorStorageClaimerForSet, earner []common.Address, oldClaimer []common.Address, claimer []common.Address) (event.Subscription, error)
+	WatchDistributionRootDisabled(opts *bind.WatchOpts, sink chan<- *RewardsCoordinatorStorageDistributionRootDisabled, rootIndex []uint32) (event.Subscription, error)
+	WatchDistributionRootSubmitted(opts *bind.WatchOpts, sink chan<- *RewardsCoordinatorStorageDistributionRootSubmitted, rootIndex []uint32, root [][32]byte, rewardsCalculationEndTimestamp []uint32) (event.Subscription, error)
+	WatchGlobalCommissionBipsSet(opts *bind.WatchOpts, sink chan<- *RewardsCoordinatorStorageGlobalCommissionBipsSet) (event.Subscription, error)
+	WatchRewardsClaimed(opts *bind.WatchOpts, sink chan<- *RewardsCoordinatorStorageRewardsClaimed, earner []common.Address, claimer []common.Address, recipient []common.Address) (event.Subscription, error)
+	WatchRewardsForAllSubmitterSet(opts *bind.WatchOpts, sink chan<- *RewardsCoordinatorStorageRewardsForAllSubmitterSet, rewardsForAllSubmitter []common.Address, oldValue []bool, newValue []bool) (event.Subscription, error)
+	WatchRewardsSubmissionForAllCreated(opts *bind.WatchOpts, sink chan<- *RewardsCoordinatorStorageRewardsSubmissionForAllCreated, submitter []common.Address, submissionNonce []*big.Int, rewardsSubmissionHash [][32]byte) (event.Subscription, error)
+	WatchRewardsUpdaterSet(opts *bind.WatchOpts, sink chan<- *RewardsCoordinatorStorageRewardsUpdaterSet, oldRewardsUpdater []common.Address, newRewardsUpdater []common.Address) (event.Subscription, error)
+}
+
+var (
+	_ RewardsCoordinatorStorageReader = (*RewardsCoordinatorStorageCaller)(nil)
+	_ RewardsCoordinatorStorageWriter = (*RewardsCoordinatorStorageTransactor)(nil)
+	_ RewardsCoordinatorStorageEvents = (*RewardsCoordinatorStorageFilterer)(nil)
+	_ RewardsCoordinatorStorageReader = (*RewardsCoordinatorStorage)(nil)
+	_ RewardsCoordinatorStorageWriter = (*RewardsCoordinatorStorage)(nil)
+	_ RewardsCoordinatorStorageEvents = (*RewardsCoordinatorStorage)(nil)
+)
@@ -0,0 +1,95 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package RewardsCoordinatorStorage
+
+import (
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/types"
+)
+
+// Canonical converts s to the shared types.DistributionRoot.
+func (s IRewardsCoordinatorDistributionRoot) Canonical() types.DistributionRoot {
+	return types.DistributionRoot(s)
+}
+
+// ToIRewardsCoordinatorDistributionRoot converts the shared types.DistributionRoot to this binding's copy.
+func ToIRewardsCoordinatorDistributionRoot(v types.DistributionRoot) IRewardsCoordinatorDistributionRoot {
+	return IRewardsCoordinatorDistributionRoot(v)
+}
+
+// Canonical converts s to the shared types.EarnerTreeMerkleLeaf.
+func (s IRewardsCoordinatorEarnerTreeMerkleLeaf) Canonical() types.EarnerTreeMerkleLeaf {
+	return types.EarnerTreeMerkleLeaf(s)
+}
+
+// ToIRewardsCoordinatorEarnerTreeMerkleLeaf converts the shared types.EarnerTreeMerkleLeaf to this binding's copy.
+func ToIRewardsCoordinatorEarnerTreeMerkleLeaf(v types.EarnerTreeMerkleLeaf) IRewardsCoordinatorEarnerTreeMerkleLeaf {
+	return IRewardsCoordinatorEarnerTreeMerkleLeaf(v)
+}
+
+// Canonical converts s to the shared types.RewardsMerkleClaim.
+func (s IRewardsCoordinatorRewardsMerkleClaim) Canonical() types.RewardsMerkleClaim {
+	return types.RewardsMerkleClaim{
+		RootIndex:       s.RootIndex,
+		EarnerIndex:     s.EarnerIndex,
+		EarnerTreeProof: s.EarnerTreeProof,
+		EarnerLeaf:      s.EarnerLeaf.Canonical(),
+		TokenIndices:    s.TokenIndices,
+		TokenTreeProofs: s.TokenTreeProofs,
+		TokenLeaves:     types.ConvertSlice(s.TokenLeaves, IRewardsCoordinatorTokenTreeMerkleLeaf.Canonical),
+	}
+}
+
+// ToIRewardsCoordinatorRewardsMerkleClaim converts the shared types.RewardsMerkleClaim to this binding's copy.
+func ToIRewardsCoordinatorRewardsMerkleClaim(v types.RewardsMerkleClaim) IRewardsCoordinatorRewardsMerkleClaim {
+	return IRewardsCoordinatorRewardsMerkleClaim{
+		RootIndex:       v.RootIndex,
+		EarnerIndex:     v.EarnerIndex,
+		EarnerTreeProof: v.EarnerTreeProof,
+		EarnerLeaf:      ToIRewardsCoordinatorEarnerTreeMerkleLeaf(v.EarnerLeaf),
+		TokenIndices:    v.TokenIndices,
+		TokenTreeProofs: v.TokenTreeProofs,
+		TokenLeaves:     types.ConvertSlice(v.TokenLeaves, ToIRewardsCoordinatorTokenTreeMerkleLeaf),
+	}
+}
+
+// Canonical converts s to the shared types.RewardsSubmission.
+func (s IRewardsCoordinatorRewardsSubmission) Canonical() types.RewardsSubmission {
+	return types.RewardsSubmission{
+		StrategiesAndMultipliers: types.ConvertSlice(s.StrategiesAndMultipliers, IRewardsCoordinatorStrategyAndMultiplier.Canonical),
+		Token:                    s.Token,
+		Amount:                   s.Amount,
+		StartTimestamp:           s.StartTimestamp,
+		Duration:                 s.Duration,
+	}
+}
+
+// ToIRewardsCoordinatorRewardsSubmission converts the shared types.RewardsSubmission to this binding's copy.
+func ToIRewardsCoordinatorRewardsSubmission(v types.RewardsSubmission) IRewardsCoordinatorRewardsSubmission {
+	return IRewardsCoordinatorRewardsSubmission{
+		StrategiesAndMultipliers: types.ConvertSlice(v.StrategiesAndMultipliers, ToIRewardsCoordinatorStrategyAndMultiplier),
+		Token:                    v.Token,
+		Amount:                   v.Amount,
+		StartTimestamp:           v.StartTimestamp,
+		Duration:                 v.Duration,
+	}
+}
+
+// Canonical converts s to the shared types.StrategyAndMultiplier.
+func (s IRewardsCoordinatorStrategyAndMultiplier) Canonical() types.StrategyAndMultiplier {
+	return types.StrategyAndMultiplier(s)
+}
+
+// ToIRewardsCoordinatorStrategyAndMultiplier converts the shared types.StrategyAndMultiplier to this binding's copy.
+func ToIRewardsCoordinatorStrategyAndMultiplier(v types.StrategyAndMultiplier) IRewardsCoordinatorStrategyAndMultiplier {
+	return IRewardsCoordinatorStrategyAndMultiplier(v)
+}
+
+// Canonical converts s to the shared types.TokenTreeMerkleLeaf.
+func (s IRewardsCoordinatorTokenTreeMerkleLeaf) Canonical() types.TokenTreeMerkleLeaf {
+	return types.TokenTreeMerkleLeaf(s)
+}
+
+// ToIRewardsCoordinatorTokenTreeMerkleLeaf converts the shared types.TokenTreeMerkleLeaf to this binding's copy.
+func ToIRewardsCoordinatorTokenTreeMerkleLeaf(v types.TokenTreeMerkleLeaf) IRewardsCoordinatorTokenTreeMerkleLeaf {
+	return IRewardsCoordinatorTokenTreeMerkleLeaf(v)
+}
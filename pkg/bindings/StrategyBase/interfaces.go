@@ -0,0 +1,66 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package StrategyBase
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// StrategyBaseReader is the read-only, constant method surface of StrategyBase.
+type StrategyBaseReader interface {
+	Explanation(opts *bind.CallOpts) (string, error)
+	Paused(opts *bind.CallOpts, index uint8) (bool, error)
+	Paused0(opts *bind.CallOpts) (*big.Int, error)
+	PauserRegistry(opts *bind.CallOpts) (common.Address, error)
+	Shares(opts *bind.CallOpts, user common.Address) (*big.Int, error)
+	SharesToUnderlying(opts *bind.CallOpts, amountShares *big.Int) (*big.Int, error)
+	SharesToUnderlyingView(opts *bind.CallOpts, amountShares *big.Int) (*big.Int, error)
+	StrategyManager(opts *bind.CallOpts) (common.Address, error)
+	TotalShares(opts *bind.CallOpts) (*big.Int, error)
+	UnderlyingToShares(opts *bind.CallOpts, amountUnderlying *big.Int) (*big.Int, error)
+	UnderlyingToSharesView(opts *bind.CallOpts, amountUnderlying *big.Int) (*big.Int, error)
+	UnderlyingToken(opts *bind.CallOpts) (common.Address, error)
+	UserUnderlyingView(opts *bind.CallOpts, user common.Address) (*big.Int, error)
+}
+
+// StrategyBaseWriter is the transaction sending method surface of StrategyBase.
+type StrategyBaseWriter interface {
+	Deposit(opts *bind.TransactOpts, token common.Address, amount *big.Int) (*types.Transaction, error)
+	Initialize(opts *bind.TransactOpts, _underlyingToken common.Address, _pauserRegistry common.Address) (*types.Transaction, error)
+	Pause(opts *bind.TransactOpts, newPausedStatus *big.Int) (*types.Transaction, error)
+	PauseAll(opts *bind.TransactOpts) (*types.Transaction, error)
+	SetPauserRegistry(opts *bind.TransactOpts, newPauserRegistry common.Address) (*types.Transaction, error)
+	Unpause(opts *bind.TransactOpts, newPausedStatus *big.Int) (*types.Transaction, error)
+	UserUnderlying(opts *bind.TransactOpts, user common.Address) (*types.Transaction, error)
+	Withdraw(opts *bind.TransactOpts, recipient common.Address, token common.Address, amountShares *big.Int) (*types.Transaction, error)
+}
+
+// StrategyBaseEvents is the log filtering, watching and parsing surface of StrategyBase.
+type StrategyBaseEvents interface {
+	FilterInitialized(opts *bind.FilterOpts) (*StrategyBaseInitializedIterator, error)
+	FilterPaused(opts *bind.FilterOpts, account []common.Address) (*StrategyBasePausedIterator, error)
+	FilterPauserRegistrySet(opts *bind.FilterOpts) (*StrategyBasePauserRegistrySetIterator, error)
+	FilterUnpaused(opts *bind.FilterOpts, account []common.Address) (*StrategyBaseUnpausedIterator, error)
+	ParseInitialized(log types.Log) (*StrategyBaseInitialized, error)
+	ParsePaused(log types.Log) (*StrategyBasePaused, error)
+	ParsePauserRegistrySet(log types.Log) (*StrategyBasePauserRegistrySet, error)
+	ParseUnpaused(log types.Log) (*StrategyBaseUnpaused, error)
+	WatchInitialized(opts *bind.WatchOpts, sink chan<- *StrategyBaseInitialized) (event.Subscription, error)
+	WatchPaused(opts *bind.WatchOpts, sink chan<- *StrategyBasePaused, account []common.Address) (event.Subscription, error)
+	WatchPauserRegistrySet(opts *bind.WatchOpts, sink chan<- *StrategyBasePauserRegistrySet) (event.Subscription, error)
+	WatchUnpaused(opts *bind.WatchOpts, sink chan<- *StrategyBaseUnpaused, account []common.Address) (event.Subscription, error)
+}
+
+var (
+	_ StrategyBaseReader = (*StrategyBaseCaller)(nil)
+	_ StrategyBaseWriter = (*StrategyBaseTransactor)(nil)
+	_ StrategyBaseEvents = (*StrategyBaseFilterer)(nil)
+	_ StrategyBaseReader = (*StrategyBase)(nil)
+	_ StrategyBaseWriter = (*StrategyBase)(nil)
+	_ StrategyBaseEvents = (*StrategyBase)(nil)
+)
@@ -0,0 +1,97 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package StrategyManager
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// StrategyManagerReader is the read-only, constant method surface of StrategyManager.
+type StrategyManagerReader interface {
+	DEPOSITTYPEHASH(opts *bind.CallOpts) ([32]byte, error)
+	DOMAINTYPEHASH(opts *bind.CallOpts) ([32]byte, error)
+	Delegation(opts *bind.CallOpts) (common.Address, error)
+	DomainSeparator(opts *bind.CallOpts) ([32]byte, error)
+	EigenPodManager(opts *bind.CallOpts) (common.Address, error)
+	GetDeposits(opts *bind.CallOpts, staker common.Address) ([]common.Address, []*big.Int, error)
+	Nonces(opts *bind.CallOpts, arg0 common.Address) (*big.Int, error)
+	Owner(opts *bind.CallOpts) (common.Address, error)
+	Paused(opts *bind.CallOpts, index uint8) (bool, error)
+	Paused0(opts *bind.CallOpts) (*big.Int, error)
+	PauserRegistry(opts *bind.CallOpts) (common.Address, error)
+	Slasher(opts *bind.CallOpts) (common.Address, error)
+	StakerStrategyList(opts *bind.CallOpts, arg0 common.Address, arg1 *big.Int) (common.Address, error)
+	StakerStrategyListLength(opts *bind.CallOpts, staker common.Address) (*big.Int, error)
+	StakerStrategyShares(opts *bind.CallOpts, arg0 common.Address, arg1 common.Address) (*big.Int, error)
+	StrategyIsWhitelistedForDeposit(opts *bind.CallOpts, arg0 common.Address) (bool, error)
+	StrategyWhitelister(opts *bind.CallOpts) (common.Address, error)
+	ThirdPartyTransfersForbidden(opts *bind.CallOpts, arg0 common.Address) (bool, error)
+}
+
+// StrategyManagerWriter is the transaction sending method surface of StrategyManager.
+type StrategyManagerWriter interface {
+	AddShares(opts *bind.TransactOpts, staker common.Address, token common.Address, strategy common.Address, shares *big.Int) (*types.Transaction, error)
+	AddStrategiesToDepositWhitelist(opts *bind.TransactOpts, strategiesToWhitelist []common.Address, thirdPartyTransfersForbiddenValues []bool) (*types.Transaction, error)
+	DepositIntoStrategy(opts *bind.TransactOpts, strategy common.Address, token common.Address, amount *big.Int) (*types.Transaction, error)
+	DepositIntoStrategyWithSignature(opts *bind.TransactOpts, strategy common.Address, token common.Address, amount *big.Int, staker common.Address, expiry *big.Int, signature []byte) (*types.Transaction, error)
+	Initialize(opts *bind.TransactOpts, initialOwner common.Address, initialStrategyWhitelister common.Address, _pauserRegistry common.Address, initialPausedStatus *big.Int) (*types.Transaction, error)
+	Pause(opts *bind.TransactOpts, newPausedStatus *big.Int) (*types.Transaction, error)
+	PauseAll(opts *bind.TransactOpts) (*types.Transaction, error)
+	RemoveShares(opts *bind.TransactOpts, staker common.Address, strategy common.Address, shares *big.Int) (*types.Transaction, error)
+	RemoveStrategiesFromDepositWhitelist(opts *bind.TransactOpts, strategiesToRemoveFromWhitelist []common.Address) (*types.Transaction, error)
+	RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error)
+	SetPauserRegistry(opts *bind.TransactOpts, newPauserRegistry common.Address) (*types.Transaction, error)
+	SetStrategyWhitelister(opts *bind.TransactOpts, newStrategyWhitelister common.Address) (*types.Transaction, error)
+	SetThirdPartyTransfersForbidden(opts *bind.TransactOpts, strategy common.Address, value bool) (*types.Transaction, error)
+	TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error)
+	Unpause(opts *bind.TransactOpts, newPausedStatus *big.Int) (*types.Transaction, error)
+	WithdrawSharesAsTokens(opts *bind.TransactOpts, recipient common.Address, strategy common.Address, shares *big.Int, token common.Address) (*types.Transaction, error)
+}
+
+// StrategyManagerEvents is the log filtering, watching and parsing surface of StrategyManager.
+type StrategyManagerEvents interface {
+	FilterDeposit(opts *bind.FilterOpts) (*StrategyManagerDepositIterator, error)
+	FilterInitialized(opts *bind.FilterOpts) (*StrategyManagerInitializedIterator, error)
+	FilterOwnershipTransferred(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*StrategyManagerOwnershipTransferredIterator, error)
+	FilterPaused(opts *bind.FilterOpts, account []common.Address) (*StrategyManagerPausedIterator, error)
+	FilterPauserRegistrySet(opts *bind.FilterOpts) (*StrategyManagerPauserRegistrySetIterator, error)
+	FilterStrategyAddedToDepositWhitelist(opts *bind.FilterOpts) (*StrategyManagerStrategyAddedToDepositWhitelistIterator, error)
+	FilterStrategyRemovedFromDepositWhitelist(opts *bind.FilterOpts) (*StrategyManagerStrategyRemovedFromDepositWhitelistIterator, error)
+	FilterStrategyWhitelisterChanged(opts *bind.FilterOpts) (*StrategyManagerStrategyWhitelisterChangedIterator, error)
+	FilterUnpaused(opts *bind.FilterOpts, account []common.Address) (*StrategyManagerUnpausedIterator, error)
+	FilterUpdatedThirdPartyTransfersForbidden(opts *bind.FilterOpts) (*StrategyManagerUpdatedThirdPartyTransfersForbiddenIterator, error)
+	ParseDeposit(log types.Log) (*StrategyManagerDeposit, error)
+	ParseInitialized(log types.Log) (*StrategyManagerInitialized, error)
+	ParseOwnershipTransferred(log types.Log) (*StrategyManagerOwnershipTransferred, error)
+	ParsePaused(log types.Log) (*StrategyManagerPaused, error)
+	ParsePauserRegistrySet(log types.Log) (*StrategyManagerPauserRegistrySet, error)
+	ParseStrategyAddedToDepositWhitelist(log types.Log) (*StrategyManagerStrategyAddedToDepositWhitelist, error)
+	ParseStrategyRemovedFromDepositWhitelist(log types.Log) (*StrategyManagerStrategyRemovedFromDepositWhitelist, error)
+	ParseStrategyWhitelisterChanged(log types.Log) (*StrategyManagerStrategyWhitelisterChanged, error)
+	ParseUnpaused(log types.Log) (*StrategyManagerUnpaused, error)
+	ParseUpdatedThirdPartyTransfersForbidden(log types.Log) (*StrategyManagerUpdatedThirdPartyTransfersForbidden, error)
+	WatchDeposit(opts *bind.WatchOpts, sink chan<- *StrategyManagerDeposit) (event.Subscription, error)
+	WatchInitialized(opts *bind.WatchOpts, sink chan<- *StrategyManagerInitialized) (event.Subscription, error)
+	WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *StrategyManagerOwnershipTransferred, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error)
+	WatchPaused(opts *bind.WatchOpts, sink chan<- *StrategyManagerPaused, account []common.Address) (event.Subscription, error)
+	WatchPauserRegistrySet(opts *bind.WatchOpts, sink chan<- *StrategyManagerPauserRegistrySet) (event.Subscription, error)
+	WatchStrategyAddedToDepositWhitelist(opts *bind.WatchOpts, sink chan<- *StrategyManagerStrategyAddedToDepositWhitelist) (event.Subscription, error)
+	WatchStrategyRemovedFromDepositWhitelist(opts *bind.WatchOpts, sink chan<- *StrategyManagerStrategyRemovedFromDepositWhitelist) (event.Subscription, error)
+	WatchStrategyWhitelisterChanged(opts *bind.WatchOpts, sink chan<- *StrategyManagerStrategyWhitelisterChanged) (event.Subscription, error)
+	WatchUnpaused(opts *bind.WatchOpts, sink chan<- *StrategyManagerUnpaused, account []common.Address) (event.Subscription, error)
+	WatchUpdatedThirdPartyTransfersForbidden(opts *bind.WatchOpts, sink chan<- *StrategyManagerUpdatedThirdPartyTransfersForbidden) (event.Subscription, error)
+}
+
+var (
+	_ StrategyManagerReader = (*StrategyManagerCaller)(nil)
+	_ StrategyManagerWriter = (*StrategyManagerTransactor)(nil)
+	_ StrategyManagerEvents = (*StrategyManagerFilterer)(nil)
+	_ StrategyManagerReader = (*StrategyManager)(nil)
+	_ StrategyManagerWriter = (*StrategyManager)(nil)
+	_ StrategyManagerEvents = (*StrategyManager)(nil)
+)
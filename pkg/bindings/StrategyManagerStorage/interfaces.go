@@ -0,0 +1,68 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package StrategyManagerStorage
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// StrategyManagerStorageReader is the read-only, constant method surface of StrategyManagerStorage.
+type StrategyManagerStorageReader interface {
+	DEPOSITTYPEHASH(opts *bind.CallOpts) ([32]byte, error)
+	DOMAINTYPEHASH(opts *bind.CallOpts) ([32]byte, error)
+	Delegation(opts *bind.CallOpts) (common.Address, error)
+	EigenPodManager(opts *bind.CallOpts) (common.Address, error)
+	GetDeposits(opts *bind.CallOpts, staker common.Address) ([]common.Address, []*big.Int, error)
+	Nonces(opts *bind.CallOpts, arg0 common.Address) (*big.Int, error)
+	Slasher(opts *bind.CallOpts) (common.Address, error)
+	StakerStrategyList(opts *bind.CallOpts, arg0 common.Address, arg1 *big.Int) (common.Address, error)
+	StakerStrategyListLength(opts *bind.CallOpts, staker common.Address) (*big.Int, error)
+	StakerStrategyShares(opts *bind.CallOpts, arg0 common.Address, arg1 common.Address) (*big.Int, error)
+	StrategyIsWhitelistedForDeposit(opts *bind.CallOpts, arg0 common.Address) (bool, error)
+	StrategyWhitelister(opts *bind.CallOpts) (common.Address, error)
+	ThirdPartyTransfersForbidden(opts *bind.CallOpts, arg0 common.Address) (bool, error)
+}
+
+// StrategyManagerStorageWriter is the transaction sending method surface of StrategyManagerStorage.
+type StrategyManagerStorageWriter interface {
+	AddShares(opts *bind.TransactOpts, staker common.Address, token common.Address, strategy common.Address, shares *big.Int) (*types.Transaction, error)
+	AddStrategiesToDepositWhitelist(opts *bind.TransactOpts, strategiesToWhitelist []common.Address, thirdPartyTransfersForbiddenValues []bool) (*types.Transaction, error)
+	DepositIntoStrategy(opts *bind.TransactOpts, strategy common.Address, token common.Address, amount *big.Int) (*types.Transaction, error)
+	DepositIntoStrategyWithSignature(opts *bind.TransactOpts, strategy common.Address, token common.Address, amount *big.Int, staker common.Address, expiry *big.Int, signature []byte) (*types.Transaction, error)
+	RemoveShares(opts *bind.TransactOpts, staker common.Address, strategy common.Address, shares *big.Int) (*types.Transaction, error)
+	RemoveStrategiesFromDepositWhitelist(opts *bind.TransactOpts, strategiesToRemoveFromWhitelist []common.Address) (*types.Transaction, error)
+	WithdrawSharesAsTokens(opts *bind.TransactOpts, recipient common.Address, strategy common.Address, shares *big.Int, token common.Address) (*types.Transaction, error)
+}
+
+// StrategyManagerStorageEvents is the log filtering, watching and parsing surface of StrategyManagerStorage.
+type StrategyManagerStorageEvents interface {
+	FilterDeposit(opts *bind.FilterOpts) (*StrategyManagerStorageDepositIterator, error)
+	FilterStrategyAddedToDepositWhitelist(opts *bind.FilterOpts) (*StrategyManagerStorageStrategyAddedToDepositWhitelistIterator, error)
+	FilterStrategyRemovedFromDepositWhitelist(opts *bind.FilterOpts) (*StrategyManagerStorageStrategyRemovedFromDepositWhitelistIterator, error)
+	FilterStrategyWhitelisterChanged(opts *bind.FilterOpts) (*StrategyManagerStorageStrategyWhitelisterChangedIterator, error)
+	FilterUpdatedThirdPartyTransfersForbidden(opts *bind.FilterOpts) (*StrategyManagerStorageUpdatedThirdPartyTransfersForbiddenIterator, error)
+	ParseDeposit(log types.Log) (*StrategyManagerStorageDeposit, error)
+	ParseStrategyAddedToDepositWhitelist(log types.Log) (*StrategyManagerStorageStrategyAddedToDepositWhitelist, error)
+	ParseStrategyRemovedFromDepositWhitelist(log types.Log) (*StrategyManagerStorageStrategyRemovedFromDepositWhitelist, error)
+	ParseStrategyWhitelisterChanged(log types.Log) (*StrategyManagerStorageStrategyWhitelisterChanged, error)
+	ParseUpdatedThirdPartyTransfersForbidden(log types.Log) (*StrategyManagerStorageUpdatedThirdPartyTransfersForbidden, error)
+	WatchDeposit(opts *bind.WatchOpts, sink chan<- *StrategyManagerStorageDeposit) (event.Subscription, error)
+	WatchStrategyAddedToDepositWhitelist(opts *bind.WatchOpts, sink chan<- *StrategyManagerStorageStrategyAddedToDepositWhitelist) (event.Subscription, error)
+	WatchStrategyRemovedFromDepositWhitelist(opts *bind.WatchOpts, sink chan<- *StrategyManagerStorageStrategyRemovedFromDepositWhitelist) (event.Subscription, error)
+	WatchStrategyWhitelisterChanged(opts *bind.WatchOpts, sink chan<- *StrategyManagerStorageStrategyWhitelisterChanged) (event.Subscription, error)
+	WatchUpdatedThirdPartyTransfersForbidden(opts *bind.WatchOpts, sink chan<- *StrategyManagerStorageUpdatedThirdPartyTransfersForbidden) (event.Subscription, error)
+}
+
+var (
+	_ StrategyManagerStorageReader = (*StrategyManagerStorageCaller)(nil)
+	_ StrategyManagerStorageWriter = (*StrategyManagerStorageTransactor)(nil)
+	_ StrategyManagerStorageEvents = (*StrategyManagerStorageFilterer)(nil)
+	_ StrategyManagerStorageReader = (*StrategyManagerStorage)(nil)
+	_ StrategyManagerStorageWriter = (*StrategyManagerStorage)(nil)
+	_ StrategyManagerStorageEvents = (*StrategyManagerStorage)(nil)
+)
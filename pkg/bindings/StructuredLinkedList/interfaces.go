@@ -0,0 +1,24 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package StructuredLinkedList
+
+// StructuredLinkedListReader is the read-only, constant method surface of StructuredLinkedList.
+type StructuredLinkedListReader interface {
+}
+
+// StructuredLinkedListWriter is the transaction sending method surface of StructuredLinkedList.
+type StructuredLinkedListWriter interface {
+}
+
+// StructuredLinkedListEvents is the log filtering, watching and parsing surface of StructuredLinkedList.
+type StructuredLinkedListEvents interface {
+}
+
+var (
+	_ StructuredLinkedListReader = (*StructuredLinkedListCaller)(nil)
+	_ StructuredLinkedListWriter = (*StructuredLinkedListTransactor)(nil)
+	_ StructuredLinkedListEvents = (*StructuredLinkedListFilterer)(nil)
+	_ StructuredLinkedListReader = (*StructuredLinkedList)(nil)
+	_ StructuredLinkedListWriter = (*StructuredLinkedList)(nil)
+	_ StructuredLinkedListEvents = (*StructuredLinkedList)(nil)
+)
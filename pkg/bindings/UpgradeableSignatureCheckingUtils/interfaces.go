@@ -0,0 +1,35 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package UpgradeableSignatureCheckingUtils
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// UpgradeableSignatureCheckingUtilsReader is the read-only, constant method surface of UpgradeableSignatureCheckingUtils.
+type UpgradeableSignatureCheckingUtilsReader interface {
+	DOMAINTYPEHASH(opts *bind.CallOpts) ([32]byte, error)
+	DomainSeparator(opts *bind.CallOpts) ([32]byte, error)
+}
+
+// UpgradeableSignatureCheckingUtilsWriter is the transaction sending method surface of UpgradeableSignatureCheckingUtils.
+type UpgradeableSignatureCheckingUtilsWriter interface {
+}
+
+// UpgradeableSignatureCheckingUtilsEvents is the log filtering, watching and parsing surface of UpgradeableSignatureCheckingUtils.
+type UpgradeableSignatureCheckingUtilsEvents interface {
+	FilterInitialized(opts *bind.FilterOpts) (*UpgradeableSignatureCheckingUtilsInitializedIterator, error)
+	ParseInitialized(log types.Log) (*UpgradeableSignatureCheckingUtilsInitialized, error)
+	WatchInitialized(opts *bind.WatchOpts, sink chan<- *UpgradeableSignatureCheckingUtilsInitialized) (event.Subscription, error)
+}
+
+var (
+	_ UpgradeableSignatureCheckingUtilsReader = (*UpgradeableSignatureCheckingUtilsCaller)(nil)
+	_ UpgradeableSignatureCheckingUtilsWriter = (*UpgradeableSignatureCheckingUtilsTransactor)(nil)
+	_ UpgradeableSignatureCheckingUtilsEvents = (*UpgradeableSignatureCheckingUtilsFilterer)(nil)
+	_ UpgradeableSignatureCheckingUtilsReader = (*UpgradeableSignatureCheckingUtils)(nil)
+	_ UpgradeableSignatureCheckingUtilsWriter = (*UpgradeableSignatureCheckingUtils)(nil)
+	_ UpgradeableSignatureCheckingUtilsEvents = (*UpgradeableSignatureCheckingUtils)(nil)
+)
@@ -0,0 +1,24 @@
+package bindings_test
+
+import (
+	"testing"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindgen"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/bindingstest"
+)
+
+// root is the repository checkout, relative to this package.
+const root = "../.."
+
+func TestUpToDate(t *testing.T) {
+	bindingstest.AssertUpToDate(t, root, bindgen.Config{})
+}
+
+func TestConforms(t *testing.T) {
+	for _, set := range bindings.Releases {
+		t.Run(set.Release, func(t *testing.T) {
+			bindingstest.AssertConforms(t, set)
+		})
+	}
+}
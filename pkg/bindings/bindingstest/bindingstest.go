@@ -0,0 +1,103 @@
+// Package bindingstest provides test helpers for the generated bindings.
+//
+// AssertUpToDate and AssertConstantsUpToDate check what make check-bindings
+// checks, the latter without a forge build, and AssertConforms what make
+// check-abi checks.
+package bindingstest
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindgen"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/constants"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/reverts"
+)
+
+// AssertUpToDate fails t if any binding in pkg/bindings, or the storage
+// layout it embeds, differs from the forge artifacts of the contracts
+// selected by cfg. Every release is checked unless cfg names one. Relative
+// directories in cfg are resolved against root, the repository checkout. The
+// test is skipped if the artifacts have not been built.
+func AssertUpToDate(t testing.TB, root string, cfg bindgen.Config) {
+	t.Helper()
+	if cfg.SourceDir == "" {
+		cfg.SourceDir = bindgen.DefaultSourceDir
+	}
+	if cfg.ArtifactDir == "" {
+		cfg.ArtifactDir = bindgen.DefaultArtifactDir
+	}
+	if !filepath.IsAbs(cfg.SourceDir) {
+		cfg.SourceDir = filepath.Join(root, cfg.SourceDir)
+	}
+	if !filepath.IsAbs(cfg.ArtifactDir) {
+		cfg.ArtifactDir = filepath.Join(root, cfg.ArtifactDir)
+	}
+	if _, err := os.Stat(cfg.ArtifactDir); os.IsNotExist(err) {
+		t.Skipf("no forge artifacts at %s, run `forge build`", cfg.ArtifactDir)
+	}
+	for _, cfg := range cfg.ReleaseConfigs() {
+		set, ok := bindings.Release(cfg.Release)
+		if !ok {
+			t.Errorf("no binding set for release %s", cfg.Release)
+			continue
+		}
+		drifts, err := bindgen.Check(cfg, set.MetaData)
+		if err != nil {
+			t.Fatalf("failed to check %s bindings: %v", cfg.Release, err)
+		}
+		for _, d := range drifts {
+			t.Errorf("stale %s binding %v", cfg.Release, d)
+		}
+		layoutDrifts, err := bindgen.CheckLayouts(cfg, set.StorageLayouts)
+		if err != nil {
+			t.Fatalf("failed to check %s storage layouts: %v", cfg.Release, err)
+		}
+		for _, d := range layoutDrifts {
+			t.Errorf("stale %s storage layout %v", cfg.Release, d)
+		}
+	}
+}
+
+// AssertConstantsUpToDate fails t if any constant in pkg/constants differs
+// from its value in the Solidity sources under root, or if the revert
+// reasons catalogued in pkg/reverts differ from those the sources raise.
+// Unlike AssertUpToDate it needs no forge build.
+func AssertConstantsUpToDate(t testing.TB, root string) {
+	t.Helper()
+	sourceDir := filepath.Join(root, bindgen.DefaultSourceDir)
+	drifts, err := bindgen.CheckConstants(sourceDir, constants.Solidity)
+	if err != nil {
+		t.Fatalf("failed to check constants: %v", err)
+	}
+	for _, d := range drifts {
+		t.Errorf("stale constant %v", d)
+	}
+	catalogued := make([]string, 0, len(reverts.Catalogue))
+	for reason := range reverts.Catalogue {
+		catalogued = append(catalogued, reason)
+	}
+	revertDrifts, err := bindgen.CheckReverts(sourceDir, catalogued)
+	if err != nil {
+		t.Fatalf("failed to check revert reasons: %v", err)
+	}
+	for _, d := range revertDrifts {
+		t.Errorf("stale revert reason %v", d)
+	}
+}
+
+// AssertConforms fails t if the implementation bindings of set do not
+// expose the functions and events of their interface bindings identically,
+// as checked by bindgen.CheckConformance.
+func AssertConforms(t testing.TB, set bindings.Set) {
+	t.Helper()
+	violations, err := bindgen.CheckConformance(set.MetaData, bindgen.InterfacePairs(set.MetaData))
+	if err != nil {
+		t.Fatalf("failed to check %s conformance: %v", set.Release, err)
+	}
+	for _, v := range violations {
+		t.Errorf("%s: %v", set.Release, v)
+	}
+}
@@ -0,0 +1,980 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package bindings
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/AVSDirectory"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/BackingEigen"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/DelayedWithdrawalRouter"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/DelegationManager"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/Eigen"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/EigenPod"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/EigenPodManager"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/EigenStrategy"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/Pausable"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/PauserRegistry"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/RewardsCoordinator"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/StrategyBase"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/StrategyBaseTVLLimits"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/StrategyManager"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/events"
+)
+
+// Events lists the events of every deployable bound contract, each parsed
+// into the struct of its binding package.
+var Events = []events.Event{
+	{
+		Contract:  "AVSDirectory",
+		Name:      "AVSMetadataURIUpdated",
+		Signature: "AVSMetadataURIUpdated(address,string)",
+		ID:        common.HexToHash("0xa89c1dc243d8908a96dd84944bcc97d6bc6ac00dd78e20621576be6a3c943713"),
+		Topics:    2,
+		Parse:     events.Parser(AVSDirectory.NewAVSDirectoryFilterer, (*AVSDirectory.AVSDirectoryFilterer).ParseAVSMetadataURIUpdated),
+	},
+	{
+		Contract:  "AVSDirectory",
+		Name:      "Initialized",
+		Signature: "Initialized(uint8)",
+		ID:        common.HexToHash("0x7f26b83ff96e1f2b6a682f133852f6798a09c465da95921460cefb3847402498"),
+		Topics:    1,
+		Parse:     events.Parser(AVSDirectory.NewAVSDirectoryFilterer, (*AVSDirectory.AVSDirectoryFilterer).ParseInitialized),
+	},
+	{
+		Contract:  "AVSDirectory",
+		Name:      "OperatorAVSRegistrationStatusUpdated",
+		Signature: "OperatorAVSRegistrationStatusUpdated(address,address,uint8)",
+		ID:        common.HexToHash("0xf0952b1c65271d819d39983d2abb044b9cace59bcc4d4dd389f586ebdcb15b41"),
+		Topics:    3,
+		Parse:     events.Parser(AVSDirectory.NewAVSDirectoryFilterer, (*AVSDirectory.AVSDirectoryFilterer).ParseOperatorAVSRegistrationStatusUpdated),
+	},
+	{
+		Contract:  "AVSDirectory",
+		Name:      "OwnershipTransferred",
+		Signature: "OwnershipTransferred(address,address)",
+		ID:        common.HexToHash("0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0"),
+		Topics:    3,
+		Parse:     events.Parser(AVSDirectory.NewAVSDirectoryFilterer, (*AVSDirectory.AVSDirectoryFilterer).ParseOwnershipTransferred),
+	},
+	{
+		Contract:  "AVSDirectory",
+		Name:      "Paused",
+		Signature: "Paused(address,uint256)",
+		ID:        common.HexToHash("0xab40a374bc51de372200a8bc981af8c9ecdc08dfdaef0bb6e09f88f3c616ef3d"),
+		Topics:    2,
+		Parse:     events.Parser(AVSDirectory.NewAVSDirectoryFilterer, (*AVSDirectory.AVSDirectoryFilterer).ParsePaused),
+	},
+	{
+		Contract:  "AVSDirectory",
+		Name:      "PauserRegistrySet",
+		Signature: "PauserRegistrySet(address,address)",
+		ID:        common.HexToHash("0x6e9fcd539896fca60e8b0f01dd580233e48a6b0f7df013b89ba7f565869acdb6"),
+		Topics:    1,
+		Parse:     events.Parser(AVSDirectory.NewAVSDirectoryFilterer, (*AVSDirectory.AVSDirectoryFilterer).ParsePauserRegistrySet),
+	},
+	{
+		Contract:  "AVSDirectory",
+		Name:      "Unpaused",
+		Signature: "Unpaused(address,uint256)",
+		ID:        common.HexToHash("0x3582d1828e26bf56bd801502bc021ac0bc8afb57c826e4986b45593c8fad389c"),
+		Topics:    2,
+		Parse:     events.Parser(AVSDirectory.NewAVSDirectoryFilterer, (*AVSDirectory.AVSDirectoryFilterer).ParseUnpaused),
+	},
+	{
+		Contract:  "BackingEigen",
+		Name:      "Approval",
+		Signature: "Approval(address,address,uint256)",
+		ID:        common.HexToHash("0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925"),
+		Topics:    3,
+		Parse:     events.Parser(BackingEigen.NewBackingEigenFilterer, (*BackingEigen.BackingEigenFilterer).ParseApproval),
+	},
+	{
+		Contract:  "BackingEigen",
+		Name:      "Backed",
+		Signature: "Backed()",
+		ID:        common.HexToHash("0xb7c23c1e2e36f298e9879a88ecfcd07e28fbb439bcfa9c78ca1363ca14370d26"),
+		Topics:    1,
+		Parse:     events.Parser(BackingEigen.NewBackingEigenFilterer, (*BackingEigen.BackingEigenFilterer).ParseBacked),
+	},
+	{
+		Contract:  "BackingEigen",
+		Name:      "DelegateChanged",
+		Signature: "DelegateChanged(address,address,address)",
+		ID:        common.HexToHash("0x3134e8a2e6d97e929a7e54011ea5485d7d196dd5f0ba4d4ef95803e8e3fc257f"),
+		Topics:    4,
+		Parse:     events.Parser(BackingEigen.NewBackingEigenFilterer, (*BackingEigen.BackingEigenFilterer).ParseDelegateChanged),
+	},
+	{
+		Contract:  "BackingEigen",
+		Name:      "DelegateVotesChanged",
+		Signature: "DelegateVotesChanged(address,uint256,uint256)",
+		ID:        common.HexToHash("0xdec2bacdd2f05b59de34da9b523dff8be42e5e38e818c82fdb0bae774387a724"),
+		Topics:    2,
+		Parse:     events.Parser(BackingEigen.NewBackingEigenFilterer, (*BackingEigen.BackingEigenFilterer).ParseDelegateVotesChanged),
+	},
+	{
+		Contract:  "BackingEigen",
+		Name:      "EIP712DomainChanged",
+		Signature: "EIP712DomainChanged()",
+		ID:        common.HexToHash("0x0a6387c9ea3628b88a633bb4f3b151770f70085117a15f9bf3787cda53f13d31"),
+		Topics:    1,
+		Parse:     events.Parser(BackingEigen.NewBackingEigenFilterer, (*BackingEigen.BackingEigenFilterer).ParseEIP712DomainChanged),
+	},
+	{
+		Contract:  "BackingEigen",
+		Name:      "Initialized",
+		Signature: "Initialized(uint8)",
+		ID:        common.HexToHash("0x7f26b83ff96e1f2b6a682f133852f6798a09c465da95921460cefb3847402498"),
+		Topics:    1,
+		Parse:     events.Parser(BackingEigen.NewBackingEigenFilterer, (*BackingEigen.BackingEigenFilterer).ParseInitialized),
+	},
+	{
+		Contract:  "BackingEigen",
+		Name:      "OwnershipTransferred",
+		Signature: "OwnershipTransferred(address,address)",
+		ID:        common.HexToHash("0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0"),
+		Topics:    3,
+		Parse:     events.Parser(BackingEigen.NewBackingEigenFilterer, (*BackingEigen.BackingEigenFilterer).ParseOwnershipTransferred),
+	},
+	{
+		Contract:  "BackingEigen",
+		Name:      "SetAllowedFrom",
+		Signature: "SetAllowedFrom(address,bool)",
+		ID:        common.HexToHash("0xcf20b1ecb604b0e8888d579c64e8a3b10e590d45c1c2dddb393bed2843622271"),
+		Topics:    2,
+		Parse:     events.Parser(BackingEigen.NewBackingEigenFilterer, (*BackingEigen.BackingEigenFilterer).ParseSetAllowedFrom),
+	},
+	{
+		Contract:  "BackingEigen",
+		Name:      "SetAllowedTo",
+		Signature: "SetAllowedTo(address,bool)",
+		ID:        common.HexToHash("0x72a561d1af7409467dae4f1e9fc52590a9335a1dda17727e2b6aa8c4db35109b"),
+		Topics:    2,
+		Parse:     events.Parser(BackingEigen.NewBackingEigenFilterer, (*BackingEigen.BackingEigenFilterer).ParseSetAllowedTo),
+	},
+	{
+		Contract:  "BackingEigen",
+		Name:      "Transfer",
+		Signature: "Transfer(address,address,uint256)",
+		ID:        common.HexToHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"),
+		Topics:    3,
+		Parse:     events.Parser(BackingEigen.NewBackingEigenFilterer, (*BackingEigen.BackingEigenFilterer).ParseTransfer),
+	},
+	{
+		Contract:  "BackingEigen",
+		Name:      "TransferRestrictionsDisabled",
+		Signature: "TransferRestrictionsDisabled()",
+		ID:        common.HexToHash("0x2b18986d3ba809db2f13a5d7bf17f60d357b37d9cbb55dd71cbbac8dc4060f64"),
+		Topics:    1,
+		Parse:     events.Parser(BackingEigen.NewBackingEigenFilterer, (*BackingEigen.BackingEigenFilterer).ParseTransferRestrictionsDisabled),
+	},
+	{
+		Contract:  "DelayedWithdrawalRouter",
+		Name:      "DelayedWithdrawalCreated",
+		Signature: "DelayedWithdrawalCreated(address,address,uint256,uint256)",
+		ID:        common.HexToHash("0xb8f1b14c7caf74150801dcc9bc18d575cbeaf5b421943497e409df92c92e0f59"),
+		Topics:    1,
+		Parse:     events.Parser(DelayedWithdrawalRouter.NewDelayedWithdrawalRouterFilterer, (*DelayedWithdrawalRouter.DelayedWithdrawalRouterFilterer).ParseDelayedWithdrawalCreated),
+	},
+	{
+		Contract:  "DelayedWithdrawalRouter",
+		Name:      "DelayedWithdrawalsClaimed",
+		Signature: "DelayedWithdrawalsClaimed(address,uint256,uint256)",
+		ID:        common.HexToHash("0x6b7151500bd0b5cc211bcc47b3029831b769004df4549e8e1c9a69da05bb0943"),
+		Topics:    1,
+		Parse:     events.Parser(DelayedWithdrawalRouter.NewDelayedWithdrawalRouterFilterer, (*DelayedWithdrawalRouter.DelayedWithdrawalRouterFilterer).ParseDelayedWithdrawalsClaimed),
+	},
+	{
+		Contract:  "DelayedWithdrawalRouter",
+		Name:      "Initialized",
+		Signature: "Initialized(uint8)",
+		ID:        common.HexToHash("0x7f26b83ff96e1f2b6a682f133852f6798a09c465da95921460cefb3847402498"),
+		Topics:    1,
+		Parse:     events.Parser(DelayedWithdrawalRouter.NewDelayedWithdrawalRouterFilterer, (*DelayedWithdrawalRouter.DelayedWithdrawalRouterFilterer).ParseInitialized),
+	},
+	{
+		Contract:  "DelayedWithdrawalRouter",
+		Name:      "OwnershipTransferred",
+		Signature: "OwnershipTransferred(address,address)",
+		ID:        common.HexToHash("0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0"),
+		Topics:    3,
+		Parse:     events.Parser(DelayedWithdrawalRouter.NewDelayedWithdrawalRouterFilterer, (*DelayedWithdrawalRouter.DelayedWithdrawalRouterFilterer).ParseOwnershipTransferred),
+	},
+	{
+		Contract:  "DelayedWithdrawalRouter",
+		Name:      "Paused",
+		Signature: "Paused(address,uint256)",
+		ID:        common.HexToHash("0xab40a374bc51de372200a8bc981af8c9ecdc08dfdaef0bb6e09f88f3c616ef3d"),
+		Topics:    2,
+		Parse:     events.Parser(DelayedWithdrawalRouter.NewDelayedWithdrawalRouterFilterer, (*DelayedWithdrawalRouter.DelayedWithdrawalRouterFilterer).ParsePaused),
+	},
+	{
+		Contract:  "DelayedWithdrawalRouter",
+		Name:      "PauserRegistrySet",
+		Signature: "PauserRegistrySet(address,address)",
+		ID:        common.HexToHash("0x6e9fcd539896fca60e8b0f01dd580233e48a6b0f7df013b89ba7f565869acdb6"),
+		Topics:    1,
+		Parse:     events.Parser(DelayedWithdrawalRouter.NewDelayedWithdrawalRouterFilterer, (*DelayedWithdrawalRouter.DelayedWithdrawalRouterFilterer).ParsePauserRegistrySet),
+	},
+	{
+		Contract:  "DelayedWithdrawalRouter",
+		Name:      "Unpaused",
+		Signature: "Unpaused(address,uint256)",
+		ID:        common.HexToHash("0x3582d1828e26bf56bd801502bc021ac0bc8afb57c826e4986b45593c8fad389c"),
+		Topics:    2,
+		Parse:     events.Parser(DelayedWithdrawalRouter.NewDelayedWithdrawalRouterFilterer, (*DelayedWithdrawalRouter.DelayedWithdrawalRouterFilterer).ParseUnpaused),
+	},
+	{
+		Contract:  "DelayedWithdrawalRouter",
+		Name:      "WithdrawalDelayBlocksSet",
+		Signature: "WithdrawalDelayBlocksSet(uint256,uint256)",
+		ID:        common.HexToHash("0x4ffb00400574147429ee377a5633386321e66d45d8b14676014b5fa393e61e9e"),
+		Topics:    1,
+		Parse:     events.Parser(DelayedWithdrawalRouter.NewDelayedWithdrawalRouterFilterer, (*DelayedWithdrawalRouter.DelayedWithdrawalRouterFilterer).ParseWithdrawalDelayBlocksSet),
+	},
+	{
+		Contract:  "DelegationManager",
+		Name:      "Initialized",
+		Signature: "Initialized(uint8)",
+		ID:        common.HexToHash("0x7f26b83ff96e1f2b6a682f133852f6798a09c465da95921460cefb3847402498"),
+		Topics:    1,
+		Parse:     events.Parser(DelegationManager.NewDelegationManagerFilterer, (*DelegationManager.DelegationManagerFilterer).ParseInitialized),
+	},
+	{
+		Contract:  "DelegationManager",
+		Name:      "MinWithdrawalDelayBlocksSet",
+		Signature: "MinWithdrawalDelayBlocksSet(uint256,uint256)",
+		ID:        common.HexToHash("0xafa003cd76f87ff9d62b35beea889920f33c0c42b8d45b74954d61d50f4b6b69"),
+		Topics:    1,
+		Parse:     events.Parser(DelegationManager.NewDelegationManagerFilterer, (*DelegationManager.DelegationManagerFilterer).ParseMinWithdrawalDelayBlocksSet),
+	},
+	{
+		Contract:  "DelegationManager",
+		Name:      "OperatorDetailsModified",
+		Signature: "OperatorDetailsModified(address,(address,address,uint32))",
+		ID:        common.HexToHash("0xfebe5cd24b2cbc7b065b9d0fdeb904461e4afcff57dd57acda1e7832031ba7ac"),
+		Topics:    2,
+		Parse:     events.Parser(DelegationManager.NewDelegationManagerFilterer, (*DelegationManager.DelegationManagerFilterer).ParseOperatorDetailsModified),
+	},
+	{
+		Contract:  "DelegationManager",
+		Name:      "OperatorMetadataURIUpdated",
+		Signature: "OperatorMetadataURIUpdated(address,string)",
+		ID:        common.HexToHash("0x02a919ed0e2acad1dd90f17ef2fa4ae5462ee1339170034a8531cca4b6708090"),
+		Topics:    2,
+		Parse:     events.Parser(DelegationManager.NewDelegationManagerFilterer, (*DelegationManager.DelegationManagerFilterer).ParseOperatorMetadataURIUpdated),
+	},
+	{
+		Contract:  "DelegationManager",
+		Name:      "OperatorRegistered",
+		Signature: "OperatorRegistered(address,(address,address,uint32))",
+		ID:        common.HexToHash("0x8e8485583a2310d41f7c82b9427d0bd49bad74bb9cff9d3402a29d8f9b28a0e2"),
+		Topics:    2,
+		Parse:     events.Parser(DelegationManager.NewDelegationManagerFilterer, (*DelegationManager.DelegationManagerFilterer).ParseOperatorRegistered),
+	},
+	{
+		Contract:  "DelegationManager",
+		Name:      "OperatorSharesDecreased",
+		Signature: "OperatorSharesDecreased(address,address,address,uint256)",
+		ID:        common.HexToHash("0x6909600037b75d7b4733aedd815442b5ec018a827751c832aaff64eba5d6d2dd"),
+		Topics:    2,
+		Parse:     events.Parser(DelegationManager.NewDelegationManagerFilterer, (*DelegationManager.DelegationManagerFilterer).ParseOperatorSharesDecreased),
+	},
+	{
+		Contract:  "DelegationManager",
+		Name:      "OperatorSharesIncreased",
+		Signature: "OperatorSharesIncreased(address,address,address,uint256)",
+		ID:        common.HexToHash("0x1ec042c965e2edd7107b51188ee0f383e22e76179041ab3a9d18ff151405166c"),
+		Topics:    2,
+		Parse:     events.Parser(DelegationManager.NewDelegationManagerFilterer, (*DelegationManager.DelegationManagerFilterer).ParseOperatorSharesIncreased),
+	},
+	{
+		Contract:  "DelegationManager",
+		Name:      "OwnershipTransferred",
+		Signature: "OwnershipTransferred(address,address)",
+		ID:        common.HexToHash("0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0"),
+		Topics:    3,
+		Parse:     events.Parser(DelegationManager.NewDelegationManagerFilterer, (*DelegationManager.DelegationManagerFilterer).ParseOwnershipTransferred),
+	},
+	{
+		Contract:  "DelegationManager",
+		Name:      "Paused",
+		Signature: "Paused(address,uint256)",
+		ID:        common.HexToHash("0xab40a374bc51de372200a8bc981af8c9ecdc08dfdaef0bb6e09f88f3c616ef3d"),
+		Topics:    2,
+		Parse:     events.Parser(DelegationManager.NewDelegationManagerFilterer, (*DelegationManager.DelegationManagerFilterer).ParsePaused),
+	},
+	{
+		Contract:  "DelegationManager",
+		Name:      "PauserRegistrySet",
+		Signature: "PauserRegistrySet(address,address)",
+		ID:        common.HexToHash("0x6e9fcd539896fca60e8b0f01dd580233e48a6b0f7df013b89ba7f565869acdb6"),
+		Topics:    1,
+		Parse:     events.Parser(DelegationManager.NewDelegationManagerFilterer, (*DelegationManager.DelegationManagerFilterer).ParsePauserRegistrySet),
+	},
+	{
+		Contract:  "DelegationManager",
+		Name:      "StakerDelegated",
+		Signature: "StakerDelegated(address,address)",
+		ID:        common.HexToHash("0xc3ee9f2e5fda98e8066a1f745b2df9285f416fe98cf2559cd21484b3d8743304"),
+		Topics:    3,
+		Parse:     events.Parser(DelegationManager.NewDelegationManagerFilterer, (*DelegationManager.DelegationManagerFilterer).ParseStakerDelegated),
+	},
+	{
+		Contract:  "DelegationManager",
+		Name:      "StakerForceUndelegated",
+		Signature: "StakerForceUndelegated(address,address)",
+		ID:        common.HexToHash("0xf0eddf07e6ea14f388b47e1e94a0f464ecbd9eed4171130e0fc0e99fb4030a8a"),
+		Topics:    3,
+		Parse:     events.Parser(DelegationManager.NewDelegationManagerFilterer, (*DelegationManager.DelegationManagerFilterer).ParseStakerForceUndelegated),
+	},
+	{
+		Contract:  "DelegationManager",
+		Name:      "StakerUndelegated",
+		Signature: "StakerUndelegated(address,address)",
+		ID:        common.HexToHash("0xfee30966a256b71e14bc0ebfc94315e28ef4a97a7131a9e2b7a310a73af44676"),
+		Topics:    3,
+		Parse:     events.Parser(DelegationManager.NewDelegationManagerFilterer, (*DelegationManager.DelegationManagerFilterer).ParseStakerUndelegated),
+	},
+	{
+		Contract:  "DelegationManager",
+		Name:      "StrategyWithdrawalDelayBlocksSet",
+		Signature: "StrategyWithdrawalDelayBlocksSet(address,uint256,uint256)",
+		ID:        common.HexToHash("0x0e7efa738e8b0ce6376a0c1af471655540d2e9a81647d7b09ed823018426576d"),
+		Topics:    1,
+		Parse:     events.Parser(DelegationManager.NewDelegationManagerFilterer, (*DelegationManager.DelegationManagerFilterer).ParseStrategyWithdrawalDelayBlocksSet),
+	},
+	{
+		Contract:  "DelegationManager",
+		Name:      "Unpaused",
+		Signature: "Unpaused(address,uint256)",
+		ID:        common.HexToHash("0x3582d1828e26bf56bd801502bc021ac0bc8afb57c826e4986b45593c8fad389c"),
+		Topics:    2,
+		Parse:     events.Parser(DelegationManager.NewDelegationManagerFilterer, (*DelegationManager.DelegationManagerFilterer).ParseUnpaused),
+	},
+	{
+		Contract:  "DelegationManager",
+		Name:      "WithdrawalCompleted",
+		Signature: "WithdrawalCompleted(bytes32)",
+		ID:        common.HexToHash("0xc97098c2f658800b4df29001527f7324bcdffcf6e8751a699ab920a1eced5b1d"),
+		Topics:    1,
+		Parse:     events.Parser(DelegationManager.NewDelegationManagerFilterer, (*DelegationManager.DelegationManagerFilterer).ParseWithdrawalCompleted),
+	},
+	{
+		Contract:  "DelegationManager",
+		Name:      "WithdrawalQueued",
+		Signature: "WithdrawalQueued(bytes32,(address,address,address,uint256,uint32,address[],uint256[]))",
+		ID:        common.HexToHash("0x9009ab153e8014fbfb02f2217f5cde7aa7f9ad734ae85ca3ee3f4ca2fdd499f9"),
+		Topics:    1,
+		Parse:     events.Parser(DelegationManager.NewDelegationManagerFilterer, (*DelegationManager.DelegationManagerFilterer).ParseWithdrawalQueued),
+	},
+	{
+		Contract:  "Eigen",
+		Name:      "Approval",
+		Signature: "Approval(address,address,uint256)",
+		ID:        common.HexToHash("0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925"),
+		Topics:    3,
+		Parse:     events.Parser(Eigen.NewEigenFilterer, (*Eigen.EigenFilterer).ParseApproval),
+	},
+	{
+		Contract:  "Eigen",
+		Name:      "DelegateChanged",
+		Signature: "DelegateChanged(address,address,address)",
+		ID:        common.HexToHash("0x3134e8a2e6d97e929a7e54011ea5485d7d196dd5f0ba4d4ef95803e8e3fc257f"),
+		Topics:    4,
+		Parse:     events.Parser(Eigen.NewEigenFilterer, (*Eigen.EigenFilterer).ParseDelegateChanged),
+	},
+	{
+		Contract:  "Eigen",
+		Name:      "DelegateVotesChanged",
+		Signature: "DelegateVotesChanged(address,uint256,uint256)",
+		ID:        common.HexToHash("0xdec2bacdd2f05b59de34da9b523dff8be42e5e38e818c82fdb0bae774387a724"),
+		Topics:    2,
+		Parse:     events.Parser(Eigen.NewEigenFilterer, (*Eigen.EigenFilterer).ParseDelegateVotesChanged),
+	},
+	{
+		Contract:  "Eigen",
+		Name:      "EIP712DomainChanged",
+		Signature: "EIP712DomainChanged()",
+		ID:        common.HexToHash("0x0a6387c9ea3628b88a633bb4f3b151770f70085117a15f9bf3787cda53f13d31"),
+		Topics:    1,
+		Parse:     events.Parser(Eigen.NewEigenFilterer, (*Eigen.EigenFilterer).ParseEIP712DomainChanged),
+	},
+	{
+		Contract:  "Eigen",
+		Name:      "Initialized",
+		Signature: "Initialized(uint8)",
+		ID:        common.HexToHash("0x7f26b83ff96e1f2b6a682f133852f6798a09c465da95921460cefb3847402498"),
+		Topics:    1,
+		Parse:     events.Parser(Eigen.NewEigenFilterer, (*Eigen.EigenFilterer).ParseInitialized),
+	},
+	{
+		Contract:  "Eigen",
+		Name:      "Mint",
+		Signature: "Mint(address,uint256)",
+		ID:        common.HexToHash("0x0f6798a560793a54c3bcfe86a93cde1e73087d944c0ea20544137d4121396885"),
+		Topics:    2,
+		Parse:     events.Parser(Eigen.NewEigenFilterer, (*Eigen.EigenFilterer).ParseMint),
+	},
+	{
+		Contract:  "Eigen",
+		Name:      "OwnershipTransferred",
+		Signature: "OwnershipTransferred(address,address)",
+		ID:        common.HexToHash("0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0"),
+		Topics:    3,
+		Parse:     events.Parser(Eigen.NewEigenFilterer, (*Eigen.EigenFilterer).ParseOwnershipTransferred),
+	},
+	{
+		Contract:  "Eigen",
+		Name:      "SetAllowedFrom",
+		Signature: "SetAllowedFrom(address,bool)",
+		ID:        common.HexToHash("0xcf20b1ecb604b0e8888d579c64e8a3b10e590d45c1c2dddb393bed2843622271"),
+		Topics:    2,
+		Parse:     events.Parser(Eigen.NewEigenFilterer, (*Eigen.EigenFilterer).ParseSetAllowedFrom),
+	},
+	{
+		Contract:  "Eigen",
+		Name:      "SetAllowedTo",
+		Signature: "SetAllowedTo(address,bool)",
+		ID:        common.HexToHash("0x72a561d1af7409467dae4f1e9fc52590a9335a1dda17727e2b6aa8c4db35109b"),
+		Topics:    2,
+		Parse:     events.Parser(Eigen.NewEigenFilterer, (*Eigen.EigenFilterer).ParseSetAllowedTo),
+	},
+	{
+		Contract:  "Eigen",
+		Name:      "Transfer",
+		Signature: "Transfer(address,address,uint256)",
+		ID:        common.HexToHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"),
+		Topics:    3,
+		Parse:     events.Parser(Eigen.NewEigenFilterer, (*Eigen.EigenFilterer).ParseTransfer),
+	},
+	{
+		Contract:  "Eigen",
+		Name:      "TransferRestrictionsDisabled",
+		Signature: "TransferRestrictionsDisabled()",
+		ID:        common.HexToHash("0x2b18986d3ba809db2f13a5d7bf17f60d357b37d9cbb55dd71cbbac8dc4060f64"),
+		Topics:    1,
+		Parse:     events.Parser(Eigen.NewEigenFilterer, (*Eigen.EigenFilterer).ParseTransferRestrictionsDisabled),
+	},
+	{
+		Contract:  "EigenPod",
+		Name:      "EigenPodStaked",
+		Signature: "EigenPodStaked(bytes)",
+		ID:        common.HexToHash("0x606865b7934a25d4aed43f6cdb426403353fa4b3009c4d228407474581b01e23"),
+		Topics:    1,
+		Parse:     events.Parser(EigenPod.NewEigenPodFilterer, (*EigenPod.EigenPodFilterer).ParseEigenPodStaked),
+	},
+	{
+		Contract:  "EigenPod",
+		Name:      "FullWithdrawalRedeemed",
+		Signature: "FullWithdrawalRedeemed(uint40,uint64,address,uint64)",
+		ID:        common.HexToHash("0xb76a93bb649ece524688f1a01d184e0bbebcda58eae80c28a898bec3fb5a0963"),
+		Topics:    2,
+		Parse:     events.Parser(EigenPod.NewEigenPodFilterer, (*EigenPod.EigenPodFilterer).ParseFullWithdrawalRedeemed),
+	},
+	{
+		Contract:  "EigenPod",
+		Name:      "Initialized",
+		Signature: "Initialized(uint8)",
+		ID:        common.HexToHash("0x7f26b83ff96e1f2b6a682f133852f6798a09c465da95921460cefb3847402498"),
+		Topics:    1,
+		Parse:     events.Parser(EigenPod.NewEigenPodFilterer, (*EigenPod.EigenPodFilterer).ParseInitialized),
+	},
+	{
+		Contract:  "EigenPod",
+		Name:      "NonBeaconChainETHReceived",
+		Signature: "NonBeaconChainETHReceived(uint256)",
+		ID:        common.HexToHash("0x6fdd3dbdb173299608c0aa9f368735857c8842b581f8389238bf05bd04b3bf49"),
+		Topics:    1,
+		Parse:     events.Parser(EigenPod.NewEigenPodFilterer, (*EigenPod.EigenPodFilterer).ParseNonBeaconChainETHReceived),
+	},
+	{
+		Contract:  "EigenPod",
+		Name:      "NonBeaconChainETHWithdrawn",
+		Signature: "NonBeaconChainETHWithdrawn(address,uint256)",
+		ID:        common.HexToHash("0x30420aacd028abb3c1fd03aba253ae725d6ddd52d16c9ac4cb5742cd43f53096"),
+		Topics:    2,
+		Parse:     events.Parser(EigenPod.NewEigenPodFilterer, (*EigenPod.EigenPodFilterer).ParseNonBeaconChainETHWithdrawn),
+	},
+	{
+		Contract:  "EigenPod",
+		Name:      "PartialWithdrawalRedeemed",
+		Signature: "PartialWithdrawalRedeemed(uint40,uint64,address,uint64)",
+		ID:        common.HexToHash("0x8a7335714231dbd551aaba6314f4a97a14c201e53a3e25e1140325cdf67d7a4e"),
+		Topics:    2,
+		Parse:     events.Parser(EigenPod.NewEigenPodFilterer, (*EigenPod.EigenPodFilterer).ParsePartialWithdrawalRedeemed),
+	},
+	{
+		Contract:  "EigenPod",
+		Name:      "RestakedBeaconChainETHWithdrawn",
+		Signature: "RestakedBeaconChainETHWithdrawn(address,uint256)",
+		ID:        common.HexToHash("0x8947fd2ce07ef9cc302c4e8f0461015615d91ce851564839e91cc804c2f49d8e"),
+		Topics:    2,
+		Parse:     events.Parser(EigenPod.NewEigenPodFilterer, (*EigenPod.EigenPodFilterer).ParseRestakedBeaconChainETHWithdrawn),
+	},
+	{
+		Contract:  "EigenPod",
+		Name:      "RestakingActivated",
+		Signature: "RestakingActivated(address)",
+		ID:        common.HexToHash("0xca8dfc8c5e0a67a74501c072a3325f685259bebbae7cfd230ab85198a78b70cd"),
+		Topics:    2,
+		Parse:     events.Parser(EigenPod.NewEigenPodFilterer, (*EigenPod.EigenPodFilterer).ParseRestakingActivated),
+	},
+	{
+		Contract:  "EigenPod",
+		Name:      "ValidatorBalanceUpdated",
+		Signature: "ValidatorBalanceUpdated(uint40,uint64,uint64)",
+		ID:        common.HexToHash("0x0e5fac175b83177cc047381e030d8fb3b42b37bd1c025e22c280facad62c32df"),
+		Topics:    1,
+		Parse:     events.Parser(EigenPod.NewEigenPodFilterer, (*EigenPod.EigenPodFilterer).ParseValidatorBalanceUpdated),
+	},
+	{
+		Contract:  "EigenPod",
+		Name:      "ValidatorRestaked",
+		Signature: "ValidatorRestaked(uint40)",
+		ID:        common.HexToHash("0x2d0800bbc377ea54a08c5db6a87aafff5e3e9c8fead0eda110e40e0c10441449"),
+		Topics:    1,
+		Parse:     events.Parser(EigenPod.NewEigenPodFilterer, (*EigenPod.EigenPodFilterer).ParseValidatorRestaked),
+	},
+	{
+		Contract:  "EigenPodManager",
+		Name:      "BeaconChainETHDeposited",
+		Signature: "BeaconChainETHDeposited(address,uint256)",
+		ID:        common.HexToHash("0x35a85cabc603f48abb2b71d9fbd8adea7c449d7f0be900ae7a2986ea369c3d0d"),
+		Topics:    2,
+		Parse:     events.Parser(EigenPodManager.NewEigenPodManagerFilterer, (*EigenPodManager.EigenPodManagerFilterer).ParseBeaconChainETHDeposited),
+	},
+	{
+		Contract:  "EigenPodManager",
+		Name:      "BeaconChainETHWithdrawalCompleted",
+		Signature: "BeaconChainETHWithdrawalCompleted(address,uint256,uint96,address,address,bytes32)",
+		ID:        common.HexToHash("0xa6bab1d55a361fcea2eee2bc9491e4f01e6cf333df03c9c4f2c144466429f7d6"),
+		Topics:    2,
+		Parse:     events.Parser(EigenPodManager.NewEigenPodManagerFilterer, (*EigenPodManager.EigenPodManagerFilterer).ParseBeaconChainETHWithdrawalCompleted),
+	},
+	{
+		Contract:  "EigenPodManager",
+		Name:      "BeaconOracleUpdated",
+		Signature: "BeaconOracleUpdated(address)",
+		ID:        common.HexToHash("0x08f0470754946ccfbb446ff7fd2d6ae6af1bbdae19f85794c0cc5ed5e8ceb4f6"),
+		Topics:    2,
+		Parse:     events.Parser(EigenPodManager.NewEigenPodManagerFilterer, (*EigenPodManager.EigenPodManagerFilterer).ParseBeaconOracleUpdated),
+	},
+	{
+		Contract:  "EigenPodManager",
+		Name:      "DenebForkTimestampUpdated",
+		Signature: "DenebForkTimestampUpdated(uint64)",
+		ID:        common.HexToHash("0x19200b6fdad58f91b2f496b0c444fc4be3eff74a7e24b07770e04a7137bfd9db"),
+		Topics:    1,
+		Parse:     events.Parser(EigenPodManager.NewEigenPodManagerFilterer, (*EigenPodManager.EigenPodManagerFilterer).ParseDenebForkTimestampUpdated),
+	},
+	{
+		Contract:  "EigenPodManager",
+		Name:      "Initialized",
+		Signature: "Initialized(uint8)",
+		ID:        common.HexToHash("0x7f26b83ff96e1f2b6a682f133852f6798a09c465da95921460cefb3847402498"),
+		Topics:    1,
+		Parse:     events.Parser(EigenPodManager.NewEigenPodManagerFilterer, (*EigenPodManager.EigenPodManagerFilterer).ParseInitialized),
+	},
+	{
+		Contract:  "EigenPodManager",
+		Name:      "OwnershipTransferred",
+		Signature: "OwnershipTransferred(address,address)",
+		ID:        common.HexToHash("0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0"),
+		Topics:    3,
+		Parse:     events.Parser(EigenPodManager.NewEigenPodManagerFilterer, (*EigenPodManager.EigenPodManagerFilterer).ParseOwnershipTransferred),
+	},
+	{
+		Contract:  "EigenPodManager",
+		Name:      "Paused",
+		Signature: "Paused(address,uint256)",
+		ID:        common.HexToHash("0xab40a374bc51de372200a8bc981af8c9ecdc08dfdaef0bb6e09f88f3c616ef3d"),
+		Topics:    2,
+		Parse:     events.Parser(EigenPodManager.NewEigenPodManagerFilterer, (*EigenPodManager.EigenPodManagerFilterer).ParsePaused),
+	},
+	{
+		Contract:  "EigenPodManager",
+		Name:      "PauserRegistrySet",
+		Signature: "PauserRegistrySet(address,address)",
+		ID:        common.HexToHash("0x6e9fcd539896fca60e8b0f01dd580233e48a6b0f7df013b89ba7f565869acdb6"),
+		Topics:    1,
+		Parse:     events.Parser(EigenPodManager.NewEigenPodManagerFilterer, (*EigenPodManager.EigenPodManagerFilterer).ParsePauserRegistrySet),
+	},
+	{
+		Contract:  "EigenPodManager",
+		Name:      "PodDeployed",
+		Signature: "PodDeployed(address,address)",
+		ID:        common.HexToHash("0x21c99d0db02213c32fff5b05cf0a718ab5f858802b91498f80d82270289d856a"),
+		Topics:    3,
+		Parse:     events.Parser(EigenPodManager.NewEigenPodManagerFilterer, (*EigenPodManager.EigenPodManagerFilterer).ParsePodDeployed),
+	},
+	{
+		Contract:  "EigenPodManager",
+		Name:      "PodSharesUpdated",
+		Signature: "PodSharesUpdated(address,int256)",
+		ID:        common.HexToHash("0x4e2b791dedccd9fb30141b088cabf5c14a8912b52f59375c95c010700b8c6193"),
+		Topics:    2,
+		Parse:     events.Parser(EigenPodManager.NewEigenPodManagerFilterer, (*EigenPodManager.EigenPodManagerFilterer).ParsePodSharesUpdated),
+	},
+	{
+		Contract:  "EigenPodManager",
+		Name:      "Unpaused",
+		Signature: "Unpaused(address,uint256)",
+		ID:        common.HexToHash("0x3582d1828e26bf56bd801502bc021ac0bc8afb57c826e4986b45593c8fad389c"),
+		Topics:    2,
+		Parse:     events.Parser(EigenPodManager.NewEigenPodManagerFilterer, (*EigenPodManager.EigenPodManagerFilterer).ParseUnpaused),
+	},
+	{
+		Contract:  "EigenStrategy",
+		Name:      "Initialized",
+		Signature: "Initialized(uint8)",
+		ID:        common.HexToHash("0x7f26b83ff96e1f2b6a682f133852f6798a09c465da95921460cefb3847402498"),
+		Topics:    1,
+		Parse:     events.Parser(EigenStrategy.NewEigenStrategyFilterer, (*EigenStrategy.EigenStrategyFilterer).ParseInitialized),
+	},
+	{
+		Contract:  "EigenStrategy",
+		Name:      "Paused",
+		Signature: "Paused(address,uint256)",
+		ID:        common.HexToHash("0xab40a374bc51de372200a8bc981af8c9ecdc08dfdaef0bb6e09f88f3c616ef3d"),
+		Topics:    2,
+		Parse:     events.Parser(EigenStrategy.NewEigenStrategyFilterer, (*EigenStrategy.EigenStrategyFilterer).ParsePaused),
+	},
+	{
+		Contract:  "EigenStrategy",
+		Name:      "PauserRegistrySet",
+		Signature: "PauserRegistrySet(address,address)",
+		ID:        common.HexToHash("0x6e9fcd539896fca60e8b0f01dd580233e48a6b0f7df013b89ba7f565869acdb6"),
+		Topics:    1,
+		Parse:     events.Parser(EigenStrategy.NewEigenStrategyFilterer, (*EigenStrategy.EigenStrategyFilterer).ParsePauserRegistrySet),
+	},
+	{
+		Contract:  "EigenStrategy",
+		Name:      "Unpaused",
+		Signature: "Unpaused(address,uint256)",
+		ID:        common.HexToHash("0x3582d1828e26bf56bd801502bc021ac0bc8afb57c826e4986b45593c8fad389c"),
+		Topics:    2,
+		Parse:     events.Parser(EigenStrategy.NewEigenStrategyFilterer, (*EigenStrategy.EigenStrategyFilterer).ParseUnpaused),
+	},
+	{
+		Contract:  "Pausable",
+		Name:      "Paused",
+		Signature: "Paused(address,uint256)",
+		ID:        common.HexToHash("0xab40a374bc51de372200a8bc981af8c9ecdc08dfdaef0bb6e09f88f3c616ef3d"),
+		Topics:    2,
+		Parse:     events.Parser(Pausable.NewPausableFilterer, (*Pausable.PausableFilterer).ParsePaused),
+	},
+	{
+		Contract:  "Pausable",
+		Name:      "PauserRegistrySet",
+		Signature: "PauserRegistrySet(address,address)",
+		ID:        common.HexToHash("0x6e9fcd539896fca60e8b0f01dd580233e48a6b0f7df013b89ba7f565869acdb6"),
+		Topics:    1,
+		Parse:     events.Parser(Pausable.NewPausableFilterer, (*Pausable.PausableFilterer).ParsePauserRegistrySet),
+	},
+	{
+		Contract:  "Pausable",
+		Name:      "Unpaused",
+		Signature: "Unpaused(address,uint256)",
+		ID:        common.HexToHash("0x3582d1828e26bf56bd801502bc021ac0bc8afb57c826e4986b45593c8fad389c"),
+		Topics:    2,
+		Parse:     events.Parser(Pausable.NewPausableFilterer, (*Pausable.PausableFilterer).ParseUnpaused),
+	},
+	{
+		Contract:  "PauserRegistry",
+		Name:      "PauserStatusChanged",
+		Signature: "PauserStatusChanged(address,bool)",
+		ID:        common.HexToHash("0x65d3a1fd4c13f05cba164f80d03ce90fb4b5e21946bfc3ab7dbd434c2d0b9152"),
+		Topics:    1,
+		Parse:     events.Parser(PauserRegistry.NewPauserRegistryFilterer, (*PauserRegistry.PauserRegistryFilterer).ParsePauserStatusChanged),
+	},
+	{
+		Contract:  "PauserRegistry",
+		Name:      "UnpauserChanged",
+		Signature: "UnpauserChanged(address,address)",
+		ID:        common.HexToHash("0x06b4167a2528887a1e97a366eefe8549bfbf1ea3e6ac81cb2564a934d20e8892"),
+		Topics:    1,
+		Parse:     events.Parser(PauserRegistry.NewPauserRegistryFilterer, (*PauserRegistry.PauserRegistryFilterer).ParseUnpauserChanged),
+	},
+	{
+		Contract:  "RewardsCoordinator",
+		Name:      "AVSRewardsSubmissionCreated",
+		Signature: "AVSRewardsSubmissionCreated(address,uint256,bytes32,((address,uint96)[],address,uint256,uint32,uint32))",
+		ID:        common.HexToHash("0x450a367a380c4e339e5ae7340c8464ef27af7781ad9945cfe8abd828f89e6281"),
+		Topics:    4,
+		Parse:     events.Parser(RewardsCoordinator.NewRewardsCoordinatorFilterer, (*RewardsCoordinator.RewardsCoordinatorFilterer).ParseAVSRewardsSubmissionCreated),
+	},
+	{
+		Contract:  "RewardsCoordinator",
+		Name:      "ActivationDelaySet",
+		Signature: "ActivationDelaySet(uint32,uint32)",
+		ID:        common.HexToHash("0xaf557c6c02c208794817a705609cfa935f827312a1adfdd26494b6b95dd2b4b3"),
+		Topics:    1,
+		Parse:     events.Parser(RewardsCoordinator.NewRewardsCoordinatorFilterer, (*RewardsCoordinator.RewardsCoordinatorFilterer).ParseActivationDelaySet),
+	},
+	{
+		Contract:  "RewardsCoordinator",
+		Name:      "ClaimerForSet",
+		Signature: "ClaimerForSet(address,address,address)",
+		ID:        common.HexToHash("0xbab947934d42e0ad206f25c9cab18b5bb6ae144acfb00f40b4e3aa59590ca312"),
+		Topics:    4,
+		Parse:     events.Parser(RewardsCoordinator.NewRewardsCoordinatorFilterer, (*RewardsCoordinator.RewardsCoordinatorFilterer).ParseClaimerForSet),
+	},
+	{
+		Contract:  "RewardsCoordinator",
+		Name:      "DistributionRootDisabled",
+		Signature: "DistributionRootDisabled(uint32)",
+		ID:        common.HexToHash("0xd850e6e5dfa497b72661fa73df2923464eaed9dc2ff1d3cb82bccbfeabe5c41e"),
+		Topics:    2,
+		Parse:     events.Parser(RewardsCoordinator.NewRewardsCoordinatorFilterer, (*RewardsCoordinator.RewardsCoordinatorFilterer).ParseDistributionRootDisabled),
+	},
+	{
+		Contract:  "RewardsCoordinator",
+		Name:      "DistributionRootSubmitted",
+		Signature: "DistributionRootSubmitted(uint32,bytes32,uint32,uint32)",
+		ID:        common.HexToHash("0xecd866c3c158fa00bf34d803d5f6023000b57080bcb48af004c2b4b46b3afd08"),
+		Topics:    4,
+		Parse:     events.Parser(RewardsCoordinator.NewRewardsCoordinatorFilterer, (*RewardsCoordinator.RewardsCoordinatorFilterer).ParseDistributionRootSubmitted),
+	},
+	{
+		Contract:  "RewardsCoordinator",
+		Name:      "GlobalCommissionBipsSet",
+		Signature: "GlobalCommissionBipsSet(uint16,uint16)",
+		ID:        common.HexToHash("0x8cdc428b0431b82d1619763f443a48197db344ba96905f3949643acd1c863a06"),
+		Topics:    1,
+		Parse:     events.Parser(RewardsCoordinator.NewRewardsCoordinatorFilterer, (*RewardsCoordinator.RewardsCoordinatorFilterer).ParseGlobalCommissionBipsSet),
+	},
+	{
+		Contract:  "RewardsCoordinator",
+		Name:      "Initialized",
+		Signature: "Initialized(uint8)",
+		ID:        common.HexToHash("0x7f26b83ff96e1f2b6a682f133852f6798a09c465da95921460cefb3847402498"),
+		Topics:    1,
+		Parse:     events.Parser(RewardsCoordinator.NewRewardsCoordinatorFilterer, (*RewardsCoordinator.RewardsCoordinatorFilterer).ParseInitialized),
+	},
+	{
+		Contract:  "RewardsCoordinator",
+		Name:      "OwnershipTransferred",
+		Signature: "OwnershipTransferred(address,address)",
+		ID:        common.HexToHash("0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0"),
+		Topics:    3,
+		Parse:     events.Parser(RewardsCoordinator.NewRewardsCoordinatorFilterer, (*RewardsCoordinator.RewardsCoordinatorFilterer).ParseOwnershipTransferred),
+	},
+	{
+		Contract:  "RewardsCoordinator",
+		Name:      "Paused",
+		Signature: "Paused(address,uint256)",
+		ID:        common.HexToHash("0xab40a374bc51de372200a8bc981af8c9ecdc08dfdaef0bb6e09f88f3c616ef3d"),
+		Topics:    2,
+		Parse:     events.Parser(RewardsCoordinator.NewRewardsCoordinatorFilterer, (*RewardsCoordinator.RewardsCoordinatorFilterer).ParsePaused),
+	},
+	{
+		Contract:  "RewardsCoordinator",
+		Name:      "PauserRegistrySet",
+		Signature: "PauserRegistrySet(address,address)",
+		ID:        common.HexToHash("0x6e9fcd539896fca60e8b0f01dd580233e48a6b0f7df013b89ba7f565869acdb6"),
+		Topics:    1,
+		Parse:     events.Parser(RewardsCoordinator.NewRewardsCoordinatorFilterer, (*RewardsCoordinator.RewardsCoordinatorFilterer).ParsePauserRegistrySet),
+	},
+	{
+		Contract:  "RewardsCoordinator",
+		Name:      "RewardsClaimed",
+		Signature: "RewardsClaimed(bytes32,address,address,address,address,uint256)",
+		ID:        common.HexToHash("0x9543dbd55580842586a951f0386e24d68a5df99ae29e3b216588b45fd684ce31"),
+		Topics:    4,
+		Parse:     events.Parser(RewardsCoordinator.NewRewardsCoordinatorFilterer, (*RewardsCoordinator.RewardsCoordinatorFilterer).ParseRewardsClaimed),
+	},
+	{
+		Contract:  "RewardsCoordinator",
+		Name:      "RewardsForAllSubmitterSet",
+		Signature: "RewardsForAllSubmitterSet(address,bool,bool)",
+		ID:        common.HexToHash("0x4de6293e668df1398422e1def12118052c1539a03cbfedc145895d48d7685f1c"),
+		Topics:    4,
+		Parse:     events.Parser(RewardsCoordinator.NewRewardsCoordinatorFilterer, (*RewardsCoordinator.RewardsCoordinatorFilterer).ParseRewardsForAllSubmitterSet),
+	},
+	{
+		Contract:  "RewardsCoordinator",
+		Name:      "RewardsSubmissionForAllCreated",
+		Signature: "RewardsSubmissionForAllCreated(address,uint256,bytes32,((address,uint96)[],address,uint256,uint32,uint32))",
+		ID:        common.HexToHash("0x51088b8c89628df3a8174002c2a034d0152fce6af8415d651b2a4734bf270482"),
+		Topics:    4,
+		Parse:     events.Parser(RewardsCoordinator.NewRewardsCoordinatorFilterer, (*RewardsCoordinator.RewardsCoordinatorFilterer).ParseRewardsSubmissionForAllCreated),
+	},
+	{
+		Contract:  "RewardsCoordinator",
+		Name:      "RewardsUpdaterSet",
+		Signature: "RewardsUpdaterSet(address,address)",
+		ID:        common.HexToHash("0x237b82f438d75fc568ebab484b75b01d9287b9e98b490b7c23221623b6705dbb"),
+		Topics:    3,
+		Parse:     events.Parser(RewardsCoordinator.NewRewardsCoordinatorFilterer, (*RewardsCoordinator.RewardsCoordinatorFilterer).ParseRewardsUpdaterSet),
+	},
+	{
+		Contract:  "RewardsCoordinator",
+		Name:      "Unpaused",
+		Signature: "Unpaused(address,uint256)",
+		ID:        common.HexToHash("0x3582d1828e26bf56bd801502bc021ac0bc8afb57c826e4986b45593c8fad389c"),
+		Topics:    2,
+		Parse:     events.Parser(RewardsCoordinator.NewRewardsCoordinatorFilterer, (*RewardsCoordinator.RewardsCoordinatorFilterer).ParseUnpaused),
+	},
+	{
+		Contract:  "StrategyBase",
+		Name:      "Initialized",
+		Signature: "Initialized(uint8)",
+		ID:        common.HexToHash("0x7f26b83ff96e1f2b6a682f133852f6798a09c465da95921460cefb3847402498"),
+		Topics:    1,
+		Parse:     events.Parser(StrategyBase.NewStrategyBaseFilterer, (*StrategyBase.StrategyBaseFilterer).ParseInitialized),
+	},
+	{
+		Contract:  "StrategyBase",
+		Name:      "Paused",
+		Signature: "Paused(address,uint256)",
+		ID:        common.HexToHash("0xab40a374bc51de372200a8bc981af8c9ecdc08dfdaef0bb6e09f88f3c616ef3d"),
+		Topics:    2,
+		Parse:     events.Parser(StrategyBase.NewStrategyBaseFilterer, (*StrategyBase.StrategyBaseFilterer).ParsePaused),
+	},
+	{
+		Contract:  "StrategyBase",
+		Name:      "PauserRegistrySet",
+		Signature: "PauserRegistrySet(address,address)",
+		ID:        common.HexToHash("0x6e9fcd539896fca60e8b0f01dd580233e48a6b0f7df013b89ba7f565869acdb6"),
+		Topics:    1,
+		Parse:     events.Parser(StrategyBase.NewStrategyBaseFilterer, (*StrategyBase.StrategyBaseFilterer).ParsePauserRegistrySet),
+	},
+	{
+		Contract:  "StrategyBase",
+		Name:      "Unpaused",
+		Signature: "Unpaused(address,uint256)",
+		ID:        common.HexToHash("0x3582d1828e26bf56bd801502bc021ac0bc8afb57c826e4986b45593c8fad389c"),
+		Topics:    2,
+		Parse:     events.Parser(StrategyBase.NewStrategyBaseFilterer, (*StrategyBase.StrategyBaseFilterer).ParseUnpaused),
+	},
+	{
+		Contract:  "StrategyBaseTVLLimits",
+		Name:      "Initialized",
+		Signature: "Initialized(uint8)",
+		ID:        common.HexToHash("0x7f26b83ff96e1f2b6a682f133852f6798a09c465da95921460cefb3847402498"),
+		Topics:    1,
+		Parse:     events.Parser(StrategyBaseTVLLimits.NewStrategyBaseTVLLimitsFilterer, (*StrategyBaseTVLLimits.StrategyBaseTVLLimitsFilterer).ParseInitialized),
+	},
+	{
+		Contract:  "StrategyBaseTVLLimits",
+		Name:      "MaxPerDepositUpdated",
+		Signature: "MaxPerDepositUpdated(uint256,uint256)",
+		ID:        common.HexToHash("0xf97ed4e083acac67830025ecbc756d8fe847cdbdca4cee3fe1e128e98b54ecb5"),
+		Topics:    1,
+		Parse:     events.Parser(StrategyBaseTVLLimits.NewStrategyBaseTVLLimitsFilterer, (*StrategyBaseTVLLimits.StrategyBaseTVLLimitsFilterer).ParseMaxPerDepositUpdated),
+	},
+	{
+		Contract:  "StrategyBaseTVLLimits",
+		Name:      "MaxTotalDepositsUpdated",
+		Signature: "MaxTotalDepositsUpdated(uint256,uint256)",
+		ID:        common.HexToHash("0x6ab181e0440bfbf4bacdf2e99674735ce6638005490688c5f994f5399353e452"),
+		Topics:    1,
+		Parse:     events.Parser(StrategyBaseTVLLimits.NewStrategyBaseTVLLimitsFilterer, (*StrategyBaseTVLLimits.StrategyBaseTVLLimitsFilterer).ParseMaxTotalDepositsUpdated),
+	},
+	{
+		Contract:  "StrategyBaseTVLLimits",
+		Name:      "Paused",
+		Signature: "Paused(address,uint256)",
+		ID:        common.HexToHash("0xab40a374bc51de372200a8bc981af8c9ecdc08dfdaef0bb6e09f88f3c616ef3d"),
+		Topics:    2,
+		Parse:     events.Parser(StrategyBaseTVLLimits.NewStrategyBaseTVLLimitsFilterer, (*StrategyBaseTVLLimits.StrategyBaseTVLLimitsFilterer).ParsePaused),
+	},
+	{
+		Contract:  "StrategyBaseTVLLimits",
+		Name:      "PauserRegistrySet",
+		Signature: "PauserRegistrySet(address,address)",
+		ID:        common.HexToHash("0x6e9fcd539896fca60e8b0f01dd580233e48a6b0f7df013b89ba7f565869acdb6"),
+		Topics:    1,
+		Parse:     events.Parser(StrategyBaseTVLLimits.NewStrategyBaseTVLLimitsFilterer, (*StrategyBaseTVLLimits.StrategyBaseTVLLimitsFilterer).ParsePauserRegistrySet),
+	},
+	{
+		Contract:  "StrategyBaseTVLLimits",
+		Name:      "Unpaused",
+		Signature: "Unpaused(address,uint256)",
+		ID:        common.HexToHash("0x3582d1828e26bf56bd801502bc021ac0bc8afb57c826e4986b45593c8fad389c"),
+		Topics:    2,
+		Parse:     events.Parser(StrategyBaseTVLLimits.NewStrategyBaseTVLLimitsFilterer, (*StrategyBaseTVLLimits.StrategyBaseTVLLimitsFilterer).ParseUnpaused),
+	},
+	{
+		Contract:  "StrategyManager",
+		Name:      "Deposit",
+		Signature: "Deposit(address,address,address,uint256)",
+		ID:        common.HexToHash("0x7cfff908a4b583f36430b25d75964c458d8ede8a99bd61be750e97ee1b2f3a96"),
+		Topics:    1,
+		Parse:     events.Parser(StrategyManager.NewStrategyManagerFilterer, (*StrategyManager.StrategyManagerFilterer).ParseDeposit),
+	},
+	{
+		Contract:  "StrategyManager",
+		Name:      "Initialized",
+		Signature: "Initialized(uint8)",
+		ID:        common.HexToHash("0x7f26b83ff96e1f2b6a682f133852f6798a09c465da95921460cefb3847402498"),
+		Topics:    1,
+		Parse:     events.Parser(StrategyManager.NewStrategyManagerFilterer, (*StrategyManager.StrategyManagerFilterer).ParseInitialized),
+	},
+	{
+		Contract:  "StrategyManager",
+		Name:      "OwnershipTransferred",
+		Signature: "OwnershipTransferred(address,address)",
+		ID:        common.HexToHash("0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0"),
+		Topics:    3,
+		Parse:     events.Parser(StrategyManager.NewStrategyManagerFilterer, (*StrategyManager.StrategyManagerFilterer).ParseOwnershipTransferred),
+	},
+	{
+		Contract:  "StrategyManager",
+		Name:      "Paused",
+		Signature: "Paused(address,uint256)",
+		ID:        common.HexToHash("0xab40a374bc51de372200a8bc981af8c9ecdc08dfdaef0bb6e09f88f3c616ef3d"),
+		Topics:    2,
+		Parse:     events.Parser(StrategyManager.NewStrategyManagerFilterer, (*StrategyManager.StrategyManagerFilterer).ParsePaused),
+	},
+	{
+		Contract:  "StrategyManager",
+		Name:      "PauserRegistrySet",
+		Signature: "PauserRegistrySet(address,address)",
+		ID:        common.HexToHash("0x6e9fcd539896fca60e8b0f01dd580233e48a6b0f7df013b89ba7f565869acdb6"),
+		Topics:    1,
+		Parse:     events.Parser(StrategyManager.NewStrategyManagerFilterer, (*StrategyManager.StrategyManagerFilterer).ParsePauserRegistrySet),
+	},
+	{
+		Contract:  "StrategyManager",
+		Name:      "StrategyAddedToDepositWhitelist",
+		Signature: "StrategyAddedToDepositWhitelist(address)",
+		ID:        common.HexToHash("0x0c35b17d91c96eb2751cd456e1252f42a386e524ef9ff26ecc9950859fdc04fe"),
+		Topics:    1,
+		Parse:     events.Parser(StrategyManager.NewStrategyManagerFilterer, (*StrategyManager.StrategyManagerFilterer).ParseStrategyAddedToDepositWhitelist),
+	},
+	{
+		Contract:  "StrategyManager",
+		Name:      "StrategyRemovedFromDepositWhitelist",
+		Signature: "StrategyRemovedFromDepositWhitelist(address)",
+		ID:        common.HexToHash("0x4074413b4b443e4e58019f2855a8765113358c7c72e39509c6af45fc0f5ba030"),
+		Topics:    1,
+		Parse:     events.Parser(StrategyManager.NewStrategyManagerFilterer, (*StrategyManager.StrategyManagerFilterer).ParseStrategyRemovedFromDepositWhitelist),
+	},
+	{
+		Contract:  "StrategyManager",
+		Name:      "StrategyWhitelisterChanged",
+		Signature: "StrategyWhitelisterChanged(address,address)",
+		ID:        common.HexToHash("0x4264275e593955ff9d6146a51a4525f6ddace2e81db9391abcc9d1ca48047d29"),
+		Topics:    1,
+		Parse:     events.Parser(StrategyManager.NewStrategyManagerFilterer, (*StrategyManager.StrategyManagerFilterer).ParseStrategyWhitelisterChanged),
+	},
+	{
+		Contract:  "StrategyManager",
+		Name:      "Unpaused",
+		Signature: "Unpaused(address,uint256)",
+		ID:        common.HexToHash("0x3582d1828e26bf56bd801502bc021ac0bc8afb57c826e4986b45593c8fad389c"),
+		Topics:    2,
+		Parse:     events.Parser(StrategyManager.NewStrategyManagerFilterer, (*StrategyManager.StrategyManagerFilterer).ParseUnpaused),
+	},
+	{
+		Contract:  "StrategyManager",
+		Name:      "UpdatedThirdPartyTransfersForbidden",
+		Signature: "UpdatedThirdPartyTransfersForbidden(address,bool)",
+		ID:        common.HexToHash("0x77d930df4937793473a95024d87a98fd2ccb9e92d3c2463b3dacd65d3e6a5786"),
+		Topics:    1,
+		Parse:     events.Parser(StrategyManager.NewStrategyManagerFilterer, (*StrategyManager.StrategyManagerFilterer).ParseUpdatedThirdPartyTransfersForbidden),
+	},
+}
@@ -0,0 +1,110 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package bindings
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/AVSDirectory"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/AVSDirectoryStorage"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/BackingEigen"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/BeaconChainProofs"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/BytesLib"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/DelayedWithdrawalRouter"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/DelegationManager"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/DelegationManagerStorage"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/EIP1271SignatureUtils"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/Eigen"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/EigenPod"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/EigenPodManager"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/EigenPodManagerStorage"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/EigenPodPausingConstants"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/EigenStrategy"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/Endian"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/IAVSDirectory"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/IBackingEigen"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/IBeaconChainOracle"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/IDelayedWithdrawalRouter"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/IDelegationFaucet"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/IDelegationManager"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/IETHPOSDeposit"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/IEigen"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/IEigenPod"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/IEigenPodManager"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/IPausable"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/IPauserRegistry"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/IRewardsCoordinator"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/ISignatureUtils"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/ISlasher"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/ISocketUpdater"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/IStrategy"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/IStrategyManager"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/IWhitelister"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/Merkle"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/Pausable"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/PauserRegistry"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/RewardsCoordinator"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/RewardsCoordinatorStorage"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/StrategyBase"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/StrategyBaseTVLLimits"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/StrategyManager"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/StrategyManagerStorage"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/StructuredLinkedList"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/UpgradeableSignatureCheckingUtils"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/storage"
+)
+
+// MetaData maps every bound contract name to the MetaData embedded in its
+// binding package.
+var MetaData = map[string]*bind.MetaData{
+	"AVSDirectory":                      AVSDirectory.AVSDirectoryMetaData,
+	"AVSDirectoryStorage":               AVSDirectoryStorage.AVSDirectoryStorageMetaData,
+	"BackingEigen":                      BackingEigen.BackingEigenMetaData,
+	"BeaconChainProofs":                 BeaconChainProofs.BeaconChainProofsMetaData,
+	"BytesLib":                          BytesLib.BytesLibMetaData,
+	"DelayedWithdrawalRouter":           DelayedWithdrawalRouter.DelayedWithdrawalRouterMetaData,
+	"DelegationManager":                 DelegationManager.DelegationManagerMetaData,
+	"DelegationManagerStorage":          DelegationManagerStorage.DelegationManagerStorageMetaData,
+	"EIP1271SignatureUtils":             EIP1271SignatureUtils.EIP1271SignatureUtilsMetaData,
+	"Eigen":                             Eigen.EigenMetaData,
+	"EigenPod":                          EigenPod.EigenPodMetaData,
+	"EigenPodManager":                   EigenPodManager.EigenPodManagerMetaData,
+	"EigenPodManagerStorage":            EigenPodManagerStorage.EigenPodManagerStorageMetaData,
+	"EigenPodPausingConstants":          EigenPodPausingConstants.EigenPodPausingConstantsMetaData,
+	"EigenStrategy":                     EigenStrategy.EigenStrategyMetaData,
+	"Endian":                            Endian.EndianMetaData,
+	"IAVSDirectory":                     IAVSDirectory.IAVSDirectoryMetaData,
+	"IBackingEigen":                     IBackingEigen.IBackingEigenMetaData,
+	"IBeaconChainOracle":                IBeaconChainOracle.IBeaconChainOracleMetaData,
+	"IDelayedWithdrawalRouter":          IDelayedWithdrawalRouter.IDelayedWithdrawalRouterMetaData,
+	"IDelegationFaucet":                 IDelegationFaucet.IDelegationFaucetMetaData,
+	"IDelegationManager":                IDelegationManager.IDelegationManagerMetaData,
+	"IETHPOSDeposit":                    IETHPOSDeposit.IETHPOSDepositMetaData,
+	"IEigen":                            IEigen.IEigenMetaData,
+	"IEigenPod":                         IEigenPod.IEigenPodMetaData,
+	"IEigenPodManager":                  IEigenPodManager.IEigenPodManagerMetaData,
+	"IPausable":                         IPausable.IPausableMetaData,
+	"IPauserRegistry":                   IPauserRegistry.IPauserRegistryMetaData,
+	"IRewardsCoordinator":               IRewardsCoordinator.IRewardsCoordinatorMetaData,
+	"ISignatureUtils":                   ISignatureUtils.ISignatureUtilsMetaData,
+	"ISlasher":                          ISlasher.ISlasherMetaData,
+	"ISocketUpdater":                    ISocketUpdater.ISocketUpdaterMetaData,
+	"IStrategy":                         IStrategy.IStrategyMetaData,
+	"IStrategyManager":                  IStrategyManager.IStrategyManagerMetaData,
+	"IWhitelister":                      IWhitelister.IWhitelisterMetaData,
+	"Merkle":                            Merkle.MerkleMetaData,
+	"Pausable":                          Pausable.PausableMetaData,
+	"PauserRegistry":                    PauserRegistry.PauserRegistryMetaData,
+	"RewardsCoordinator":                RewardsCoordinator.RewardsCoordinatorMetaData,
+	"RewardsCoordinatorStorage":         RewardsCoordinatorStorage.RewardsCoordinatorStorageMetaData,
+	"StrategyBase":                      StrategyBase.StrategyBaseMetaData,
+	"StrategyBaseTVLLimits":             StrategyBaseTVLLimits.StrategyBaseTVLLimitsMetaData,
+	"StrategyManager":                   StrategyManager.StrategyManagerMetaData,
+	"StrategyManagerStorage":            StrategyManagerStorage.StrategyManagerStorageMetaData,
+	"StructuredLinkedList":              StructuredLinkedList.StructuredLinkedListMetaData,
+	"UpgradeableSignatureCheckingUtils": UpgradeableSignatureCheckingUtils.UpgradeableSignatureCheckingUtilsMetaData,
+}
+
+// StorageLayouts maps every bound contract with state variables to the
+// storage layout embedded in its binding package.
+var StorageLayouts = map[string]*storage.Layout{}
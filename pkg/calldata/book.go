@@ -0,0 +1,135 @@
+package calldata
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// MultiSendCallOnly is the address of Safe's MultiSendCallOnly on mainnet,
+// Holesky and most other chains, which operations batch calls through.
+var MultiSendCallOnly = common.HexToAddress("0x40A2aCCbd92BCA938b02010E17A5b8929b49130D")
+
+// Entry names an address of a deployment.
+type Entry struct {
+	// Label is the key the address is listed under, e.g.
+	// "delegationManager" or "stETH".
+	Label string
+	// Path is the full key path, e.g. "addresses.strategies.stETH".
+	Path string
+}
+
+// AddressBook names the addresses of a deployment.
+type AddressBook struct {
+	entries map[common.Address]Entry
+}
+
+// NewAddressBook returns an address book naming only MultiSendCallOnly.
+func NewAddressBook() *AddressBook {
+	b := &AddressBook{entries: make(map[common.Address]Entry)}
+	b.Add(MultiSendCallOnly, "multiSendCallOnly")
+	return b
+}
+
+// LoadAddressBook reads the deployment config or output at path, such as
+// script/configs/mainnet/v0.3.0-eigenlayer-addresses.config.json.
+func LoadAddressBook(path string) (*AddressBook, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	b, err := ParseAddressBook(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return b, nil
+}
+
+// ParseAddressBook names every non-zero address in a deployment JSON file
+// by the key it is listed under. An address listed under several keys is
+// named by the one least nested, preferring object keys over array
+// indices, e.g. "stETH" over "strategyAddresses[0]".
+func ParseAddressBook(data []byte) (*AddressBook, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var root any
+	if err := dec.Decode(&root); err != nil {
+		return nil, fmt.Errorf("invalid deployment json: %w", err)
+	}
+	type found struct {
+		address common.Address
+		entry   Entry
+		depth   int
+		indexed bool
+	}
+	var all []found
+	var walk func(v any, label, path string, depth int, indexed bool)
+	walk = func(v any, label, path string, depth int, indexed bool) {
+		switch v := v.(type) {
+		case map[string]any:
+			keys := make([]string, 0, len(v))
+			for k := range v {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+			for _, k := range keys {
+				walk(v[k], k, strings.TrimPrefix(path+"."+k, "."), depth+1, false)
+			}
+		case []any:
+			for i, elem := range v {
+				walk(elem, fmt.Sprintf("%s[%d]", label, i), fmt.Sprintf("%s[%d]", path, i), depth+1, true)
+			}
+		case string:
+			if len(v) != 2+2*common.AddressLength || !common.IsHexAddress(v) {
+				return
+			}
+			if addr := common.HexToAddress(v); addr != (common.Address{}) {
+				all = append(all, found{addr, Entry{Label: label, Path: path}, depth, indexed})
+			}
+		}
+	}
+	walk(root, "", "", 0, false)
+
+	sort.SliceStable(all, func(i, j int) bool {
+		if all[i].indexed != all[j].indexed {
+			return !all[i].indexed
+		}
+		return all[i].depth < all[j].depth
+	})
+	b := NewAddressBook()
+	seen := make(map[common.Address]bool)
+	for _, f := range all {
+		if !seen[f.address] {
+			seen[f.address] = true
+			b.entries[f.address] = f.entry
+		}
+	}
+	return b, nil
+}
+
+// Add names address, replacing any previous name.
+func (b *AddressBook) Add(address common.Address, label string) {
+	b.entries[address] = Entry{Label: label, Path: label}
+}
+
+// Lookup returns the name of address.
+func (b *AddressBook) Lookup(address common.Address) (Entry, bool) {
+	if b == nil {
+		return Entry{}, false
+	}
+	e, ok := b.entries[address]
+	return e, ok
+}
+
+// Name returns the label of address, or its checksummed hex if unnamed.
+func (b *AddressBook) Name(address common.Address) string {
+	if e, ok := b.Lookup(address); ok {
+		return e.Label
+	}
+	return address.Hex()
+}
@@ -0,0 +1,341 @@
+// Package calldata decodes the input of transactions to the EigenLayer
+// contracts into readable calls, for multisig review and incident response:
+//
+//	book, _ := calldata.LoadAddressBook("script/configs/mainnet/v0.3.0-eigenlayer-addresses.config.json")
+//	dec, _ := calldata.NewDecoder(bindings.Latest, book)
+//	call, err := dec.Decode(&to, nil, input)
+//	fmt.Println(call) // DelegationManager.queueWithdrawals([{strategies: [stETH], ...}])
+//
+// Calls wrapped by the Safe multisigs, MultiSendCallOnly, the Timelock, the
+// ProxyAdmin and the callAddress functions of IWhitelister and
+// IDelegationFaucet are decoded recursively.
+package calldata
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings"
+)
+
+// ErrUnknownSelector is returned for calldata whose selector is not that of
+// a function of any bound or wrapper contract.
+var ErrUnknownSelector = errors.New("unknown selector")
+
+// Call is a decoded call.
+type Call struct {
+	// To is the address called, nil if not known.
+	To *common.Address
+	// Value is the wei sent with the call, nil if not known.
+	Value *big.Int
+	// DelegateCall is set for a call a Safe or MultiSend delegates.
+	DelegateCall bool
+	Data         []byte
+
+	// Contract is the name of the contract whose ABI the call was decoded
+	// with. It, Method and Args are empty for a nested call whose data could
+	// not be decoded.
+	Contract string
+	Method   *abi.Method
+	Args     []any
+
+	// Calls are the calls decoded from the arguments of this one, such as
+	// the data of Safe.execTransaction or the transactions of multiSend.
+	Calls []*Call
+	// Arg is the index of the argument of the parent call this one was
+	// decoded from.
+	Arg int
+
+	book *AddressBook
+}
+
+// candidate is a function of a contract.
+type candidate struct {
+	contract string
+	method   *abi.Method
+}
+
+// Decoder decodes calls against the ABIs of a binding set and of Wrappers,
+// naming addresses with an address book. It is safe for concurrent use.
+type Decoder struct {
+	book       *AddressBook
+	abis       map[string]*abi.ABI
+	names      map[string]string // lower-cased name -> contract
+	bySelector map[[4]byte][]candidate
+}
+
+// NewDecoder returns a decoder of the contracts of set. book may be nil.
+func NewDecoder(set bindings.Set, book *AddressBook) (*Decoder, error) {
+	d := &Decoder{
+		book:       book,
+		abis:       make(map[string]*abi.ABI),
+		names:      make(map[string]string),
+		bySelector: make(map[[4]byte][]candidate),
+	}
+	add := func(name, abiJSON string) error {
+		parsed, err := abi.JSON(strings.NewReader(abiJSON))
+		if err != nil {
+			return fmt.Errorf("failed to parse abi of %s: %w", name, err)
+		}
+		d.abis[name] = &parsed
+		d.names[strings.ToLower(name)] = name
+		return nil
+	}
+	for name, md := range set.MetaData {
+		if err := add(name, md.ABI); err != nil {
+			return nil, err
+		}
+	}
+	for name, abiJSON := range Wrappers {
+		if _, ok := d.abis[name]; ok {
+			continue
+		}
+		if err := add(name, abiJSON); err != nil {
+			return nil, err
+		}
+	}
+	for name, parsed := range d.abis {
+		for _, m := range parsed.Methods {
+			m := m
+			var sel [4]byte
+			copy(sel[:], m.ID)
+			d.bySelector[sel] = append(d.bySelector[sel], candidate{name, &m})
+		}
+	}
+	for sel, cs := range d.bySelector {
+		d.bySelector[sel] = d.rank(cs)
+	}
+	return d, nil
+}
+
+// family folds an interface or storage contract into the contract
+// implementing it, e.g. IDelegationManager and DelegationManagerStorage into
+// DelegationManager.
+func (d *Decoder) family(name string) string {
+	if base := strings.TrimSuffix(name, "Storage"); base != name && d.abis[base] != nil {
+		return base
+	}
+	if len(name) > 2 && name[0] == 'I' && name[1] >= 'A' && name[1] <= 'Z' && d.abis[name[1:]] != nil {
+		return name[1:]
+	}
+	return name
+}
+
+// rank orders the contracts declaring a selector by how specific they are:
+// the contract with the fewest functions first, after folding families, so
+// that pause(uint256) is labeled Pausable rather than one of the many
+// contracts inheriting it.
+func (d *Decoder) rank(cs []candidate) []candidate {
+	seen := make(map[string]bool)
+	var out []candidate
+	for _, c := range cs {
+		if f := d.family(c.contract); !seen[f] {
+			seen[f] = true
+			if m, ok := d.abis[f].Methods[c.method.Name]; ok && m.Sig == c.method.Sig {
+				c = candidate{f, &m}
+			}
+			out = append(out, c)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		ni, nj := len(d.abis[out[i].contract].Methods), len(d.abis[out[j].contract].Methods)
+		if ni != nj {
+			return ni < nj
+		}
+		return out[i].contract < out[j].contract
+	})
+	return out
+}
+
+// aliases maps the lower-cased labels of the deployment configs that do not
+// name their contract to it.
+var aliases = map[string]string{
+	"delegation":           "DelegationManager",
+	"eigenlayerpauserreg":  "PauserRegistry",
+	"eigenlayerproxyadmin": "ProxyAdmin",
+	"tokenproxyadmin":      "ProxyAdmin",
+	"eigenpodbeacon":       "UpgradeableBeacon",
+	"basestrategy":         "StrategyBaseTVLLimits",
+	"beigen":               "BackingEigen",
+}
+
+// Contract returns the name of the contract deployed at address, as far as
+// its label in the address book tells.
+func (d *Decoder) Contract(address common.Address) (string, bool) {
+	e, ok := d.book.Lookup(address)
+	if !ok {
+		return "", false
+	}
+	if strings.HasPrefix(e.Path, "addresses.strategies.") || strings.Contains(e.Path, "strategyAddresses[") {
+		return "StrategyBaseTVLLimits", true
+	}
+	if strings.HasSuffix(e.Label, "Multisig") {
+		return "Safe", true
+	}
+	label := strings.ToLower(e.Label)
+	for _, suffix := range []string{"implementation", "impl"} {
+		label = strings.TrimSuffix(label, suffix)
+	}
+	if name, ok := aliases[label]; ok {
+		return name, true
+	}
+	name, ok := d.names[label]
+	return name, ok
+}
+
+// Decode decodes the input of a call of value wei to address to. to and
+// value may be nil; without to, the contract is told by the selector alone.
+func (d *Decoder) Decode(to *common.Address, value *big.Int, data []byte) (*Call, error) {
+	c := d.decode(to, to, value, data)
+	if c.Method == nil {
+		if len(data) < 4 {
+			return nil, fmt.Errorf("%w: calldata of %d bytes", ErrUnknownSelector, len(data))
+		}
+		return nil, fmt.Errorf("%w: %#x", ErrUnknownSelector, data[:4])
+	}
+	return c, nil
+}
+
+// decode decodes a call to to with the ABI of the contract at abiOf, falling
+// back to every ABI declaring the selector. The returned call is left raw if
+// none decodes it.
+func (d *Decoder) decode(to, abiOf *common.Address, value *big.Int, data []byte) *Call {
+	c := &Call{To: to, Value: value, Data: data, book: d.book}
+	if len(data) < 4 {
+		return c
+	}
+	var sel [4]byte
+	copy(sel[:], data)
+	candidates := d.bySelector[sel]
+	for _, at := range []*common.Address{abiOf, to} {
+		if at == nil {
+			continue
+		}
+		if name, ok := d.Contract(*at); ok {
+			if m, err := d.abis[name].MethodById(data); err == nil {
+				candidates = append([]candidate{{name, m}}, candidates...)
+				break
+			}
+		}
+	}
+	for _, cand := range candidates {
+		args, err := cand.method.Inputs.Unpack(data[4:])
+		if err != nil {
+			continue
+		}
+		c.Contract, c.Method, c.Args = cand.contract, cand.method, args
+		break
+	}
+	if c.Method == nil {
+		return c
+	}
+	if unwrap, ok := unwrappers[c.Method.Sig]; ok {
+		inner, err := unwrap(c)
+		if err != nil {
+			// Leave the argument as raw bytes.
+			return c
+		}
+		for _, in := range inner {
+			nested := d.decode(in.to, in.abiOf, in.value, in.data)
+			nested.Arg, nested.DelegateCall = in.arg, in.delegate
+			c.Calls = append(c.Calls, nested)
+		}
+	}
+	return c
+}
+
+// inner is a call wrapped in an argument of another.
+type inner struct {
+	arg      int
+	to       *common.Address
+	abiOf    *common.Address
+	value    *big.Int
+	data     []byte
+	delegate bool
+}
+
+// unwrappers extract the calls wrapped by a function, keyed by its
+// signature.
+var unwrappers = map[string]func(c *Call) ([]inner, error){
+	// IWhitelister and IDelegationFaucet.
+	"callAddress(address,bytes)": func(c *Call) ([]inner, error) {
+		to := c.Args[0].(common.Address)
+		return []inner{{arg: 1, to: &to, abiOf: &to, data: c.Args[1].([]byte)}}, nil
+	},
+	// ProxyAdmin: the call is made to the proxy, with the implementation's
+	// ABI.
+	"upgradeAndCall(address,address,bytes)": func(c *Call) ([]inner, error) {
+		proxy, impl := c.Args[0].(common.Address), c.Args[1].(common.Address)
+		return []inner{{arg: 2, to: &proxy, abiOf: &impl, data: c.Args[2].([]byte)}}, nil
+	},
+	// TransparentUpgradeableProxy, called by its admin.
+	"upgradeToAndCall(address,bytes)": func(c *Call) ([]inner, error) {
+		impl := c.Args[0].(common.Address)
+		return []inner{{arg: 1, to: c.To, abiOf: &impl, data: c.Args[1].([]byte)}}, nil
+	},
+	"execTransaction(address,uint256,bytes,uint8,uint256,uint256,uint256,address,address,bytes)": func(c *Call) ([]inner, error) {
+		to := c.Args[0].(common.Address)
+		return []inner{{
+			arg:      2,
+			to:       &to,
+			abiOf:    &to,
+			value:    c.Args[1].(*big.Int),
+			data:     c.Args[2].([]byte),
+			delegate: c.Args[3].(uint8) == 1,
+		}}, nil
+	},
+	"queueTransaction(address,uint256,string,bytes,uint256)":   timelocked,
+	"executeTransaction(address,uint256,string,bytes,uint256)": timelocked,
+	"cancelTransaction(address,uint256,string,bytes,uint256)":  timelocked,
+	"multiSend(bytes)": func(c *Call) ([]inner, error) {
+		return unpackMultiSend(c.Args[0].([]byte))
+	},
+}
+
+// timelocked extracts the call a Timelock transaction makes: its data,
+// prefixed with the selector of its signature unless that is empty.
+func timelocked(c *Call) ([]inner, error) {
+	to := c.Args[0].(common.Address)
+	data := c.Args[3].([]byte)
+	if sig := c.Args[2].(string); sig != "" {
+		data = append(crypto.Keccak256([]byte(sig))[:4], data...)
+	}
+	return []inner{{arg: 3, to: &to, abiOf: &to, value: c.Args[1].(*big.Int), data: data}}, nil
+}
+
+// unpackMultiSend splits the transactions of multiSend, each packed as the
+// operation (1 byte), to (20 bytes), value (32 bytes), data length (32
+// bytes) and data.
+func unpackMultiSend(packed []byte) ([]inner, error) {
+	const header = 1 + common.AddressLength + 32 + 32
+	var out []inner
+	for len(packed) > 0 {
+		if len(packed) < header {
+			return nil, fmt.Errorf("truncated multiSend transaction %d", len(out))
+		}
+		to := common.BytesToAddress(packed[1 : 1+common.AddressLength])
+		value := new(big.Int).SetBytes(packed[21:53])
+		size := new(big.Int).SetBytes(packed[53:85])
+		if !size.IsUint64() || size.Uint64() > uint64(len(packed)-header) {
+			return nil, fmt.Errorf("truncated multiSend transaction %d", len(out))
+		}
+		n := int(binary.BigEndian.Uint64(packed[77:85]))
+		out = append(out, inner{
+			to:       &to,
+			abiOf:    &to,
+			value:    value,
+			data:     packed[header : header+n],
+			delegate: packed[0] == 1,
+		})
+		packed = packed[header+n:]
+	}
+	return out, nil
+}
@@ -0,0 +1,229 @@
+package calldata_test
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/calldata"
+)
+
+// Addresses of the mainnet deployment.
+var (
+	delegationManager    = common.HexToAddress("0x39053D51B77DC0d36036Fc1fCc8Cb819df8Ef37A")
+	strategyManager      = common.HexToAddress("0x858646372CC42E1A627fcE94aa7A7033e7CF075A")
+	eigenPodManager      = common.HexToAddress("0x91E677b07F7AF907ec9a428aafA9fc14a0d3A338")
+	eigenPodManagerImpl  = common.HexToAddress("0xe4297e3dadbc7d99e26a2954820f514cb50c5762")
+	eigenLayerProxyAdmin = common.HexToAddress("0x8b9566AdA63B64d1E1dcF1418b43fd1433b72444")
+	executorMultisig     = common.HexToAddress("0x369e6F597e22EaB55fFb173C6d9cD234BD699111")
+	stETHStrategy        = common.HexToAddress("0x93c4b944D05dfe6df7645A86cd2206016c51564D")
+	eigenLayerPauserReg  = common.HexToAddress("0x0c431C66F4dE941d089625E5B423D00707977060")
+	beaconOracle         = common.HexToAddress("0x343907185b71aDF0eBa9567538314396aa985442")
+	staker               = common.HexToAddress("0x1000")
+)
+
+func newDecoder(t *testing.T) *calldata.Decoder {
+	t.Helper()
+	book, err := calldata.LoadAddressBook("../../script/configs/mainnet/v0.3.0-eigenlayer-addresses.config.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	dec, err := calldata.NewDecoder(bindings.Latest, book)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return dec
+}
+
+// pack returns the calldata of method of the bound or wrapper contract.
+func pack(t *testing.T, contract, method string, args ...any) []byte {
+	t.Helper()
+	var (
+		parsed *abi.ABI
+		err    error
+	)
+	if md, ok := bindings.Latest.MetaData[contract]; ok {
+		parsed, err = md.GetAbi()
+	} else {
+		var a abi.ABI
+		a, err = abi.JSON(strings.NewReader(calldata.Wrappers[contract]))
+		parsed = &a
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := parsed.Pack(method, args...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+// multiSend packs the transactions of a multiSend, calls to each of to
+// with the data of the same index.
+func multiSend(to []common.Address, data [][]byte) []byte {
+	var packed []byte
+	for i := range to {
+		packed = append(packed, 0)
+		packed = append(packed, to[i].Bytes()...)
+		packed = append(packed, common.LeftPadBytes(nil, 32)...)
+		packed = append(packed, common.LeftPadBytes(big.NewInt(int64(len(data[i]))).Bytes(), 32)...)
+		packed = append(packed, data[i]...)
+	}
+	return packed
+}
+
+// execTransaction returns the calldata of a Safe transaction making a call
+// of data to to, delegated if delegate is set.
+func execTransaction(t *testing.T, to common.Address, data []byte, delegate bool) []byte {
+	var operation uint8
+	if delegate {
+		operation = 1
+	}
+	return pack(t, "Safe", "execTransaction", to, new(big.Int), data, operation,
+		new(big.Int), new(big.Int), new(big.Int), common.Address{}, common.Address{}, []byte{})
+}
+
+func TestDecode(t *testing.T) {
+	tests := []struct {
+		name string
+		// to is the address called, unknown if nil.
+		to   *common.Address
+		data func(t *testing.T) []byte
+		want string
+		err  string
+	}{
+		{
+			name: "core call",
+			to:   &delegationManager,
+			data: func(t *testing.T) []byte {
+				return pack(t, "DelegationManager", "undelegate", staker)
+			},
+			want: "DelegationManager.undelegate(0x0000000000000000000000000000000000001000)",
+		},
+		{
+			name: "core call naming a strategy",
+			to:   &strategyManager,
+			data: func(t *testing.T) []byte {
+				return pack(t, "StrategyManager", "removeStrategiesFromDepositWhitelist", []common.Address{stETHStrategy})
+			},
+			want: "StrategyManager.removeStrategiesFromDepositWhitelist([stETH])",
+		},
+		{
+			name: "core call to an unknown address",
+			data: func(t *testing.T) []byte {
+				return pack(t, "DelegationManager", "undelegate", staker)
+			},
+			want: "DelegationManager.undelegate(0x0000000000000000000000000000000000001000)",
+		},
+		{
+			name: "selector of many contracts to an unknown address",
+			data: func(t *testing.T) []byte { return pack(t, "StrategyManager", "pause", big.NewInt(1)) },
+			want: "Pausable.pause(1)",
+		},
+		{
+			name: "selector of many contracts to a known address",
+			to:   &strategyManager,
+			data: func(t *testing.T) []byte { return pack(t, "StrategyManager", "pause", big.NewInt(1)) },
+			want: "StrategyManager.pause(1)",
+		},
+		{
+			name: "upgrade and call",
+			to:   &eigenLayerProxyAdmin,
+			data: func(t *testing.T) []byte {
+				initialize := pack(t, "EigenPodManager", "initialize", beaconOracle, executorMultisig, eigenLayerPauserReg, big.NewInt(0))
+				return pack(t, "ProxyAdmin", "upgradeAndCall", eigenPodManager, eigenPodManagerImpl, initialize)
+			},
+			want: `ProxyAdmin(eigenLayerProxyAdmin).upgradeAndCall(
+	eigenPodManager,
+	eigenPodManagerImplementation,
+	EigenPodManager.initialize(beaconOracle, executorMultisig, eigenLayerPauserReg, 0),
+)`,
+		},
+		{
+			name: "multisend through a safe",
+			to:   &executorMultisig,
+			data: func(t *testing.T) []byte {
+				batch := multiSend(
+					[]common.Address{delegationManager, strategyManager},
+					[][]byte{
+						pack(t, "DelegationManager", "unpause", big.NewInt(0)),
+						pack(t, "StrategyManager", "removeStrategiesFromDepositWhitelist", []common.Address{stETHStrategy}),
+					},
+				)
+				return execTransaction(t, calldata.MultiSendCallOnly, pack(t, "MultiSendCallOnly", "multiSend", batch), true)
+			},
+			want: `Safe(executorMultisig).execTransaction(
+	multiSendCallOnly,
+	0,
+	delegatecall MultiSendCallOnly.multiSend(
+		[
+			DelegationManager.unpause(0),
+			StrategyManager.removeStrategiesFromDepositWhitelist([stETH]),
+		],
+	),
+	1,
+	0,
+	0,
+	0,
+	0x0000000000000000000000000000000000000000,
+	0x0000000000000000000000000000000000000000,
+	0x,
+)`,
+		},
+		{
+			name: "multisend of an unknown call",
+			data: func(t *testing.T) []byte {
+				batch := multiSend([]common.Address{delegationManager}, [][]byte{{0xde, 0xad, 0xbe, 0xef}})
+				return pack(t, "MultiSendCallOnly", "multiSend", batch)
+			},
+			want: `MultiSendCallOnly.multiSend(
+	[
+		delegationManager.call(0xdeadbeef),
+	],
+)`,
+		},
+		{
+			name: "truncated multisend",
+			data: func(t *testing.T) []byte {
+				batch := multiSend([]common.Address{delegationManager}, [][]byte{pack(t, "DelegationManager", "unpause", big.NewInt(0))})
+				return pack(t, "MultiSendCallOnly", "multiSend", batch[:50])
+			},
+			// The transactions are left as bytes.
+			want: "MultiSendCallOnly.multiSend(0x0039053d51b77dc0d36036fc1fcc8cb819df8ef37a" + strings.Repeat("00", 29) + ")",
+		},
+		{
+			name: "unknown selector",
+			to:   &delegationManager,
+			data: func(t *testing.T) []byte { return []byte{0xde, 0xad, 0xbe, 0xef} },
+			err:  "unknown selector: 0xdeadbeef",
+		},
+		{
+			name: "short calldata",
+			data: func(t *testing.T) []byte { return []byte{0xde} },
+			err:  "unknown selector: calldata of 1 bytes",
+		},
+	}
+	dec := newDecoder(t)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			call, err := dec.Decode(tt.to, nil, tt.data(t))
+			switch {
+			case tt.err == "" && err != nil:
+				t.Fatal(err)
+			case tt.err != "" && (err == nil || err.Error() != tt.err || !errors.Is(err, calldata.ErrUnknownSelector)):
+				t.Fatalf("err = %v, want %q", err, tt.err)
+			case tt.err != "":
+				return
+			}
+			if got := call.String(); got != tt.want {
+				t.Errorf("decoded\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
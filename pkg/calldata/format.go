@@ -0,0 +1,133 @@
+package calldata
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// String renders the call with its arguments in order, structs as
+// {name: value}, addresses by their names in the address book and wrapped
+// calls in place of the bytes they were decoded from, e.g.
+//
+//	DelegationManager.queueWithdrawals([{strategies: [stETH], shares: [1000000000000000000], withdrawer: 0x...}])
+func (c *Call) String() string {
+	var b strings.Builder
+	c.format(&b, "")
+	return b.String()
+}
+
+// header renders the contract and method of the call, e.g.
+// "StrategyBaseTVLLimits(stETH).deposit".
+func (c *Call) header() string {
+	var b strings.Builder
+	if c.DelegateCall {
+		b.WriteString("delegatecall ")
+	}
+	target := ""
+	if c.To != nil {
+		target = c.book.Name(*c.To)
+	}
+	switch {
+	case c.Contract == "":
+		b.WriteString(target)
+	case target == "" || strings.EqualFold(target, c.Contract):
+		b.WriteString(c.Contract)
+	default:
+		fmt.Fprintf(&b, "%s(%s)", c.Contract, target)
+	}
+	b.WriteByte('.')
+	switch {
+	case c.Method != nil:
+		b.WriteString(c.Method.RawName)
+	case len(c.Data) == 0:
+		b.WriteString("receive")
+	default:
+		b.WriteString("call")
+	}
+	if c.Value != nil && c.Value.Sign() != 0 {
+		fmt.Fprintf(&b, "{value: %s}", c.Value)
+	}
+	return b.String()
+}
+
+func (c *Call) format(b *strings.Builder, indent string) {
+	b.WriteString(c.header())
+	if c.Method == nil {
+		if len(c.Data) == 0 {
+			b.WriteString("()")
+		} else {
+			fmt.Fprintf(b, "(%s)", hexutil.Encode(c.Data))
+		}
+		return
+	}
+	args := make([]string, len(c.Args))
+	for i, arg := range c.Args {
+		args[i] = c.formatValue(c.Method.Inputs[i].Type, reflect.ValueOf(arg))
+	}
+	if len(c.Calls) == 0 {
+		fmt.Fprintf(b, "(%s)", strings.Join(args, ", "))
+		return
+	}
+
+	// Wrapped calls are rendered one argument per line.
+	nested := indent + "\t"
+	b.WriteString("(\n")
+	for i, arg := range args {
+		b.WriteString(nested)
+		var calls []*Call
+		for _, call := range c.Calls {
+			if call.Arg == i {
+				calls = append(calls, call)
+			}
+		}
+		switch {
+		case len(calls) == 0:
+			b.WriteString(arg)
+		case c.Method.Sig == "multiSend(bytes)":
+			b.WriteString("[\n")
+			for _, call := range calls {
+				b.WriteString(nested + "\t")
+				call.format(b, nested+"\t")
+				b.WriteString(",\n")
+			}
+			b.WriteString(nested + "]")
+		default:
+			calls[0].format(b, nested)
+		}
+		b.WriteString(",\n")
+	}
+	b.WriteString(indent + ")")
+}
+
+func (c *Call) formatValue(t abi.Type, v reflect.Value) string {
+	switch t.T {
+	case abi.AddressTy:
+		return c.book.Name(v.Interface().(common.Address))
+	case abi.BytesTy:
+		return hexutil.Encode(v.Bytes())
+	case abi.FixedBytesTy:
+		buf := make([]byte, v.Len())
+		reflect.Copy(reflect.ValueOf(buf), v)
+		return hexutil.Encode(buf)
+	case abi.StringTy:
+		return fmt.Sprintf("%q", v.String())
+	case abi.SliceTy, abi.ArrayTy:
+		elems := make([]string, v.Len())
+		for i := range elems {
+			elems[i] = c.formatValue(*t.Elem, v.Index(i))
+		}
+		return "[" + strings.Join(elems, ", ") + "]"
+	case abi.TupleTy:
+		fields := make([]string, len(t.TupleElems))
+		for i, elem := range t.TupleElems {
+			fields[i] = t.TupleRawNames[i] + ": " + c.formatValue(*elem, v.Field(i))
+		}
+		return "{" + strings.Join(fields, ", ") + "}"
+	}
+	return fmt.Sprint(v.Interface())
+}
@@ -0,0 +1,50 @@
+package calldata
+
+// Wrappers holds the ABIs of the contracts outside this repository that
+// governance and operations transactions are routed through, as declared in
+// script/utils: the Safe multisigs, the MultiSendCallOnly batching calls
+// and the Compound style Timelock. It also holds the admin functions of the
+// OpenZeppelin 4.7 proxies the deployments sit behind, for binding sets that
+// do not bind them; a binding of the same name takes precedence.
+var Wrappers = map[string]string{
+	"Safe": `[{"type":"function","name":"execTransaction","stateMutability":"payable","inputs":[
+		{"name":"to","type":"address"},{"name":"value","type":"uint256"},{"name":"data","type":"bytes"},
+		{"name":"operation","type":"uint8"},{"name":"safeTxGas","type":"uint256"},{"name":"baseGas","type":"uint256"},
+		{"name":"gasPrice","type":"uint256"},{"name":"gasToken","type":"address"},{"name":"refundReceiver","type":"address"},
+		{"name":"signatures","type":"bytes"}],"outputs":[{"name":"success","type":"bool"}]}]`,
+	"MultiSendCallOnly": `[{"type":"function","name":"multiSend","stateMutability":"payable","inputs":[
+		{"name":"transactions","type":"bytes"}],"outputs":[]}]`,
+	"Timelock": `[
+		{"type":"function","name":"queueTransaction","stateMutability":"nonpayable","inputs":[
+			{"name":"target","type":"address"},{"name":"value","type":"uint256"},{"name":"signature","type":"string"},
+			{"name":"data","type":"bytes"},{"name":"eta","type":"uint256"}],"outputs":[{"name":"","type":"bytes32"}]},
+		{"type":"function","name":"executeTransaction","stateMutability":"payable","inputs":[
+			{"name":"target","type":"address"},{"name":"value","type":"uint256"},{"name":"signature","type":"string"},
+			{"name":"data","type":"bytes"},{"name":"eta","type":"uint256"}],"outputs":[{"name":"","type":"bytes"}]},
+		{"type":"function","name":"cancelTransaction","stateMutability":"nonpayable","inputs":[
+			{"name":"target","type":"address"},{"name":"value","type":"uint256"},{"name":"signature","type":"string"},
+			{"name":"data","type":"bytes"},{"name":"eta","type":"uint256"}],"outputs":[]}]`,
+	"ProxyAdmin": `[
+		{"type":"function","name":"upgrade","stateMutability":"nonpayable","inputs":[
+			{"name":"proxy","type":"address"},{"name":"implementation","type":"address"}],"outputs":[]},
+		{"type":"function","name":"upgradeAndCall","stateMutability":"payable","inputs":[
+			{"name":"proxy","type":"address"},{"name":"implementation","type":"address"},{"name":"data","type":"bytes"}],"outputs":[]},
+		{"type":"function","name":"changeProxyAdmin","stateMutability":"nonpayable","inputs":[
+			{"name":"proxy","type":"address"},{"name":"newAdmin","type":"address"}],"outputs":[]},
+		{"type":"function","name":"transferOwnership","stateMutability":"nonpayable","inputs":[
+			{"name":"newOwner","type":"address"}],"outputs":[]},
+		{"type":"function","name":"renounceOwnership","stateMutability":"nonpayable","inputs":[],"outputs":[]}]`,
+	"TransparentUpgradeableProxy": `[
+		{"type":"function","name":"upgradeTo","stateMutability":"nonpayable","inputs":[
+			{"name":"newImplementation","type":"address"}],"outputs":[]},
+		{"type":"function","name":"upgradeToAndCall","stateMutability":"payable","inputs":[
+			{"name":"newImplementation","type":"address"},{"name":"data","type":"bytes"}],"outputs":[]},
+		{"type":"function","name":"changeAdmin","stateMutability":"nonpayable","inputs":[
+			{"name":"newAdmin","type":"address"}],"outputs":[]}]`,
+	"UpgradeableBeacon": `[
+		{"type":"function","name":"upgradeTo","stateMutability":"nonpayable","inputs":[
+			{"name":"newImplementation","type":"address"}],"outputs":[]},
+		{"type":"function","name":"transferOwnership","stateMutability":"nonpayable","inputs":[
+			{"name":"newOwner","type":"address"}],"outputs":[]},
+		{"type":"function","name":"renounceOwnership","stateMutability":"nonpayable","inputs":[],"outputs":[]}]`,
+}
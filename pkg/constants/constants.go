@@ -0,0 +1,387 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+// Package constants exports the internal constants of the EigenLayer
+// contracts, which never reach an ABI and so have no binding. Each Pausable
+// contract's pause flags are typed, so that
+// DelegationManagerPausedEnterWithdrawalQueue.Mask() is the paused status
+// to pass to Pause.
+package constants
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// Internal constants of BeaconChainProofs.
+const (
+	BeaconChainProofsBeaconBlockBodyFieldTreeHeight               = 4   // uint256 BEACON_BLOCK_BODY_FIELD_TREE_HEIGHT
+	BeaconChainProofsBeaconBlockHeaderFieldTreeHeight             = 3   // uint256 BEACON_BLOCK_HEADER_FIELD_TREE_HEIGHT
+	BeaconChainProofsBeaconStateFieldTreeHeight                   = 5   // uint256 BEACON_STATE_FIELD_TREE_HEIGHT
+	BeaconChainProofsBlockRootsTreeHeight                         = 13  // uint256 BLOCK_ROOTS_TREE_HEIGHT
+	BeaconChainProofsBlockSummaryRootIndex                        = 0   // uint256 BLOCK_SUMMARY_ROOT_INDEX
+	BeaconChainProofsBodyRootIndex                                = 4   // uint256 BODY_ROOT_INDEX
+	BeaconChainProofsExecutionPayloadHeaderFieldTreeHeightCapella = 4   // uint256 EXECUTION_PAYLOAD_HEADER_FIELD_TREE_HEIGHT_CAPELLA
+	BeaconChainProofsExecutionPayloadHeaderFieldTreeHeightDeneb   = 5   // uint256 EXECUTION_PAYLOAD_HEADER_FIELD_TREE_HEIGHT_DENEB
+	BeaconChainProofsExecutionPayloadIndex                        = 9   // uint256 EXECUTION_PAYLOAD_INDEX
+	BeaconChainProofsHistoricalSummariesIndex                     = 27  // uint256 HISTORICAL_SUMMARIES_INDEX
+	BeaconChainProofsHistoricalSummariesTreeHeight                = 24  // uint256 HISTORICAL_SUMMARIES_TREE_HEIGHT
+	BeaconChainProofsSecondsPerEpoch                              = 384 // uint64 SECONDS_PER_EPOCH
+	BeaconChainProofsSecondsPerSlot                               = 12  // uint64 SECONDS_PER_SLOT
+	BeaconChainProofsSlotsPerEpoch                                = 32  // uint64 SLOTS_PER_EPOCH
+	BeaconChainProofsSlotIndex                                    = 0   // uint256 SLOT_INDEX
+	BeaconChainProofsStateRootIndex                               = 3   // uint256 STATE_ROOT_INDEX
+	BeaconChainProofsTimestampIndex                               = 9   // uint256 TIMESTAMP_INDEX
+	BeaconChainProofsValidatorBalanceIndex                        = 2   // uint256 VALIDATOR_BALANCE_INDEX
+	BeaconChainProofsValidatorFieldTreeHeight                     = 3   // uint256 VALIDATOR_FIELD_TREE_HEIGHT
+	BeaconChainProofsValidatorPubkeyIndex                         = 0   // uint256 VALIDATOR_PUBKEY_INDEX
+	BeaconChainProofsValidatorTreeHeight                          = 40  // uint256 VALIDATOR_TREE_HEIGHT
+	BeaconChainProofsValidatorTreeRootIndex                       = 11  // uint256 VALIDATOR_TREE_ROOT_INDEX
+	BeaconChainProofsValidatorWithdrawableEpochIndex              = 7   // uint256 VALIDATOR_WITHDRAWABLE_EPOCH_INDEX
+	BeaconChainProofsValidatorWithdrawalCredentialsIndex          = 1   // uint256 VALIDATOR_WITHDRAWAL_CREDENTIALS_INDEX
+	BeaconChainProofsWithdrawalsIndex                             = 14  // uint256 WITHDRAWALS_INDEX
+	BeaconChainProofsWithdrawalsTreeHeight                        = 4   // uint256 WITHDRAWALS_TREE_HEIGHT
+	BeaconChainProofsWithdrawalFieldTreeHeight                    = 2   // uint256 WITHDRAWAL_FIELD_TREE_HEIGHT
+	BeaconChainProofsWithdrawalValidatorAmountIndex               = 3   // uint256 WITHDRAWAL_VALIDATOR_AMOUNT_INDEX
+	BeaconChainProofsWithdrawalValidatorIndexIndex                = 1   // uint256 WITHDRAWAL_VALIDATOR_INDEX_INDEX
+)
+
+// Internal constants of EigenPod.
+const (
+	EigenPodGweiToWei                        = 1000000000 // uint256 GWEI_TO_WEI
+	EigenPodVerifyBalanceUpdateWindowSeconds = 16200      // uint256 VERIFY_BALANCE_UPDATE_WINDOW_SECONDS
+)
+
+// Internal constants of EigenPodManagerStorage.
+const (
+	EigenPodManagerStorageGweiToWei = 1000000000 // uint256 GWEI_TO_WEI
+)
+
+// Internal constants of Pausable.
+const (
+	PausablePauseAll   = 115792089237316195423570985008687907853269984665640564039457584007913129639935 // uint256 PAUSE_ALL
+	PausableUnpauseAll = 0                                                                              // uint256 UNPAUSE_ALL
+)
+
+// Internal constants of RewardsCoordinator.
+const (
+	RewardsCoordinatorEarnerLeafSalt   = 0                                      // uint8 EARNER_LEAF_SALT
+	RewardsCoordinatorMaxRewardsAmount = 99999999999999999999999999999999999999 // uint256 MAX_REWARDS_AMOUNT
+	RewardsCoordinatorTokenLeafSalt    = 1                                      // uint8 TOKEN_LEAF_SALT
+)
+
+// Internal constants of RewardsCoordinatorStorage.
+const (
+	RewardsCoordinatorStorageSnapshotCadence = 86400 // uint32 SNAPSHOT_CADENCE
+)
+
+// Internal constants of StrategyBase.
+const (
+	StrategyBaseBalanceOffset = 1000 // uint256 BALANCE_OFFSET
+	StrategyBaseSharesOffset  = 1000 // uint256 SHARES_OFFSET
+)
+
+// Internal constants of StrategyManagerStorage.
+const (
+	StrategyManagerStorageMaxStakerStrategyListLength = 32 // uint8 MAX_STAKER_STRATEGY_LIST_LENGTH
+)
+
+// AVSDirectoryPauseFlag is a bit index into the paused status of AVSDirectory.
+type AVSDirectoryPauseFlag uint8
+
+// Pause flags of AVSDirectory.
+const (
+	AVSDirectoryPausedOperatorRegisterDeregisterToAVS AVSDirectoryPauseFlag = 0 // AVSDirectory.PAUSED_OPERATOR_REGISTER_DEREGISTER_TO_AVS
+)
+
+// Mask returns the paused status with only f set.
+func (f AVSDirectoryPauseFlag) Mask() *big.Int {
+	return new(big.Int).Lsh(big.NewInt(1), uint(f))
+}
+
+// String returns the Solidity name of f.
+func (f AVSDirectoryPauseFlag) String() string {
+	switch f {
+	case AVSDirectoryPausedOperatorRegisterDeregisterToAVS:
+		return "PAUSED_OPERATOR_REGISTER_DEREGISTER_TO_AVS"
+	}
+	return fmt.Sprintf("AVSDirectoryPauseFlag(%d)", uint8(f))
+}
+
+// DelayedWithdrawalRouterPauseFlag is a bit index into the paused status of DelayedWithdrawalRouter.
+type DelayedWithdrawalRouterPauseFlag uint8
+
+// Pause flags of DelayedWithdrawalRouter.
+const (
+	DelayedWithdrawalRouterPausedDelayedWithdrawalClaims DelayedWithdrawalRouterPauseFlag = 0 // DelayedWithdrawalRouter.PAUSED_DELAYED_WITHDRAWAL_CLAIMS
+)
+
+// Mask returns the paused status with only f set.
+func (f DelayedWithdrawalRouterPauseFlag) Mask() *big.Int {
+	return new(big.Int).Lsh(big.NewInt(1), uint(f))
+}
+
+// String returns the Solidity name of f.
+func (f DelayedWithdrawalRouterPauseFlag) String() string {
+	switch f {
+	case DelayedWithdrawalRouterPausedDelayedWithdrawalClaims:
+		return "PAUSED_DELAYED_WITHDRAWAL_CLAIMS"
+	}
+	return fmt.Sprintf("DelayedWithdrawalRouterPauseFlag(%d)", uint8(f))
+}
+
+// DelegationManagerPauseFlag is a bit index into the paused status of DelegationManager.
+type DelegationManagerPauseFlag uint8
+
+// Pause flags of DelegationManager.
+const (
+	DelegationManagerPausedNewDelegation        DelegationManagerPauseFlag = 0 // DelegationManager.PAUSED_NEW_DELEGATION
+	DelegationManagerPausedEnterWithdrawalQueue DelegationManagerPauseFlag = 1 // DelegationManager.PAUSED_ENTER_WITHDRAWAL_QUEUE
+	DelegationManagerPausedExitWithdrawalQueue  DelegationManagerPauseFlag = 2 // DelegationManager.PAUSED_EXIT_WITHDRAWAL_QUEUE
+)
+
+// Mask returns the paused status with only f set.
+func (f DelegationManagerPauseFlag) Mask() *big.Int {
+	return new(big.Int).Lsh(big.NewInt(1), uint(f))
+}
+
+// String returns the Solidity name of f.
+func (f DelegationManagerPauseFlag) String() string {
+	switch f {
+	case DelegationManagerPausedNewDelegation:
+		return "PAUSED_NEW_DELEGATION"
+	case DelegationManagerPausedEnterWithdrawalQueue:
+		return "PAUSED_ENTER_WITHDRAWAL_QUEUE"
+	case DelegationManagerPausedExitWithdrawalQueue:
+		return "PAUSED_EXIT_WITHDRAWAL_QUEUE"
+	}
+	return fmt.Sprintf("DelegationManagerPauseFlag(%d)", uint8(f))
+}
+
+// EigenPodManagerPauseFlag is a bit index into the paused status of EigenPodManager.
+type EigenPodManagerPauseFlag uint8
+
+// Pause flags of EigenPodManager.
+const (
+	EigenPodManagerPausedNewEigenPods                 EigenPodManagerPauseFlag = 0 // EigenPodPausingConstants.PAUSED_NEW_EIGENPODS
+	EigenPodManagerPausedWithdrawRestakedETH          EigenPodManagerPauseFlag = 1 // EigenPodPausingConstants.PAUSED_WITHDRAW_RESTAKED_ETH
+	EigenPodManagerPausedEigenPodsVerifyCredentials   EigenPodManagerPauseFlag = 2 // EigenPodPausingConstants.PAUSED_EIGENPODS_VERIFY_CREDENTIALS
+	EigenPodManagerPausedEigenPodsVerifyBalanceUpdate EigenPodManagerPauseFlag = 3 // EigenPodPausingConstants.PAUSED_EIGENPODS_VERIFY_BALANCE_UPDATE
+	EigenPodManagerPausedEigenPodsVerifyWithdrawal    EigenPodManagerPauseFlag = 4 // EigenPodPausingConstants.PAUSED_EIGENPODS_VERIFY_WITHDRAWAL
+	EigenPodManagerPausedNonProofWithdrawals          EigenPodManagerPauseFlag = 5 // EigenPodPausingConstants.PAUSED_NON_PROOF_WITHDRAWALS
+)
+
+// Mask returns the paused status with only f set.
+func (f EigenPodManagerPauseFlag) Mask() *big.Int {
+	return new(big.Int).Lsh(big.NewInt(1), uint(f))
+}
+
+// String returns the Solidity name of f.
+func (f EigenPodManagerPauseFlag) String() string {
+	switch f {
+	case EigenPodManagerPausedNewEigenPods:
+		return "PAUSED_NEW_EIGENPODS"
+	case EigenPodManagerPausedWithdrawRestakedETH:
+		return "PAUSED_WITHDRAW_RESTAKED_ETH"
+	case EigenPodManagerPausedEigenPodsVerifyCredentials:
+		return "PAUSED_EIGENPODS_VERIFY_CREDENTIALS"
+	case EigenPodManagerPausedEigenPodsVerifyBalanceUpdate:
+		return "PAUSED_EIGENPODS_VERIFY_BALANCE_UPDATE"
+	case EigenPodManagerPausedEigenPodsVerifyWithdrawal:
+		return "PAUSED_EIGENPODS_VERIFY_WITHDRAWAL"
+	case EigenPodManagerPausedNonProofWithdrawals:
+		return "PAUSED_NON_PROOF_WITHDRAWALS"
+	}
+	return fmt.Sprintf("EigenPodManagerPauseFlag(%d)", uint8(f))
+}
+
+// EigenStrategyPauseFlag is a bit index into the paused status of EigenStrategy.
+type EigenStrategyPauseFlag uint8
+
+// Pause flags of EigenStrategy.
+const (
+	EigenStrategyPausedDeposits    EigenStrategyPauseFlag = 0 // StrategyBase.PAUSED_DEPOSITS
+	EigenStrategyPausedWithdrawals EigenStrategyPauseFlag = 1 // StrategyBase.PAUSED_WITHDRAWALS
+)
+
+// Mask returns the paused status with only f set.
+func (f EigenStrategyPauseFlag) Mask() *big.Int {
+	return new(big.Int).Lsh(big.NewInt(1), uint(f))
+}
+
+// String returns the Solidity name of f.
+func (f EigenStrategyPauseFlag) String() string {
+	switch f {
+	case EigenStrategyPausedDeposits:
+		return "PAUSED_DEPOSITS"
+	case EigenStrategyPausedWithdrawals:
+		return "PAUSED_WITHDRAWALS"
+	}
+	return fmt.Sprintf("EigenStrategyPauseFlag(%d)", uint8(f))
+}
+
+// RewardsCoordinatorPauseFlag is a bit index into the paused status of RewardsCoordinator.
+type RewardsCoordinatorPauseFlag uint8
+
+// Pause flags of RewardsCoordinator.
+const (
+	RewardsCoordinatorPausedAVSRewardsSubmission    RewardsCoordinatorPauseFlag = 0 // RewardsCoordinator.PAUSED_AVS_REWARDS_SUBMISSION
+	RewardsCoordinatorPausedRewardsForAllSubmission RewardsCoordinatorPauseFlag = 1 // RewardsCoordinator.PAUSED_REWARDS_FOR_ALL_SUBMISSION
+	RewardsCoordinatorPausedProcessClaim            RewardsCoordinatorPauseFlag = 2 // RewardsCoordinator.PAUSED_PROCESS_CLAIM
+	RewardsCoordinatorPausedSubmitDisableRoots      RewardsCoordinatorPauseFlag = 3 // RewardsCoordinator.PAUSED_SUBMIT_DISABLE_ROOTS
+)
+
+// Mask returns the paused status with only f set.
+func (f RewardsCoordinatorPauseFlag) Mask() *big.Int {
+	return new(big.Int).Lsh(big.NewInt(1), uint(f))
+}
+
+// String returns the Solidity name of f.
+func (f RewardsCoordinatorPauseFlag) String() string {
+	switch f {
+	case RewardsCoordinatorPausedAVSRewardsSubmission:
+		return "PAUSED_AVS_REWARDS_SUBMISSION"
+	case RewardsCoordinatorPausedRewardsForAllSubmission:
+		return "PAUSED_REWARDS_FOR_ALL_SUBMISSION"
+	case RewardsCoordinatorPausedProcessClaim:
+		return "PAUSED_PROCESS_CLAIM"
+	case RewardsCoordinatorPausedSubmitDisableRoots:
+		return "PAUSED_SUBMIT_DISABLE_ROOTS"
+	}
+	return fmt.Sprintf("RewardsCoordinatorPauseFlag(%d)", uint8(f))
+}
+
+// StrategyBasePauseFlag is a bit index into the paused status of StrategyBase.
+type StrategyBasePauseFlag uint8
+
+// Pause flags of StrategyBase.
+const (
+	StrategyBasePausedDeposits    StrategyBasePauseFlag = 0 // StrategyBase.PAUSED_DEPOSITS
+	StrategyBasePausedWithdrawals StrategyBasePauseFlag = 1 // StrategyBase.PAUSED_WITHDRAWALS
+)
+
+// Mask returns the paused status with only f set.
+func (f StrategyBasePauseFlag) Mask() *big.Int {
+	return new(big.Int).Lsh(big.NewInt(1), uint(f))
+}
+
+// String returns the Solidity name of f.
+func (f StrategyBasePauseFlag) String() string {
+	switch f {
+	case StrategyBasePausedDeposits:
+		return "PAUSED_DEPOSITS"
+	case StrategyBasePausedWithdrawals:
+		return "PAUSED_WITHDRAWALS"
+	}
+	return fmt.Sprintf("StrategyBasePauseFlag(%d)", uint8(f))
+}
+
+// StrategyBaseTVLLimitsPauseFlag is a bit index into the paused status of StrategyBaseTVLLimits.
+type StrategyBaseTVLLimitsPauseFlag uint8
+
+// Pause flags of StrategyBaseTVLLimits.
+const (
+	StrategyBaseTVLLimitsPausedDeposits    StrategyBaseTVLLimitsPauseFlag = 0 // StrategyBase.PAUSED_DEPOSITS
+	StrategyBaseTVLLimitsPausedWithdrawals StrategyBaseTVLLimitsPauseFlag = 1 // StrategyBase.PAUSED_WITHDRAWALS
+)
+
+// Mask returns the paused status with only f set.
+func (f StrategyBaseTVLLimitsPauseFlag) Mask() *big.Int {
+	return new(big.Int).Lsh(big.NewInt(1), uint(f))
+}
+
+// String returns the Solidity name of f.
+func (f StrategyBaseTVLLimitsPauseFlag) String() string {
+	switch f {
+	case StrategyBaseTVLLimitsPausedDeposits:
+		return "PAUSED_DEPOSITS"
+	case StrategyBaseTVLLimitsPausedWithdrawals:
+		return "PAUSED_WITHDRAWALS"
+	}
+	return fmt.Sprintf("StrategyBaseTVLLimitsPauseFlag(%d)", uint8(f))
+}
+
+// StrategyManagerPauseFlag is a bit index into the paused status of StrategyManager.
+type StrategyManagerPauseFlag uint8
+
+// Pause flags of StrategyManager.
+const (
+	StrategyManagerPausedDeposits StrategyManagerPauseFlag = 0 // StrategyManager.PAUSED_DEPOSITS
+)
+
+// Mask returns the paused status with only f set.
+func (f StrategyManagerPauseFlag) Mask() *big.Int {
+	return new(big.Int).Lsh(big.NewInt(1), uint(f))
+}
+
+// String returns the Solidity name of f.
+func (f StrategyManagerPauseFlag) String() string {
+	switch f {
+	case StrategyManagerPausedDeposits:
+		return "PAUSED_DEPOSITS"
+	}
+	return fmt.Sprintf("StrategyManagerPauseFlag(%d)", uint8(f))
+}
+
+// Solidity maps every constant, as "<Contract>.<NAME>", to the decimal
+// value it was generated from.
+var Solidity = map[string]string{
+	"AVSDirectory.PAUSED_OPERATOR_REGISTER_DEREGISTER_TO_AVS":              "0",
+	"BeaconChainProofs.BEACON_BLOCK_BODY_FIELD_TREE_HEIGHT":                "4",
+	"BeaconChainProofs.BEACON_BLOCK_HEADER_FIELD_TREE_HEIGHT":              "3",
+	"BeaconChainProofs.BEACON_STATE_FIELD_TREE_HEIGHT":                     "5",
+	"BeaconChainProofs.BLOCK_ROOTS_TREE_HEIGHT":                            "13",
+	"BeaconChainProofs.BLOCK_SUMMARY_ROOT_INDEX":                           "0",
+	"BeaconChainProofs.BODY_ROOT_INDEX":                                    "4",
+	"BeaconChainProofs.EXECUTION_PAYLOAD_HEADER_FIELD_TREE_HEIGHT_CAPELLA": "4",
+	"BeaconChainProofs.EXECUTION_PAYLOAD_HEADER_FIELD_TREE_HEIGHT_DENEB":   "5",
+	"BeaconChainProofs.EXECUTION_PAYLOAD_INDEX":                            "9",
+	"BeaconChainProofs.HISTORICAL_SUMMARIES_INDEX":                         "27",
+	"BeaconChainProofs.HISTORICAL_SUMMARIES_TREE_HEIGHT":                   "24",
+	"BeaconChainProofs.SECONDS_PER_EPOCH":                                  "384",
+	"BeaconChainProofs.SECONDS_PER_SLOT":                                   "12",
+	"BeaconChainProofs.SLOTS_PER_EPOCH":                                    "32",
+	"BeaconChainProofs.SLOT_INDEX":                                         "0",
+	"BeaconChainProofs.STATE_ROOT_INDEX":                                   "3",
+	"BeaconChainProofs.TIMESTAMP_INDEX":                                    "9",
+	"BeaconChainProofs.VALIDATOR_BALANCE_INDEX":                            "2",
+	"BeaconChainProofs.VALIDATOR_FIELD_TREE_HEIGHT":                        "3",
+	"BeaconChainProofs.VALIDATOR_PUBKEY_INDEX":                             "0",
+	"BeaconChainProofs.VALIDATOR_TREE_HEIGHT":                              "40",
+	"BeaconChainProofs.VALIDATOR_TREE_ROOT_INDEX":                          "11",
+	"BeaconChainProofs.VALIDATOR_WITHDRAWABLE_EPOCH_INDEX":                 "7",
+	"BeaconChainProofs.VALIDATOR_WITHDRAWAL_CREDENTIALS_INDEX":             "1",
+	"BeaconChainProofs.WITHDRAWALS_INDEX":                                  "14",
+	"BeaconChainProofs.WITHDRAWALS_TREE_HEIGHT":                            "4",
+	"BeaconChainProofs.WITHDRAWAL_FIELD_TREE_HEIGHT":                       "2",
+	"BeaconChainProofs.WITHDRAWAL_VALIDATOR_AMOUNT_INDEX":                  "3",
+	"BeaconChainProofs.WITHDRAWAL_VALIDATOR_INDEX_INDEX":                   "1",
+	"DelayedWithdrawalRouter.PAUSED_DELAYED_WITHDRAWAL_CLAIMS":             "0",
+	"DelegationManager.PAUSED_ENTER_WITHDRAWAL_QUEUE":                      "1",
+	"DelegationManager.PAUSED_EXIT_WITHDRAWAL_QUEUE":                       "2",
+	"DelegationManager.PAUSED_NEW_DELEGATION":                              "0",
+	"EigenPod.GWEI_TO_WEI":                                                 "1000000000",
+	"EigenPod.VERIFY_BALANCE_UPDATE_WINDOW_SECONDS":                        "16200",
+	"EigenPodManagerStorage.GWEI_TO_WEI":                                   "1000000000",
+	"EigenPodPausingConstants.PAUSED_EIGENPODS_VERIFY_BALANCE_UPDATE":      "3",
+	"EigenPodPausingConstants.PAUSED_EIGENPODS_VERIFY_CREDENTIALS":         "2",
+	"EigenPodPausingConstants.PAUSED_EIGENPODS_VERIFY_WITHDRAWAL":          "4",
+	"EigenPodPausingConstants.PAUSED_NEW_EIGENPODS":                        "0",
+	"EigenPodPausingConstants.PAUSED_NON_PROOF_WITHDRAWALS":                "5",
+	"EigenPodPausingConstants.PAUSED_WITHDRAW_RESTAKED_ETH":                "1",
+	"Pausable.PAUSE_ALL":                                                   "115792089237316195423570985008687907853269984665640564039457584007913129639935",
+	"Pausable.UNPAUSE_ALL":                                                 "0",
+	"RewardsCoordinator.EARNER_LEAF_SALT":                                  "0",
+	"RewardsCoordinator.MAX_REWARDS_AMOUNT":                                "99999999999999999999999999999999999999",
+	"RewardsCoordinator.PAUSED_AVS_REWARDS_SUBMISSION":                     "0",
+	"RewardsCoordinator.PAUSED_PROCESS_CLAIM":                              "2",
+	"RewardsCoordinator.PAUSED_REWARDS_FOR_ALL_SUBMISSION":                 "1",
+	"RewardsCoordinator.PAUSED_SUBMIT_DISABLE_ROOTS":                       "3",
+	"RewardsCoordinator.TOKEN_LEAF_SALT":                                   "1",
+	"RewardsCoordinatorStorage.SNAPSHOT_CADENCE":                           "86400",
+	"StrategyBase.BALANCE_OFFSET":                                          "1000",
+	"StrategyBase.PAUSED_DEPOSITS":                                         "0",
+	"StrategyBase.PAUSED_WITHDRAWALS":                                      "1",
+	"StrategyBase.SHARES_OFFSET":                                           "1000",
+	"StrategyManager.PAUSED_DEPOSITS":                                      "0",
+	"StrategyManagerStorage.MAX_STAKER_STRATEGY_LIST_LENGTH":               "32",
+}
@@ -0,0 +1,11 @@
+package constants_test
+
+import (
+	"testing"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/bindingstest"
+)
+
+func TestUpToDate(t *testing.T) {
+	bindingstest.AssertConstantsUpToDate(t, "../..")
+}
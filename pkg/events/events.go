@@ -0,0 +1,163 @@
+// Package events decodes the logs of the EigenLayer contracts into the
+// typed event structs of their binding packages, without knowing in advance
+// which contract emitted them:
+//
+//	reg := events.NewRegistry(bindings.Latest.Events)
+//	reg.Register(delegationManager, "TransparentUpgradeableProxy", "DelegationManager")
+//	for _, log := range receipt.Logs {
+//		ev, err := reg.Decode(*log)
+//		...
+//	}
+//
+// The event list of each release is generated by bindgen.
+package events
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+var (
+	// ErrUnknownEvent is returned for a log no candidate event matches.
+	ErrUnknownEvent = errors.New("unknown event")
+	// ErrAmbiguousEvent is returned for a log several contracts could have
+	// emitted, from an address that is not registered or is registered with
+	// more than one of them.
+	ErrAmbiguousEvent = errors.New("ambiguous event")
+)
+
+// Event is an event of a bound contract.
+type Event struct {
+	// Contract is the name of the contract and of its binding package.
+	Contract string
+	// Name is the name of the event in the ABI, e.g. "StakerDelegated".
+	Name string
+	// Signature is the canonical signature the ID is hashed from, e.g.
+	// "StakerDelegated(address,address)".
+	Signature string
+	// ID is topic0 of the event's logs.
+	ID common.Hash
+	// Topics is the number of topics the event's logs carry: the ID and
+	// one per indexed input.
+	Topics int
+	// Parse decodes a log of the event into the struct of the binding
+	// package, e.g. *DelegationManager.DelegationManagerStakerDelegated.
+	Parse func(types.Log) (any, error)
+}
+
+// Parser adapts the Parse method of a binding's filterer, given as a method
+// expression, to Event.Parse.
+func Parser[F, T any](newFilterer func(common.Address, bind.ContractFilterer) (F, error), parse func(F, types.Log) (T, error)) func(types.Log) (any, error) {
+	return func(log types.Log) (any, error) {
+		f, err := newFilterer(log.Address, nil)
+		if err != nil {
+			return nil, err
+		}
+		v, err := parse(f, log)
+		if err != nil {
+			return nil, err
+		}
+		return v, nil
+	}
+}
+
+// Registry resolves logs to the events of a binding set. Events several
+// contracts declare, such as Paused or Initialized, are told apart by the
+// contracts registered at the emitting address. It is safe for concurrent
+// use.
+type Registry struct {
+	mu    sync.RWMutex
+	byID  map[common.Hash][]*Event
+	roles map[common.Address][]string
+}
+
+// NewRegistry returns a registry of events, typically the Events of a
+// bindings.Set.
+func NewRegistry(events []Event) *Registry {
+	r := &Registry{
+		byID:  make(map[common.Hash][]*Event),
+		roles: make(map[common.Address][]string),
+	}
+	for i := range events {
+		e := &events[i]
+		r.byID[e.ID] = append(r.byID[e.ID], e)
+	}
+	return r
+}
+
+// Register records that the contract at address is an instance of the
+// named contracts. Logs of a registered address only resolve to events of
+// its contracts. A proxy is an instance of both its own contract and its
+// implementation's, e.g.
+//
+//	r.Register(address, "TransparentUpgradeableProxy", "DelegationManager")
+func (r *Registry) Register(address common.Address, contracts ...string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.roles[address] = append(r.roles[address], contracts...)
+}
+
+// Lookup returns the event of log: the one with its topic0 and number of
+// topics, narrowed down to the contracts registered at its address if any
+// are.
+func (r *Registry) Lookup(log types.Log) (*Event, error) {
+	if len(log.Topics) == 0 {
+		return nil, fmt.Errorf("%w: log of %s has no topics", ErrUnknownEvent, log.Address)
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var (
+		candidates []*Event
+		hint       = ", register the address"
+	)
+	for _, e := range r.byID[log.Topics[0]] {
+		if e.Topics == len(log.Topics) {
+			candidates = append(candidates, e)
+		}
+	}
+	if roles, ok := r.roles[log.Address]; ok {
+		var own []*Event
+		for _, e := range candidates {
+			for _, role := range roles {
+				if e.Contract == role {
+					own = append(own, e)
+					break
+				}
+			}
+		}
+		if len(own) == 0 {
+			return nil, fmt.Errorf("%w: topic %s is not an event of %s at %s", ErrUnknownEvent, log.Topics[0], strings.Join(roles, " or "), log.Address)
+		}
+		candidates, hint = own, ""
+	}
+	switch len(candidates) {
+	case 0:
+		return nil, fmt.Errorf("%w: topic %s of %s", ErrUnknownEvent, log.Topics[0], log.Address)
+	case 1:
+		return candidates[0], nil
+	}
+	names := make([]string, len(candidates))
+	for i, e := range candidates {
+		names[i] = e.Contract
+	}
+	return nil, fmt.Errorf("%w: %s of %s is declared by %s%s", ErrAmbiguousEvent, candidates[0].Name, log.Address, strings.Join(names, ", "), hint)
+}
+
+// Decode parses log into the struct of its event's binding package.
+func (r *Registry) Decode(log types.Log) (any, error) {
+	e, err := r.Lookup(log)
+	if err != nil {
+		return nil, err
+	}
+	v, err := e.Parse(log)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s.%s: %w", e.Contract, e.Name, err)
+	}
+	return v, nil
+}
@@ -0,0 +1,219 @@
+package events_test
+
+import (
+	"errors"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings"
+	delegationmanager "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/DelegationManager"
+	strategymanager "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/StrategyManager"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/events"
+)
+
+var (
+	delegation = common.HexToAddress("0x1000")
+	strategy   = common.HexToAddress("0x2000")
+	unknown    = common.HexToAddress("0x3000")
+	pauser     = common.HexToAddress("0x4000")
+	staker     = common.HexToAddress("0x5000")
+	operator   = common.HexToAddress("0x6000")
+)
+
+// newLog returns a log of the event name of the contract with metadata,
+// emitted at address with args as its inputs: the indexed ones as topics,
+// the others as data.
+func newLog(t *testing.T, metadata *bind.MetaData, name string, address common.Address, args ...any) types.Log {
+	t.Helper()
+	parsed, err := metadata.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ev := parsed.Events[name]
+	var (
+		indexed [][]any
+		data    []any
+	)
+	for i, input := range ev.Inputs {
+		if input.Indexed {
+			indexed = append(indexed, []any{args[i]})
+		} else {
+			data = append(data, args[i])
+		}
+	}
+	topics, err := abi.MakeTopics(indexed...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	log := types.Log{Address: address, Topics: []common.Hash{ev.ID}}
+	for _, topic := range topics {
+		log.Topics = append(log.Topics, topic[0])
+	}
+	if log.Data, err = ev.Inputs.NonIndexed().Pack(data...); err != nil {
+		t.Fatal(err)
+	}
+	return log
+}
+
+func newRegistry() *events.Registry {
+	r := events.NewRegistry(bindings.Events)
+	r.Register(delegation, "TransparentUpgradeableProxy", "DelegationManager")
+	r.Register(strategy, "TransparentUpgradeableProxy", "StrategyManager")
+	return r
+}
+
+func TestLookup(t *testing.T) {
+	dm, sm := delegationmanager.DelegationManagerMetaData, strategymanager.StrategyManagerMetaData
+	tests := []struct {
+		name string
+		log  func(t *testing.T) types.Log
+		// contract and event name the log resolves to.
+		contract, event string
+		err             string
+		sentinel        error
+	}{
+		{
+			name:     "paused by a registered contract",
+			log:      func(t *testing.T) types.Log { return newLog(t, dm, "Paused", delegation, pauser, big.NewInt(1)) },
+			contract: "DelegationManager",
+			event:    "Paused",
+		},
+		{
+			name:     "unpaused by another registered contract",
+			log:      func(t *testing.T) types.Log { return newLog(t, sm, "Unpaused", strategy, pauser, big.NewInt(0)) },
+			contract: "StrategyManager",
+			event:    "Unpaused",
+		},
+		{
+			name:     "paused by an unregistered contract",
+			log:      func(t *testing.T) types.Log { return newLog(t, dm, "Paused", unknown, pauser, big.NewInt(1)) },
+			err:      "ambiguous event: Paused of 0x0000000000000000000000000000000000003000 is declared by AVSDirectory, DelayedWithdrawalRouter, DelegationManager, EigenPodManager, EigenStrategy, Pausable, RewardsCoordinator, StrategyBase, StrategyBaseTVLLimits, StrategyManager, register the address",
+			sentinel: events.ErrAmbiguousEvent,
+		},
+		{
+			name: "event of one contract from an unregistered address",
+			log: func(t *testing.T) types.Log {
+				return newLog(t, dm, "StakerDelegated", unknown, staker, operator)
+			},
+			contract: "DelegationManager",
+			event:    "StakerDelegated",
+		},
+		{
+			name: "event of another contract than the one registered",
+			log: func(t *testing.T) types.Log {
+				return newLog(t, dm, "StakerDelegated", strategy, staker, operator)
+			},
+			err:      "unknown event: topic 0xc3ee9f2e5fda98e8066a1f745b2df9285f416fe98cf2559cd21484b3d8743304 is not an event of TransparentUpgradeableProxy or StrategyManager at 0x0000000000000000000000000000000000002000",
+			sentinel: events.ErrUnknownEvent,
+		},
+		{
+			name: "too few topics",
+			log: func(t *testing.T) types.Log {
+				log := newLog(t, dm, "StakerDelegated", delegation, staker, operator)
+				log.Topics = log.Topics[:2]
+				return log
+			},
+			err:      "unknown event: topic 0xc3ee9f2e5fda98e8066a1f745b2df9285f416fe98cf2559cd21484b3d8743304 is not an event of TransparentUpgradeableProxy or DelegationManager at 0x0000000000000000000000000000000000001000",
+			sentinel: events.ErrUnknownEvent,
+		},
+		{
+			name:     "unknown topic",
+			log:      func(t *testing.T) types.Log { return types.Log{Address: unknown, Topics: []common.Hash{{1}}} },
+			err:      "unknown event: topic 0x0100000000000000000000000000000000000000000000000000000000000000 of 0x0000000000000000000000000000000000003000",
+			sentinel: events.ErrUnknownEvent,
+		},
+		{
+			name:     "no topics",
+			log:      func(t *testing.T) types.Log { return types.Log{Address: delegation} },
+			err:      "unknown event: log of 0x0000000000000000000000000000000000001000 has no topics",
+			sentinel: events.ErrUnknownEvent,
+		},
+	}
+	r := newRegistry()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e, err := r.Lookup(tt.log(t))
+			switch {
+			case tt.err == "" && err != nil:
+				t.Fatal(err)
+			case tt.err != "" && (err == nil || err.Error() != tt.err || !errors.Is(err, tt.sentinel)):
+				t.Fatalf("err = %v, want %q", err, tt.err)
+			case tt.err != "":
+				return
+			}
+			if e.Contract != tt.contract || e.Name != tt.event {
+				t.Errorf("resolved to %s.%s, want %s.%s", e.Contract, e.Name, tt.contract, tt.event)
+			}
+		})
+	}
+}
+
+func TestDecode(t *testing.T) {
+	dm, sm := delegationmanager.DelegationManagerMetaData, strategymanager.StrategyManagerMetaData
+	tests := []struct {
+		name string
+		log  func(t *testing.T) types.Log
+		// want is the decoded event, but for its Raw log.
+		want any
+		err  string
+	}{
+		{
+			name: "paused",
+			log:  func(t *testing.T) types.Log { return newLog(t, dm, "Paused", delegation, pauser, big.NewInt(1)) },
+			want: &delegationmanager.DelegationManagerPaused{Account: pauser, NewPausedStatus: big.NewInt(1)},
+		},
+		{
+			name: "unpaused",
+			log:  func(t *testing.T) types.Log { return newLog(t, sm, "Unpaused", strategy, pauser, big.NewInt(6)) },
+			want: &strategymanager.StrategyManagerUnpaused{Account: pauser, NewPausedStatus: big.NewInt(6)},
+		},
+		{
+			name: "staker delegated",
+			log: func(t *testing.T) types.Log {
+				return newLog(t, dm, "StakerDelegated", delegation, staker, operator)
+			},
+			want: &delegationmanager.DelegationManagerStakerDelegated{Staker: staker, Operator: operator},
+		},
+		{
+			name: "truncated data",
+			log: func(t *testing.T) types.Log {
+				log := newLog(t, dm, "Paused", delegation, pauser, big.NewInt(1))
+				log.Data = log.Data[:31]
+				return log
+			},
+			err: "failed to parse DelegationManager.Paused: abi: cannot marshal in to go type: length insufficient 31 require 32",
+		},
+		{
+			name: "ambiguous",
+			log:  func(t *testing.T) types.Log { return newLog(t, dm, "Unpaused", unknown, pauser, big.NewInt(0)) },
+			err:  "ambiguous event: Unpaused of 0x0000000000000000000000000000000000003000 is declared by AVSDirectory, DelayedWithdrawalRouter, DelegationManager, EigenPodManager, EigenStrategy, Pausable, RewardsCoordinator, StrategyBase, StrategyBaseTVLLimits, StrategyManager, register the address",
+		},
+	}
+	r := newRegistry()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			log := tt.log(t)
+			got, err := r.Decode(log)
+			switch {
+			case tt.err == "" && err != nil:
+				t.Fatal(err)
+			case tt.err != "" && (err == nil || err.Error() != tt.err):
+				t.Fatalf("err = %v, want %q", err, tt.err)
+			case tt.err != "":
+				return
+			}
+			// The bindings keep the log the event was parsed from.
+			raw := reflect.ValueOf(tt.want).Elem().FieldByName("Raw")
+			raw.Set(reflect.ValueOf(log))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("decoded %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
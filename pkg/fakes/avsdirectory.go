@@ -0,0 +1,171 @@
+package fakes
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	avsdirectory "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/AVSDirectory"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/constants"
+)
+
+// Operator registration statuses of the AVSDirectory.
+const (
+	OperatorUnregistered uint8 = iota
+	OperatorRegistered
+)
+
+const avsPausedOperatorRegisterDeregister = uint8(constants.AVSDirectoryPausedOperatorRegisterDeregisterToAVS)
+
+var operatorAVSRegistrationTypehash = crypto.Keccak256Hash([]byte("OperatorAVSRegistration(address operator,address avs,bytes32 salt,uint256 expiry)"))
+
+// AVSDirectory is a fake of the AVSDirectory contract, tracking which
+// operators are registered to which AVSs.
+type AVSDirectory struct {
+	*avsdirectory.AVSDirectoryFilterer
+	pausable
+
+	delegation *DelegationManager
+
+	operatorStatus map[pair]uint8
+	saltSpent      map[saltKey]bool
+}
+
+var (
+	_ avsdirectory.AVSDirectoryReader = (*AVSDirectory)(nil)
+	_ avsdirectory.AVSDirectoryWriter = (*AVSDirectory)(nil)
+	_ avsdirectory.AVSDirectoryEvents = (*AVSDirectory)(nil)
+)
+
+func newAVSDirectory(chain *Chain, address common.Address, cfg *Config) *AVSDirectory {
+	filterer, err := avsdirectory.NewAVSDirectoryFilterer(address, chain)
+	if err != nil {
+		panic(err)
+	}
+	c := newContract(chain, address, avsdirectory.AVSDirectoryMetaData)
+	avs := &AVSDirectory{
+		AVSDirectoryFilterer: filterer,
+		pausable:             newPausable(c, cfg.Owner, cfg.PauserRegistry, nil),
+		operatorStatus:       make(map[pair]uint8),
+		saltSpent:            make(map[saltKey]bool),
+	}
+	chain.Register(address, avs)
+	return avs
+}
+
+// AvsOperatorStatus returns the registration status of operator with avs.
+func (d *AVSDirectory) AvsOperatorStatus(opts *bind.CallOpts, avs common.Address, operator common.Address) (uint8, error) {
+	d.chain.mu.Lock()
+	defer d.chain.mu.Unlock()
+	return d.operatorStatus[pair{avs, operator}], nil
+}
+
+// CalculateOperatorAVSRegistrationDigestHash returns the digest operator
+// signs to be registered with avs.
+func (d *AVSDirectory) CalculateOperatorAVSRegistrationDigestHash(opts *bind.CallOpts, operator common.Address, avs common.Address, salt [32]byte, expiry *big.Int) ([32]byte, error) {
+	return d.registrationDigest(operator, avs, salt, expiry), nil
+}
+
+// DOMAINTYPEHASH returns the EIP-712 domain typehash.
+func (d *AVSDirectory) DOMAINTYPEHASH(opts *bind.CallOpts) ([32]byte, error) {
+	return domainTypehash, nil
+}
+
+// Delegation returns the address of the DelegationManager.
+func (d *AVSDirectory) Delegation(opts *bind.CallOpts) (common.Address, error) {
+	return d.delegation.address, nil
+}
+
+// DomainSeparator returns the EIP-712 domain separator.
+func (d *AVSDirectory) DomainSeparator(opts *bind.CallOpts) ([32]byte, error) {
+	return d.domainSeparator(), nil
+}
+
+// OPERATORAVSREGISTRATIONTYPEHASH returns the EIP-712 typehash of operator
+// registrations.
+func (d *AVSDirectory) OPERATORAVSREGISTRATIONTYPEHASH(opts *bind.CallOpts) ([32]byte, error) {
+	return operatorAVSRegistrationTypehash, nil
+}
+
+// OperatorSaltIsSpent reports whether operator has used or cancelled salt.
+func (d *AVSDirectory) OperatorSaltIsSpent(opts *bind.CallOpts, operator common.Address, salt [32]byte) (bool, error) {
+	d.chain.mu.Lock()
+	defer d.chain.mu.Unlock()
+	return d.saltSpent[saltKey{operator, salt}], nil
+}
+
+// CancelSalt marks salt as spent for the sending operator.
+func (d *AVSDirectory) CancelSalt(opts *bind.TransactOpts, salt [32]byte) (*types.Transaction, error) {
+	return d.chain.transact(opts, &d.contract, "cancelSalt", []any{salt}, func(tx *txContext) error {
+		if d.saltSpent[saltKey{tx.sender, salt}] {
+			return revert("AVSDirectory.cancelSalt: cannot cancel spent salt")
+		}
+		set(tx, d.saltSpent, saltKey{tx.sender, salt}, true)
+		return nil
+	})
+}
+
+// DeregisterOperatorFromAVS deregisters operator from the sending AVS.
+func (d *AVSDirectory) DeregisterOperatorFromAVS(opts *bind.TransactOpts, operator common.Address) (*types.Transaction, error) {
+	return d.chain.transact(opts, &d.contract, "deregisterOperatorFromAVS", []any{operator}, func(tx *txContext) error {
+		if err := d.onlyWhenNotPaused(avsPausedOperatorRegisterDeregister); err != nil {
+			return err
+		}
+		if d.operatorStatus[pair{tx.sender, operator}] != OperatorRegistered {
+			return revert("AVSDirectory.deregisterOperatorFromAVS: operator not registered")
+		}
+		set(tx, d.operatorStatus, pair{tx.sender, operator}, OperatorUnregistered)
+		d.emit(tx, "OperatorAVSRegistrationStatusUpdated", operator, tx.sender, OperatorUnregistered)
+		return nil
+	})
+}
+
+// Initialize always reverts, since the fake is deployed initialized.
+func (d *AVSDirectory) Initialize(opts *bind.TransactOpts, initialOwner common.Address, _pauserRegistry common.Address, initialPausedStatus *big.Int) (*types.Transaction, error) {
+	return d.alreadyInitialized(opts, initialOwner, _pauserRegistry, initialPausedStatus)
+}
+
+// RegisterOperatorToAVS registers operator with the sending AVS, authorized
+// by the operator's EIP-712 signature.
+func (d *AVSDirectory) RegisterOperatorToAVS(opts *bind.TransactOpts, operator common.Address, operatorSignature avsdirectory.ISignatureUtilsSignatureWithSaltAndExpiry) (*types.Transaction, error) {
+	return d.chain.transact(opts, &d.contract, "registerOperatorToAVS", []any{operator, operatorSignature}, func(tx *txContext) error {
+		if err := d.onlyWhenNotPaused(avsPausedOperatorRegisterDeregister); err != nil {
+			return err
+		}
+		if orZero(operatorSignature.Expiry).Cmp(new(big.Int).SetUint64(tx.time)) < 0 {
+			return revert("AVSDirectory.registerOperatorToAVS: operator signature expired")
+		}
+		if d.operatorStatus[pair{tx.sender, operator}] == OperatorRegistered {
+			return revert("AVSDirectory.registerOperatorToAVS: operator already registered")
+		}
+		if d.saltSpent[saltKey{operator, operatorSignature.Salt}] {
+			return revert("AVSDirectory.registerOperatorToAVS: salt already spent")
+		}
+		if !d.delegation.isOperator(operator) {
+			return revert("AVSDirectory.registerOperatorToAVS: operator not registered to EigenLayer yet")
+		}
+		digest := d.registrationDigest(operator, tx.sender, operatorSignature.Salt, operatorSignature.Expiry)
+		if err := checkSignature(operator, digest, operatorSignature.Signature); err != nil {
+			return err
+		}
+		set(tx, d.operatorStatus, pair{tx.sender, operator}, OperatorRegistered)
+		set(tx, d.saltSpent, saltKey{operator, operatorSignature.Salt}, true)
+		d.emit(tx, "OperatorAVSRegistrationStatusUpdated", operator, tx.sender, OperatorRegistered)
+		return nil
+	})
+}
+
+// UpdateAVSMetadataURI emits a new metadata URI for the sending AVS.
+func (d *AVSDirectory) UpdateAVSMetadataURI(opts *bind.TransactOpts, metadataURI string) (*types.Transaction, error) {
+	return d.chain.transact(opts, &d.contract, "updateAVSMetadataURI", []any{metadataURI}, func(tx *txContext) error {
+		d.emit(tx, "AVSMetadataURIUpdated", tx.sender, metadataURI)
+		return nil
+	})
+}
+
+func (d *AVSDirectory) registrationDigest(operator, avs common.Address, salt [32]byte, expiry *big.Int) common.Hash {
+	return d.digest(crypto.Keccak256Hash(words(operatorAVSRegistrationTypehash, operator, avs, salt, orZero(expiry))))
+}
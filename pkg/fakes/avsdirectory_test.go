@@ -0,0 +1,168 @@
+package fakes_test
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	avsdirectory "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/AVSDirectory"
+	delegationmanager "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/DelegationManager"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/fakes"
+)
+
+var (
+	signerKey, _ = crypto.HexToECDSA("b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f291")
+	otherKey, _  = crypto.HexToECDSA("8a1f9a8f95be41cd7ccb6168179afb4504aefe388d1e14474d32c45c72ce7b7a")
+	signer       = crypto.PubkeyToAddress(signerKey.PublicKey)
+)
+
+func (e *env) registerSigner() error {
+	_, err := e.DelegationManager.RegisterAsOperator(from(signer), delegationmanager.IDelegationManagerOperatorDetails{DeprecatedEarningsReceiver: signer}, "")
+	return err
+}
+
+// registerToAVS registers signer with avs, authorized by a signature of key
+// over salt that expires at expiry.
+func (e *env) registerToAVS(key *ecdsa.PrivateKey, salt byte, expiry uint64) error {
+	s := avsdirectory.ISignatureUtilsSignatureWithSaltAndExpiry{Salt: [32]byte{salt}, Expiry: new(big.Int).SetUint64(expiry)}
+	digest, err := e.AVSDirectory.CalculateOperatorAVSRegistrationDigestHash(&bind.CallOpts{}, signer, avs, s.Salt, s.Expiry)
+	if err != nil {
+		return err
+	}
+	if s.Signature, err = fakes.Sign(digest, key); err != nil {
+		return err
+	}
+	_, err = e.AVSDirectory.RegisterOperatorToAVS(from(avs), signer, s)
+	return err
+}
+
+// register registers signer with avs, signed over salt and expiring in a
+// day.
+func register(salt byte) func(*env) error {
+	return func(e *env) error { return e.registerToAVS(signerKey, salt, e.Chain.Time()+86400) }
+}
+
+func deregister(e *env) error {
+	_, err := e.AVSDirectory.DeregisterOperatorFromAVS(from(avs), signer)
+	return err
+}
+
+// avsState is what the deployment records of signer's registration with
+// avs and its first salt.
+type avsState struct {
+	status uint8
+	spent  bool
+}
+
+func (e *env) avsState() avsState {
+	e.t.Helper()
+	call := &bind.CallOpts{}
+	status, err := e.AVSDirectory.AvsOperatorStatus(call, avs, signer)
+	if err != nil {
+		e.t.Fatal(err)
+	}
+	spent, err := e.AVSDirectory.OperatorSaltIsSpent(call, signer, [32]byte{1})
+	if err != nil {
+		e.t.Fatal(err)
+	}
+	return avsState{status: status, spent: spent}
+}
+
+func TestAVSDirectory(t *testing.T) {
+	tests := []struct {
+		name string
+		// steps run in order, and all but the last must succeed.
+		steps []func(e *env) error
+		// err is the revert reason of the last step, if it reverts.
+		err  string
+		want avsState
+	}{
+		{
+			name:  "register",
+			steps: []func(*env) error{(*env).registerSigner, register(1)},
+			want:  avsState{status: fakes.OperatorRegistered, spent: true},
+		},
+		{
+			name: "register with a signature expiring in the block",
+			steps: []func(*env) error{(*env).registerSigner, func(e *env) error {
+				return e.registerToAVS(signerKey, 1, e.Chain.Time()+fakes.DefaultBlockTime)
+			}},
+			want: avsState{status: fakes.OperatorRegistered, spent: true},
+		},
+		{
+			name: "register with an expired signature",
+			steps: []func(*env) error{(*env).registerSigner, func(e *env) error {
+				return e.registerToAVS(signerKey, 1, e.Chain.Time()+fakes.DefaultBlockTime-1)
+			}},
+			err: "AVSDirectory.registerOperatorToAVS: operator signature expired",
+		},
+		{
+			name:  "register twice",
+			steps: []func(*env) error{(*env).registerSigner, register(1), register(2)},
+			err:   "AVSDirectory.registerOperatorToAVS: operator already registered",
+			want:  avsState{status: fakes.OperatorRegistered, spent: true},
+		},
+		{
+			name:  "reregister with a spent salt",
+			steps: []func(*env) error{(*env).registerSigner, register(1), deregister, register(1)},
+			err:   "AVSDirectory.registerOperatorToAVS: salt already spent",
+			want:  avsState{status: fakes.OperatorUnregistered, spent: true},
+		},
+		{
+			name:  "reregister with a new salt",
+			steps: []func(*env) error{(*env).registerSigner, register(1), deregister, register(2)},
+			want:  avsState{status: fakes.OperatorRegistered, spent: true},
+		},
+		{
+			name: "register with a cancelled salt",
+			steps: []func(*env) error{
+				(*env).registerSigner,
+				func(e *env) error {
+					_, err := e.AVSDirectory.CancelSalt(from(signer), [32]byte{1})
+					return err
+				},
+				register(1),
+			},
+			err:  "AVSDirectory.registerOperatorToAVS: salt already spent",
+			want: avsState{spent: true},
+		},
+		{
+			name:  "register an account that is not an operator",
+			steps: []func(*env) error{register(1)},
+			err:   "AVSDirectory.registerOperatorToAVS: operator not registered to EigenLayer yet",
+		},
+		{
+			name: "register with a signature of another key",
+			steps: []func(*env) error{(*env).registerSigner, func(e *env) error {
+				return e.registerToAVS(otherKey, 1, e.Chain.Time()+86400)
+			}},
+			err: "EIP1271SignatureUtils.checkSignature_EIP1271: signature not from signer",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := newEnv(t)
+			last := len(tt.steps) - 1
+			for _, step := range tt.steps[:last] {
+				if err := step(e); err != nil {
+					t.Fatal(err)
+				}
+			}
+			err := tt.steps[last](e)
+			var revert *fakes.RevertError
+			switch {
+			case tt.err == "" && err != nil:
+				t.Fatal(err)
+			case tt.err != "" && (!errors.As(err, &revert) || revert.Reason != tt.err):
+				t.Fatalf("err = %v, want a revert with %q", err, tt.err)
+			}
+			if got := e.avsState(); got != tt.want {
+				t.Errorf("state %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,280 @@
+package fakes
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/constants"
+)
+
+// BeaconChainETHStrategy is the virtual strategy holding beacon chain ETH
+// shares.
+var BeaconChainETHStrategy = common.HexToAddress("0xbeaC0eeEeeeeEEeEeEEEEeeEEeEeeeEeeEEBEaC0")
+
+const gweiToWei = constants.EigenPodManagerStorageGweiToWei
+
+var (
+	domainTypehash = crypto.Keccak256Hash([]byte("EIP712Domain(string name,uint256 chainId,address verifyingContract)"))
+	domainName     = crypto.Keccak256Hash([]byte("EigenLayer"))
+)
+
+// pauserRegistry is implemented by PauserRegistry, letting pausable fakes
+// check permissions while a transaction holds the chain lock.
+type pauserRegistry interface {
+	isPauser(account common.Address) bool
+	unpauser() common.Address
+}
+
+// pausable models OwnableUpgradeable and Pausable, which every core contract
+// inherits. Initializable is modeled by fakes being deployed initialized.
+type pausable struct {
+	contract
+	owner    common.Address
+	registry common.Address
+	paused   *big.Int
+}
+
+func newPausable(c contract, owner, registry common.Address, paused *big.Int) pausable {
+	if paused == nil {
+		paused = new(big.Int)
+	}
+	return pausable{contract: c, owner: owner, registry: registry, paused: new(big.Int).Set(paused)}
+}
+
+func (p *pausable) onlyOwner(tx *txContext) error {
+	if tx.sender != p.owner {
+		return revert("Ownable: caller is not the owner")
+	}
+	return nil
+}
+
+func (p *pausable) onlyWhenNotPaused(index uint8) error {
+	if p.paused.Bit(int(index)) == 1 {
+		return revert("Pausable: index is paused")
+	}
+	return nil
+}
+
+func (p *pausable) pauserRegistry() pauserRegistry {
+	registry, _ := p.chain.lookup(p.registry).(pauserRegistry)
+	return registry
+}
+
+func (p *pausable) onlyPauser(tx *txContext) error {
+	if registry := p.pauserRegistry(); registry == nil || !registry.isPauser(tx.sender) {
+		return revert("msg.sender is not permissioned as pauser")
+	}
+	return nil
+}
+
+func (p *pausable) onlyUnpauser(tx *txContext) error {
+	if registry := p.pauserRegistry(); registry == nil || tx.sender != registry.unpauser() {
+		return revert("msg.sender is not permissioned as unpauser")
+	}
+	return nil
+}
+
+// Owner returns the contract owner.
+func (p *pausable) Owner(opts *bind.CallOpts) (common.Address, error) {
+	p.chain.mu.Lock()
+	defer p.chain.mu.Unlock()
+	return p.owner, nil
+}
+
+// Paused reports whether the pause flag at index is set.
+func (p *pausable) Paused(opts *bind.CallOpts, index uint8) (bool, error) {
+	p.chain.mu.Lock()
+	defer p.chain.mu.Unlock()
+	return p.paused.Bit(int(index)) == 1, nil
+}
+
+// Paused0 returns the full pause bitmap.
+func (p *pausable) Paused0(opts *bind.CallOpts) (*big.Int, error) {
+	p.chain.mu.Lock()
+	defer p.chain.mu.Unlock()
+	return new(big.Int).Set(p.paused), nil
+}
+
+// PauserRegistry returns the address of the pauser registry.
+func (p *pausable) PauserRegistry(opts *bind.CallOpts) (common.Address, error) {
+	p.chain.mu.Lock()
+	defer p.chain.mu.Unlock()
+	return p.registry, nil
+}
+
+// Pause sets additional pause flags. It can only be called by a pauser.
+func (p *pausable) Pause(opts *bind.TransactOpts, newPausedStatus *big.Int) (*types.Transaction, error) {
+	return p.chain.transact(opts, &p.contract, "pause", []any{newPausedStatus}, func(tx *txContext) error {
+		if err := p.onlyPauser(tx); err != nil {
+			return err
+		}
+		if new(big.Int).And(p.paused, newPausedStatus).Cmp(p.paused) != 0 {
+			return revert("Pausable.pause: invalid attempt to unpause functionality")
+		}
+		assign(tx, &p.paused, new(big.Int).Set(newPausedStatus))
+		p.emit(tx, "Paused", tx.sender, newPausedStatus)
+		return nil
+	})
+}
+
+// PauseAll sets every pause flag. It can only be called by a pauser.
+func (p *pausable) PauseAll(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return p.chain.transact(opts, &p.contract, "pauseAll", nil, func(tx *txContext) error {
+		if err := p.onlyPauser(tx); err != nil {
+			return err
+		}
+		assign(tx, &p.paused, new(big.Int).Set(math.MaxBig256))
+		p.emit(tx, "Paused", tx.sender, math.MaxBig256)
+		return nil
+	})
+}
+
+// Unpause clears pause flags. It can only be called by the unpauser.
+func (p *pausable) Unpause(opts *bind.TransactOpts, newPausedStatus *big.Int) (*types.Transaction, error) {
+	return p.chain.transact(opts, &p.contract, "unpause", []any{newPausedStatus}, func(tx *txContext) error {
+		if err := p.onlyUnpauser(tx); err != nil {
+			return err
+		}
+		// The new status may only clear bits: (~paused & ~new) == ~paused.
+		if new(big.Int).AndNot(newPausedStatus, p.paused).Sign() != 0 {
+			return revert("Pausable.unpause: invalid attempt to pause functionality")
+		}
+		assign(tx, &p.paused, new(big.Int).Set(newPausedStatus))
+		p.emit(tx, "Unpaused", tx.sender, newPausedStatus)
+		return nil
+	})
+}
+
+// SetPauserRegistry replaces the pauser registry. It can only be called by
+// the unpauser.
+func (p *pausable) SetPauserRegistry(opts *bind.TransactOpts, newPauserRegistry common.Address) (*types.Transaction, error) {
+	return p.chain.transact(opts, &p.contract, "setPauserRegistry", []any{newPauserRegistry}, func(tx *txContext) error {
+		if err := p.onlyUnpauser(tx); err != nil {
+			return err
+		}
+		if newPauserRegistry == (common.Address{}) {
+			return revert("Pausable._setPauserRegistry: newPauserRegistry cannot be the zero address")
+		}
+		p.emit(tx, "PauserRegistrySet", p.registry, newPauserRegistry)
+		assign(tx, &p.registry, newPauserRegistry)
+		return nil
+	})
+}
+
+// RenounceOwnership leaves the contract without an owner.
+func (p *pausable) RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return p.chain.transact(opts, &p.contract, "renounceOwnership", nil, func(tx *txContext) error {
+		if err := p.onlyOwner(tx); err != nil {
+			return err
+		}
+		p.transferOwnership(tx, common.Address{})
+		return nil
+	})
+}
+
+// TransferOwnership hands the contract to newOwner.
+func (p *pausable) TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error) {
+	return p.chain.transact(opts, &p.contract, "transferOwnership", []any{newOwner}, func(tx *txContext) error {
+		if err := p.onlyOwner(tx); err != nil {
+			return err
+		}
+		if newOwner == (common.Address{}) {
+			return revert("Ownable: new owner is the zero address")
+		}
+		p.transferOwnership(tx, newOwner)
+		return nil
+	})
+}
+
+func (p *pausable) transferOwnership(tx *txContext, newOwner common.Address) {
+	p.emit(tx, "OwnershipTransferred", p.owner, newOwner)
+	assign(tx, &p.owner, newOwner)
+}
+
+// alreadyInitialized is the revert reason of every Initialize call, since
+// fakes are deployed initialized.
+func (p *pausable) alreadyInitialized(opts *bind.TransactOpts, args ...any) (*types.Transaction, error) {
+	return p.chain.transact(opts, &p.contract, "initialize", args, func(tx *txContext) error {
+		return revert("Initializable: contract is already initialized")
+	})
+}
+
+// domainSeparator returns the EIP-712 domain separator of the contract.
+func (c *contract) domainSeparator() common.Hash {
+	return crypto.Keccak256Hash(words(domainTypehash, domainName, c.chain.chainID, c.address))
+}
+
+// digest returns the EIP-712 digest of the given struct hash.
+func (c *contract) digest(structHash common.Hash) common.Hash {
+	sep := c.domainSeparator()
+	return crypto.Keccak256Hash([]byte("\x19\x01"), sep[:], structHash[:])
+}
+
+// words ABI-encodes static values the way abi.encode does, one 32 byte word
+// each.
+func words(values ...any) []byte {
+	out := make([]byte, 0, 32*len(values))
+	for _, v := range values {
+		switch v := v.(type) {
+		case common.Address:
+			out = append(out, common.LeftPadBytes(v[:], 32)...)
+		case common.Hash:
+			out = append(out, v[:]...)
+		case [32]byte:
+			out = append(out, v[:]...)
+		case *big.Int:
+			out = append(out, math.U256Bytes(new(big.Int).Set(v))...)
+		case uint64:
+			out = append(out, math.U256Bytes(new(big.Int).SetUint64(v))...)
+		default:
+			panic("fakes: cannot encode word")
+		}
+	}
+	return out
+}
+
+// checkSignature mirrors EIP1271SignatureUtils.checkSignature_EIP1271 for
+// EOA signers. Contract signers are not supported.
+func checkSignature(signer common.Address, digest common.Hash, signature []byte) error {
+	if len(signature) != crypto.SignatureLength {
+		return revert("ECDSA: invalid signature length")
+	}
+	sig := common.CopyBytes(signature)
+	if sig[crypto.RecoveryIDOffset] >= 27 {
+		sig[crypto.RecoveryIDOffset] -= 27
+	}
+	pub, err := crypto.SigToPub(digest[:], sig)
+	if err != nil {
+		return revert("ECDSA: invalid signature")
+	}
+	if crypto.PubkeyToAddress(*pub) != signer {
+		return revert("EIP1271SignatureUtils.checkSignature_EIP1271: signature not from signer")
+	}
+	return nil
+}
+
+// Sign signs an EIP-712 digest, as returned by the Calculate*DigestHash
+// methods, producing the 65 byte [R || S || V] signature with V in {27, 28}
+// that the contracts expect.
+func Sign(digest [32]byte, key *ecdsa.PrivateKey) ([]byte, error) {
+	sig, err := crypto.Sign(digest[:], key)
+	if err != nil {
+		return nil, err
+	}
+	sig[crypto.RecoveryIDOffset] += 27
+	return sig, nil
+}
+
+// orZero returns a copy of v, or zero if v is nil.
+func orZero(v *big.Int) *big.Int {
+	if v == nil {
+		return new(big.Int)
+	}
+	return new(big.Int).Set(v)
+}
@@ -0,0 +1,471 @@
+// Package fakes provides stateful, in-memory stand-ins for the core
+// EigenLayer contracts. Each fake implements the generated Reader, Writer and
+// Events interfaces of its binding package, models the contract's storage in
+// plain Go maps and emits the same logs, so code built on pkg/bindings can be
+// unit tested without an EVM.
+//
+// Fakes share a Chain, which orders transactions into blocks, records logs
+// and rolls back every state change of a transaction that reverts.
+//
+// NewDeployment wires up the fakes of DelegationManager, StrategyManager,
+// EigenPodManager, AVSDirectory and RewardsCoordinator, and the EigenPods
+// the EigenPodManager deploys:
+//
+//	d, err := fakes.NewDeployment(fakes.NewChain(genesisTime), fakes.DefaultConfig(owner))
+//
+// Transactions are mined one per block and revert with a *RevertError
+// holding the contract's reason, which matches its pkg/reverts sentinel.
+package fakes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/event"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/reverts"
+)
+
+const (
+	// DefaultChainID matches the simulated backend's chain ID.
+	DefaultChainID = 1337
+	// DefaultBlockTime is the number of seconds between automined blocks.
+	DefaultBlockTime = 12
+)
+
+// RevertError is returned by a fake transaction or call that the contract
+// would have reverted.
+type RevertError struct {
+	Reason string
+}
+
+func (e *RevertError) Error() string {
+	return "execution reverted: " + e.Reason
+}
+
+// ErrorData returns the revert data the contract would have returned as a
+// hex string, like a node's JSON-RPC error does: the ABI encoded
+// Error(string), or Panic(uint256) for a panic. This makes RevertError an
+// rpc.DataError that reverts.Decode handles like any other.
+func (e *RevertError) ErrorData() interface{} {
+	if code, ok := panicCodes[e.Reason]; ok {
+		return hexutil.Encode(append(crypto.Keccak256([]byte("Panic(uint256)"))[:4], common.LeftPadBytes([]byte{code}, 32)...))
+	}
+	stringType, _ := abi.NewType("string", "", nil)
+	data, _ := abi.Arguments{{Type: stringType}}.Pack(e.Reason)
+	return hexutil.Encode(append(crypto.Keccak256([]byte("Error(string)"))[:4], data...))
+}
+
+// Unwrap returns the sentinel error of Reason in the reverts catalogue, so
+// that errors.Is matches it without decoding. It returns nil for reasons of
+// contracts outside src/contracts, such as OpenZeppelin's.
+func (e *RevertError) Unwrap() error {
+	return reverts.Catalogue[e.Reason].Err
+}
+
+// Reasons for reverts caused by Solidity panics rather than require, as
+// abi.UnpackRevert describes them.
+const (
+	panicOutOfBounds = "out-of-bounds access of an array or bytesN"
+	panicUnderflow   = "arithmetic underflow or overflow"
+)
+
+// panicCodes maps the reason of a panic to its Solidity panic code.
+var panicCodes = map[string]byte{
+	panicOutOfBounds: 0x32,
+	panicUnderflow:   0x11,
+}
+
+func revert(reason string) error {
+	return &RevertError{Reason: reason}
+}
+
+// Chain is the shared block, log and account state of a set of fakes. Every
+// transaction is mined into its own block.
+type Chain struct {
+	mu sync.Mutex
+
+	chainID   *big.Int
+	blockTime uint64
+	number    uint64
+	time      uint64
+	txCount   uint64
+
+	logs      []types.Log
+	subs      map[*logSub]struct{}
+	contracts map[common.Address]any
+	balances  map[pair]*big.Int
+}
+
+// pair is a composite key of two addresses, used for nested Solidity
+// mappings.
+type pair struct {
+	a, b common.Address
+}
+
+// NewChain returns an empty chain whose genesis block has the given
+// timestamp.
+func NewChain(genesisTime uint64) *Chain {
+	return &Chain{
+		chainID:   big.NewInt(DefaultChainID),
+		blockTime: DefaultBlockTime,
+		time:      genesisTime,
+		subs:      make(map[*logSub]struct{}),
+		contracts: make(map[common.Address]any),
+		balances:  make(map[pair]*big.Int),
+	}
+}
+
+// ChainID returns the chain ID used in EIP-712 domain separators.
+func (c *Chain) ChainID() *big.Int {
+	return new(big.Int).Set(c.chainID)
+}
+
+// BlockNumber returns the number of the latest block.
+func (c *Chain) BlockNumber() uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.number
+}
+
+// Time returns the timestamp of the latest block.
+func (c *Chain) Time() uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.time
+}
+
+// Mine produces n empty blocks.
+func (c *Chain) Mine(n uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.number += n
+	c.time += n * c.blockTime
+}
+
+// AdvanceTime moves the timestamp of the latest block forward without
+// producing a block.
+func (c *Chain) AdvanceTime(seconds uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.time += seconds
+}
+
+// Logs returns every log emitted so far, in order.
+func (c *Chain) Logs() []types.Log {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]types.Log(nil), c.logs...)
+}
+
+// Mint credits holder with amount of an ERC20 token. Token balances are kept
+// by the chain so deposits, withdrawals, rewards and claims move funds
+// between accounts; allowances are not modeled. ETH withdrawn from EigenPods
+// is credited under the zero token address.
+func (c *Chain) Mint(token, holder common.Address, amount *big.Int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	k := pair{token, holder}
+	c.balances[k] = new(big.Int).Add(orZero(c.balances[k]), amount)
+}
+
+// BalanceOf returns the balance of holder in an ERC20 token.
+func (c *Chain) BalanceOf(token, holder common.Address) *big.Int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return orZero(c.balances[pair{token, holder}])
+}
+
+// Register makes a contract reachable by address from other fakes, e.g. a
+// pauser registry or beacon chain oracle.
+func (c *Chain) Register(addr common.Address, contract any) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.contracts[addr] = contract
+}
+
+// lookup returns the contract registered at addr. The caller must hold mu.
+func (c *Chain) lookup(addr common.Address) any {
+	return c.contracts[addr]
+}
+
+// view runs fn against the latest block with the chain locked.
+func (c *Chain) view(fn func(b block) error) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return fn(block{number: c.number, time: c.time})
+}
+
+// block is the context a call or transaction executes in.
+type block struct {
+	number uint64
+	time   uint64
+}
+
+// txContext is the execution context of a single transaction.
+type txContext struct {
+	block
+	chain  *Chain
+	sender common.Address
+	value  *big.Int
+	logs   []types.Log
+	undo   []func()
+}
+
+// record registers fn to be run, in reverse order, if the transaction reverts.
+func (tx *txContext) record(fn func()) {
+	tx.undo = append(tx.undo, fn)
+}
+
+// transfer moves amount of token from one holder to another, reverting like
+// an OpenZeppelin ERC20 if the sender's balance is too low.
+func (tx *txContext) transfer(token, from, to common.Address, amount *big.Int) error {
+	balances := tx.chain.balances
+	have := orZero(balances[pair{token, from}])
+	if have.Cmp(amount) < 0 {
+		return revert("ERC20: transfer amount exceeds balance")
+	}
+	set(tx, balances, pair{token, from}, new(big.Int).Sub(have, amount))
+	set(tx, balances, pair{token, to}, new(big.Int).Add(orZero(balances[pair{token, to}]), amount))
+	return nil
+}
+
+// credit adds amount of token to a holder without debiting anyone.
+func (tx *txContext) credit(token, to common.Address, amount *big.Int) {
+	balances := tx.chain.balances
+	set(tx, balances, pair{token, to}, new(big.Int).Add(orZero(balances[pair{token, to}]), amount))
+}
+
+// set assigns m[k] = v, restoring the previous entry on revert.
+func set[K comparable, V any](tx *txContext, m map[K]V, k K, v V) {
+	old, ok := m[k]
+	tx.record(func() {
+		if ok {
+			m[k] = old
+		} else {
+			delete(m, k)
+		}
+	})
+	m[k] = v
+}
+
+// assign sets *p = v, restoring the previous value on revert.
+func assign[T any](tx *txContext, p *T, v T) {
+	old := *p
+	tx.record(func() { *p = old })
+	*p = v
+}
+
+// transact executes fn as a transaction sent by opts.From to a contract,
+// mining it into a new block. If fn fails every state change it made is
+// rolled back and no block is produced.
+func (c *Chain) transact(opts *bind.TransactOpts, to *contract, method string, args []any, fn func(tx *txContext) error) (*types.Transaction, error) {
+	if opts == nil {
+		return nil, errors.New("fakes: nil transact opts")
+	}
+	data, err := to.abi.Pack(method, args...)
+	if err != nil {
+		return nil, err
+	}
+	value := new(big.Int)
+	if opts.Value != nil {
+		value.Set(opts.Value)
+	}
+
+	c.mu.Lock()
+	tx := &txContext{
+		block:  block{number: c.number + 1, time: c.time + c.blockTime},
+		chain:  c,
+		sender: opts.From,
+		value:  value,
+	}
+	if err := fn(tx); err != nil {
+		for i := len(tx.undo) - 1; i >= 0; i-- {
+			tx.undo[i]()
+		}
+		c.mu.Unlock()
+		return nil, err
+	}
+	addr := to.address
+	signed := types.NewTx(&types.LegacyTx{
+		Nonce:    c.txCount,
+		To:       &addr,
+		Value:    value,
+		Gas:      opts.GasLimit,
+		GasPrice: new(big.Int),
+		Data:     data,
+	})
+	c.txCount++
+	c.number, c.time = tx.number, tx.time
+	for i := range tx.logs {
+		log := &tx.logs[i]
+		log.BlockNumber = tx.number
+		log.TxHash = signed.Hash()
+		log.Index = uint(len(c.logs))
+		c.logs = append(c.logs, *log)
+	}
+	subs := make([]*logSub, 0, len(c.subs))
+	for sub := range c.subs {
+		subs = append(subs, sub)
+	}
+	c.mu.Unlock()
+
+	for _, log := range tx.logs {
+		for _, sub := range subs {
+			sub.deliver(log)
+		}
+	}
+	return signed, nil
+}
+
+// contract is the state shared by every fake contract.
+type contract struct {
+	chain   *Chain
+	address common.Address
+	abi     *abi.ABI
+}
+
+func newContract(chain *Chain, address common.Address, md *bind.MetaData) contract {
+	parsed, err := md.GetAbi()
+	if err != nil {
+		panic(fmt.Sprintf("fakes: invalid binding abi: %v", err))
+	}
+	return contract{chain: chain, address: address, abi: parsed}
+}
+
+// Address returns the address the fake is deployed at.
+func (c *contract) Address() common.Address {
+	return c.address
+}
+
+// emit appends the named event, with arguments in ABI order, to the
+// transaction's logs.
+func (c *contract) emit(tx *txContext, name string, args ...any) {
+	ev, ok := c.abi.Events[name]
+	if !ok {
+		panic("fakes: unknown event " + name)
+	}
+	if len(args) != len(ev.Inputs) {
+		panic(fmt.Sprintf("fakes: event %s takes %d arguments, got %d", name, len(ev.Inputs), len(args)))
+	}
+	topics := []common.Hash{ev.ID}
+	var data []any
+	for i, input := range ev.Inputs {
+		if !input.Indexed {
+			data = append(data, args[i])
+			continue
+		}
+		topic, err := abi.MakeTopics([]any{args[i]})
+		if err != nil {
+			panic(fmt.Sprintf("fakes: event %s: %v", name, err))
+		}
+		topics = append(topics, topic[0][0])
+	}
+	packed, err := ev.Inputs.NonIndexed().Pack(data...)
+	if err != nil {
+		panic(fmt.Sprintf("fakes: event %s: %v", name, err))
+	}
+	tx.logs = append(tx.logs, types.Log{
+		Address: c.address,
+		Topics:  topics,
+		Data:    packed,
+	})
+}
+
+// FilterLogs implements bind.ContractFilterer over the chain's logs.
+func (c *Chain) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var out []types.Log
+	for _, log := range c.logs {
+		if matches(q, log) {
+			out = append(out, log)
+		}
+	}
+	return out, nil
+}
+
+// SubscribeFilterLogs implements bind.ContractFilterer, delivering logs
+// matching q as they are emitted.
+func (c *Chain) SubscribeFilterLogs(ctx context.Context, q ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
+	sub := &logSub{query: q, ch: ch, quit: make(chan struct{})}
+	c.mu.Lock()
+	c.subs[sub] = struct{}{}
+	c.mu.Unlock()
+	return event.NewSubscription(func(unsub <-chan struct{}) error {
+		<-unsub
+		c.mu.Lock()
+		delete(c.subs, sub)
+		c.mu.Unlock()
+		close(sub.quit)
+		return nil
+	}), nil
+}
+
+type logSub struct {
+	query ethereum.FilterQuery
+	ch    chan<- types.Log
+	quit  chan struct{}
+}
+
+func (s *logSub) deliver(log types.Log) {
+	if !matches(s.query, log) {
+		return
+	}
+	select {
+	case s.ch <- log:
+	case <-s.quit:
+	}
+}
+
+// matches reports whether log satisfies the address, topic and block range
+// criteria of q.
+func matches(q ethereum.FilterQuery, log types.Log) bool {
+	if q.FromBlock != nil && q.FromBlock.Sign() >= 0 && log.BlockNumber < q.FromBlock.Uint64() {
+		return false
+	}
+	if q.ToBlock != nil && q.ToBlock.Sign() >= 0 && log.BlockNumber > q.ToBlock.Uint64() {
+		return false
+	}
+	if len(q.Addresses) > 0 {
+		found := false
+		for _, addr := range q.Addresses {
+			if addr == log.Address {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if len(q.Topics) > len(log.Topics) {
+		return false
+	}
+	for i, alternatives := range q.Topics {
+		if len(alternatives) == 0 {
+			continue
+		}
+		found := false
+		for _, topic := range alternatives {
+			if topic == log.Topics[i] {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,740 @@
+package fakes
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	delegationmanager "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/DelegationManager"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/constants"
+)
+
+const (
+	// maxStakerOptOutWindowBlocks is roughly six months of 12 second blocks.
+	maxStakerOptOutWindowBlocks = 180 * 24 * 60 * 60 / 12
+	maxWithdrawalDelayBlocks    = 216_000
+
+	dmPausedNewDelegation        = uint8(constants.DelegationManagerPausedNewDelegation)
+	dmPausedEnterWithdrawalQueue = uint8(constants.DelegationManagerPausedEnterWithdrawalQueue)
+	dmPausedExitWithdrawalQueue  = uint8(constants.DelegationManagerPausedExitWithdrawalQueue)
+)
+
+var (
+	stakerDelegationTypehash   = crypto.Keccak256Hash([]byte("StakerDelegation(address staker,address operator,uint256 nonce,uint256 expiry)"))
+	delegationApprovalTypehash = crypto.Keccak256Hash([]byte("DelegationApproval(address delegationApprover,address staker,address operator,bytes32 salt,uint256 expiry)"))
+)
+
+// saltKey indexes the salts spent by an account.
+type saltKey struct {
+	account common.Address
+	salt    [32]byte
+}
+
+// DelegationManager is a fake of the DelegationManager contract, tracking
+// operators, delegations, operator shares and queued withdrawals.
+type DelegationManager struct {
+	*delegationmanager.DelegationManagerFilterer
+	pausable
+
+	strategies *StrategyManager
+	eigenPods  *EigenPodManager
+	slasher    common.Address
+
+	minWithdrawalDelayBlocks *big.Int
+	strategyDelayBlocks      map[common.Address]*big.Int
+	operatorDetails          map[common.Address]delegationmanager.IDelegationManagerOperatorDetails
+	delegatedTo              map[common.Address]common.Address
+	operatorShares           map[pair]*big.Int
+	stakerNonce              map[common.Address]*big.Int
+	approverSaltSpent        map[saltKey]bool
+	pendingWithdrawals       map[[32]byte]bool
+	withdrawalsQueued        map[common.Address]*big.Int
+}
+
+var (
+	_ delegationmanager.DelegationManagerReader = (*DelegationManager)(nil)
+	_ delegationmanager.DelegationManagerWriter = (*DelegationManager)(nil)
+	_ delegationmanager.DelegationManagerEvents = (*DelegationManager)(nil)
+)
+
+func newDelegationManager(chain *Chain, address common.Address, cfg *Config) *DelegationManager {
+	filterer, err := delegationmanager.NewDelegationManagerFilterer(address, chain)
+	if err != nil {
+		panic(err)
+	}
+	c := newContract(chain, address, delegationmanager.DelegationManagerMetaData)
+	dm := &DelegationManager{
+		DelegationManagerFilterer: filterer,
+		pausable:                  newPausable(c, cfg.Owner, cfg.PauserRegistry, nil),
+		slasher:                   cfg.Slasher,
+		minWithdrawalDelayBlocks:  new(big.Int).SetUint64(cfg.MinWithdrawalDelayBlocks),
+		strategyDelayBlocks:       make(map[common.Address]*big.Int),
+		operatorDetails:           make(map[common.Address]delegationmanager.IDelegationManagerOperatorDetails),
+		delegatedTo:               make(map[common.Address]common.Address),
+		operatorShares:            make(map[pair]*big.Int),
+		stakerNonce:               make(map[common.Address]*big.Int),
+		approverSaltSpent:         make(map[saltKey]bool),
+		pendingWithdrawals:        make(map[[32]byte]bool),
+		withdrawalsQueued:         make(map[common.Address]*big.Int),
+	}
+	chain.Register(address, dm)
+	return dm
+}
+
+// BeaconChainETHStrategy returns the virtual beacon chain ETH strategy.
+func (dm *DelegationManager) BeaconChainETHStrategy(opts *bind.CallOpts) (common.Address, error) {
+	return BeaconChainETHStrategy, nil
+}
+
+// CalculateCurrentStakerDelegationDigestHash returns the digest staker signs
+// to delegate to operator with its current nonce.
+func (dm *DelegationManager) CalculateCurrentStakerDelegationDigestHash(opts *bind.CallOpts, staker common.Address, operator common.Address, expiry *big.Int) ([32]byte, error) {
+	dm.chain.mu.Lock()
+	defer dm.chain.mu.Unlock()
+	return dm.stakerDelegationDigest(staker, orZero(dm.stakerNonce[staker]), operator, expiry), nil
+}
+
+// CalculateDelegationApprovalDigestHash returns the digest an operator's
+// delegation approver signs to approve a delegation.
+func (dm *DelegationManager) CalculateDelegationApprovalDigestHash(opts *bind.CallOpts, staker common.Address, operator common.Address, _delegationApprover common.Address, approverSalt [32]byte, expiry *big.Int) ([32]byte, error) {
+	return dm.delegationApprovalDigest(staker, operator, _delegationApprover, approverSalt, expiry), nil
+}
+
+// CalculateStakerDelegationDigestHash returns the digest staker signs to
+// delegate to operator with the given nonce.
+func (dm *DelegationManager) CalculateStakerDelegationDigestHash(opts *bind.CallOpts, staker common.Address, _stakerNonce *big.Int, operator common.Address, expiry *big.Int) ([32]byte, error) {
+	return dm.stakerDelegationDigest(staker, _stakerNonce, operator, expiry), nil
+}
+
+// CalculateWithdrawalRoot returns the hash identifying a queued withdrawal.
+func (dm *DelegationManager) CalculateWithdrawalRoot(opts *bind.CallOpts, withdrawal delegationmanager.IDelegationManagerWithdrawal) ([32]byte, error) {
+	return dm.withdrawalRoot(withdrawal)
+}
+
+// CumulativeWithdrawalsQueued returns the number of withdrawals staker has
+// queued, used as the nonce of the next one.
+func (dm *DelegationManager) CumulativeWithdrawalsQueued(opts *bind.CallOpts, staker common.Address) (*big.Int, error) {
+	dm.chain.mu.Lock()
+	defer dm.chain.mu.Unlock()
+	return orZero(dm.withdrawalsQueued[staker]), nil
+}
+
+// DELEGATIONAPPROVALTYPEHASH returns the EIP-712 typehash of delegation
+// approvals.
+func (dm *DelegationManager) DELEGATIONAPPROVALTYPEHASH(opts *bind.CallOpts) ([32]byte, error) {
+	return delegationApprovalTypehash, nil
+}
+
+// DOMAINTYPEHASH returns the EIP-712 domain typehash.
+func (dm *DelegationManager) DOMAINTYPEHASH(opts *bind.CallOpts) ([32]byte, error) {
+	return domainTypehash, nil
+}
+
+// DelegatedTo returns the operator staker is delegated to.
+func (dm *DelegationManager) DelegatedTo(opts *bind.CallOpts, staker common.Address) (common.Address, error) {
+	dm.chain.mu.Lock()
+	defer dm.chain.mu.Unlock()
+	return dm.delegatedTo[staker], nil
+}
+
+// DelegationApprover returns the delegation approver of operator.
+func (dm *DelegationManager) DelegationApprover(opts *bind.CallOpts, operator common.Address) (common.Address, error) {
+	dm.chain.mu.Lock()
+	defer dm.chain.mu.Unlock()
+	return dm.operatorDetails[operator].DelegationApprover, nil
+}
+
+// DelegationApproverSaltIsSpent reports whether approver has used salt.
+func (dm *DelegationManager) DelegationApproverSaltIsSpent(opts *bind.CallOpts, approver common.Address, salt [32]byte) (bool, error) {
+	dm.chain.mu.Lock()
+	defer dm.chain.mu.Unlock()
+	return dm.approverSaltSpent[saltKey{approver, salt}], nil
+}
+
+// DomainSeparator returns the EIP-712 domain separator.
+func (dm *DelegationManager) DomainSeparator(opts *bind.CallOpts) ([32]byte, error) {
+	return dm.domainSeparator(), nil
+}
+
+// EigenPodManager returns the address of the EigenPodManager.
+func (dm *DelegationManager) EigenPodManager(opts *bind.CallOpts) (common.Address, error) {
+	return dm.eigenPods.address, nil
+}
+
+// GetDelegatableShares returns the strategy manager deposits of staker,
+// followed by its beacon chain ETH shares if positive.
+func (dm *DelegationManager) GetDelegatableShares(opts *bind.CallOpts, staker common.Address) ([]common.Address, []*big.Int, error) {
+	dm.chain.mu.Lock()
+	defer dm.chain.mu.Unlock()
+	strategies, shares := dm.getDelegatableShares(staker)
+	return strategies, shares, nil
+}
+
+// GetOperatorShares returns the shares delegated to operator in each strategy.
+func (dm *DelegationManager) GetOperatorShares(opts *bind.CallOpts, operator common.Address, strategies []common.Address) ([]*big.Int, error) {
+	dm.chain.mu.Lock()
+	defer dm.chain.mu.Unlock()
+	shares := make([]*big.Int, len(strategies))
+	for i, strategy := range strategies {
+		shares[i] = orZero(dm.operatorShares[pair{operator, strategy}])
+	}
+	return shares, nil
+}
+
+// GetWithdrawalDelay returns the number of blocks a withdrawal from all of
+// strategies must wait before completion.
+func (dm *DelegationManager) GetWithdrawalDelay(opts *bind.CallOpts, strategies []common.Address) (*big.Int, error) {
+	dm.chain.mu.Lock()
+	defer dm.chain.mu.Unlock()
+	delay := orZero(dm.minWithdrawalDelayBlocks)
+	for _, strategy := range strategies {
+		if d := orZero(dm.strategyDelayBlocks[strategy]); d.Cmp(delay) > 0 {
+			delay = d
+		}
+	}
+	return delay, nil
+}
+
+// IsDelegated reports whether staker is delegated to an operator.
+func (dm *DelegationManager) IsDelegated(opts *bind.CallOpts, staker common.Address) (bool, error) {
+	dm.chain.mu.Lock()
+	defer dm.chain.mu.Unlock()
+	return dm.isDelegated(staker), nil
+}
+
+// IsOperator reports whether operator is registered.
+func (dm *DelegationManager) IsOperator(opts *bind.CallOpts, operator common.Address) (bool, error) {
+	dm.chain.mu.Lock()
+	defer dm.chain.mu.Unlock()
+	return dm.isOperator(operator), nil
+}
+
+// MAXSTAKEROPTOUTWINDOWBLOCKS returns the maximum staker opt-out window.
+func (dm *DelegationManager) MAXSTAKEROPTOUTWINDOWBLOCKS(opts *bind.CallOpts) (*big.Int, error) {
+	return big.NewInt(maxStakerOptOutWindowBlocks), nil
+}
+
+// MAXWITHDRAWALDELAYBLOCKS returns the maximum withdrawal delay.
+func (dm *DelegationManager) MAXWITHDRAWALDELAYBLOCKS(opts *bind.CallOpts) (*big.Int, error) {
+	return big.NewInt(maxWithdrawalDelayBlocks), nil
+}
+
+// MinWithdrawalDelayBlocks returns the delay applying to every withdrawal.
+func (dm *DelegationManager) MinWithdrawalDelayBlocks(opts *bind.CallOpts) (*big.Int, error) {
+	dm.chain.mu.Lock()
+	defer dm.chain.mu.Unlock()
+	return orZero(dm.minWithdrawalDelayBlocks), nil
+}
+
+// OperatorDetails returns the details operator registered with.
+func (dm *DelegationManager) OperatorDetails(opts *bind.CallOpts, operator common.Address) (delegationmanager.IDelegationManagerOperatorDetails, error) {
+	dm.chain.mu.Lock()
+	defer dm.chain.mu.Unlock()
+	return dm.operatorDetails[operator], nil
+}
+
+// OperatorShares returns the shares delegated to operator in strategy.
+func (dm *DelegationManager) OperatorShares(opts *bind.CallOpts, operator common.Address, strategy common.Address) (*big.Int, error) {
+	dm.chain.mu.Lock()
+	defer dm.chain.mu.Unlock()
+	return orZero(dm.operatorShares[pair{operator, strategy}]), nil
+}
+
+// PendingWithdrawals reports whether the withdrawal with the given root is
+// queued and not yet completed.
+func (dm *DelegationManager) PendingWithdrawals(opts *bind.CallOpts, root [32]byte) (bool, error) {
+	dm.chain.mu.Lock()
+	defer dm.chain.mu.Unlock()
+	return dm.pendingWithdrawals[root], nil
+}
+
+// STAKERDELEGATIONTYPEHASH returns the EIP-712 typehash of staker
+// delegations.
+func (dm *DelegationManager) STAKERDELEGATIONTYPEHASH(opts *bind.CallOpts) ([32]byte, error) {
+	return stakerDelegationTypehash, nil
+}
+
+// Slasher returns the address of the Slasher.
+func (dm *DelegationManager) Slasher(opts *bind.CallOpts) (common.Address, error) {
+	return dm.slasher, nil
+}
+
+// StakerNonce returns the delegation-by-signature nonce of staker.
+func (dm *DelegationManager) StakerNonce(opts *bind.CallOpts, staker common.Address) (*big.Int, error) {
+	dm.chain.mu.Lock()
+	defer dm.chain.mu.Unlock()
+	return orZero(dm.stakerNonce[staker]), nil
+}
+
+// StakerOptOutWindowBlocks returns the staker opt-out window of operator.
+func (dm *DelegationManager) StakerOptOutWindowBlocks(opts *bind.CallOpts, operator common.Address) (*big.Int, error) {
+	dm.chain.mu.Lock()
+	defer dm.chain.mu.Unlock()
+	return new(big.Int).SetUint64(uint64(dm.operatorDetails[operator].StakerOptOutWindowBlocks)), nil
+}
+
+// StrategyManager returns the address of the StrategyManager.
+func (dm *DelegationManager) StrategyManager(opts *bind.CallOpts) (common.Address, error) {
+	return dm.strategies.address, nil
+}
+
+// StrategyWithdrawalDelayBlocks returns the withdrawal delay of strategy.
+func (dm *DelegationManager) StrategyWithdrawalDelayBlocks(opts *bind.CallOpts, strategy common.Address) (*big.Int, error) {
+	dm.chain.mu.Lock()
+	defer dm.chain.mu.Unlock()
+	return orZero(dm.strategyDelayBlocks[strategy]), nil
+}
+
+// CompleteQueuedWithdrawal completes a queued withdrawal, either as tokens
+// or by returning the shares to the withdrawer.
+func (dm *DelegationManager) CompleteQueuedWithdrawal(opts *bind.TransactOpts, withdrawal delegationmanager.IDelegationManagerWithdrawal, tokens []common.Address, middlewareTimesIndex *big.Int, receiveAsTokens bool) (*types.Transaction, error) {
+	return dm.chain.transact(opts, &dm.contract, "completeQueuedWithdrawal", []any{withdrawal, tokens, middlewareTimesIndex, receiveAsTokens}, func(tx *txContext) error {
+		if err := dm.onlyWhenNotPaused(dmPausedExitWithdrawalQueue); err != nil {
+			return err
+		}
+		return dm.completeQueuedWithdrawal(tx, withdrawal, tokens, receiveAsTokens)
+	})
+}
+
+// CompleteQueuedWithdrawals completes several queued withdrawals at once.
+func (dm *DelegationManager) CompleteQueuedWithdrawals(opts *bind.TransactOpts, withdrawals []delegationmanager.IDelegationManagerWithdrawal, tokens [][]common.Address, middlewareTimesIndexes []*big.Int, receiveAsTokens []bool) (*types.Transaction, error) {
+	return dm.chain.transact(opts, &dm.contract, "completeQueuedWithdrawals", []any{withdrawals, tokens, middlewareTimesIndexes, receiveAsTokens}, func(tx *txContext) error {
+		if err := dm.onlyWhenNotPaused(dmPausedExitWithdrawalQueue); err != nil {
+			return err
+		}
+		if len(tokens) < len(withdrawals) || len(middlewareTimesIndexes) < len(withdrawals) || len(receiveAsTokens) < len(withdrawals) {
+			return revert(panicOutOfBounds)
+		}
+		for i, withdrawal := range withdrawals {
+			if err := dm.completeQueuedWithdrawal(tx, withdrawal, tokens[i], receiveAsTokens[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// DecreaseDelegatedShares is called by the StrategyManager or
+// EigenPodManager when a delegated staker's shares decrease.
+func (dm *DelegationManager) DecreaseDelegatedShares(opts *bind.TransactOpts, staker common.Address, strategy common.Address, shares *big.Int) (*types.Transaction, error) {
+	return dm.chain.transact(opts, &dm.contract, "decreaseDelegatedShares", []any{staker, strategy, shares}, func(tx *txContext) error {
+		if err := dm.onlyStrategyManagerOrEigenPodManager(tx); err != nil {
+			return err
+		}
+		return dm.decreaseDelegatedShares(tx, staker, strategy, shares)
+	})
+}
+
+// DelegateTo delegates the sender's shares to operator.
+func (dm *DelegationManager) DelegateTo(opts *bind.TransactOpts, operator common.Address, approverSignatureAndExpiry delegationmanager.ISignatureUtilsSignatureWithExpiry, approverSalt [32]byte) (*types.Transaction, error) {
+	return dm.chain.transact(opts, &dm.contract, "delegateTo", []any{operator, approverSignatureAndExpiry, approverSalt}, func(tx *txContext) error {
+		if dm.isDelegated(tx.sender) {
+			return revert("DelegationManager.delegateTo: staker is already actively delegated")
+		}
+		if !dm.isOperator(operator) {
+			return revert("DelegationManager.delegateTo: operator is not registered in EigenLayer")
+		}
+		return dm.delegate(tx, tx.sender, operator, approverSignatureAndExpiry, approverSalt)
+	})
+}
+
+// DelegateToBySignature delegates staker's shares to operator, authorized by
+// the staker's EIP-712 signature.
+func (dm *DelegationManager) DelegateToBySignature(opts *bind.TransactOpts, staker common.Address, operator common.Address, stakerSignatureAndExpiry delegationmanager.ISignatureUtilsSignatureWithExpiry, approverSignatureAndExpiry delegationmanager.ISignatureUtilsSignatureWithExpiry, approverSalt [32]byte) (*types.Transaction, error) {
+	return dm.chain.transact(opts, &dm.contract, "delegateToBySignature", []any{staker, operator, stakerSignatureAndExpiry, approverSignatureAndExpiry, approverSalt}, func(tx *txContext) error {
+		if orZero(stakerSignatureAndExpiry.Expiry).Cmp(new(big.Int).SetUint64(tx.time)) < 0 {
+			return revert("DelegationManager.delegateToBySignature: staker signature expired")
+		}
+		if dm.isDelegated(staker) {
+			return revert("DelegationManager.delegateToBySignature: staker is already actively delegated")
+		}
+		if !dm.isOperator(operator) {
+			return revert("DelegationManager.delegateToBySignature: operator is not registered in EigenLayer")
+		}
+		nonce := orZero(dm.stakerNonce[staker])
+		digest := dm.stakerDelegationDigest(staker, nonce, operator, stakerSignatureAndExpiry.Expiry)
+		set(tx, dm.stakerNonce, staker, new(big.Int).Add(nonce, common.Big1))
+		if err := checkSignature(staker, digest, stakerSignatureAndExpiry.Signature); err != nil {
+			return err
+		}
+		return dm.delegate(tx, staker, operator, approverSignatureAndExpiry, approverSalt)
+	})
+}
+
+// IncreaseDelegatedShares is called by the StrategyManager or
+// EigenPodManager when a delegated staker's shares increase.
+func (dm *DelegationManager) IncreaseDelegatedShares(opts *bind.TransactOpts, staker common.Address, strategy common.Address, shares *big.Int) (*types.Transaction, error) {
+	return dm.chain.transact(opts, &dm.contract, "increaseDelegatedShares", []any{staker, strategy, shares}, func(tx *txContext) error {
+		if err := dm.onlyStrategyManagerOrEigenPodManager(tx); err != nil {
+			return err
+		}
+		return dm.increaseDelegatedShares(tx, staker, strategy, shares)
+	})
+}
+
+// Initialize always reverts, since the fake is deployed initialized.
+func (dm *DelegationManager) Initialize(opts *bind.TransactOpts, initialOwner common.Address, _pauserRegistry common.Address, initialPausedStatus *big.Int, _minWithdrawalDelayBlocks *big.Int, _strategies []common.Address, _withdrawalDelayBlocks []*big.Int) (*types.Transaction, error) {
+	return dm.alreadyInitialized(opts, initialOwner, _pauserRegistry, initialPausedStatus, _minWithdrawalDelayBlocks, _strategies, _withdrawalDelayBlocks)
+}
+
+// ModifyOperatorDetails updates the details of the sending operator.
+func (dm *DelegationManager) ModifyOperatorDetails(opts *bind.TransactOpts, newOperatorDetails delegationmanager.IDelegationManagerOperatorDetails) (*types.Transaction, error) {
+	return dm.chain.transact(opts, &dm.contract, "modifyOperatorDetails", []any{newOperatorDetails}, func(tx *txContext) error {
+		if !dm.isOperator(tx.sender) {
+			return revert("DelegationManager.modifyOperatorDetails: caller must be an operator")
+		}
+		return dm.setOperatorDetails(tx, tx.sender, newOperatorDetails)
+	})
+}
+
+// QueueWithdrawals removes shares from the sender and its operator and
+// queues them for withdrawal.
+func (dm *DelegationManager) QueueWithdrawals(opts *bind.TransactOpts, queuedWithdrawalParams []delegationmanager.IDelegationManagerQueuedWithdrawalParams) (*types.Transaction, error) {
+	return dm.chain.transact(opts, &dm.contract, "queueWithdrawals", []any{queuedWithdrawalParams}, func(tx *txContext) error {
+		if err := dm.onlyWhenNotPaused(dmPausedEnterWithdrawalQueue); err != nil {
+			return err
+		}
+		operator := dm.delegatedTo[tx.sender]
+		for _, params := range queuedWithdrawalParams {
+			if len(params.Strategies) != len(params.Shares) {
+				return revert("DelegationManager.queueWithdrawal: input length mismatch")
+			}
+			if params.Withdrawer != tx.sender {
+				return revert("DelegationManager.queueWithdrawal: withdrawer must be staker")
+			}
+			if _, err := dm.removeSharesAndQueueWithdrawal(tx, tx.sender, operator, params.Withdrawer, params.Strategies, params.Shares); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// RegisterAsOperator registers the sender as an operator delegated to
+// itself.
+func (dm *DelegationManager) RegisterAsOperator(opts *bind.TransactOpts, registeringOperatorDetails delegationmanager.IDelegationManagerOperatorDetails, metadataURI string) (*types.Transaction, error) {
+	return dm.chain.transact(opts, &dm.contract, "registerAsOperator", []any{registeringOperatorDetails, metadataURI}, func(tx *txContext) error {
+		if dm.isDelegated(tx.sender) {
+			return revert("DelegationManager.registerAsOperator: caller is already actively delegated")
+		}
+		if err := dm.setOperatorDetails(tx, tx.sender, registeringOperatorDetails); err != nil {
+			return err
+		}
+		var noSignature delegationmanager.ISignatureUtilsSignatureWithExpiry
+		if err := dm.delegate(tx, tx.sender, tx.sender, noSignature, [32]byte{}); err != nil {
+			return err
+		}
+		dm.emit(tx, "OperatorRegistered", tx.sender, registeringOperatorDetails)
+		dm.emit(tx, "OperatorMetadataURIUpdated", tx.sender, metadataURI)
+		return nil
+	})
+}
+
+// SetMinWithdrawalDelayBlocks sets the delay applying to every withdrawal.
+// It can only be called by the owner.
+func (dm *DelegationManager) SetMinWithdrawalDelayBlocks(opts *bind.TransactOpts, newMinWithdrawalDelayBlocks *big.Int) (*types.Transaction, error) {
+	return dm.chain.transact(opts, &dm.contract, "setMinWithdrawalDelayBlocks", []any{newMinWithdrawalDelayBlocks}, func(tx *txContext) error {
+		if err := dm.onlyOwner(tx); err != nil {
+			return err
+		}
+		if newMinWithdrawalDelayBlocks.Cmp(big.NewInt(maxWithdrawalDelayBlocks)) > 0 {
+			return revert("DelegationManager._setMinWithdrawalDelayBlocks: _minWithdrawalDelayBlocks cannot be > MAX_WITHDRAWAL_DELAY_BLOCKS")
+		}
+		dm.emit(tx, "MinWithdrawalDelayBlocksSet", dm.minWithdrawalDelayBlocks, newMinWithdrawalDelayBlocks)
+		assign(tx, &dm.minWithdrawalDelayBlocks, new(big.Int).Set(newMinWithdrawalDelayBlocks))
+		return nil
+	})
+}
+
+// SetStrategyWithdrawalDelayBlocks sets per-strategy withdrawal delays. It
+// can only be called by the owner.
+func (dm *DelegationManager) SetStrategyWithdrawalDelayBlocks(opts *bind.TransactOpts, strategies []common.Address, withdrawalDelayBlocks []*big.Int) (*types.Transaction, error) {
+	return dm.chain.transact(opts, &dm.contract, "setStrategyWithdrawalDelayBlocks", []any{strategies, withdrawalDelayBlocks}, func(tx *txContext) error {
+		if err := dm.onlyOwner(tx); err != nil {
+			return err
+		}
+		if len(strategies) != len(withdrawalDelayBlocks) {
+			return revert("DelegationManager._setStrategyWithdrawalDelayBlocks: input length mismatch")
+		}
+		for i, strategy := range strategies {
+			delay := withdrawalDelayBlocks[i]
+			if delay.Cmp(big.NewInt(maxWithdrawalDelayBlocks)) > 0 {
+				return revert("DelegationManager._setStrategyWithdrawalDelayBlocks: _withdrawalDelayBlocks cannot be > MAX_WITHDRAWAL_DELAY_BLOCKS")
+			}
+			prev := orZero(dm.strategyDelayBlocks[strategy])
+			set(tx, dm.strategyDelayBlocks, strategy, new(big.Int).Set(delay))
+			dm.emit(tx, "StrategyWithdrawalDelayBlocksSet", strategy, prev, delay)
+		}
+		return nil
+	})
+}
+
+// Undelegate undelegates staker from its operator, queueing a withdrawal for
+// each strategy it holds shares in.
+func (dm *DelegationManager) Undelegate(opts *bind.TransactOpts, staker common.Address) (*types.Transaction, error) {
+	return dm.chain.transact(opts, &dm.contract, "undelegate", []any{staker}, func(tx *txContext) error {
+		if err := dm.onlyWhenNotPaused(dmPausedEnterWithdrawalQueue); err != nil {
+			return err
+		}
+		if !dm.isDelegated(staker) {
+			return revert("DelegationManager.undelegate: staker must be delegated to undelegate")
+		}
+		if dm.isOperator(staker) {
+			return revert("DelegationManager.undelegate: operators cannot be undelegated")
+		}
+		if staker == (common.Address{}) {
+			return revert("DelegationManager.undelegate: cannot undelegate zero address")
+		}
+		operator := dm.delegatedTo[staker]
+		if tx.sender != staker && tx.sender != operator && tx.sender != dm.operatorDetails[operator].DelegationApprover {
+			return revert("DelegationManager.undelegate: caller cannot undelegate staker")
+		}
+		strategies, shares := dm.getDelegatableShares(staker)
+		if tx.sender != staker {
+			dm.emit(tx, "StakerForceUndelegated", staker, operator)
+		}
+		dm.emit(tx, "StakerUndelegated", staker, operator)
+		set(tx, dm.delegatedTo, staker, common.Address{})
+		for i := range strategies {
+			if _, err := dm.removeSharesAndQueueWithdrawal(tx, staker, operator, staker, strategies[i:i+1], shares[i:i+1]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// UpdateOperatorMetadataURI emits a new metadata URI for the sending
+// operator.
+func (dm *DelegationManager) UpdateOperatorMetadataURI(opts *bind.TransactOpts, metadataURI string) (*types.Transaction, error) {
+	return dm.chain.transact(opts, &dm.contract, "updateOperatorMetadataURI", []any{metadataURI}, func(tx *txContext) error {
+		if !dm.isOperator(tx.sender) {
+			return revert("DelegationManager.updateOperatorMetadataURI: caller must be an operator")
+		}
+		dm.emit(tx, "OperatorMetadataURIUpdated", tx.sender, metadataURI)
+		return nil
+	})
+}
+
+func (dm *DelegationManager) onlyStrategyManagerOrEigenPodManager(tx *txContext) error {
+	if tx.sender != dm.strategies.address && tx.sender != dm.eigenPods.address {
+		return revert("DelegationManager: onlyStrategyManagerOrEigenPodManager")
+	}
+	return nil
+}
+
+func (dm *DelegationManager) isDelegated(staker common.Address) bool {
+	return dm.delegatedTo[staker] != (common.Address{})
+}
+
+func (dm *DelegationManager) isOperator(operator common.Address) bool {
+	return operator != (common.Address{}) && dm.delegatedTo[operator] == operator
+}
+
+func (dm *DelegationManager) getDelegatableShares(staker common.Address) ([]common.Address, []*big.Int) {
+	strategies, shares := dm.strategies.getDeposits(staker)
+	if podShares := orZero(dm.eigenPods.podOwnerShares[staker]); podShares.Sign() > 0 {
+		strategies = append(strategies, BeaconChainETHStrategy)
+		shares = append(shares, podShares)
+	}
+	return strategies, shares
+}
+
+func (dm *DelegationManager) stakerDelegationDigest(staker common.Address, nonce *big.Int, operator common.Address, expiry *big.Int) common.Hash {
+	return dm.digest(crypto.Keccak256Hash(words(stakerDelegationTypehash, staker, operator, orZero(nonce), orZero(expiry))))
+}
+
+func (dm *DelegationManager) delegationApprovalDigest(staker, operator, approver common.Address, salt [32]byte, expiry *big.Int) common.Hash {
+	return dm.digest(crypto.Keccak256Hash(words(delegationApprovalTypehash, approver, staker, operator, salt, orZero(expiry))))
+}
+
+func (dm *DelegationManager) withdrawalRoot(withdrawal delegationmanager.IDelegationManagerWithdrawal) ([32]byte, error) {
+	encoded, err := dm.abi.Methods["calculateWithdrawalRoot"].Inputs.Pack(withdrawal)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	return crypto.Keccak256Hash(encoded), nil
+}
+
+func (dm *DelegationManager) setOperatorDetails(tx *txContext, operator common.Address, details delegationmanager.IDelegationManagerOperatorDetails) error {
+	if details.StakerOptOutWindowBlocks > maxStakerOptOutWindowBlocks {
+		return revert("DelegationManager._setOperatorDetails: stakerOptOutWindowBlocks cannot be > MAX_STAKER_OPT_OUT_WINDOW_BLOCKS")
+	}
+	if details.StakerOptOutWindowBlocks < dm.operatorDetails[operator].StakerOptOutWindowBlocks {
+		return revert("DelegationManager._setOperatorDetails: stakerOptOutWindowBlocks cannot be decreased")
+	}
+	set(tx, dm.operatorDetails, operator, details)
+	dm.emit(tx, "OperatorDetailsModified", tx.sender, details)
+	return nil
+}
+
+func (dm *DelegationManager) delegate(tx *txContext, staker, operator common.Address, approverSignature delegationmanager.ISignatureUtilsSignatureWithExpiry, approverSalt [32]byte) error {
+	if err := dm.onlyWhenNotPaused(dmPausedNewDelegation); err != nil {
+		return err
+	}
+	approver := dm.operatorDetails[operator].DelegationApprover
+	if approver != (common.Address{}) && tx.sender != approver && tx.sender != operator {
+		if orZero(approverSignature.Expiry).Cmp(new(big.Int).SetUint64(tx.time)) < 0 {
+			return revert("DelegationManager._delegate: approver signature expired")
+		}
+		if dm.approverSaltSpent[saltKey{approver, approverSalt}] {
+			return revert("DelegationManager._delegate: approverSalt already spent")
+		}
+		set(tx, dm.approverSaltSpent, saltKey{approver, approverSalt}, true)
+		digest := dm.delegationApprovalDigest(staker, operator, approver, approverSalt, approverSignature.Expiry)
+		if err := checkSignature(approver, digest, approverSignature.Signature); err != nil {
+			return err
+		}
+	}
+	set(tx, dm.delegatedTo, staker, operator)
+	dm.emit(tx, "StakerDelegated", staker, operator)
+	strategies, shares := dm.getDelegatableShares(staker)
+	for i, strategy := range strategies {
+		dm.increaseOperatorShares(tx, operator, staker, strategy, shares[i])
+	}
+	return nil
+}
+
+func (dm *DelegationManager) increaseDelegatedShares(tx *txContext, staker, strategy common.Address, shares *big.Int) error {
+	if dm.isDelegated(staker) {
+		dm.increaseOperatorShares(tx, dm.delegatedTo[staker], staker, strategy, shares)
+	}
+	return nil
+}
+
+func (dm *DelegationManager) decreaseDelegatedShares(tx *txContext, staker, strategy common.Address, shares *big.Int) error {
+	if dm.isDelegated(staker) {
+		return dm.decreaseOperatorShares(tx, dm.delegatedTo[staker], staker, strategy, shares)
+	}
+	return nil
+}
+
+func (dm *DelegationManager) increaseOperatorShares(tx *txContext, operator, staker, strategy common.Address, shares *big.Int) {
+	k := pair{operator, strategy}
+	set(tx, dm.operatorShares, k, new(big.Int).Add(orZero(dm.operatorShares[k]), shares))
+	dm.emit(tx, "OperatorSharesIncreased", operator, staker, strategy, shares)
+}
+
+func (dm *DelegationManager) decreaseOperatorShares(tx *txContext, operator, staker, strategy common.Address, shares *big.Int) error {
+	k := pair{operator, strategy}
+	current := orZero(dm.operatorShares[k])
+	if current.Cmp(shares) < 0 {
+		return revert(panicUnderflow)
+	}
+	set(tx, dm.operatorShares, k, current.Sub(current, shares))
+	dm.emit(tx, "OperatorSharesDecreased", operator, staker, strategy, shares)
+	return nil
+}
+
+func (dm *DelegationManager) removeSharesAndQueueWithdrawal(tx *txContext, staker, operator, withdrawer common.Address, strategies []common.Address, shares []*big.Int) ([32]byte, error) {
+	if staker == (common.Address{}) {
+		return [32]byte{}, revert("DelegationManager._removeSharesAndQueueWithdrawal: staker cannot be zero address")
+	}
+	if len(strategies) == 0 {
+		return [32]byte{}, revert("DelegationManager._removeSharesAndQueueWithdrawal: strategies cannot be empty")
+	}
+	for i, strategy := range strategies {
+		if operator != (common.Address{}) {
+			if err := dm.decreaseOperatorShares(tx, operator, staker, strategy, shares[i]); err != nil {
+				return [32]byte{}, err
+			}
+		}
+		if strategy == BeaconChainETHStrategy {
+			if err := dm.eigenPods.removeShares(tx, staker, shares[i]); err != nil {
+				return [32]byte{}, err
+			}
+			continue
+		}
+		if staker != withdrawer && dm.strategies.thirdPartyBlocked[strategy] {
+			return [32]byte{}, revert("DelegationManager._removeSharesAndQueueWithdrawal: withdrawer must be same address as staker if thirdPartyTransfersForbidden are set")
+		}
+		if err := dm.strategies.removeShares(tx, staker, strategy, shares[i]); err != nil {
+			return [32]byte{}, err
+		}
+	}
+
+	nonce := orZero(dm.withdrawalsQueued[staker])
+	set(tx, dm.withdrawalsQueued, staker, new(big.Int).Add(nonce, common.Big1))
+	withdrawal := delegationmanager.IDelegationManagerWithdrawal{
+		Staker:      staker,
+		DelegatedTo: operator,
+		Withdrawer:  withdrawer,
+		Nonce:       nonce,
+		StartBlock:  uint32(tx.number),
+		Strategies:  append([]common.Address{}, strategies...),
+		Shares:      append([]*big.Int{}, shares...),
+	}
+	root, err := dm.withdrawalRoot(withdrawal)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	set(tx, dm.pendingWithdrawals, root, true)
+	dm.emit(tx, "WithdrawalQueued", root, withdrawal)
+	return root, nil
+}
+
+func (dm *DelegationManager) completeQueuedWithdrawal(tx *txContext, withdrawal delegationmanager.IDelegationManagerWithdrawal, tokens []common.Address, receiveAsTokens bool) error {
+	root, err := dm.withdrawalRoot(withdrawal)
+	if err != nil {
+		return err
+	}
+	if !dm.pendingWithdrawals[root] {
+		return revert("DelegationManager._completeQueuedWithdrawal: action is not in queue")
+	}
+	startBlock := new(big.Int).SetUint64(uint64(withdrawal.StartBlock))
+	number := new(big.Int).SetUint64(tx.number)
+	if new(big.Int).Add(startBlock, dm.minWithdrawalDelayBlocks).Cmp(number) > 0 {
+		return revert("DelegationManager._completeQueuedWithdrawal: minWithdrawalDelayBlocks period has not yet passed")
+	}
+	if tx.sender != withdrawal.Withdrawer {
+		return revert("DelegationManager._completeQueuedWithdrawal: only withdrawer can complete action")
+	}
+	if receiveAsTokens && len(tokens) != len(withdrawal.Strategies) {
+		return revert("DelegationManager._completeQueuedWithdrawal: input length mismatch")
+	}
+	delete(dm.pendingWithdrawals, root)
+	tx.record(func() { dm.pendingWithdrawals[root] = true })
+
+	currentOperator := dm.delegatedTo[tx.sender]
+	for i, strategy := range withdrawal.Strategies {
+		if new(big.Int).Add(startBlock, orZero(dm.strategyDelayBlocks[strategy])).Cmp(number) > 0 {
+			return revert("DelegationManager._completeQueuedWithdrawal: withdrawalDelayBlocks period has not yet passed for this strategy")
+		}
+		shares := withdrawal.Shares[i]
+		switch {
+		case receiveAsTokens && strategy == BeaconChainETHStrategy:
+			if err := dm.eigenPods.withdrawSharesAsTokens(tx, withdrawal.Staker, tx.sender, shares); err != nil {
+				return err
+			}
+		case receiveAsTokens:
+			if err := dm.strategies.withdrawSharesAsTokens(tx, tx.sender, strategy, shares, tokens[i]); err != nil {
+				return err
+			}
+		case strategy == BeaconChainETHStrategy:
+			// Beacon chain ETH shares are not transferable and always
+			// return to the staker.
+			increase, err := dm.eigenPods.addShares(tx, withdrawal.Staker, shares)
+			if err != nil {
+				return err
+			}
+			if operator := dm.delegatedTo[withdrawal.Staker]; operator != (common.Address{}) {
+				dm.increaseOperatorShares(tx, operator, withdrawal.Staker, strategy, increase)
+			}
+		default:
+			if i >= len(tokens) {
+				return revert(panicOutOfBounds)
+			}
+			if err := dm.strategies.addShares(tx, tx.sender, tokens[i], strategy, shares); err != nil {
+				return err
+			}
+			if currentOperator != (common.Address{}) {
+				dm.increaseOperatorShares(tx, currentOperator, tx.sender, strategy, shares)
+			}
+		}
+	}
+	dm.emit(tx, "WithdrawalCompleted", root)
+	return nil
+}
@@ -0,0 +1,324 @@
+package fakes_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	delegationmanager "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/DelegationManager"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/fakes"
+)
+
+const withdrawalDelay = 5
+
+var (
+	owner    = common.HexToAddress("0x1000")
+	operator = common.HexToAddress("0x2000")
+	staker   = common.HexToAddress("0x3000")
+	stranger = common.HexToAddress("0x4000")
+	strategy = common.HexToAddress("0x5000")
+	token    = common.HexToAddress("0x6000")
+
+	noSignature = delegationmanager.ISignatureUtilsSignatureWithExpiry{Expiry: new(big.Int)}
+)
+
+// env is a deployment in which operator is registered and staker holds 100
+// tokens it has not deposited.
+type env struct {
+	*fakes.Deployment
+	t *testing.T
+}
+
+func newEnv(t *testing.T) *env {
+	t.Helper()
+	cfg := fakes.DefaultConfig(owner)
+	cfg.Strategies = []common.Address{strategy}
+	cfg.MinWithdrawalDelayBlocks = withdrawalDelay
+	d, err := fakes.NewDeployment(fakes.NewChain(uint64(cfg.GenesisRewardsTimestamp)), cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d.Chain.Mint(token, staker, big.NewInt(100))
+	e := &env{Deployment: d, t: t}
+	e.must(d.DelegationManager.RegisterAsOperator(from(operator), delegationmanager.IDelegationManagerOperatorDetails{DeprecatedEarningsReceiver: operator}, ""))
+	return e
+}
+
+func from(addr common.Address) *bind.TransactOpts {
+	return &bind.TransactOpts{From: addr}
+}
+
+func (e *env) must(_ any, err error) {
+	e.t.Helper()
+	if err != nil {
+		e.t.Fatal(err)
+	}
+}
+
+func (e *env) deposit(amount int64) error {
+	_, err := e.StrategyManager.DepositIntoStrategy(from(staker), strategy, token, big.NewInt(amount))
+	return err
+}
+
+func (e *env) delegate() error {
+	_, err := e.DelegationManager.DelegateTo(from(staker), operator, noSignature, [32]byte{})
+	return err
+}
+
+func (e *env) queue(shares int64) error {
+	_, err := e.DelegationManager.QueueWithdrawals(from(staker), []delegationmanager.IDelegationManagerQueuedWithdrawalParams{{
+		Strategies: []common.Address{strategy},
+		Shares:     []*big.Int{big.NewInt(shares)},
+		Withdrawer: staker,
+	}})
+	return err
+}
+
+// complete completes the latest queued withdrawal as sender.
+func (e *env) complete(sender common.Address, receiveAsTokens bool) error {
+	it, err := e.DelegationManager.FilterWithdrawalQueued(&bind.FilterOpts{})
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+	var withdrawal delegationmanager.IDelegationManagerWithdrawal
+	for it.Next() {
+		withdrawal = it.Event.Withdrawal
+	}
+	if err := it.Error(); err != nil {
+		return err
+	}
+	_, err = e.DelegationManager.CompleteQueuedWithdrawal(from(sender), withdrawal, []common.Address{token}, common.Big0, receiveAsTokens)
+	return err
+}
+
+// state is what the deployment records for staker and operator.
+type state struct {
+	delegatedTo    common.Address
+	stakerShares   int64
+	operatorShares int64
+	tokens         int64
+	queued         int64
+}
+
+func (e *env) state() state {
+	e.t.Helper()
+	call := &bind.CallOpts{}
+	var s state
+	var err error
+	if s.delegatedTo, err = e.DelegationManager.DelegatedTo(call, staker); err != nil {
+		e.t.Fatal(err)
+	}
+	stakerShares, err := e.StrategyManager.StakerStrategyShares(call, staker, strategy)
+	if err != nil {
+		e.t.Fatal(err)
+	}
+	operatorShares, err := e.DelegationManager.OperatorShares(call, operator, strategy)
+	if err != nil {
+		e.t.Fatal(err)
+	}
+	queued, err := e.DelegationManager.CumulativeWithdrawalsQueued(call, staker)
+	if err != nil {
+		e.t.Fatal(err)
+	}
+	s.stakerShares, s.operatorShares, s.queued = stakerShares.Int64(), operatorShares.Int64(), queued.Int64()
+	s.tokens = e.Chain.BalanceOf(token, staker).Int64()
+	return s
+}
+
+func TestDelegationManager(t *testing.T) {
+	tests := []struct {
+		name string
+		// steps run in order, and all but the last must succeed.
+		steps []func(e *env) error
+		// err is the revert reason of the last step, if it reverts.
+		err  string
+		want state
+	}{
+		{
+			name:  "deposit then delegate",
+			steps: []func(*env) error{func(e *env) error { return e.deposit(100) }, (*env).delegate},
+			want:  state{delegatedTo: operator, stakerShares: 100, operatorShares: 100},
+		},
+		{
+			name:  "delegate then deposit",
+			steps: []func(*env) error{(*env).delegate, func(e *env) error { return e.deposit(60) }},
+			want:  state{delegatedTo: operator, stakerShares: 60, operatorShares: 60, tokens: 40},
+		},
+		{
+			name:  "delegate twice",
+			steps: []func(*env) error{(*env).delegate, (*env).delegate},
+			err:   "DelegationManager.delegateTo: staker is already actively delegated",
+			want:  state{delegatedTo: operator, tokens: 100},
+		},
+		{
+			name: "delegate to an unregistered operator",
+			steps: []func(*env) error{func(e *env) error {
+				_, err := e.DelegationManager.DelegateTo(from(staker), stranger, noSignature, [32]byte{})
+				return err
+			}},
+			err:  "DelegationManager.delegateTo: operator is not registered in EigenLayer",
+			want: state{tokens: 100},
+		},
+		{
+			name: "undelegate",
+			steps: []func(*env) error{func(e *env) error { return e.deposit(100) }, (*env).delegate, func(e *env) error {
+				_, err := e.DelegationManager.Undelegate(from(staker), staker)
+				return err
+			}},
+			want: state{queued: 1},
+		},
+		{
+			name: "undelegate by the operator",
+			steps: []func(*env) error{func(e *env) error { return e.deposit(100) }, (*env).delegate, func(e *env) error {
+				_, err := e.DelegationManager.Undelegate(from(operator), staker)
+				return err
+			}},
+			want: state{queued: 1},
+		},
+		{
+			name: "undelegate by a stranger",
+			steps: []func(*env) error{func(e *env) error { return e.deposit(100) }, (*env).delegate, func(e *env) error {
+				_, err := e.DelegationManager.Undelegate(from(stranger), staker)
+				return err
+			}},
+			err:  "DelegationManager.undelegate: caller cannot undelegate staker",
+			want: state{delegatedTo: operator, stakerShares: 100, operatorShares: 100},
+		},
+		{
+			name: "undelegate an undelegated staker",
+			steps: []func(*env) error{func(e *env) error {
+				_, err := e.DelegationManager.Undelegate(from(staker), staker)
+				return err
+			}},
+			err:  "DelegationManager.undelegate: staker must be delegated to undelegate",
+			want: state{tokens: 100},
+		},
+		{
+			name:  "queue part of the shares",
+			steps: []func(*env) error{func(e *env) error { return e.deposit(100) }, (*env).delegate, func(e *env) error { return e.queue(40) }},
+			want:  state{delegatedTo: operator, stakerShares: 60, operatorShares: 60, queued: 1},
+		},
+		{
+			// The strategy manager's check comes after the operator's
+			// shares are decreased, which underflows first.
+			name:  "queue more shares than held",
+			steps: []func(*env) error{func(e *env) error { return e.deposit(100) }, (*env).delegate, func(e *env) error { return e.queue(101) }},
+			err:   "arithmetic underflow or overflow",
+			want:  state{delegatedTo: operator, stakerShares: 100, operatorShares: 100},
+		},
+		{
+			name:  "queue more shares than held undelegated",
+			steps: []func(*env) error{func(e *env) error { return e.deposit(100) }, func(e *env) error { return e.queue(101) }},
+			err:   "StrategyManager._removeShares: shareAmount too high",
+			want:  state{stakerShares: 100},
+		},
+		{
+			// The shares of the first strategy, removed before the second
+			// reverts, are restored.
+			name: "queue a strategy not held after one held",
+			steps: []func(*env) error{func(e *env) error { return e.deposit(100) }, (*env).delegate, func(e *env) error {
+				_, err := e.DelegationManager.QueueWithdrawals(from(staker), []delegationmanager.IDelegationManagerQueuedWithdrawalParams{{
+					Strategies: []common.Address{strategy, stranger},
+					Shares:     []*big.Int{big.NewInt(10), big.NewInt(10)},
+					Withdrawer: staker,
+				}})
+				return err
+			}},
+			err:  "arithmetic underflow or overflow",
+			want: state{delegatedTo: operator, stakerShares: 100, operatorShares: 100},
+		},
+		{
+			name: "queue for another withdrawer",
+			steps: []func(*env) error{func(e *env) error { return e.deposit(100) }, func(e *env) error {
+				_, err := e.DelegationManager.QueueWithdrawals(from(staker), []delegationmanager.IDelegationManagerQueuedWithdrawalParams{{
+					Strategies: []common.Address{strategy},
+					Shares:     []*big.Int{big.NewInt(10)},
+					Withdrawer: stranger,
+				}})
+				return err
+			}},
+			err:  "DelegationManager.queueWithdrawal: withdrawer must be staker",
+			want: state{stakerShares: 100},
+		},
+		{
+			name: "complete before the delay",
+			steps: []func(*env) error{func(e *env) error { return e.deposit(100) }, func(e *env) error { return e.queue(40) }, func(e *env) error {
+				return e.complete(staker, true)
+			}},
+			err:  "DelegationManager._completeQueuedWithdrawal: minWithdrawalDelayBlocks period has not yet passed",
+			want: state{stakerShares: 60, queued: 1},
+		},
+		{
+			name: "complete as tokens",
+			steps: []func(*env) error{func(e *env) error { return e.deposit(100) }, (*env).delegate, func(e *env) error { return e.queue(40) }, func(e *env) error {
+				e.Chain.Mine(withdrawalDelay)
+				return e.complete(staker, true)
+			}},
+			want: state{delegatedTo: operator, stakerShares: 60, operatorShares: 60, tokens: 40, queued: 1},
+		},
+		{
+			name: "complete as shares",
+			steps: []func(*env) error{func(e *env) error { return e.deposit(100) }, (*env).delegate, func(e *env) error { return e.queue(40) }, func(e *env) error {
+				e.Chain.Mine(withdrawalDelay)
+				return e.complete(staker, false)
+			}},
+			want: state{delegatedTo: operator, stakerShares: 100, operatorShares: 100, queued: 1},
+		},
+		{
+			// Shares received after undelegating are not delegated.
+			name: "complete as shares after undelegating",
+			steps: []func(*env) error{func(e *env) error { return e.deposit(100) }, (*env).delegate, func(e *env) error {
+				_, err := e.DelegationManager.Undelegate(from(staker), staker)
+				return err
+			}, func(e *env) error {
+				e.Chain.Mine(withdrawalDelay)
+				return e.complete(staker, false)
+			}},
+			want: state{stakerShares: 100, queued: 1},
+		},
+		{
+			name: "complete by another account",
+			steps: []func(*env) error{func(e *env) error { return e.deposit(100) }, func(e *env) error { return e.queue(40) }, func(e *env) error {
+				e.Chain.Mine(withdrawalDelay)
+				return e.complete(stranger, true)
+			}},
+			err:  "DelegationManager._completeQueuedWithdrawal: only withdrawer can complete action",
+			want: state{stakerShares: 60, queued: 1},
+		},
+		{
+			name: "complete twice",
+			steps: []func(*env) error{func(e *env) error { return e.deposit(100) }, func(e *env) error { return e.queue(40) }, func(e *env) error {
+				e.Chain.Mine(withdrawalDelay)
+				return e.complete(staker, true)
+			}, func(e *env) error { return e.complete(staker, true) }},
+			err:  "DelegationManager._completeQueuedWithdrawal: action is not in queue",
+			want: state{stakerShares: 60, tokens: 40, queued: 1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := newEnv(t)
+			last := len(tt.steps) - 1
+			for _, step := range tt.steps[:last] {
+				if err := step(e); err != nil {
+					t.Fatal(err)
+				}
+			}
+			err := tt.steps[last](e)
+			var revert *fakes.RevertError
+			switch {
+			case tt.err == "" && err != nil:
+				t.Fatal(err)
+			case tt.err != "" && (!errors.As(err, &revert) || revert.Reason != tt.err):
+				t.Fatalf("err = %v, want a revert with %q", err, tt.err)
+			}
+			if got := e.state(); got != tt.want {
+				t.Errorf("state %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package fakes
+
+import (
+	"errors"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Config holds the constructor and initializer arguments of a Deployment.
+type Config struct {
+	// Owner owns every core contract, and is the only pauser and the
+	// unpauser of the PauserRegistry deployed when PauserRegistry is zero.
+	Owner          common.Address
+	PauserRegistry common.Address
+	Slasher        common.Address
+
+	// StrategyManager.
+	StrategyWhitelister common.Address
+	// Strategies are whitelisted for deposit at deployment.
+	Strategies []common.Address
+
+	// DelegationManager.
+	MinWithdrawalDelayBlocks uint64
+
+	// EigenPodManager.
+	ETHPOSDeposit      common.Address
+	EigenPodBeacon     common.Address
+	BeaconChainOracle  common.Address
+	DenebForkTimestamp uint64
+
+	// EigenPod.
+	DelayedWithdrawalRouter            common.Address
+	MaxRestakedBalanceGweiPerValidator uint64
+	BeaconGenesisTime                  uint64
+
+	// RewardsCoordinator.
+	RewardsUpdater             common.Address
+	ActivationDelay            uint32
+	GlobalCommissionBips       uint16
+	CalculationIntervalSeconds uint32
+	MaxRewardsDuration         uint32
+	MaxRetroactiveLength       uint32
+	MaxFutureLength            uint32
+	GenesisRewardsTimestamp    uint32
+}
+
+// DefaultConfig returns a Config owned by owner with the mainnet parameters
+// of the core contracts.
+func DefaultConfig(owner common.Address) Config {
+	return Config{
+		Owner:                              owner,
+		StrategyWhitelister:                owner,
+		MinWithdrawalDelayBlocks:           50400,
+		DenebForkTimestamp:                 1710338135,
+		MaxRestakedBalanceGweiPerValidator: 32e9,
+		BeaconGenesisTime:                  1606824023,
+		RewardsUpdater:                     owner,
+		ActivationDelay:                    604800,
+		GlobalCommissionBips:               1000,
+		CalculationIntervalSeconds:         604800,
+		MaxRewardsDuration:                 6048000,
+		MaxRetroactiveLength:               14515200,
+		MaxFutureLength:                    2592000,
+		GenesisRewardsTimestamp:            1710979200,
+	}
+}
+
+// Deployment is a set of wired-up core contract fakes sharing one Chain.
+type Deployment struct {
+	Chain              *Chain
+	PauserRegistry     *PauserRegistry
+	DelegationManager  *DelegationManager
+	StrategyManager    *StrategyManager
+	EigenPodManager    *EigenPodManager
+	AVSDirectory       *AVSDirectory
+	RewardsCoordinator *RewardsCoordinator
+}
+
+// NewDeployment deploys the core contract fakes to chain, at the addresses
+// cfg.Owner would deploy them to with its first nonces. It returns an error
+// if a contract constructor would revert for cfg.
+func NewDeployment(chain *Chain, cfg Config) (*Deployment, error) {
+	if cfg.CalculationIntervalSeconds == 0 {
+		return nil, errors.New("fakes: CalculationIntervalSeconds must be non-zero")
+	}
+	if cfg.GenesisRewardsTimestamp%cfg.CalculationIntervalSeconds != 0 {
+		return nil, revert("RewardsCoordinator: GENESIS_REWARDS_TIMESTAMP must be a multiple of CALCULATION_INTERVAL_SECONDS")
+	}
+	if cfg.CalculationIntervalSeconds%snapshotCadence != 0 {
+		return nil, revert("RewardsCoordinator: CALCULATION_INTERVAL_SECONDS must be a multiple of SNAPSHOT_CADENCE")
+	}
+
+	var nonce uint64
+	next := func() common.Address {
+		addr := crypto.CreateAddress(cfg.Owner, nonce)
+		nonce++
+		return addr
+	}
+
+	d := &Deployment{Chain: chain}
+	if cfg.PauserRegistry == (common.Address{}) {
+		d.PauserRegistry = NewPauserRegistry(chain, next(), []common.Address{cfg.Owner}, cfg.Owner)
+		cfg.PauserRegistry = d.PauserRegistry.Address()
+	}
+	d.DelegationManager = newDelegationManager(chain, next(), &cfg)
+	d.StrategyManager = newStrategyManager(chain, next(), &cfg)
+	d.EigenPodManager = newEigenPodManager(chain, next(), &cfg)
+	d.AVSDirectory = newAVSDirectory(chain, next(), &cfg)
+	d.RewardsCoordinator = newRewardsCoordinator(chain, next(), &cfg)
+
+	d.DelegationManager.strategies = d.StrategyManager
+	d.DelegationManager.eigenPods = d.EigenPodManager
+	d.StrategyManager.delegation = d.DelegationManager
+	d.StrategyManager.eigenPods = d.EigenPodManager
+	d.EigenPodManager.strategies = d.StrategyManager
+	d.EigenPodManager.delegation = d.DelegationManager
+	d.AVSDirectory.delegation = d.DelegationManager
+	d.RewardsCoordinator.delegation = d.DelegationManager
+	d.RewardsCoordinator.strategies = d.StrategyManager
+	return d, nil
+}
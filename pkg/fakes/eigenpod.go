@@ -0,0 +1,678 @@
+package fakes
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/beaconproofs"
+	eigenpod "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/EigenPod"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/constants"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/reverts"
+)
+
+const (
+	podPausedVerifyCredentials   = uint8(constants.EigenPodManagerPausedEigenPodsVerifyCredentials)
+	podPausedVerifyBalanceUpdate = uint8(constants.EigenPodManagerPausedEigenPodsVerifyBalanceUpdate)
+	podPausedVerifyWithdrawal    = uint8(constants.EigenPodManagerPausedEigenPodsVerifyWithdrawal)
+	podPausedNonProofWithdrawals = uint8(constants.EigenPodManagerPausedNonProofWithdrawals)
+
+	verifyBalanceUpdateWindowSeconds = constants.EigenPodVerifyBalanceUpdateWindowSeconds
+	secondsPerEpoch                  = constants.BeaconChainProofsSecondsPerEpoch
+)
+
+// The VALIDATOR_STATUS values of IEigenPod.
+const (
+	validatorInactive uint8 = iota
+	validatorActive
+	validatorWithdrawn
+)
+
+// withdrawalKey keys provenWithdrawal by validator and withdrawal timestamp.
+type withdrawalKey struct {
+	pubkeyHash common.Hash
+	timestamp  uint64
+}
+
+// EigenPod is a fake of the EigenPod contract, deployed and initialized by
+// the EigenPodManager fake for each pod owner. Proofs are checked with
+// pkg/beaconproofs against the block roots of the EigenPodManager's oracle.
+//
+// The pod's ETH is held in the chain's ledger under the ETH token: the
+// beacon chain sweeping withdrawals to the pod is modeled by minting ETH to
+// it, and ETH the pod sends as a delayed withdrawal is credited to the
+// DelayedWithdrawalRouter, which is not faked.
+type EigenPod struct {
+	*eigenpod.EigenPodFilterer
+	contract
+
+	manager                *EigenPodManager
+	ethPOS                 common.Address
+	router                 common.Address
+	maxRestakedBalanceGwei uint64
+	genesisTime            uint64
+
+	podOwner                               common.Address
+	mostRecentWithdrawalTimestamp          uint64
+	withdrawableRestakedExecutionLayerGwei uint64
+	hasRestaked                            bool
+	provenWithdrawal                       map[withdrawalKey]bool
+	validators                             map[common.Hash]eigenpod.IEigenPodValidatorInfo
+	nonBeaconChainETHBalanceWei            *big.Int
+	sumOfPartialWithdrawalsClaimedGwei     uint64
+}
+
+var (
+	_ eigenpod.EigenPodReader = (*EigenPod)(nil)
+	_ eigenpod.EigenPodWriter = (*EigenPod)(nil)
+	_ eigenpod.EigenPodEvents = (*EigenPod)(nil)
+)
+
+func newEigenPod(epm *EigenPodManager, address common.Address) *EigenPod {
+	filterer, err := eigenpod.NewEigenPodFilterer(address, epm.chain)
+	if err != nil {
+		panic(err)
+	}
+	return &EigenPod{
+		EigenPodFilterer:            filterer,
+		contract:                    newContract(epm.chain, address, eigenpod.EigenPodMetaData),
+		manager:                     epm,
+		ethPOS:                      epm.ethPOS,
+		router:                      epm.delayedWithdrawalRouter,
+		maxRestakedBalanceGwei:      epm.maxRestakedBalanceGwei,
+		genesisTime:                 epm.beaconGenesisTime,
+		provenWithdrawal:            make(map[withdrawalKey]bool),
+		validators:                  make(map[common.Hash]eigenpod.IEigenPodValidatorInfo),
+		nonBeaconChainETHBalanceWei: new(big.Int),
+	}
+}
+
+// initialize sets the owner of a newly deployed pod, which has restaking
+// enabled from the start.
+func (p *EigenPod) initialize(tx *txContext, podOwner common.Address) {
+	p.podOwner = podOwner
+	p.hasRestaked = true
+	p.emit(tx, "RestakingActivated", podOwner)
+}
+
+// DelayedWithdrawalRouter returns the address of the DelayedWithdrawalRouter.
+func (p *EigenPod) DelayedWithdrawalRouter(opts *bind.CallOpts) (common.Address, error) {
+	return p.router, nil
+}
+
+// EigenPodManager returns the address of the EigenPodManager.
+func (p *EigenPod) EigenPodManager(opts *bind.CallOpts) (common.Address, error) {
+	return p.manager.address, nil
+}
+
+// EthPOS returns the address of the beacon chain deposit contract.
+func (p *EigenPod) EthPOS(opts *bind.CallOpts) (common.Address, error) {
+	return p.ethPOS, nil
+}
+
+// GENESISTIME returns the genesis time of the beacon chain.
+func (p *EigenPod) GENESISTIME(opts *bind.CallOpts) (uint64, error) {
+	return p.genesisTime, nil
+}
+
+// HasRestaked reports whether restaking is enabled.
+func (p *EigenPod) HasRestaked(opts *bind.CallOpts) (bool, error) {
+	p.chain.mu.Lock()
+	defer p.chain.mu.Unlock()
+	return p.hasRestaked, nil
+}
+
+// MAXRESTAKEDBALANCEGWEIPERVALIDATOR returns the most a validator's
+// balance counts for.
+func (p *EigenPod) MAXRESTAKEDBALANCEGWEIPERVALIDATOR(opts *bind.CallOpts) (uint64, error) {
+	return p.maxRestakedBalanceGwei, nil
+}
+
+// MostRecentWithdrawalTimestamp returns the time restaking was activated,
+// or zero for pods that had it enabled from the start.
+func (p *EigenPod) MostRecentWithdrawalTimestamp(opts *bind.CallOpts) (uint64, error) {
+	p.chain.mu.Lock()
+	defer p.chain.mu.Unlock()
+	return p.mostRecentWithdrawalTimestamp, nil
+}
+
+// NonBeaconChainETHBalanceWei returns the ETH the pod received outside of
+// the beacon chain.
+func (p *EigenPod) NonBeaconChainETHBalanceWei(opts *bind.CallOpts) (*big.Int, error) {
+	p.chain.mu.Lock()
+	defer p.chain.mu.Unlock()
+	return new(big.Int).Set(p.nonBeaconChainETHBalanceWei), nil
+}
+
+// PodOwner returns the owner of the pod.
+func (p *EigenPod) PodOwner(opts *bind.CallOpts) (common.Address, error) {
+	p.chain.mu.Lock()
+	defer p.chain.mu.Unlock()
+	return p.podOwner, nil
+}
+
+// ProvenWithdrawal reports whether the withdrawal of a validator at a
+// timestamp has been proven.
+func (p *EigenPod) ProvenWithdrawal(opts *bind.CallOpts, arg0 [32]byte, arg1 uint64) (bool, error) {
+	p.chain.mu.Lock()
+	defer p.chain.mu.Unlock()
+	return p.provenWithdrawal[withdrawalKey{arg0, arg1}], nil
+}
+
+// SumOfPartialWithdrawalsClaimedGwei returns the total of the partial
+// withdrawals proven.
+func (p *EigenPod) SumOfPartialWithdrawalsClaimedGwei(opts *bind.CallOpts) (uint64, error) {
+	p.chain.mu.Lock()
+	defer p.chain.mu.Unlock()
+	return p.sumOfPartialWithdrawalsClaimedGwei, nil
+}
+
+// ValidatorPubkeyHashToInfo returns the pod's record of a validator.
+func (p *EigenPod) ValidatorPubkeyHashToInfo(opts *bind.CallOpts, validatorPubkeyHash [32]byte) (eigenpod.IEigenPodValidatorInfo, error) {
+	p.chain.mu.Lock()
+	defer p.chain.mu.Unlock()
+	return p.validators[validatorPubkeyHash], nil
+}
+
+// ValidatorPubkeyToInfo returns the pod's record of the validator with
+// validatorPubkey.
+func (p *EigenPod) ValidatorPubkeyToInfo(opts *bind.CallOpts, validatorPubkey []byte) (eigenpod.IEigenPodValidatorInfo, error) {
+	pubkeyHash, err := pubkeyHash(validatorPubkey)
+	if err != nil {
+		return eigenpod.IEigenPodValidatorInfo{}, err
+	}
+	return p.ValidatorPubkeyHashToInfo(opts, pubkeyHash)
+}
+
+// ValidatorStatus returns the status of the validator with validatorPubkey.
+func (p *EigenPod) ValidatorStatus(opts *bind.CallOpts, validatorPubkey []byte) (uint8, error) {
+	info, err := p.ValidatorPubkeyToInfo(opts, validatorPubkey)
+	return info.Status, err
+}
+
+// ValidatorStatus0 returns the status of the validator with pubkeyHash.
+func (p *EigenPod) ValidatorStatus0(opts *bind.CallOpts, pubkeyHash [32]byte) (uint8, error) {
+	info, err := p.ValidatorPubkeyHashToInfo(opts, pubkeyHash)
+	return info.Status, err
+}
+
+// WithdrawableRestakedExecutionLayerGwei returns the fully withdrawn ETH
+// the EigenPodManager may withdraw from the pod.
+func (p *EigenPod) WithdrawableRestakedExecutionLayerGwei(opts *bind.CallOpts) (uint64, error) {
+	p.chain.mu.Lock()
+	defer p.chain.mu.Unlock()
+	return p.withdrawableRestakedExecutionLayerGwei, nil
+}
+
+// ActivateRestaking enables restaking on a pod deployed before it was
+// enabled by default, which the EigenPodManager fake never deploys.
+func (p *EigenPod) ActivateRestaking(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return p.chain.transact(opts, &p.contract, "activateRestaking", nil, func(tx *txContext) error {
+		if err := p.onlyWhenNotPaused(podPausedVerifyCredentials); err != nil {
+			return err
+		}
+		if err := p.onlyEigenPodOwner(tx); err != nil {
+			return err
+		}
+		if err := p.hasNeverRestaked(); err != nil {
+			return err
+		}
+		assign(tx, &p.hasRestaked, true)
+		if err := p.processWithdrawalBeforeRestaking(tx); err != nil {
+			return err
+		}
+		p.emit(tx, "RestakingActivated", p.podOwner)
+		return nil
+	})
+}
+
+// Initialize always reverts, since pods are deployed initialized.
+func (p *EigenPod) Initialize(opts *bind.TransactOpts, _podOwner common.Address) (*types.Transaction, error) {
+	return p.chain.transact(opts, &p.contract, "initialize", []any{_podOwner}, func(tx *txContext) error {
+		return revert("Initializable: contract is already initialized")
+	})
+}
+
+// Receive accepts ETH sent outside of the beacon chain.
+func (p *EigenPod) Receive(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return p.chain.transact(opts, &p.contract, "", nil, func(tx *txContext) error {
+		assign(tx, &p.nonBeaconChainETHBalanceWei, new(big.Int).Add(p.nonBeaconChainETHBalanceWei, tx.value))
+		tx.credit(ETH, p.address, tx.value)
+		p.emit(tx, "NonBeaconChainETHReceived", tx.value)
+		return nil
+	})
+}
+
+// RecoverTokens sends tokens held by the pod to recipient.
+func (p *EigenPod) RecoverTokens(opts *bind.TransactOpts, tokenList []common.Address, amountsToWithdraw []*big.Int, recipient common.Address) (*types.Transaction, error) {
+	return p.chain.transact(opts, &p.contract, "recoverTokens", []any{tokenList, amountsToWithdraw, recipient}, func(tx *txContext) error {
+		if err := p.onlyEigenPodOwner(tx); err != nil {
+			return err
+		}
+		if err := p.onlyWhenNotPaused(podPausedNonProofWithdrawals); err != nil {
+			return err
+		}
+		if len(tokenList) != len(amountsToWithdraw) {
+			return revert("EigenPod.recoverTokens: tokenList and amountsToWithdraw must be same length")
+		}
+		for i, token := range tokenList {
+			if err := tx.transfer(token, p.address, recipient, amountsToWithdraw[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Stake deposits a new validator. It can only be called by the
+// EigenPodManager.
+func (p *EigenPod) Stake(opts *bind.TransactOpts, pubkey []byte, signature []byte, depositDataRoot [32]byte) (*types.Transaction, error) {
+	return p.chain.transact(opts, &p.contract, "stake", []any{pubkey, signature, depositDataRoot}, func(tx *txContext) error {
+		if err := p.onlyEigenPodManager(tx); err != nil {
+			return err
+		}
+		return p.stake(tx, tx.value, pubkey)
+	})
+}
+
+func (p *EigenPod) stake(tx *txContext, value *big.Int, pubkey []byte) error {
+	if value.Cmp(stakeAmount) != 0 {
+		return revert("EigenPod.stake: must initially stake for any validator with 32 ether")
+	}
+	p.emit(tx, "EigenPodStaked", pubkey)
+	return nil
+}
+
+// VerifyAndProcessWithdrawals proves withdrawals of the pod's validators
+// against the state at oracleTimestamp.
+func (p *EigenPod) VerifyAndProcessWithdrawals(opts *bind.TransactOpts, oracleTimestamp uint64, stateRootProof eigenpod.BeaconChainProofsStateRootProof, withdrawalProofs []eigenpod.BeaconChainProofsWithdrawalProof, validatorFieldsProofs [][]byte, validatorFields [][][32]byte, withdrawalFields [][][32]byte) (*types.Transaction, error) {
+	args := []any{oracleTimestamp, stateRootProof, withdrawalProofs, validatorFieldsProofs, validatorFields, withdrawalFields}
+	return p.chain.transact(opts, &p.contract, "verifyAndProcessWithdrawals", args, func(tx *txContext) error {
+		if err := p.onlyWhenNotPaused(podPausedVerifyWithdrawal); err != nil {
+			return err
+		}
+		if len(validatorFields) != len(validatorFieldsProofs) || len(validatorFieldsProofs) != len(withdrawalProofs) || len(withdrawalProofs) != len(withdrawalFields) {
+			return revert("EigenPod.verifyAndProcessWithdrawals: inputs must be same length")
+		}
+		if err := p.verifyStateRoot(oracleTimestamp, stateRootProof); err != nil {
+			return err
+		}
+		amountToSendGwei, sharesDeltaGwei := new(big.Int), new(big.Int)
+		for i := range withdrawalFields {
+			sendGwei, deltaGwei, err := p.verifyAndProcessWithdrawal(tx, stateRootProof.BeaconStateRoot, withdrawalProofs[i], validatorFieldsProofs[i], hashes(validatorFields[i]), hashes(withdrawalFields[i]))
+			if err != nil {
+				return err
+			}
+			amountToSendGwei.Add(amountToSendGwei, sendGwei)
+			sharesDeltaGwei.Add(sharesDeltaGwei, deltaGwei)
+		}
+		if amountToSendGwei.Sign() != 0 {
+			if err := p.sendETHAsDelayedWithdrawal(tx, amountToSendGwei.Mul(amountToSendGwei, big.NewInt(gweiToWei))); err != nil {
+				return err
+			}
+		}
+		if sharesDeltaGwei.Sign() != 0 {
+			return p.manager.recordBeaconChainETHBalanceUpdate(tx, p.address, p.podOwner, sharesDeltaGwei.Mul(sharesDeltaGwei, big.NewInt(gweiToWei)))
+		}
+		return nil
+	})
+}
+
+// VerifyBalanceUpdates proves the current effective balances of the pod's
+// active validators against the state at oracleTimestamp.
+func (p *EigenPod) VerifyBalanceUpdates(opts *bind.TransactOpts, oracleTimestamp uint64, validatorIndices []*big.Int, stateRootProof eigenpod.BeaconChainProofsStateRootProof, validatorFieldsProofs [][]byte, validatorFields [][][32]byte) (*types.Transaction, error) {
+	args := []any{oracleTimestamp, validatorIndices, stateRootProof, validatorFieldsProofs, validatorFields}
+	return p.chain.transact(opts, &p.contract, "verifyBalanceUpdates", args, func(tx *txContext) error {
+		if err := p.onlyWhenNotPaused(podPausedVerifyBalanceUpdate); err != nil {
+			return err
+		}
+		if len(validatorIndices) != len(validatorFieldsProofs) || len(validatorFieldsProofs) != len(validatorFields) {
+			return revert("EigenPod.verifyBalanceUpdates: validatorIndices and proofs must be same length")
+		}
+		if oracleTimestamp+verifyBalanceUpdateWindowSeconds < tx.time {
+			return revert("EigenPod.verifyBalanceUpdates: specified timestamp is too far in past")
+		}
+		if err := p.verifyStateRoot(oracleTimestamp, stateRootProof); err != nil {
+			return err
+		}
+		sharesDeltaGwei := new(big.Int)
+		for i, index := range validatorIndices {
+			deltaGwei, err := p.verifyBalanceUpdate(tx, oracleTimestamp, index.Uint64(), stateRootProof.BeaconStateRoot, validatorFieldsProofs[i], hashes(validatorFields[i]))
+			if err != nil {
+				return err
+			}
+			sharesDeltaGwei.Add(sharesDeltaGwei, deltaGwei)
+		}
+		return p.manager.recordBeaconChainETHBalanceUpdate(tx, p.address, p.podOwner, sharesDeltaGwei.Mul(sharesDeltaGwei, big.NewInt(gweiToWei)))
+	})
+}
+
+// VerifyWithdrawalCredentials proves that validators' withdrawal
+// credentials point at the pod against the state at oracleTimestamp,
+// restaking them. It can only be called by the pod owner.
+func (p *EigenPod) VerifyWithdrawalCredentials(opts *bind.TransactOpts, oracleTimestamp uint64, stateRootProof eigenpod.BeaconChainProofsStateRootProof, validatorIndices []*big.Int, validatorFieldsProofs [][]byte, validatorFields [][][32]byte) (*types.Transaction, error) {
+	args := []any{oracleTimestamp, stateRootProof, validatorIndices, validatorFieldsProofs, validatorFields}
+	return p.chain.transact(opts, &p.contract, "verifyWithdrawalCredentials", args, func(tx *txContext) error {
+		if err := p.onlyEigenPodOwner(tx); err != nil {
+			return err
+		}
+		if err := p.onlyWhenNotPaused(podPausedVerifyCredentials); err != nil {
+			return err
+		}
+		if !p.hasRestaked {
+			return revert("EigenPod.hasEnabledRestaking: restaking is not enabled")
+		}
+		if len(validatorIndices) != len(validatorFieldsProofs) || len(validatorFieldsProofs) != len(validatorFields) {
+			return revert("EigenPod.verifyWithdrawalCredentials: validatorIndices and proofs must be same length")
+		}
+		if p.mostRecentWithdrawalTimestamp != 0 {
+			epoch, err := p.timestampToEpoch(p.mostRecentWithdrawalTimestamp)
+			if err != nil {
+				return err
+			}
+			if oracleTimestamp < p.genesisTime+(epoch+1)*secondsPerEpoch {
+				return revert("EigenPod.verifyWithdrawalCredentials: proof must be in the epoch after activation")
+			}
+		}
+		if oracleTimestamp+verifyBalanceUpdateWindowSeconds < tx.time {
+			return revert("EigenPod.verifyWithdrawalCredentials: specified timestamp is too far in past")
+		}
+		if err := p.verifyStateRoot(oracleTimestamp, stateRootProof); err != nil {
+			return err
+		}
+		totalGwei := new(big.Int)
+		for i, index := range validatorIndices {
+			restakedGwei, err := p.verifyWithdrawalCredentials(tx, oracleTimestamp, stateRootProof.BeaconStateRoot, index.Uint64(), validatorFieldsProofs[i], hashes(validatorFields[i]))
+			if err != nil {
+				return err
+			}
+			totalGwei.Add(totalGwei, new(big.Int).SetUint64(restakedGwei))
+		}
+		return p.manager.recordBeaconChainETHBalanceUpdate(tx, p.address, p.podOwner, totalGwei.Mul(totalGwei, big.NewInt(gweiToWei)))
+	})
+}
+
+// WithdrawBeforeRestaking withdraws the ETH of a pod deployed before
+// restaking was enabled by default, which the EigenPodManager fake never
+// deploys.
+func (p *EigenPod) WithdrawBeforeRestaking(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return p.chain.transact(opts, &p.contract, "withdrawBeforeRestaking", nil, func(tx *txContext) error {
+		if err := p.onlyEigenPodOwner(tx); err != nil {
+			return err
+		}
+		if err := p.hasNeverRestaked(); err != nil {
+			return err
+		}
+		return p.processWithdrawalBeforeRestaking(tx)
+	})
+}
+
+// WithdrawNonBeaconChainETHBalanceWei sends ETH the pod received outside
+// of the beacon chain to recipient as a delayed withdrawal.
+func (p *EigenPod) WithdrawNonBeaconChainETHBalanceWei(opts *bind.TransactOpts, recipient common.Address, amountToWithdraw *big.Int) (*types.Transaction, error) {
+	return p.chain.transact(opts, &p.contract, "withdrawNonBeaconChainETHBalanceWei", []any{recipient, amountToWithdraw}, func(tx *txContext) error {
+		if err := p.onlyEigenPodOwner(tx); err != nil {
+			return err
+		}
+		if err := p.onlyWhenNotPaused(podPausedNonProofWithdrawals); err != nil {
+			return err
+		}
+		if amountToWithdraw.Cmp(p.nonBeaconChainETHBalanceWei) > 0 {
+			return revert("EigenPod.withdrawnonBeaconChainETHBalanceWei: amountToWithdraw is greater than nonBeaconChainETHBalanceWei")
+		}
+		assign(tx, &p.nonBeaconChainETHBalanceWei, new(big.Int).Sub(p.nonBeaconChainETHBalanceWei, amountToWithdraw))
+		p.emit(tx, "NonBeaconChainETHWithdrawn", recipient, amountToWithdraw)
+		return p.sendETHAsDelayedWithdrawal(tx, amountToWithdraw)
+	})
+}
+
+// WithdrawRestakedBeaconChainETH sends fully withdrawn ETH to recipient. It
+// can only be called by the EigenPodManager.
+func (p *EigenPod) WithdrawRestakedBeaconChainETH(opts *bind.TransactOpts, recipient common.Address, amountWei *big.Int) (*types.Transaction, error) {
+	return p.chain.transact(opts, &p.contract, "withdrawRestakedBeaconChainETH", []any{recipient, amountWei}, func(tx *txContext) error {
+		if err := p.onlyEigenPodManager(tx); err != nil {
+			return err
+		}
+		if new(big.Int).Rem(amountWei, big.NewInt(gweiToWei)).Sign() != 0 {
+			return revert("EigenPod.withdrawRestakedBeaconChainETH: amountWei must be a whole Gwei amount")
+		}
+		amountGwei := new(big.Int).Quo(amountWei, big.NewInt(gweiToWei))
+		if !amountGwei.IsUint64() || amountGwei.Uint64() > p.withdrawableRestakedExecutionLayerGwei {
+			return revert("EigenPod.withdrawRestakedBeaconChainETH: amountGwei exceeds withdrawableRestakedExecutionLayerGwei")
+		}
+		assign(tx, &p.withdrawableRestakedExecutionLayerGwei, p.withdrawableRestakedExecutionLayerGwei-amountGwei.Uint64())
+		p.emit(tx, "RestakedBeaconChainETHWithdrawn", recipient, amountWei)
+		return tx.transfer(ETH, p.address, recipient, amountWei)
+	})
+}
+
+func (p *EigenPod) onlyEigenPodManager(tx *txContext) error {
+	if tx.sender != p.manager.address {
+		return revert("EigenPod.onlyEigenPodManager: not eigenPodManager")
+	}
+	return nil
+}
+
+func (p *EigenPod) onlyEigenPodOwner(tx *txContext) error {
+	if tx.sender != p.podOwner {
+		return revert("EigenPod.onlyEigenPodOwner: not podOwner")
+	}
+	return nil
+}
+
+func (p *EigenPod) onlyWhenNotPaused(index uint8) error {
+	if p.manager.paused.Bit(int(index)) == 1 {
+		return revert("EigenPod.onlyWhenNotPaused: index is paused in EigenPodManager")
+	}
+	return nil
+}
+
+func (p *EigenPod) hasNeverRestaked() error {
+	if p.hasRestaked {
+		return revert("EigenPod.hasNeverRestaked: restaking is enabled")
+	}
+	return nil
+}
+
+func (p *EigenPod) processWithdrawalBeforeRestaking(tx *txContext) error {
+	assign(tx, &p.mostRecentWithdrawalTimestamp, uint64(uint32(tx.time)))
+	assign(tx, &p.nonBeaconChainETHBalanceWei, new(big.Int))
+	return p.sendETHAsDelayedWithdrawal(tx, orZero(tx.chain.balances[pair{ETH, p.address}]))
+}
+
+func (p *EigenPod) sendETHAsDelayedWithdrawal(tx *txContext, amountWei *big.Int) error {
+	return tx.transfer(ETH, p.address, p.router, amountWei)
+}
+
+func (p *EigenPod) timestampToEpoch(timestamp uint64) (uint64, error) {
+	if timestamp < p.genesisTime {
+		return 0, revert("EigenPod._timestampToEpoch: timestamp is before genesis")
+	}
+	return (timestamp - p.genesisTime) / secondsPerEpoch, nil
+}
+
+// verifyStateRoot checks the state root against the block root the
+// EigenPodManager's oracle serves at oracleTimestamp.
+func (p *EigenPod) verifyStateRoot(oracleTimestamp uint64, stateRootProof eigenpod.BeaconChainProofsStateRootProof) error {
+	root, err := blockRootAtTimestamp(&bind.CallOpts{}, p.manager.beaconChainOracle(), oracleTimestamp)
+	if err != nil {
+		return err
+	}
+	return proofRevert(beaconproofs.VerifyStateRootAgainstLatestBlockRoot(root, stateRootProof.BeaconStateRoot, stateRootProof.Proof))
+}
+
+func (p *EigenPod) verifyWithdrawalCredentials(tx *txContext, oracleTimestamp uint64, beaconStateRoot common.Hash, validatorIndex uint64, validatorFieldsProof []byte, validatorFields beaconproofs.ValidatorFields) (uint64, error) {
+	pubkeyHash, err := validatorFields.PubkeyHash()
+	if err != nil {
+		return 0, fieldsRevert(err)
+	}
+	info := p.validators[pubkeyHash]
+	if info.Status != validatorInactive {
+		return 0, revert("EigenPod.verifyCorrectWithdrawalCredentials: Validator must be inactive to prove withdrawal credentials")
+	}
+	credentials, err := validatorFields.WithdrawalCredentials()
+	if err != nil {
+		return 0, fieldsRevert(err)
+	}
+	if credentials != beaconproofs.PodWithdrawalCredentials(p.address) {
+		return 0, revert("EigenPod.verifyCorrectWithdrawalCredentials: Proof is not for this EigenPod")
+	}
+	if err := proofRevert(beaconproofs.VerifyValidatorFields(beaconStateRoot, validatorFields, validatorFieldsProof, validatorIndex)); err != nil {
+		return 0, err
+	}
+	info.Status = validatorActive
+	info.ValidatorIndex = validatorIndex
+	effectiveBalanceGwei, err := validatorFields.EffectiveBalanceGwei()
+	if err != nil {
+		return 0, fieldsRevert(err)
+	}
+	info.MostRecentBalanceUpdateTimestamp = oracleTimestamp
+	info.RestakedBalanceGwei = min(effectiveBalanceGwei, p.maxRestakedBalanceGwei)
+	set(tx, p.validators, pubkeyHash, info)
+	p.emit(tx, "ValidatorRestaked", new(big.Int).SetUint64(validatorIndex))
+	p.emit(tx, "ValidatorBalanceUpdated", new(big.Int).SetUint64(validatorIndex), oracleTimestamp, info.RestakedBalanceGwei)
+	return info.RestakedBalanceGwei, nil
+}
+
+func (p *EigenPod) verifyBalanceUpdate(tx *txContext, oracleTimestamp, validatorIndex uint64, beaconStateRoot common.Hash, validatorFieldsProof []byte, validatorFields beaconproofs.ValidatorFields) (*big.Int, error) {
+	effectiveBalanceGwei, err := validatorFields.EffectiveBalanceGwei()
+	if err != nil {
+		return nil, fieldsRevert(err)
+	}
+	pubkeyHash, err := validatorFields.PubkeyHash()
+	if err != nil {
+		return nil, fieldsRevert(err)
+	}
+	info := p.validators[pubkeyHash]
+	if info.MostRecentBalanceUpdateTimestamp >= oracleTimestamp {
+		return nil, revert("EigenPod.verifyBalanceUpdate: Validators balance has already been updated for this timestamp")
+	}
+	if info.Status != validatorActive {
+		return nil, revert("EigenPod.verifyBalanceUpdate: Validator not active")
+	}
+	epoch, err := p.timestampToEpoch(oracleTimestamp)
+	if err != nil {
+		return nil, err
+	}
+	withdrawableEpoch, err := validatorFields.WithdrawableEpoch()
+	if err != nil {
+		return nil, fieldsRevert(err)
+	}
+	if withdrawableEpoch <= epoch && effectiveBalanceGwei == 0 {
+		return nil, revert("EigenPod.verifyBalanceUpdate: validator is withdrawable but has not withdrawn")
+	}
+	if err := proofRevert(beaconproofs.VerifyValidatorFields(beaconStateRoot, validatorFields, validatorFieldsProof, validatorIndex)); err != nil {
+		return nil, err
+	}
+	current := info.RestakedBalanceGwei
+	info.RestakedBalanceGwei = min(effectiveBalanceGwei, p.maxRestakedBalanceGwei)
+	info.MostRecentBalanceUpdateTimestamp = oracleTimestamp
+	set(tx, p.validators, pubkeyHash, info)
+	if info.RestakedBalanceGwei == current {
+		return new(big.Int), nil
+	}
+	p.emit(tx, "ValidatorBalanceUpdated", new(big.Int).SetUint64(validatorIndex), oracleTimestamp, info.RestakedBalanceGwei)
+	return sharesDelta(info.RestakedBalanceGwei, current), nil
+}
+
+// verifyAndProcessWithdrawal returns the gwei to send to the pod owner and
+// the change in its shares for a withdrawal.
+func (p *EigenPod) verifyAndProcessWithdrawal(tx *txContext, beaconStateRoot common.Hash, withdrawalProof eigenpod.BeaconChainProofsWithdrawalProof, validatorFieldsProof []byte, validatorFields beaconproofs.ValidatorFields, withdrawalFields beaconproofs.WithdrawalFields) (*big.Int, *big.Int, error) {
+	proof := withdrawalProof.Canonical()
+	withdrawalTimestamp := beaconproofs.WithdrawalTimestamp(proof)
+	if withdrawalTimestamp < p.mostRecentWithdrawalTimestamp {
+		return nil, nil, revert("EigenPod.proofIsForValidTimestamp: beacon chain proof must be at or after mostRecentWithdrawalTimestamp")
+	}
+	pubkeyHash, err := validatorFields.PubkeyHash()
+	if err != nil {
+		return nil, nil, fieldsRevert(err)
+	}
+	info := p.validators[pubkeyHash]
+	if info.Status == validatorInactive {
+		return nil, nil, revert("EigenPod._verifyAndProcessWithdrawal: Validator never proven to have withdrawal credentials pointed to this contract")
+	}
+	key := withdrawalKey{pubkeyHash, withdrawalTimestamp}
+	if p.provenWithdrawal[key] {
+		return nil, nil, revert("EigenPod._verifyAndProcessWithdrawal: withdrawal has already been proven for this timestamp")
+	}
+	set(tx, p.provenWithdrawal, key, true)
+	if err := proofRevert(beaconproofs.VerifyWithdrawal(beaconStateRoot, withdrawalFields, proof, p.manager.denebFork())); err != nil {
+		return nil, nil, err
+	}
+	validatorIndex, err := withdrawalFields.ValidatorIndex()
+	if err != nil {
+		return nil, nil, fieldsRevert(err)
+	}
+	if err := proofRevert(beaconproofs.VerifyValidatorFields(beaconStateRoot, validatorFields, validatorFieldsProof, validatorIndex)); err != nil {
+		return nil, nil, err
+	}
+	amountGwei, err := withdrawalFields.AmountGwei()
+	if err != nil {
+		return nil, nil, fieldsRevert(err)
+	}
+	withdrawableEpoch, err := validatorFields.WithdrawableEpoch()
+	if err != nil {
+		return nil, nil, fieldsRevert(err)
+	}
+	if beaconproofs.WithdrawalEpoch(proof) < withdrawableEpoch {
+		p.emit(tx, "PartialWithdrawalRedeemed", new(big.Int).SetUint64(validatorIndex), withdrawalTimestamp, p.podOwner, amountGwei)
+		assign(tx, &p.sumOfPartialWithdrawalsClaimedGwei, p.sumOfPartialWithdrawalsClaimedGwei+amountGwei)
+		return new(big.Int).SetUint64(amountGwei), new(big.Int), nil
+	}
+
+	amountToQueueGwei := min(amountGwei, p.maxRestakedBalanceGwei)
+	assign(tx, &p.withdrawableRestakedExecutionLayerGwei, p.withdrawableRestakedExecutionLayerGwei+amountToQueueGwei)
+	delta := sharesDelta(amountToQueueGwei, info.RestakedBalanceGwei)
+	info.Status = validatorWithdrawn
+	info.RestakedBalanceGwei = 0
+	set(tx, p.validators, pubkeyHash, info)
+	p.emit(tx, "FullWithdrawalRedeemed", new(big.Int).SetUint64(validatorIndex), withdrawalTimestamp, p.podOwner, amountGwei)
+	return new(big.Int).SetUint64(amountGwei - amountToQueueGwei), delta, nil
+}
+
+// sharesDelta returns newAmountGwei - previousAmountGwei.
+func sharesDelta(newAmountGwei, previousAmountGwei uint64) *big.Int {
+	return new(big.Int).Sub(new(big.Int).SetUint64(newAmountGwei), new(big.Int).SetUint64(previousAmountGwei))
+}
+
+// pubkeyHash hashes a validator public key as the pod does, reverting for
+// one that is not 48 bytes long.
+func pubkeyHash(pubkey []byte) (common.Hash, error) {
+	if len(pubkey) != 48 {
+		return common.Hash{}, revert("EigenPod._calculateValidatorPubkeyHash must be a 48-byte BLS public key")
+	}
+	return beaconproofs.HashValidatorBLSPubkey(pubkey)
+}
+
+// hashes converts the [32]byte words of the binding to fields.
+func hashes(words [][32]byte) []common.Hash {
+	out := make([]common.Hash, len(words))
+	for i, w := range words {
+		out[i] = w
+	}
+	return out
+}
+
+// proofRevert converts the revert of a beaconproofs check into the fake's
+// revert.
+func proofRevert(err error) error {
+	var r *reverts.Error
+	if errors.As(err, &r) {
+		return revert(r.Reason)
+	}
+	return err
+}
+
+// fieldsRevert turns the error of a getter of validator or withdrawal
+// fields into the revert of the pod, which indexes the fields it is given
+// and so panics on too few. Given too many, the pod would revert once it
+// checks their proof, where this one reverts on reading them.
+func fieldsRevert(err error) error {
+	if errors.Is(err, beaconproofs.ErrFieldCount) {
+		return revert(panicOutOfBounds)
+	}
+	return err
+}
@@ -0,0 +1,451 @@
+package fakes_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/beacon"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/beacon/beacontest"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/beaconoracle"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/beaconproofs"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/constants"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/fakes"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/prooffile"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/proofgen"
+)
+
+// The slots of the beacon states the pod's proofs are made against.
+const (
+	restakeSlot = 9_000_000
+	updateSlot  = restakeSlot + 32
+)
+
+var podOracle = common.HexToAddress("0x7000")
+
+// podEnv is a deployment whose EigenPodManager reads block roots from the
+// recorded headers of a beacon chain built in memory, with owner's pod
+// deployed. The chain has a state at restakeSlot, in which validators 0, 1
+// and 3 withdraw to the pod and validator 2 to another address, and one at
+// updateSlot, by which validator 0 lost 1 ETH and validator 3 has been
+// withdrawn from. Validator 1 holds 40 ETH in both.
+type podEnv struct {
+	*fakes.Deployment
+	t        *testing.T
+	beacon   *beacontest.Chain
+	schedule beaconproofs.ForkSchedule
+	pod      *fakes.EigenPod
+	// replay serves the recorded beacon chain.
+	replay beacon.Client
+}
+
+func newPodEnv(t *testing.T) *podEnv {
+	t.Helper()
+	cfg := fakes.DefaultConfig(owner)
+	cfg.BeaconChainOracle = podOracle
+	schedule := beaconproofs.ForkSchedule{DenebForkTimestamp: cfg.DenebForkTimestamp}
+	chain := beacontest.NewChain(cfg.BeaconGenesisTime, schedule)
+	d, err := fakes.NewDeployment(fakes.NewChain(chain.Timestamp(updateSlot)), cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	e := &podEnv{Deployment: d, t: t, beacon: chain, schedule: schedule}
+	e.must(d.EigenPodManager.CreatePod(from(owner)))
+	e.pod = d.EigenPodManager.Pod(owner)
+
+	credentials := beaconproofs.PodWithdrawalCredentials(e.pod.Address())
+	validator := func(i, balance, withdrawableEpoch uint64, credentials common.Hash) beacontest.Validator {
+		return beacontest.Validator{
+			Pubkey:                beacontest.Pubkey(i),
+			WithdrawalCredentials: credentials,
+			EffectiveBalance:      balance,
+			Balance:               balance,
+			ExitEpoch:             min(withdrawableEpoch, beaconproofs.FarFutureEpoch),
+			WithdrawableEpoch:     withdrawableEpoch,
+		}
+	}
+	withdrawn := chain.Epoch(restakeSlot) + 1
+	states := map[uint64][]beacontest.Validator{
+		restakeSlot: {
+			validator(0, 32e9, beaconproofs.FarFutureEpoch, credentials),
+			validator(1, 40e9, beaconproofs.FarFutureEpoch, credentials),
+			validator(2, 32e9, beaconproofs.FarFutureEpoch, common.Hash{1}),
+			validator(3, 32e9, beaconproofs.FarFutureEpoch, credentials),
+		},
+		updateSlot: {
+			validator(0, 31e9, beaconproofs.FarFutureEpoch, credentials),
+			validator(1, 40e9, beaconproofs.FarFutureEpoch, credentials),
+			validator(2, 32e9, beaconproofs.FarFutureEpoch, common.Hash{1}),
+			validator(3, 0, withdrawn, credentials),
+		},
+	}
+	for slot, validators := range states {
+		if _, err := chain.AddState(slot, validators); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	dir := t.TempDir()
+	if err := chain.Record(dir); err != nil {
+		t.Fatal(err)
+	}
+	archive, err := beaconoracle.Load(cfg.BeaconGenesisTime, filepath.Join(dir, "headers"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	d.Chain.Register(podOracle, archive)
+	e.replay = beacon.NewReplayer(dir)
+	return e
+}
+
+func (e *podEnv) must(_ any, err error) {
+	e.t.Helper()
+	if err != nil {
+		e.t.Fatal(err)
+	}
+}
+
+// proofs proves the validators with indices against the replayed state at
+// slot.
+func (e *podEnv) proofs(slot uint64, prove func(*proofgen.Prover, uint64) (*prooffile.File, error), indices []uint64) ([]*prooffile.File, error) {
+	state, err := e.replay.State(context.Background(), strconv.FormatUint(slot, 10))
+	if err != nil {
+		return nil, err
+	}
+	p, err := proofgen.NewProver(state, e.schedule)
+	if err != nil {
+		return nil, err
+	}
+	files := make([]*prooffile.File, len(indices))
+	for i, index := range indices {
+		if files[i], err = prove(p, index); err != nil {
+			return nil, err
+		}
+	}
+	return files, nil
+}
+
+// verifyCredentials proves the withdrawal credentials of the validators
+// with indices as sender, against the state at slot and the block root
+// the oracle serves at oracleTimestamp.
+func (e *podEnv) verifyCredentials(sender common.Address, slot, oracleTimestamp uint64, indices ...uint64) error {
+	files, err := e.proofs(slot, (*proofgen.Prover).WithdrawalCredentialProof, indices)
+	if err != nil {
+		return err
+	}
+	validatorIndices, validatorFieldsProofs, validatorFields := prooffile.Credentials(files...)
+	_, err = e.pod.VerifyWithdrawalCredentials(from(sender), oracleTimestamp, files[0].ToStateRootProof(), validatorIndices, validatorFieldsProofs, validatorFields)
+	return err
+}
+
+// restake proves the withdrawal credentials of the validators with indices
+// against the state at restakeSlot.
+func (e *podEnv) restake(indices ...uint64) error {
+	return e.verifyCredentials(owner, restakeSlot, e.beacon.Timestamp(restakeSlot), indices...)
+}
+
+// update proves the balances of the validators with indices against the
+// state at slot.
+func (e *podEnv) update(slot uint64, indices ...uint64) error {
+	files, err := e.proofs(slot, (*proofgen.Prover).BalanceUpdateProof, indices)
+	if err != nil {
+		return err
+	}
+	validatorIndices, validatorFieldsProofs, validatorFields := prooffile.Credentials(files...)
+	_, err = e.pod.VerifyBalanceUpdates(from(stranger), e.beacon.Timestamp(slot), validatorIndices, files[0].ToStateRootProof(), validatorFieldsProofs, validatorFields)
+	return err
+}
+
+// blockRoot checks the root the EigenPodManager serves at the timestamp of
+// slot against the recorded header.
+func (e *podEnv) blockRoot(slot uint64) error {
+	root, err := e.EigenPodManager.GetBlockRootAtTimestamp(&bind.CallOpts{}, e.beacon.Timestamp(slot))
+	if err != nil {
+		return err
+	}
+	header, err := e.replay.Header(context.Background(), strconv.FormatUint(slot, 10))
+	if err != nil {
+		return err
+	}
+	if root != header.Root {
+		return fmt.Errorf("block root %x, want %x", root, header.Root)
+	}
+	return nil
+}
+
+// podState is what the deployment records for owner's pod and its four
+// validators.
+type podState struct {
+	numPods        int64
+	sharesGwei     int64
+	status         [4]uint8
+	restakedGwei   [4]uint64
+	balanceUpdates int
+}
+
+func (e *podEnv) state() podState {
+	e.t.Helper()
+	call := &bind.CallOpts{}
+	var s podState
+	numPods, err := e.EigenPodManager.NumPods(call)
+	if err != nil {
+		e.t.Fatal(err)
+	}
+	shares, err := e.EigenPodManager.PodOwnerShares(call, owner)
+	if err != nil {
+		e.t.Fatal(err)
+	}
+	s.numPods, s.sharesGwei = numPods.Int64(), new(big.Int).Quo(shares, big.NewInt(1e9)).Int64()
+	for i := range s.status {
+		info, err := e.pod.ValidatorPubkeyToInfo(call, beacontest.Pubkey(uint64(i)))
+		if err != nil {
+			e.t.Fatal(err)
+		}
+		s.status[i], s.restakedGwei[i] = info.Status, info.RestakedBalanceGwei
+	}
+	it, err := e.pod.FilterValidatorBalanceUpdated(&bind.FilterOpts{})
+	if err != nil {
+		e.t.Fatal(err)
+	}
+	defer it.Close()
+	for it.Next() {
+		s.balanceUpdates++
+	}
+	if err := it.Error(); err != nil {
+		e.t.Fatal(err)
+	}
+	return s
+}
+
+type podTest struct {
+	name string
+	// steps run in order, and all but the last must succeed.
+	steps []func(e *podEnv) error
+	// err is the revert reason of the last step, if it reverts.
+	err  string
+	want podState
+}
+
+func runPodTests(t *testing.T, tests []podTest) {
+	t.Helper()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := newPodEnv(t)
+			last := len(tt.steps) - 1
+			for _, step := range tt.steps[:last] {
+				if err := step(e); err != nil {
+					t.Fatal(err)
+				}
+			}
+			err := tt.steps[last](e)
+			var revert *fakes.RevertError
+			switch {
+			case tt.err == "" && err != nil:
+				t.Fatal(err)
+			case tt.err != "" && (!errors.As(err, &revert) || revert.Reason != tt.err):
+				t.Fatalf("err = %v, want a revert with %q", err, tt.err)
+			}
+			if got := e.state(); got != tt.want {
+				t.Errorf("state %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func restake(indices ...uint64) func(*podEnv) error {
+	return func(e *podEnv) error { return e.restake(indices...) }
+}
+
+func update(slot uint64, indices ...uint64) func(*podEnv) error {
+	return func(e *podEnv) error { return e.update(slot, indices...) }
+}
+
+// Validator statuses, as IEigenPod.VALIDATOR_STATUS.
+const (
+	inactive uint8 = iota
+	active
+)
+
+func TestEigenPodVerifyWithdrawalCredentials(t *testing.T) {
+	runPodTests(t, []podTest{
+		{
+			// Validator 1's 40 ETH count for the maximum of 32.
+			name:  "restake",
+			steps: []func(*podEnv) error{restake(0, 1)},
+			want:  podState{numPods: 1, sharesGwei: 64e9, status: [4]uint8{active, active}, restakedGwei: [4]uint64{32e9, 32e9}, balanceUpdates: 2},
+		},
+		{
+			name: "restake as another account",
+			steps: []func(*podEnv) error{func(e *podEnv) error {
+				return e.verifyCredentials(stranger, restakeSlot, e.beacon.Timestamp(restakeSlot), 0)
+			}},
+			err:  "EigenPod.onlyEigenPodOwner: not podOwner",
+			want: podState{numPods: 1},
+		},
+		{
+			name:  "restake a validator of another pod",
+			steps: []func(*podEnv) error{restake(0, 2)},
+			err:   "EigenPod.verifyCorrectWithdrawalCredentials: Proof is not for this EigenPod",
+			want:  podState{numPods: 1},
+		},
+		{
+			name:  "restake twice",
+			steps: []func(*podEnv) error{restake(0), restake(0)},
+			err:   "EigenPod.verifyCorrectWithdrawalCredentials: Validator must be inactive to prove withdrawal credentials",
+			want:  podState{numPods: 1, sharesGwei: 32e9, status: [4]uint8{active}, restakedGwei: [4]uint64{32e9}, balanceUpdates: 1},
+		},
+		{
+			name: "restake against a state too far in the past",
+			steps: []func(*podEnv) error{func(e *podEnv) error {
+				e.Chain.AdvanceTime(constants.EigenPodVerifyBalanceUpdateWindowSeconds)
+				return e.restake(0)
+			}},
+			err:  "EigenPod.verifyWithdrawalCredentials: specified timestamp is too far in past",
+			want: podState{numPods: 1},
+		},
+		{
+			name: "restake at a timestamp the oracle has no root for",
+			steps: []func(*podEnv) error{func(e *podEnv) error {
+				return e.verifyCredentials(owner, restakeSlot, e.beacon.Timestamp(restakeSlot+1), 0)
+			}},
+			err:  "EigenPodManager.getBlockRootAtTimestamp: state root at timestamp not yet finalized",
+			want: podState{numPods: 1},
+		},
+		{
+			// The oracle's root at restakeSlot does not commit to the
+			// state at updateSlot.
+			name: "restake against another state",
+			steps: []func(*podEnv) error{func(e *podEnv) error {
+				return e.verifyCredentials(owner, updateSlot, e.beacon.Timestamp(restakeSlot), 0)
+			}},
+			err:  "BeaconChainProofs.verifyStateRootAgainstLatestBlockRoot: Invalid latest block header root merkle proof",
+			want: podState{numPods: 1},
+		},
+		{
+			name: "restake without an oracle",
+			steps: []func(*podEnv) error{func(e *podEnv) error {
+				_, err := e.EigenPodManager.UpdateBeaconChainOracle(from(owner), stranger)
+				return err
+			}, restake(0)},
+			err:  "function call to a non-contract account",
+			want: podState{numPods: 1},
+		},
+	})
+}
+
+func TestEigenPodVerifyBalanceUpdates(t *testing.T) {
+	restaked := podState{numPods: 1, sharesGwei: 96e9, status: [4]uint8{active, active, inactive, active}, restakedGwei: [4]uint64{32e9, 32e9, 0, 32e9}, balanceUpdates: 3}
+	runPodTests(t, []podTest{
+		{
+			name:  "decrease",
+			steps: []func(*podEnv) error{restake(0, 1, 3), update(updateSlot, 0)},
+			want:  podState{numPods: 1, sharesGwei: 95e9, status: restaked.status, restakedGwei: [4]uint64{31e9, 32e9, 0, 32e9}, balanceUpdates: 4},
+		},
+		{
+			// Validator 1's restaked balance stays at the maximum, so no
+			// event is emitted.
+			name:  "unchanged",
+			steps: []func(*podEnv) error{restake(0, 1, 3), update(updateSlot, 1)},
+			want:  restaked,
+		},
+		{
+			name:  "withdrawable but not withdrawn",
+			steps: []func(*podEnv) error{restake(0, 1, 3), update(updateSlot, 0, 3)},
+			err:   "EigenPod.verifyBalanceUpdate: validator is withdrawable but has not withdrawn",
+			want:  restaked,
+		},
+		{
+			name:  "not restaked",
+			steps: []func(*podEnv) error{restake(0, 1, 3), update(updateSlot, 2)},
+			err:   "EigenPod.verifyBalanceUpdate: Validator not active",
+			want:  restaked,
+		},
+		{
+			name:  "already updated for the timestamp",
+			steps: []func(*podEnv) error{restake(0, 1, 3), update(restakeSlot, 0)},
+			err:   "EigenPod.verifyBalanceUpdate: Validators balance has already been updated for this timestamp",
+			want:  restaked,
+		},
+		{
+			name: "too far in the past",
+			steps: []func(*podEnv) error{restake(0, 1, 3), func(e *podEnv) error {
+				e.Chain.AdvanceTime(constants.EigenPodVerifyBalanceUpdateWindowSeconds)
+				return e.update(updateSlot, 0)
+			}},
+			err:  "EigenPod.verifyBalanceUpdates: specified timestamp is too far in past",
+			want: restaked,
+		},
+	})
+}
+
+func TestEigenPodManager(t *testing.T) {
+	stake := func(value *big.Int) func(*podEnv) error {
+		return func(e *podEnv) error {
+			_, err := e.EigenPodManager.Stake(&bind.TransactOpts{From: stranger, Value: value}, beacontest.Pubkey(9), nil, [32]byte{})
+			return err
+		}
+	}
+	runPodTests(t, []podTest{
+		{
+			name: "create a second pod",
+			steps: []func(*podEnv) error{func(e *podEnv) error {
+				_, err := e.EigenPodManager.CreatePod(from(owner))
+				return err
+			}},
+			err:  "EigenPodManager.createPod: Sender already has a pod",
+			want: podState{numPods: 1},
+		},
+		{
+			name:  "stake deploys a pod",
+			steps: []func(*podEnv) error{stake(new(big.Int).Mul(big.NewInt(32), big.NewInt(1e18)))},
+			want:  podState{numPods: 2},
+		},
+		{
+			name:  "stake less than 32 ETH",
+			steps: []func(*podEnv) error{stake(big.NewInt(1e18))},
+			err:   "EigenPod.stake: must initially stake for any validator with 32 ether",
+			want:  podState{numPods: 1},
+		},
+		{
+			name: "record a balance update from the owner",
+			steps: []func(*podEnv) error{func(e *podEnv) error {
+				_, err := e.EigenPodManager.RecordBeaconChainETHBalanceUpdate(from(owner), owner, big.NewInt(1e9))
+				return err
+			}},
+			err:  "EigenPodManager.onlyEigenPod: not a pod",
+			want: podState{numPods: 1},
+		},
+		{
+			name: "block roots of the recorded headers",
+			steps: []func(*podEnv) error{func(e *podEnv) error {
+				if err := e.blockRoot(restakeSlot); err != nil {
+					return err
+				}
+				return e.blockRoot(updateSlot)
+			}},
+			want: podState{numPods: 1},
+		},
+		{
+			name:  "block root of a slot not recorded",
+			steps: []func(*podEnv) error{func(e *podEnv) error { return e.blockRoot(restakeSlot + 1) }},
+			err:   "EigenPodManager.getBlockRootAtTimestamp: state root at timestamp not yet finalized",
+			want:  podState{numPods: 1},
+		},
+		{
+			name: "set the Deneb fork timestamp again",
+			steps: []func(*podEnv) error{func(e *podEnv) error {
+				_, err := e.EigenPodManager.SetDenebForkTimestamp(from(owner), 1)
+				return err
+			}},
+			err:  "EigenPodManager.setDenebForkTimestamp: cannot set denebForkTimestamp more than once",
+			want: podState{numPods: 1},
+		},
+	})
+}
@@ -0,0 +1,482 @@
+package fakes
+
+import (
+	"math"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	eigenpodmanager "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/EigenPodManager"
+	ibeaconchainoracle "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/IBeaconChainOracle"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/constants"
+)
+
+const epmPausedNewEigenPods = uint8(constants.EigenPodManagerPausedNewEigenPods)
+
+// ETH is the token address under which ETH withdrawn from EigenPods is
+// credited in the chain's token ledger.
+var ETH = common.Address{}
+
+// stakeAmount is the deposit EigenPod.stake requires.
+var stakeAmount = new(big.Int).Mul(big.NewInt(32), big.NewInt(1e18))
+
+// beaconProxyPrefix starts the BeaconProxy creation code the EigenPodManager
+// stores as beaconProxyBytecode, which is 2318 bytes long.
+const (
+	beaconProxyPrefix = "608060405260405161090e38038061090e8339"
+	beaconProxyLength = 0x90e
+)
+
+var beaconProxyBytecode = sync.OnceValue(func() []byte {
+	bin := strings.TrimPrefix(eigenpodmanager.EigenPodManagerMetaData.Bin, "0x")
+	i := strings.Index(bin, beaconProxyPrefix)
+	if i < 0 || len(bin) < i+2*beaconProxyLength {
+		panic("fakes: EigenPodManager bytecode does not embed the BeaconProxy creation code")
+	}
+	return common.FromHex(bin[i : i+2*beaconProxyLength])
+})
+
+// EigenPodManager is a fake of the EigenPodManager contract. Pods are
+// EigenPod fakes deployed at the addresses the contract would CREATE2 them
+// at, and ETH withdrawn through a pod is credited to the destination under
+// the ETH token in the chain's ledger. Pods read block roots from the oracle
+// with the chain locked, so an oracle registered on the chain must not call
+// back into it.
+type EigenPodManager struct {
+	*eigenpodmanager.EigenPodManagerFilterer
+	pausable
+
+	ethPOS     common.Address
+	beacon     common.Address
+	strategies *StrategyManager
+	delegation *DelegationManager
+	slasher    common.Address
+
+	delayedWithdrawalRouter common.Address
+	maxRestakedBalanceGwei  uint64
+	beaconGenesisTime       uint64
+
+	oracle             common.Address
+	denebForkTimestamp uint64
+	ownerToPod         map[common.Address]common.Address
+	pods               map[common.Address]*EigenPod
+	numPods            uint64
+	podOwnerShares     map[common.Address]*big.Int
+}
+
+var (
+	_ eigenpodmanager.EigenPodManagerReader = (*EigenPodManager)(nil)
+	_ eigenpodmanager.EigenPodManagerWriter = (*EigenPodManager)(nil)
+	_ eigenpodmanager.EigenPodManagerEvents = (*EigenPodManager)(nil)
+)
+
+func newEigenPodManager(chain *Chain, address common.Address, cfg *Config) *EigenPodManager {
+	filterer, err := eigenpodmanager.NewEigenPodManagerFilterer(address, chain)
+	if err != nil {
+		panic(err)
+	}
+	c := newContract(chain, address, eigenpodmanager.EigenPodManagerMetaData)
+	epm := &EigenPodManager{
+		EigenPodManagerFilterer: filterer,
+		pausable:                newPausable(c, cfg.Owner, cfg.PauserRegistry, nil),
+		ethPOS:                  cfg.ETHPOSDeposit,
+		beacon:                  cfg.EigenPodBeacon,
+		slasher:                 cfg.Slasher,
+		oracle:                  cfg.BeaconChainOracle,
+		denebForkTimestamp:      cfg.DenebForkTimestamp,
+		delayedWithdrawalRouter: cfg.DelayedWithdrawalRouter,
+		maxRestakedBalanceGwei:  cfg.MaxRestakedBalanceGweiPerValidator,
+		beaconGenesisTime:       cfg.BeaconGenesisTime,
+		ownerToPod:              make(map[common.Address]common.Address),
+		pods:                    make(map[common.Address]*EigenPod),
+		podOwnerShares:          make(map[common.Address]*big.Int),
+	}
+	chain.Register(address, epm)
+	return epm
+}
+
+// BeaconChainETHStrategy returns the virtual beacon chain ETH strategy.
+func (epm *EigenPodManager) BeaconChainETHStrategy(opts *bind.CallOpts) (common.Address, error) {
+	return BeaconChainETHStrategy, nil
+}
+
+// BeaconChainOracle returns the address of the beacon chain oracle.
+func (epm *EigenPodManager) BeaconChainOracle(opts *bind.CallOpts) (common.Address, error) {
+	epm.chain.mu.Lock()
+	defer epm.chain.mu.Unlock()
+	return epm.oracle, nil
+}
+
+// DelegationManager returns the address of the DelegationManager.
+func (epm *EigenPodManager) DelegationManager(opts *bind.CallOpts) (common.Address, error) {
+	return epm.delegation.address, nil
+}
+
+// DenebForkTimestamp returns the Deneb fork timestamp, or the maximum
+// uint64 if it has not been set.
+func (epm *EigenPodManager) DenebForkTimestamp(opts *bind.CallOpts) (uint64, error) {
+	epm.chain.mu.Lock()
+	defer epm.chain.mu.Unlock()
+	return epm.denebFork(), nil
+}
+
+// EigenPodBeacon returns the address of the EigenPod beacon.
+func (epm *EigenPodManager) EigenPodBeacon(opts *bind.CallOpts) (common.Address, error) {
+	return epm.beacon, nil
+}
+
+// EthPOS returns the address of the beacon chain deposit contract.
+func (epm *EigenPodManager) EthPOS(opts *bind.CallOpts) (common.Address, error) {
+	return epm.ethPOS, nil
+}
+
+// GetBlockRootAtTimestamp returns the beacon block root the oracle reports
+// for timestamp. The oracle must be registered on the chain and implement
+// IBeaconChainOracleReader.
+func (epm *EigenPodManager) GetBlockRootAtTimestamp(opts *bind.CallOpts, timestamp uint64) ([32]byte, error) {
+	epm.chain.mu.Lock()
+	oracle := epm.beaconChainOracle()
+	epm.chain.mu.Unlock()
+	return blockRootAtTimestamp(opts, oracle, timestamp)
+}
+
+// blockRootAtTimestamp returns the root oracle reports for timestamp,
+// reverting as getBlockRootAtTimestamp does.
+func blockRootAtTimestamp(opts *bind.CallOpts, oracle ibeaconchainoracle.IBeaconChainOracleReader, timestamp uint64) ([32]byte, error) {
+	if oracle == nil {
+		return [32]byte{}, revert("function call to a non-contract account")
+	}
+	root, err := oracle.TimestampToBlockRoot(opts, new(big.Int).SetUint64(timestamp))
+	if err != nil {
+		return [32]byte{}, err
+	}
+	if root == ([32]byte{}) {
+		return [32]byte{}, revert("EigenPodManager.getBlockRootAtTimestamp: state root at timestamp not yet finalized")
+	}
+	return root, nil
+}
+
+// GetPod returns the pod of podOwner, or the address it would be deployed
+// at.
+func (epm *EigenPodManager) GetPod(opts *bind.CallOpts, podOwner common.Address) (common.Address, error) {
+	epm.chain.mu.Lock()
+	defer epm.chain.mu.Unlock()
+	if pod, ok := epm.ownerToPod[podOwner]; ok {
+		return pod, nil
+	}
+	return epm.podAddress(podOwner), nil
+}
+
+// Pod returns the pod of podOwner, or nil if podOwner has not deployed
+// one.
+func (epm *EigenPodManager) Pod(podOwner common.Address) *EigenPod {
+	epm.chain.mu.Lock()
+	defer epm.chain.mu.Unlock()
+	return epm.pods[podOwner]
+}
+
+// HasPod reports whether podOwner has deployed a pod.
+func (epm *EigenPodManager) HasPod(opts *bind.CallOpts, podOwner common.Address) (bool, error) {
+	epm.chain.mu.Lock()
+	defer epm.chain.mu.Unlock()
+	_, ok := epm.ownerToPod[podOwner]
+	return ok, nil
+}
+
+// NumPods returns the number of pods deployed.
+func (epm *EigenPodManager) NumPods(opts *bind.CallOpts) (*big.Int, error) {
+	epm.chain.mu.Lock()
+	defer epm.chain.mu.Unlock()
+	return new(big.Int).SetUint64(epm.numPods), nil
+}
+
+// OwnerToPod returns the pod deployed by podOwner, if any.
+func (epm *EigenPodManager) OwnerToPod(opts *bind.CallOpts, podOwner common.Address) (common.Address, error) {
+	epm.chain.mu.Lock()
+	defer epm.chain.mu.Unlock()
+	return epm.ownerToPod[podOwner], nil
+}
+
+// PodOwnerShares returns the beacon chain ETH shares of podOwner, which may
+// be negative.
+func (epm *EigenPodManager) PodOwnerShares(opts *bind.CallOpts, podOwner common.Address) (*big.Int, error) {
+	epm.chain.mu.Lock()
+	defer epm.chain.mu.Unlock()
+	return orZero(epm.podOwnerShares[podOwner]), nil
+}
+
+// Slasher returns the address of the Slasher.
+func (epm *EigenPodManager) Slasher(opts *bind.CallOpts) (common.Address, error) {
+	return epm.slasher, nil
+}
+
+// StrategyManager returns the address of the StrategyManager.
+func (epm *EigenPodManager) StrategyManager(opts *bind.CallOpts) (common.Address, error) {
+	return epm.strategies.address, nil
+}
+
+// AddShares is called by the DelegationManager when a beacon chain ETH
+// withdrawal is completed as shares.
+func (epm *EigenPodManager) AddShares(opts *bind.TransactOpts, podOwner common.Address, shares *big.Int) (*types.Transaction, error) {
+	return epm.chain.transact(opts, &epm.contract, "addShares", []any{podOwner, shares}, func(tx *txContext) error {
+		if err := epm.onlyDelegationManager(tx); err != nil {
+			return err
+		}
+		_, err := epm.addShares(tx, podOwner, shares)
+		return err
+	})
+}
+
+// CreatePod deploys a pod for the sender.
+func (epm *EigenPodManager) CreatePod(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return epm.chain.transact(opts, &epm.contract, "createPod", nil, func(tx *txContext) error {
+		if err := epm.onlyWhenNotPaused(epmPausedNewEigenPods); err != nil {
+			return err
+		}
+		if _, ok := epm.ownerToPod[tx.sender]; ok {
+			return revert("EigenPodManager.createPod: Sender already has a pod")
+		}
+		epm.deployPod(tx)
+		return nil
+	})
+}
+
+// Initialize always reverts, since the fake is deployed initialized.
+func (epm *EigenPodManager) Initialize(opts *bind.TransactOpts, _beaconChainOracle common.Address, initialOwner common.Address, _pauserRegistry common.Address, _initPausedStatus *big.Int) (*types.Transaction, error) {
+	return epm.alreadyInitialized(opts, _beaconChainOracle, initialOwner, _pauserRegistry, _initPausedStatus)
+}
+
+// RecordBeaconChainETHBalanceUpdate applies a change in the beacon chain
+// balance of podOwner's validators. It must be sent from podOwner's pod.
+func (epm *EigenPodManager) RecordBeaconChainETHBalanceUpdate(opts *bind.TransactOpts, podOwner common.Address, sharesDelta *big.Int) (*types.Transaction, error) {
+	return epm.chain.transact(opts, &epm.contract, "recordBeaconChainETHBalanceUpdate", []any{podOwner, sharesDelta}, func(tx *txContext) error {
+		return epm.recordBeaconChainETHBalanceUpdate(tx, tx.sender, podOwner, sharesDelta)
+	})
+}
+
+// RemoveShares is called by the DelegationManager when a beacon chain ETH
+// withdrawal is queued.
+func (epm *EigenPodManager) RemoveShares(opts *bind.TransactOpts, podOwner common.Address, shares *big.Int) (*types.Transaction, error) {
+	return epm.chain.transact(opts, &epm.contract, "removeShares", []any{podOwner, shares}, func(tx *txContext) error {
+		if err := epm.onlyDelegationManager(tx); err != nil {
+			return err
+		}
+		return epm.removeShares(tx, podOwner, shares)
+	})
+}
+
+// SetDenebForkTimestamp sets the Deneb fork timestamp once. It can only be
+// called by the owner.
+func (epm *EigenPodManager) SetDenebForkTimestamp(opts *bind.TransactOpts, newDenebForkTimestamp uint64) (*types.Transaction, error) {
+	return epm.chain.transact(opts, &epm.contract, "setDenebForkTimestamp", []any{newDenebForkTimestamp}, func(tx *txContext) error {
+		if err := epm.onlyOwner(tx); err != nil {
+			return err
+		}
+		if newDenebForkTimestamp == 0 {
+			return revert("EigenPodManager.setDenebForkTimestamp: cannot set newDenebForkTimestamp to 0")
+		}
+		if epm.denebForkTimestamp != 0 {
+			return revert("EigenPodManager.setDenebForkTimestamp: cannot set denebForkTimestamp more than once")
+		}
+		assign(tx, &epm.denebForkTimestamp, newDenebForkTimestamp)
+		epm.emit(tx, "DenebForkTimestampUpdated", newDenebForkTimestamp)
+		return nil
+	})
+}
+
+// Stake deploys the sender's pod if needed and stakes a new validator
+// through it. The sent value must be exactly 32 ETH; it is not debited from
+// the sender.
+func (epm *EigenPodManager) Stake(opts *bind.TransactOpts, pubkey []byte, signature []byte, depositDataRoot [32]byte) (*types.Transaction, error) {
+	return epm.chain.transact(opts, &epm.contract, "stake", []any{pubkey, signature, depositDataRoot}, func(tx *txContext) error {
+		if err := epm.onlyWhenNotPaused(epmPausedNewEigenPods); err != nil {
+			return err
+		}
+		if _, ok := epm.ownerToPod[tx.sender]; !ok {
+			epm.deployPod(tx)
+		}
+		return epm.pods[tx.sender].stake(tx, tx.value, pubkey)
+	})
+}
+
+// UpdateBeaconChainOracle replaces the beacon chain oracle. It can only be
+// called by the owner.
+func (epm *EigenPodManager) UpdateBeaconChainOracle(opts *bind.TransactOpts, newBeaconChainOracle common.Address) (*types.Transaction, error) {
+	return epm.chain.transact(opts, &epm.contract, "updateBeaconChainOracle", []any{newBeaconChainOracle}, func(tx *txContext) error {
+		if err := epm.onlyOwner(tx); err != nil {
+			return err
+		}
+		assign(tx, &epm.oracle, newBeaconChainOracle)
+		epm.emit(tx, "BeaconOracleUpdated", newBeaconChainOracle)
+		return nil
+	})
+}
+
+// WithdrawSharesAsTokens is called by the DelegationManager when a beacon
+// chain ETH withdrawal is completed as tokens.
+func (epm *EigenPodManager) WithdrawSharesAsTokens(opts *bind.TransactOpts, podOwner common.Address, destination common.Address, shares *big.Int) (*types.Transaction, error) {
+	return epm.chain.transact(opts, &epm.contract, "withdrawSharesAsTokens", []any{podOwner, destination, shares}, func(tx *txContext) error {
+		if err := epm.onlyDelegationManager(tx); err != nil {
+			return err
+		}
+		return epm.withdrawSharesAsTokens(tx, podOwner, destination, shares)
+	})
+}
+
+func (epm *EigenPodManager) onlyDelegationManager(tx *txContext) error {
+	if tx.sender != epm.delegation.address {
+		return revert("EigenPodManager.onlyDelegationManager: not the DelegationManager")
+	}
+	return nil
+}
+
+// podAddress computes the CREATE2 address of podOwner's pod.
+func (epm *EigenPodManager) podAddress(podOwner common.Address) common.Address {
+	var salt [32]byte
+	copy(salt[12:], podOwner[:])
+	// abi.encode(eigenPodBeacon, ""): the beacon, the offset of the empty
+	// string and its zero length.
+	args := words(epm.beacon, big.NewInt(0x40), big.NewInt(0))
+	initCode := append(append([]byte{}, beaconProxyBytecode()...), args...)
+	return crypto.CreateAddress2(epm.address, salt, crypto.Keccak256(initCode))
+}
+
+func (epm *EigenPodManager) deployPod(tx *txContext) {
+	addr := epm.podAddress(tx.sender)
+	pod := newEigenPod(epm, addr)
+	set(tx, epm.chain.contracts, addr, any(pod))
+	pod.initialize(tx, tx.sender)
+	assign(tx, &epm.numPods, epm.numPods+1)
+	set(tx, epm.ownerToPod, tx.sender, addr)
+	set(tx, epm.pods, tx.sender, pod)
+	epm.emit(tx, "PodDeployed", addr, tx.sender)
+}
+
+// beaconChainOracle returns the oracle registered at the oracle address, or
+// nil. The caller must hold the chain lock.
+func (epm *EigenPodManager) beaconChainOracle() ibeaconchainoracle.IBeaconChainOracleReader {
+	oracle, _ := epm.chain.lookup(epm.oracle).(ibeaconchainoracle.IBeaconChainOracleReader)
+	return oracle
+}
+
+// denebFork returns the Deneb fork timestamp, or the maximum uint64 if it
+// has not been set. The caller must hold the chain lock.
+func (epm *EigenPodManager) denebFork() uint64 {
+	if epm.denebForkTimestamp == 0 {
+		return math.MaxUint64
+	}
+	return epm.denebForkTimestamp
+}
+
+// recordBeaconChainETHBalanceUpdate applies sharesDelta to podOwner's
+// shares on behalf of sender, which must be podOwner's pod.
+func (epm *EigenPodManager) recordBeaconChainETHBalanceUpdate(tx *txContext, sender, podOwner common.Address, sharesDelta *big.Int) error {
+	if pod, ok := epm.ownerToPod[podOwner]; !ok || pod != sender {
+		return revert("EigenPodManager.onlyEigenPod: not a pod")
+	}
+	if podOwner == (common.Address{}) {
+		return revert("EigenPodManager.recordBeaconChainETHBalanceUpdate: podOwner cannot be zero address")
+	}
+	if new(big.Int).Rem(sharesDelta, big.NewInt(gweiToWei)).Sign() != 0 {
+		return revert("EigenPodManager.recordBeaconChainETHBalanceUpdate: sharesDelta must be a whole Gwei amount")
+	}
+	before := orZero(epm.podOwnerShares[podOwner])
+	after := new(big.Int).Add(before, sharesDelta)
+	set(tx, epm.podOwnerShares, podOwner, after)
+	switch change := delegatableChange(before, after); change.Sign() {
+	case -1:
+		if err := epm.delegation.decreaseDelegatedShares(tx, podOwner, BeaconChainETHStrategy, change.Neg(change)); err != nil {
+			return err
+		}
+	case 1:
+		if err := epm.delegation.increaseDelegatedShares(tx, podOwner, BeaconChainETHStrategy, change); err != nil {
+			return err
+		}
+	}
+	epm.emit(tx, "PodSharesUpdated", podOwner, sharesDelta)
+	return nil
+}
+
+// delegatableChange returns the change in delegatable shares when a pod
+// owner's shares move from before to after. Negative shares are never
+// delegated.
+func delegatableChange(before, after *big.Int) *big.Int {
+	zero := new(big.Int)
+	if before.Sign() <= 0 {
+		if after.Sign() <= 0 {
+			return zero
+		}
+		return new(big.Int).Set(after)
+	}
+	if after.Sign() <= 0 {
+		return new(big.Int).Neg(before)
+	}
+	return new(big.Int).Sub(after, before)
+}
+
+func (epm *EigenPodManager) checkShares(shares *big.Int, method string) error {
+	if shares.Sign() < 0 || shares.BitLen() > 255 {
+		return revert("EigenPodManager." + method + ": shares cannot be negative")
+	}
+	if new(big.Int).Rem(shares, big.NewInt(gweiToWei)).Sign() != 0 {
+		return revert("EigenPodManager." + method + ": shares must be a whole Gwei amount")
+	}
+	return nil
+}
+
+func (epm *EigenPodManager) removeShares(tx *txContext, podOwner common.Address, shares *big.Int) error {
+	if err := epm.checkShares(shares, "removeShares"); err != nil {
+		return err
+	}
+	updated := new(big.Int).Sub(orZero(epm.podOwnerShares[podOwner]), shares)
+	if updated.Sign() < 0 {
+		return revert("EigenPodManager.removeShares: cannot result in pod owner having negative shares")
+	}
+	set(tx, epm.podOwnerShares, podOwner, updated)
+	return nil
+}
+
+func (epm *EigenPodManager) addShares(tx *txContext, podOwner common.Address, shares *big.Int) (*big.Int, error) {
+	if podOwner == (common.Address{}) {
+		return nil, revert("EigenPodManager.addShares: podOwner cannot be zero address")
+	}
+	if err := epm.checkShares(shares, "addShares"); err != nil {
+		return nil, err
+	}
+	before := orZero(epm.podOwnerShares[podOwner])
+	after := new(big.Int).Add(before, shares)
+	set(tx, epm.podOwnerShares, podOwner, after)
+	epm.emit(tx, "PodSharesUpdated", podOwner, shares)
+	return delegatableChange(before, after), nil
+}
+
+func (epm *EigenPodManager) withdrawSharesAsTokens(tx *txContext, podOwner, destination common.Address, shares *big.Int) error {
+	if podOwner == (common.Address{}) {
+		return revert("EigenPodManager.withdrawSharesAsTokens: podOwner cannot be zero address")
+	}
+	if destination == (common.Address{}) {
+		return revert("EigenPodManager.withdrawSharesAsTokens: destination cannot be zero address")
+	}
+	if err := epm.checkShares(shares, "withdrawSharesAsTokens"); err != nil {
+		return err
+	}
+	shares = new(big.Int).Set(shares)
+	if current := orZero(epm.podOwnerShares[podOwner]); current.Sign() < 0 {
+		deficit := new(big.Int).Neg(current)
+		if shares.Cmp(deficit) <= 0 {
+			set(tx, epm.podOwnerShares, podOwner, current.Add(current, shares))
+			epm.emit(tx, "PodSharesUpdated", podOwner, shares)
+			return nil
+		}
+		set(tx, epm.podOwnerShares, podOwner, new(big.Int))
+		shares.Sub(shares, deficit)
+		epm.emit(tx, "PodSharesUpdated", podOwner, deficit)
+	}
+	tx.credit(ETH, destination, shares)
+	return nil
+}
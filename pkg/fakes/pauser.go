@@ -0,0 +1,98 @@
+package fakes
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	pauserregistry "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/PauserRegistry"
+)
+
+// PauserRegistry is a fake of the PauserRegistry contract consulted by the
+// Pause, PauseAll, Unpause and SetPauserRegistry methods of every fake.
+type PauserRegistry struct {
+	*pauserregistry.PauserRegistryFilterer
+	contract
+
+	pausers      map[common.Address]bool
+	unpauserAddr common.Address
+}
+
+var (
+	_ pauserregistry.PauserRegistryReader = (*PauserRegistry)(nil)
+	_ pauserregistry.PauserRegistryWriter = (*PauserRegistry)(nil)
+	_ pauserregistry.PauserRegistryEvents = (*PauserRegistry)(nil)
+)
+
+// NewPauserRegistry deploys a pauser registry at address on chain.
+func NewPauserRegistry(chain *Chain, address common.Address, pausers []common.Address, unpauser common.Address) *PauserRegistry {
+	filterer, err := pauserregistry.NewPauserRegistryFilterer(address, chain)
+	if err != nil {
+		panic(err)
+	}
+	r := &PauserRegistry{
+		PauserRegistryFilterer: filterer,
+		contract:               newContract(chain, address, pauserregistry.PauserRegistryMetaData),
+		pausers:                make(map[common.Address]bool),
+		unpauserAddr:           unpauser,
+	}
+	for _, pauser := range pausers {
+		r.pausers[pauser] = true
+	}
+	chain.Register(address, r)
+	return r
+}
+
+func (r *PauserRegistry) isPauser(account common.Address) bool {
+	return r.pausers[account]
+}
+
+func (r *PauserRegistry) unpauser() common.Address {
+	return r.unpauserAddr
+}
+
+// IsPauser reports whether account may pause contracts using the registry.
+func (r *PauserRegistry) IsPauser(opts *bind.CallOpts, account common.Address) (bool, error) {
+	r.chain.mu.Lock()
+	defer r.chain.mu.Unlock()
+	return r.pausers[account], nil
+}
+
+// Unpauser returns the account allowed to unpause contracts.
+func (r *PauserRegistry) Unpauser(opts *bind.CallOpts) (common.Address, error) {
+	r.chain.mu.Lock()
+	defer r.chain.mu.Unlock()
+	return r.unpauserAddr, nil
+}
+
+// SetIsPauser grants or revokes the pauser role. It can only be called by
+// the unpauser.
+func (r *PauserRegistry) SetIsPauser(opts *bind.TransactOpts, newPauser common.Address, canPause bool) (*types.Transaction, error) {
+	return r.chain.transact(opts, &r.contract, "setIsPauser", []any{newPauser, canPause}, func(tx *txContext) error {
+		if tx.sender != r.unpauserAddr {
+			return revert("msg.sender is not permissioned as unpauser")
+		}
+		if newPauser == (common.Address{}) {
+			return revert("PauserRegistry._setPauser: zero address input")
+		}
+		set(tx, r.pausers, newPauser, canPause)
+		r.emit(tx, "PauserStatusChanged", newPauser, canPause)
+		return nil
+	})
+}
+
+// SetUnpauser replaces the unpauser. It can only be called by the current
+// unpauser.
+func (r *PauserRegistry) SetUnpauser(opts *bind.TransactOpts, newUnpauser common.Address) (*types.Transaction, error) {
+	return r.chain.transact(opts, &r.contract, "setUnpauser", []any{newUnpauser}, func(tx *txContext) error {
+		if tx.sender != r.unpauserAddr {
+			return revert("msg.sender is not permissioned as unpauser")
+		}
+		if newUnpauser == (common.Address{}) {
+			return revert("PauserRegistry._setUnpauser: zero address input")
+		}
+		r.emit(tx, "UnpauserChanged", r.unpauserAddr, newUnpauser)
+		assign(tx, &r.unpauserAddr, newUnpauser)
+		return nil
+	})
+}
@@ -0,0 +1,633 @@
+package fakes
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	rewardscoordinator "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/RewardsCoordinator"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/constants"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/merkle"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/reverts"
+)
+
+const (
+	rcPausedAVSRewardsSubmission    = uint8(constants.RewardsCoordinatorPausedAVSRewardsSubmission)
+	rcPausedRewardsForAllSubmission = uint8(constants.RewardsCoordinatorPausedRewardsForAllSubmission)
+	rcPausedProcessClaim            = uint8(constants.RewardsCoordinatorPausedProcessClaim)
+	rcPausedSubmitDisableRoots      = uint8(constants.RewardsCoordinatorPausedSubmitDisableRoots)
+
+	earnerLeafSalt = constants.RewardsCoordinatorEarnerLeafSalt
+	tokenLeafSalt  = constants.RewardsCoordinatorTokenLeafSalt
+
+	// snapshotCadence is the granularity of rewards snapshots, one day.
+	snapshotCadence = constants.RewardsCoordinatorStorageSnapshotCadence
+)
+
+// maxRewardsAmount is the largest amount a rewards submission may carry.
+var maxRewardsAmount = new(big.Int).Sub(new(big.Int).Exp(big.NewInt(10), big.NewInt(38), nil), common.Big1)
+
+// RewardsCoordinator is a fake of the RewardsCoordinator contract, tracking
+// rewards submissions, distribution roots and claims. Submitted rewards are
+// pulled from the submitter and claims paid out through the chain's token
+// ledger.
+type RewardsCoordinator struct {
+	*rewardscoordinator.RewardsCoordinatorFilterer
+	pausable
+
+	delegation *DelegationManager
+	strategies *StrategyManager
+
+	calculationIntervalSeconds uint32
+	maxRewardsDuration         uint32
+	maxRetroactiveLength       uint32
+	maxFutureLength            uint32
+	genesisRewardsTimestamp    uint32
+
+	rewardsUpdater          common.Address
+	activationDelay         uint32
+	currCalculationEnd      uint32
+	globalCommissionBips    uint16
+	roots                   []rewardscoordinator.IRewardsCoordinatorDistributionRoot
+	claimerFor              map[common.Address]common.Address
+	cumulativeClaimed       map[pair]*big.Int
+	submissionNonce         map[common.Address]*big.Int
+	avsSubmissionHashes     map[saltKey]bool
+	forAllSubmissionHashes  map[saltKey]bool
+	rewardsForAllSubmitters map[common.Address]bool
+}
+
+var (
+	_ rewardscoordinator.RewardsCoordinatorReader = (*RewardsCoordinator)(nil)
+	_ rewardscoordinator.RewardsCoordinatorWriter = (*RewardsCoordinator)(nil)
+	_ rewardscoordinator.RewardsCoordinatorEvents = (*RewardsCoordinator)(nil)
+)
+
+func newRewardsCoordinator(chain *Chain, address common.Address, cfg *Config) *RewardsCoordinator {
+	filterer, err := rewardscoordinator.NewRewardsCoordinatorFilterer(address, chain)
+	if err != nil {
+		panic(err)
+	}
+	c := newContract(chain, address, rewardscoordinator.RewardsCoordinatorMetaData)
+	rc := &RewardsCoordinator{
+		RewardsCoordinatorFilterer: filterer,
+		pausable:                   newPausable(c, cfg.Owner, cfg.PauserRegistry, nil),
+		calculationIntervalSeconds: cfg.CalculationIntervalSeconds,
+		maxRewardsDuration:         cfg.MaxRewardsDuration,
+		maxRetroactiveLength:       cfg.MaxRetroactiveLength,
+		maxFutureLength:            cfg.MaxFutureLength,
+		genesisRewardsTimestamp:    cfg.GenesisRewardsTimestamp,
+		rewardsUpdater:             cfg.RewardsUpdater,
+		activationDelay:            cfg.ActivationDelay,
+		globalCommissionBips:       cfg.GlobalCommissionBips,
+		claimerFor:                 make(map[common.Address]common.Address),
+		cumulativeClaimed:          make(map[pair]*big.Int),
+		submissionNonce:            make(map[common.Address]*big.Int),
+		avsSubmissionHashes:        make(map[saltKey]bool),
+		forAllSubmissionHashes:     make(map[saltKey]bool),
+		rewardsForAllSubmitters:    make(map[common.Address]bool),
+	}
+	chain.Register(address, rc)
+	return rc
+}
+
+// ActivationDelay returns the delay before a submitted root is claimable.
+func (rc *RewardsCoordinator) ActivationDelay(opts *bind.CallOpts) (uint32, error) {
+	rc.chain.mu.Lock()
+	defer rc.chain.mu.Unlock()
+	return rc.activationDelay, nil
+}
+
+// BeaconChainETHStrategy returns the virtual beacon chain ETH strategy.
+func (rc *RewardsCoordinator) BeaconChainETHStrategy(opts *bind.CallOpts) (common.Address, error) {
+	return BeaconChainETHStrategy, nil
+}
+
+// CALCULATIONINTERVALSECONDS returns the interval rewards are calculated
+// over.
+func (rc *RewardsCoordinator) CALCULATIONINTERVALSECONDS(opts *bind.CallOpts) (uint32, error) {
+	return rc.calculationIntervalSeconds, nil
+}
+
+// CalculateEarnerLeafHash returns the earner tree leaf hash of leaf.
+func (rc *RewardsCoordinator) CalculateEarnerLeafHash(opts *bind.CallOpts, leaf rewardscoordinator.IRewardsCoordinatorEarnerTreeMerkleLeaf) ([32]byte, error) {
+	return earnerLeafHash(leaf), nil
+}
+
+// CalculateTokenLeafHash returns the token tree leaf hash of leaf.
+func (rc *RewardsCoordinator) CalculateTokenLeafHash(opts *bind.CallOpts, leaf rewardscoordinator.IRewardsCoordinatorTokenTreeMerkleLeaf) ([32]byte, error) {
+	return tokenLeafHash(leaf), nil
+}
+
+// CheckClaim reverts unless claim proves against an active distribution
+// root.
+func (rc *RewardsCoordinator) CheckClaim(opts *bind.CallOpts, claim rewardscoordinator.IRewardsCoordinatorRewardsMerkleClaim) (bool, error) {
+	rc.chain.mu.Lock()
+	defer rc.chain.mu.Unlock()
+	if int(claim.RootIndex) >= len(rc.roots) {
+		return false, revert(panicOutOfBounds)
+	}
+	if err := rc.checkClaim(claim, rc.roots[claim.RootIndex], rc.chain.time); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// ClaimerFor returns the account allowed to claim on behalf of earner, or
+// zero if only the earner may.
+func (rc *RewardsCoordinator) ClaimerFor(opts *bind.CallOpts, earner common.Address) (common.Address, error) {
+	rc.chain.mu.Lock()
+	defer rc.chain.mu.Unlock()
+	return rc.claimerFor[earner], nil
+}
+
+// CumulativeClaimed returns the total amount of token earner has claimed.
+func (rc *RewardsCoordinator) CumulativeClaimed(opts *bind.CallOpts, earner common.Address, token common.Address) (*big.Int, error) {
+	rc.chain.mu.Lock()
+	defer rc.chain.mu.Unlock()
+	return orZero(rc.cumulativeClaimed[pair{earner, token}]), nil
+}
+
+// CurrRewardsCalculationEndTimestamp returns the calculation end timestamp
+// of the latest root.
+func (rc *RewardsCoordinator) CurrRewardsCalculationEndTimestamp(opts *bind.CallOpts) (uint32, error) {
+	rc.chain.mu.Lock()
+	defer rc.chain.mu.Unlock()
+	return rc.currCalculationEnd, nil
+}
+
+// DelegationManager returns the address of the DelegationManager.
+func (rc *RewardsCoordinator) DelegationManager(opts *bind.CallOpts) (common.Address, error) {
+	return rc.delegation.address, nil
+}
+
+// DomainSeparator returns the EIP-712 domain separator.
+func (rc *RewardsCoordinator) DomainSeparator(opts *bind.CallOpts) ([32]byte, error) {
+	return rc.domainSeparator(), nil
+}
+
+// GENESISREWARDSTIMESTAMP returns the earliest rewards start timestamp.
+func (rc *RewardsCoordinator) GENESISREWARDSTIMESTAMP(opts *bind.CallOpts) (uint32, error) {
+	return rc.genesisRewardsTimestamp, nil
+}
+
+// GetCurrentClaimableDistributionRoot returns the latest enabled, activated
+// root, or the zero root if there is none.
+func (rc *RewardsCoordinator) GetCurrentClaimableDistributionRoot(opts *bind.CallOpts) (rewardscoordinator.IRewardsCoordinatorDistributionRoot, error) {
+	rc.chain.mu.Lock()
+	defer rc.chain.mu.Unlock()
+	for i := len(rc.roots) - 1; i >= 0; i-- {
+		if root := rc.roots[i]; !root.Disabled && rc.chain.time >= uint64(root.ActivatedAt) {
+			return root, nil
+		}
+	}
+	return rewardscoordinator.IRewardsCoordinatorDistributionRoot{}, nil
+}
+
+// GetCurrentDistributionRoot returns the latest root.
+func (rc *RewardsCoordinator) GetCurrentDistributionRoot(opts *bind.CallOpts) (rewardscoordinator.IRewardsCoordinatorDistributionRoot, error) {
+	rc.chain.mu.Lock()
+	defer rc.chain.mu.Unlock()
+	if len(rc.roots) == 0 {
+		return rewardscoordinator.IRewardsCoordinatorDistributionRoot{}, revert(panicUnderflow)
+	}
+	return rc.roots[len(rc.roots)-1], nil
+}
+
+// GetDistributionRootAtIndex returns the root at index.
+func (rc *RewardsCoordinator) GetDistributionRootAtIndex(opts *bind.CallOpts, index *big.Int) (rewardscoordinator.IRewardsCoordinatorDistributionRoot, error) {
+	rc.chain.mu.Lock()
+	defer rc.chain.mu.Unlock()
+	if !index.IsUint64() || index.Uint64() >= uint64(len(rc.roots)) {
+		return rewardscoordinator.IRewardsCoordinatorDistributionRoot{}, revert(panicOutOfBounds)
+	}
+	return rc.roots[index.Uint64()], nil
+}
+
+// GetDistributionRootsLength returns the number of submitted roots.
+func (rc *RewardsCoordinator) GetDistributionRootsLength(opts *bind.CallOpts) (*big.Int, error) {
+	rc.chain.mu.Lock()
+	defer rc.chain.mu.Unlock()
+	return big.NewInt(int64(len(rc.roots))), nil
+}
+
+// GetRootIndexFromHash returns the index of the latest root with the given
+// hash.
+func (rc *RewardsCoordinator) GetRootIndexFromHash(opts *bind.CallOpts, rootHash [32]byte) (uint32, error) {
+	rc.chain.mu.Lock()
+	defer rc.chain.mu.Unlock()
+	for i := len(rc.roots) - 1; i >= 0; i-- {
+		if rc.roots[i].Root == rootHash {
+			return uint32(i), nil
+		}
+	}
+	return 0, revert("RewardsCoordinator.getRootIndexFromHash: root not found")
+}
+
+// GlobalOperatorCommissionBips returns the commission every operator takes.
+func (rc *RewardsCoordinator) GlobalOperatorCommissionBips(opts *bind.CallOpts) (uint16, error) {
+	rc.chain.mu.Lock()
+	defer rc.chain.mu.Unlock()
+	return rc.globalCommissionBips, nil
+}
+
+// IsAVSRewardsSubmissionHash reports whether avs created the submission
+// with the given hash.
+func (rc *RewardsCoordinator) IsAVSRewardsSubmissionHash(opts *bind.CallOpts, avs common.Address, hash [32]byte) (bool, error) {
+	rc.chain.mu.Lock()
+	defer rc.chain.mu.Unlock()
+	return rc.avsSubmissionHashes[saltKey{avs, hash}], nil
+}
+
+// IsRewardsForAllSubmitter reports whether submitter may create rewards for
+// all stakers.
+func (rc *RewardsCoordinator) IsRewardsForAllSubmitter(opts *bind.CallOpts, submitter common.Address) (bool, error) {
+	rc.chain.mu.Lock()
+	defer rc.chain.mu.Unlock()
+	return rc.rewardsForAllSubmitters[submitter], nil
+}
+
+// IsRewardsSubmissionForAllHash reports whether submitter created the
+// rewards for all submission with the given hash.
+func (rc *RewardsCoordinator) IsRewardsSubmissionForAllHash(opts *bind.CallOpts, submitter common.Address, hash [32]byte) (bool, error) {
+	rc.chain.mu.Lock()
+	defer rc.chain.mu.Unlock()
+	return rc.forAllSubmissionHashes[saltKey{submitter, hash}], nil
+}
+
+// MAXFUTURELENGTH returns how far in the future a submission may start.
+func (rc *RewardsCoordinator) MAXFUTURELENGTH(opts *bind.CallOpts) (uint32, error) {
+	return rc.maxFutureLength, nil
+}
+
+// MAXRETROACTIVELENGTH returns how far in the past a submission may start.
+func (rc *RewardsCoordinator) MAXRETROACTIVELENGTH(opts *bind.CallOpts) (uint32, error) {
+	return rc.maxRetroactiveLength, nil
+}
+
+// MAXREWARDSDURATION returns the longest duration of a submission.
+func (rc *RewardsCoordinator) MAXREWARDSDURATION(opts *bind.CallOpts) (uint32, error) {
+	return rc.maxRewardsDuration, nil
+}
+
+// OperatorCommissionBips returns the commission operator takes for avs,
+// which is always the global commission.
+func (rc *RewardsCoordinator) OperatorCommissionBips(opts *bind.CallOpts, operator common.Address, avs common.Address) (uint16, error) {
+	return rc.GlobalOperatorCommissionBips(opts)
+}
+
+// RewardsUpdater returns the account allowed to submit roots.
+func (rc *RewardsCoordinator) RewardsUpdater(opts *bind.CallOpts) (common.Address, error) {
+	rc.chain.mu.Lock()
+	defer rc.chain.mu.Unlock()
+	return rc.rewardsUpdater, nil
+}
+
+// StrategyManager returns the address of the StrategyManager.
+func (rc *RewardsCoordinator) StrategyManager(opts *bind.CallOpts) (common.Address, error) {
+	return rc.strategies.address, nil
+}
+
+// SubmissionNonce returns the nonce of the next submission by submitter.
+func (rc *RewardsCoordinator) SubmissionNonce(opts *bind.CallOpts, submitter common.Address) (*big.Int, error) {
+	rc.chain.mu.Lock()
+	defer rc.chain.mu.Unlock()
+	return orZero(rc.submissionNonce[submitter]), nil
+}
+
+// CreateAVSRewardsSubmission creates rewards for the stakers and operators
+// of the sending AVS, pulling the rewarded tokens from it.
+func (rc *RewardsCoordinator) CreateAVSRewardsSubmission(opts *bind.TransactOpts, rewardsSubmissions []rewardscoordinator.IRewardsCoordinatorRewardsSubmission) (*types.Transaction, error) {
+	return rc.chain.transact(opts, &rc.contract, "createAVSRewardsSubmission", []any{rewardsSubmissions}, func(tx *txContext) error {
+		if err := rc.onlyWhenNotPaused(rcPausedAVSRewardsSubmission); err != nil {
+			return err
+		}
+		return rc.createSubmissions(tx, rewardsSubmissions, rc.avsSubmissionHashes, "AVSRewardsSubmissionCreated")
+	})
+}
+
+// CreateRewardsForAllSubmission creates rewards for all stakers. It can
+// only be called by a rewards for all submitter.
+func (rc *RewardsCoordinator) CreateRewardsForAllSubmission(opts *bind.TransactOpts, rewardsSubmissions []rewardscoordinator.IRewardsCoordinatorRewardsSubmission) (*types.Transaction, error) {
+	return rc.chain.transact(opts, &rc.contract, "createRewardsForAllSubmission", []any{rewardsSubmissions}, func(tx *txContext) error {
+		if err := rc.onlyWhenNotPaused(rcPausedRewardsForAllSubmission); err != nil {
+			return err
+		}
+		if !rc.rewardsForAllSubmitters[tx.sender] {
+			return revert("RewardsCoordinator: caller is not a valid createRewardsForAllSubmission submitter")
+		}
+		return rc.createSubmissions(tx, rewardsSubmissions, rc.forAllSubmissionHashes, "RewardsSubmissionForAllCreated")
+	})
+}
+
+// DisableRoot disables a root that has not activated yet. It can only be
+// called by the rewards updater.
+func (rc *RewardsCoordinator) DisableRoot(opts *bind.TransactOpts, rootIndex uint32) (*types.Transaction, error) {
+	return rc.chain.transact(opts, &rc.contract, "disableRoot", []any{rootIndex}, func(tx *txContext) error {
+		if err := rc.onlyWhenNotPaused(rcPausedSubmitDisableRoots); err != nil {
+			return err
+		}
+		if err := rc.onlyRewardsUpdater(tx); err != nil {
+			return err
+		}
+		if int(rootIndex) >= len(rc.roots) {
+			return revert("RewardsCoordinator.disableRoot: invalid rootIndex")
+		}
+		root := rc.roots[rootIndex]
+		if root.Disabled {
+			return revert("RewardsCoordinator.disableRoot: root already disabled")
+		}
+		if tx.time >= uint64(root.ActivatedAt) {
+			return revert("RewardsCoordinator.disableRoot: root already activated")
+		}
+		root.Disabled = true
+		assign(tx, &rc.roots, append(append(rc.roots[:0:0], rc.roots[:rootIndex]...), append([]rewardscoordinator.IRewardsCoordinatorDistributionRoot{root}, rc.roots[rootIndex+1:]...)...))
+		rc.emit(tx, "DistributionRootDisabled", rootIndex)
+		return nil
+	})
+}
+
+// Initialize always reverts, since the fake is deployed initialized.
+func (rc *RewardsCoordinator) Initialize(opts *bind.TransactOpts, initialOwner common.Address, _pauserRegistry common.Address, initialPausedStatus *big.Int, _rewardsUpdater common.Address, _activationDelay uint32, _globalCommissionBips uint16) (*types.Transaction, error) {
+	return rc.alreadyInitialized(opts, initialOwner, _pauserRegistry, initialPausedStatus, _rewardsUpdater, _activationDelay, _globalCommissionBips)
+}
+
+// ProcessClaim pays out the earnings proven by claim to recipient. It must
+// be sent by the earner or its claimer.
+func (rc *RewardsCoordinator) ProcessClaim(opts *bind.TransactOpts, claim rewardscoordinator.IRewardsCoordinatorRewardsMerkleClaim, recipient common.Address) (*types.Transaction, error) {
+	return rc.chain.transact(opts, &rc.contract, "processClaim", []any{claim, recipient}, func(tx *txContext) error {
+		if err := rc.onlyWhenNotPaused(rcPausedProcessClaim); err != nil {
+			return err
+		}
+		if int(claim.RootIndex) >= len(rc.roots) {
+			return revert(panicOutOfBounds)
+		}
+		root := rc.roots[claim.RootIndex]
+		if err := rc.checkClaim(claim, root, tx.time); err != nil {
+			return err
+		}
+		earner := claim.EarnerLeaf.Earner
+		claimer := rc.claimerFor[earner]
+		if claimer == (common.Address{}) {
+			claimer = earner
+		}
+		if tx.sender != claimer {
+			return revert("RewardsCoordinator.processClaim: caller is not valid claimer")
+		}
+		for _, leaf := range claim.TokenLeaves[:len(claim.TokenIndices)] {
+			claimed := orZero(rc.cumulativeClaimed[pair{earner, leaf.Token}])
+			if leaf.CumulativeEarnings.Cmp(claimed) <= 0 {
+				return revert("RewardsCoordinator.processClaim: cumulativeEarnings must be gt than cumulativeClaimed")
+			}
+			amount := new(big.Int).Sub(leaf.CumulativeEarnings, claimed)
+			set(tx, rc.cumulativeClaimed, pair{earner, leaf.Token}, new(big.Int).Set(leaf.CumulativeEarnings))
+			if err := tx.transfer(leaf.Token, rc.address, recipient, amount); err != nil {
+				return err
+			}
+			rc.emit(tx, "RewardsClaimed", root.Root, earner, claimer, recipient, leaf.Token, amount)
+		}
+		return nil
+	})
+}
+
+// SetActivationDelay sets the delay before submitted roots activate. It can
+// only be called by the owner.
+func (rc *RewardsCoordinator) SetActivationDelay(opts *bind.TransactOpts, _activationDelay uint32) (*types.Transaction, error) {
+	return rc.chain.transact(opts, &rc.contract, "setActivationDelay", []any{_activationDelay}, func(tx *txContext) error {
+		if err := rc.onlyOwner(tx); err != nil {
+			return err
+		}
+		rc.emit(tx, "ActivationDelaySet", rc.activationDelay, _activationDelay)
+		assign(tx, &rc.activationDelay, _activationDelay)
+		return nil
+	})
+}
+
+// SetClaimerFor lets claimer process claims on behalf of the sender.
+func (rc *RewardsCoordinator) SetClaimerFor(opts *bind.TransactOpts, claimer common.Address) (*types.Transaction, error) {
+	return rc.chain.transact(opts, &rc.contract, "setClaimerFor", []any{claimer}, func(tx *txContext) error {
+		prev := rc.claimerFor[tx.sender]
+		set(tx, rc.claimerFor, tx.sender, claimer)
+		rc.emit(tx, "ClaimerForSet", tx.sender, prev, claimer)
+		return nil
+	})
+}
+
+// SetGlobalOperatorCommission sets the commission every operator takes. It
+// can only be called by the owner.
+func (rc *RewardsCoordinator) SetGlobalOperatorCommission(opts *bind.TransactOpts, _globalCommissionBips uint16) (*types.Transaction, error) {
+	return rc.chain.transact(opts, &rc.contract, "setGlobalOperatorCommission", []any{_globalCommissionBips}, func(tx *txContext) error {
+		if err := rc.onlyOwner(tx); err != nil {
+			return err
+		}
+		rc.emit(tx, "GlobalCommissionBipsSet", rc.globalCommissionBips, _globalCommissionBips)
+		assign(tx, &rc.globalCommissionBips, _globalCommissionBips)
+		return nil
+	})
+}
+
+// SetRewardsForAllSubmitter grants or revokes the right to create rewards
+// for all stakers. It can only be called by the owner.
+func (rc *RewardsCoordinator) SetRewardsForAllSubmitter(opts *bind.TransactOpts, _submitter common.Address, _newValue bool) (*types.Transaction, error) {
+	return rc.chain.transact(opts, &rc.contract, "setRewardsForAllSubmitter", []any{_submitter, _newValue}, func(tx *txContext) error {
+		if err := rc.onlyOwner(tx); err != nil {
+			return err
+		}
+		rc.emit(tx, "RewardsForAllSubmitterSet", _submitter, rc.rewardsForAllSubmitters[_submitter], _newValue)
+		set(tx, rc.rewardsForAllSubmitters, _submitter, _newValue)
+		return nil
+	})
+}
+
+// SetRewardsUpdater replaces the rewards updater. It can only be called by
+// the owner.
+func (rc *RewardsCoordinator) SetRewardsUpdater(opts *bind.TransactOpts, _rewardsUpdater common.Address) (*types.Transaction, error) {
+	return rc.chain.transact(opts, &rc.contract, "setRewardsUpdater", []any{_rewardsUpdater}, func(tx *txContext) error {
+		if err := rc.onlyOwner(tx); err != nil {
+			return err
+		}
+		rc.emit(tx, "RewardsUpdaterSet", rc.rewardsUpdater, _rewardsUpdater)
+		assign(tx, &rc.rewardsUpdater, _rewardsUpdater)
+		return nil
+	})
+}
+
+// SubmitRoot submits a distribution root that becomes claimable after the
+// activation delay. It can only be called by the rewards updater.
+func (rc *RewardsCoordinator) SubmitRoot(opts *bind.TransactOpts, root [32]byte, rewardsCalculationEndTimestamp uint32) (*types.Transaction, error) {
+	return rc.chain.transact(opts, &rc.contract, "submitRoot", []any{root, rewardsCalculationEndTimestamp}, func(tx *txContext) error {
+		if err := rc.onlyWhenNotPaused(rcPausedSubmitDisableRoots); err != nil {
+			return err
+		}
+		if err := rc.onlyRewardsUpdater(tx); err != nil {
+			return err
+		}
+		if rewardsCalculationEndTimestamp <= rc.currCalculationEnd {
+			return revert("RewardsCoordinator.submitRoot: new root must be for newer calculated period")
+		}
+		if uint64(rewardsCalculationEndTimestamp) >= tx.time {
+			return revert("RewardsCoordinator.submitRoot: rewardsCalculationEndTimestamp cannot be in the future")
+		}
+		index := uint32(len(rc.roots))
+		activatedAt := uint32(tx.time) + rc.activationDelay
+		assign(tx, &rc.roots, append(rc.roots[:len(rc.roots):len(rc.roots)], rewardscoordinator.IRewardsCoordinatorDistributionRoot{
+			Root:                           root,
+			RewardsCalculationEndTimestamp: rewardsCalculationEndTimestamp,
+			ActivatedAt:                    activatedAt,
+		}))
+		assign(tx, &rc.currCalculationEnd, rewardsCalculationEndTimestamp)
+		rc.emit(tx, "DistributionRootSubmitted", index, root, rewardsCalculationEndTimestamp, activatedAt)
+		return nil
+	})
+}
+
+func (rc *RewardsCoordinator) onlyRewardsUpdater(tx *txContext) error {
+	if tx.sender != rc.rewardsUpdater {
+		return revert("RewardsCoordinator: caller is not the rewardsUpdater")
+	}
+	return nil
+}
+
+func (rc *RewardsCoordinator) createSubmissions(tx *txContext, submissions []rewardscoordinator.IRewardsCoordinatorRewardsSubmission, hashes map[saltKey]bool, event string) error {
+	for _, submission := range submissions {
+		nonce := orZero(rc.submissionNonce[tx.sender])
+		hash, err := rc.submissionHash(tx.sender, nonce, submission)
+		if err != nil {
+			return err
+		}
+		if err := rc.validateSubmission(tx, submission); err != nil {
+			return err
+		}
+		set(tx, hashes, saltKey{tx.sender, hash}, true)
+		set(tx, rc.submissionNonce, tx.sender, new(big.Int).Add(nonce, common.Big1))
+		rc.emit(tx, event, tx.sender, nonce, hash, submission)
+		if err := tx.transfer(submission.Token, tx.sender, rc.address, submission.Amount); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// submissionHash returns keccak256(abi.encode(submitter, nonce, submission)).
+func (rc *RewardsCoordinator) submissionHash(submitter common.Address, nonce *big.Int, submission rewardscoordinator.IRewardsCoordinatorRewardsSubmission) (common.Hash, error) {
+	submissionType := rc.abi.Methods["createAVSRewardsSubmission"].Inputs[0].Type.Elem
+	addressType, _ := abi.NewType("address", "", nil)
+	uintType, _ := abi.NewType("uint256", "", nil)
+	encoded, err := abi.Arguments{{Type: addressType}, {Type: uintType}, {Type: *submissionType}}.Pack(submitter, nonce, submission)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return crypto.Keccak256Hash(encoded), nil
+}
+
+func (rc *RewardsCoordinator) validateSubmission(tx *txContext, submission rewardscoordinator.IRewardsCoordinatorRewardsSubmission) error {
+	if len(submission.StrategiesAndMultipliers) == 0 {
+		return revert("RewardsCoordinator._validateRewardsSubmission: no strategies set")
+	}
+	if submission.Amount.Sign() == 0 {
+		return revert("RewardsCoordinator._validateRewardsSubmission: amount cannot be 0")
+	}
+	if submission.Amount.Cmp(maxRewardsAmount) > 0 {
+		return revert("RewardsCoordinator._validateRewardsSubmission: amount too large")
+	}
+	if submission.Duration > rc.maxRewardsDuration {
+		return revert("RewardsCoordinator._validateRewardsSubmission: duration exceeds MAX_REWARDS_DURATION")
+	}
+	if submission.Duration%rc.calculationIntervalSeconds != 0 {
+		return revert("RewardsCoordinator._validateRewardsSubmission: duration must be a multiple of CALCULATION_INTERVAL_SECONDS")
+	}
+	if submission.StartTimestamp%rc.calculationIntervalSeconds != 0 {
+		return revert("RewardsCoordinator._validateRewardsSubmission: startTimestamp must be a multiple of CALCULATION_INTERVAL_SECONDS")
+	}
+	if tx.time < uint64(rc.maxRetroactiveLength) {
+		return revert(panicUnderflow)
+	}
+	start := uint64(submission.StartTimestamp)
+	if tx.time-uint64(rc.maxRetroactiveLength) > start || start < uint64(rc.genesisRewardsTimestamp) {
+		return revert("RewardsCoordinator._validateRewardsSubmission: startTimestamp too far in the past")
+	}
+	if start > tx.time+uint64(rc.maxFutureLength) {
+		return revert("RewardsCoordinator._validateRewardsSubmission: startTimestamp too far in the future")
+	}
+	var prev common.Address
+	for _, sm := range submission.StrategiesAndMultipliers {
+		if !rc.strategies.whitelisted[sm.Strategy] && sm.Strategy != BeaconChainETHStrategy {
+			return revert("RewardsCoordinator._validateRewardsSubmission: invalid strategy considered")
+		}
+		if prev.Cmp(sm.Strategy) >= 0 {
+			return revert("RewardsCoordinator._validateRewardsSubmission: strategies must be in ascending order to handle duplicates")
+		}
+		prev = sm.Strategy
+	}
+	return nil
+}
+
+func (rc *RewardsCoordinator) checkClaim(claim rewardscoordinator.IRewardsCoordinatorRewardsMerkleClaim, root rewardscoordinator.IRewardsCoordinatorDistributionRoot, now uint64) error {
+	if root.Disabled {
+		return revert("RewardsCoordinator._checkClaim: root is disabled")
+	}
+	if now < uint64(root.ActivatedAt) {
+		return revert("RewardsCoordinator._checkClaim: root not activated yet")
+	}
+	if len(claim.TokenIndices) != len(claim.TokenTreeProofs) {
+		return revert("RewardsCoordinator._checkClaim: tokenIndices and tokenProofs length mismatch")
+	}
+	if len(claim.TokenTreeProofs) != len(claim.TokenLeaves) {
+		return revert("RewardsCoordinator._checkClaim: tokenTreeProofs and leaves length mismatch")
+	}
+	if !indexFits(claim.EarnerIndex, claim.EarnerTreeProof) {
+		return revert("RewardsCoordinator._verifyEarnerClaimProof: invalid earnerLeafIndex")
+	}
+	ok, err := verifyInclusionKeccak(claim.EarnerTreeProof, root.Root, earnerLeafHash(claim.EarnerLeaf), uint64(claim.EarnerIndex))
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return revert("RewardsCoordinator._verifyEarnerClaimProof: invalid earner claim proof")
+	}
+	for i, index := range claim.TokenIndices {
+		proof := claim.TokenTreeProofs[i]
+		if !indexFits(index, proof) {
+			return revert("RewardsCoordinator._verifyTokenClaim: invalid tokenLeafIndex")
+		}
+		ok, err := verifyInclusionKeccak(proof, claim.EarnerLeaf.EarnerTokenRoot, tokenLeafHash(claim.TokenLeaves[i]), uint64(index))
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return revert("RewardsCoordinator._verifyTokenClaim: invalid token claim proof")
+		}
+	}
+	return nil
+}
+
+// indexFits reports whether index addresses a leaf of a tree as deep as
+// proof is long.
+func indexFits(index uint32, proof []byte) bool {
+	depth := len(proof) / 32
+	return depth >= 32 || uint64(index) < 1<<depth
+}
+
+func earnerLeafHash(leaf rewardscoordinator.IRewardsCoordinatorEarnerTreeMerkleLeaf) common.Hash {
+	return crypto.Keccak256Hash([]byte{earnerLeafSalt}, leaf.Earner[:], leaf.EarnerTokenRoot[:])
+}
+
+func tokenLeafHash(leaf rewardscoordinator.IRewardsCoordinatorTokenTreeMerkleLeaf) common.Hash {
+	return crypto.Keccak256Hash([]byte{tokenLeafSalt}, leaf.Token[:], words(orZero(leaf.CumulativeEarnings)))
+}
+
+// verifyInclusionKeccak is merkle.VerifyInclusionKeccak, reverting as the
+// fakes do.
+func verifyInclusionKeccak(proof []byte, root, leaf common.Hash, index uint64) (bool, error) {
+	ok, err := merkle.VerifyInclusionKeccak(proof, root, leaf, index)
+	var rerr *reverts.Error
+	if errors.As(err, &rerr) {
+		return false, revert(rerr.Reason)
+	}
+	return ok, err
+}
@@ -0,0 +1,399 @@
+package fakes_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	rewardscoordinator "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/RewardsCoordinator"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/constants"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/fakes"
+)
+
+// activationDelay is the activation delay of fakes.DefaultConfig.
+const activationDelay = 604800
+
+var (
+	recipient  = common.HexToAddress("0x8000")
+	otherToken = common.HexToAddress("0x9000")
+	avs        = common.HexToAddress("0xa000")
+)
+
+func tokenLeaf(token common.Address, earnings int64) []byte {
+	return crypto.Keccak256([]byte{constants.RewardsCoordinatorTokenLeafSalt}, token[:], common.BigToHash(big.NewInt(earnings)).Bytes())
+}
+
+func earnerLeaf(earner common.Address, tokenRoot []byte) []byte {
+	return crypto.Keccak256([]byte{constants.RewardsCoordinatorEarnerLeafSalt}, earner[:], tokenRoot)
+}
+
+// distribution returns the root of a distribution in which staker has
+// earned earnings of token and stranger 1, and both have earned 1 of
+// otherToken, with staker's claim of its token earnings against the root at
+// rootIndex.
+func distribution(rootIndex uint32, earnings int64) ([32]byte, rewardscoordinator.IRewardsCoordinatorRewardsMerkleClaim) {
+	otherLeaf := tokenLeaf(otherToken, 1)
+	stakerTokenRoot := crypto.Keccak256(tokenLeaf(token, earnings), otherLeaf)
+	strangerLeaf := earnerLeaf(stranger, crypto.Keccak256(tokenLeaf(token, 1), otherLeaf))
+	root := crypto.Keccak256Hash(earnerLeaf(staker, stakerTokenRoot), strangerLeaf)
+	return root, rewardscoordinator.IRewardsCoordinatorRewardsMerkleClaim{
+		RootIndex:       rootIndex,
+		EarnerIndex:     0,
+		EarnerTreeProof: strangerLeaf,
+		EarnerLeaf:      rewardscoordinator.IRewardsCoordinatorEarnerTreeMerkleLeaf{Earner: staker, EarnerTokenRoot: common.BytesToHash(stakerTokenRoot)},
+		TokenIndices:    []uint32{0},
+		TokenTreeProofs: [][]byte{otherLeaf},
+		TokenLeaves:     []rewardscoordinator.IRewardsCoordinatorTokenTreeMerkleLeaf{{Token: token, CumulativeEarnings: big.NewInt(earnings)}},
+	}
+}
+
+// submit submits the root of the distribution paying staker earnings, for
+// the period that ended a second ago.
+func (e *env) submit(earnings int64) error {
+	root, _ := distribution(0, earnings)
+	_, err := e.RewardsCoordinator.SubmitRoot(from(owner), root, uint32(e.Chain.Time()-1))
+	return err
+}
+
+func (e *env) activate() error {
+	e.Chain.AdvanceTime(activationDelay)
+	return nil
+}
+
+// claim claims staker's earnings against the root at rootIndex as sender.
+func (e *env) claim(sender common.Address, rootIndex uint32, earnings int64) error {
+	_, claim := distribution(rootIndex, earnings)
+	_, err := e.RewardsCoordinator.ProcessClaim(from(sender), claim, recipient)
+	return err
+}
+
+// rewardsState is what the deployment records of the distribution roots,
+// staker's claims and the tokens paid out.
+type rewardsState struct {
+	roots     int64
+	claimed   int64
+	recipient int64
+	held      int64
+	avs       int64
+}
+
+func (e *env) rewardsState() rewardsState {
+	e.t.Helper()
+	call := &bind.CallOpts{}
+	roots, err := e.RewardsCoordinator.GetDistributionRootsLength(call)
+	if err != nil {
+		e.t.Fatal(err)
+	}
+	claimed, err := e.RewardsCoordinator.CumulativeClaimed(call, staker, token)
+	if err != nil {
+		e.t.Fatal(err)
+	}
+	return rewardsState{
+		roots:     roots.Int64(),
+		claimed:   claimed.Int64(),
+		recipient: e.Chain.BalanceOf(token, recipient).Int64(),
+		held:      e.Chain.BalanceOf(token, e.RewardsCoordinator.Address()).Int64(),
+		avs:       e.Chain.BalanceOf(token, avs).Int64(),
+	}
+}
+
+func TestRewardsCoordinator(t *testing.T) {
+	tests := []struct {
+		name string
+		// steps run in order, and all but the last must succeed.
+		steps []func(e *env) error
+		// err is the revert reason of the last step, if it reverts.
+		err  string
+		want rewardsState
+	}{
+		{
+			name: "claim against a submitted root",
+			steps: []func(*env) error{
+				func(e *env) error { return e.submit(60) },
+				(*env).activate,
+				func(e *env) error { return e.claim(staker, 0, 60) },
+			},
+			want: rewardsState{roots: 1, claimed: 60, recipient: 60, held: 40, avs: 100},
+		},
+		{
+			name: "claim in the block before the root activates",
+			steps: []func(*env) error{
+				func(e *env) error { return e.submit(60) },
+				func(e *env) error { e.Chain.AdvanceTime(activationDelay - fakes.DefaultBlockTime - 1); return nil },
+				func(e *env) error { return e.claim(staker, 0, 60) },
+			},
+			err:  "RewardsCoordinator._checkClaim: root not activated yet",
+			want: rewardsState{roots: 1, held: 100, avs: 100},
+		},
+		{
+			name: "claim against a disabled root",
+			steps: []func(*env) error{
+				func(e *env) error { return e.submit(60) },
+				func(e *env) error {
+					_, err := e.RewardsCoordinator.DisableRoot(from(owner), 0)
+					return err
+				},
+				(*env).activate,
+				func(e *env) error { return e.claim(staker, 0, 60) },
+			},
+			err:  "RewardsCoordinator._checkClaim: root is disabled",
+			want: rewardsState{roots: 1, held: 100, avs: 100},
+		},
+		{
+			name: "claim as another account",
+			steps: []func(*env) error{
+				func(e *env) error { return e.submit(60) },
+				(*env).activate,
+				func(e *env) error { return e.claim(stranger, 0, 60) },
+			},
+			err:  "RewardsCoordinator.processClaim: caller is not valid claimer",
+			want: rewardsState{roots: 1, held: 100, avs: 100},
+		},
+		{
+			name: "claim as the earner's claimer",
+			steps: []func(*env) error{
+				func(e *env) error {
+					_, err := e.RewardsCoordinator.SetClaimerFor(from(staker), stranger)
+					return err
+				},
+				func(e *env) error { return e.submit(60) },
+				(*env).activate,
+				func(e *env) error { return e.claim(stranger, 0, 60) },
+			},
+			want: rewardsState{roots: 1, claimed: 60, recipient: 60, held: 40, avs: 100},
+		},
+		{
+			name: "claim the leaf of another earner",
+			steps: []func(*env) error{
+				func(e *env) error { return e.submit(60) },
+				(*env).activate,
+				func(e *env) error {
+					_, claim := distribution(0, 60)
+					claim.EarnerLeaf.Earner = stranger
+					_, err := e.RewardsCoordinator.ProcessClaim(from(stranger), claim, recipient)
+					return err
+				},
+			},
+			err:  "RewardsCoordinator._verifyEarnerClaimProof: invalid earner claim proof",
+			want: rewardsState{roots: 1, held: 100, avs: 100},
+		},
+		{
+			name: "claim more earnings than the root holds",
+			steps: []func(*env) error{
+				func(e *env) error { return e.submit(60) },
+				(*env).activate,
+				func(e *env) error { return e.claim(staker, 0, 70) },
+			},
+			err:  "RewardsCoordinator._verifyEarnerClaimProof: invalid earner claim proof",
+			want: rewardsState{roots: 1, held: 100, avs: 100},
+		},
+		{
+			name: "claim twice",
+			steps: []func(*env) error{
+				func(e *env) error { return e.submit(60) },
+				(*env).activate,
+				func(e *env) error { return e.claim(staker, 0, 60) },
+				func(e *env) error { return e.claim(staker, 0, 60) },
+			},
+			err:  "RewardsCoordinator.processClaim: cumulativeEarnings must be gt than cumulativeClaimed",
+			want: rewardsState{roots: 1, claimed: 60, recipient: 60, held: 40, avs: 100},
+		},
+		{
+			name: "claim increased earnings against a later root",
+			steps: []func(*env) error{
+				func(e *env) error { return e.submit(60) },
+				(*env).activate,
+				func(e *env) error { return e.claim(staker, 0, 60) },
+				func(e *env) error { return e.submit(90) },
+				(*env).activate,
+				func(e *env) error { return e.claim(staker, 1, 90) },
+			},
+			want: rewardsState{roots: 2, claimed: 90, recipient: 90, held: 10, avs: 100},
+		},
+		{
+			name: "claim decreased earnings against a later root",
+			steps: []func(*env) error{
+				func(e *env) error { return e.submit(60) },
+				(*env).activate,
+				func(e *env) error { return e.claim(staker, 0, 60) },
+				func(e *env) error { return e.submit(50) },
+				(*env).activate,
+				func(e *env) error { return e.claim(staker, 1, 50) },
+			},
+			err:  "RewardsCoordinator.processClaim: cumulativeEarnings must be gt than cumulativeClaimed",
+			want: rewardsState{roots: 2, claimed: 60, recipient: 60, held: 40, avs: 100},
+		},
+		{
+			name: "submit as another account",
+			steps: []func(*env) error{func(e *env) error {
+				root, _ := distribution(0, 60)
+				_, err := e.RewardsCoordinator.SubmitRoot(from(stranger), root, uint32(e.Chain.Time()-1))
+				return err
+			}},
+			err:  "RewardsCoordinator: caller is not the rewardsUpdater",
+			want: rewardsState{held: 100, avs: 100},
+		},
+		{
+			name: "submit twice for a period",
+			steps: []func(*env) error{
+				func(e *env) error { return e.submit(60) },
+				func(e *env) error {
+					root, _ := distribution(1, 90)
+					end, err := e.RewardsCoordinator.CurrRewardsCalculationEndTimestamp(&bind.CallOpts{})
+					if err != nil {
+						return err
+					}
+					_, err = e.RewardsCoordinator.SubmitRoot(from(owner), root, end)
+					return err
+				},
+			},
+			err:  "RewardsCoordinator.submitRoot: new root must be for newer calculated period",
+			want: rewardsState{roots: 1, held: 100, avs: 100},
+		},
+		{
+			name: "submit for a period ending in the submitting block",
+			steps: []func(*env) error{func(e *env) error {
+				root, _ := distribution(0, 60)
+				_, err := e.RewardsCoordinator.SubmitRoot(from(owner), root, uint32(e.Chain.Time()+fakes.DefaultBlockTime))
+				return err
+			}},
+			err:  "RewardsCoordinator.submitRoot: rewardsCalculationEndTimestamp cannot be in the future",
+			want: rewardsState{held: 100, avs: 100},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := newEnv(t)
+			e.Chain.Mint(token, e.RewardsCoordinator.Address(), big.NewInt(100))
+			e.Chain.Mint(token, avs, big.NewInt(100))
+			last := len(tt.steps) - 1
+			for _, step := range tt.steps[:last] {
+				if err := step(e); err != nil {
+					t.Fatal(err)
+				}
+			}
+			err := tt.steps[last](e)
+			var revert *fakes.RevertError
+			switch {
+			case tt.err == "" && err != nil:
+				t.Fatal(err)
+			case tt.err != "" && (!errors.As(err, &revert) || revert.Reason != tt.err):
+				t.Fatalf("err = %v, want a revert with %q", err, tt.err)
+			}
+			if got := e.rewardsState(); got != tt.want {
+				t.Errorf("state %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRewardsCoordinatorCreateAVSRewardsSubmission(t *testing.T) {
+	cfg := fakes.DefaultConfig(owner)
+	interval := cfg.CalculationIntervalSeconds
+	tests := []struct {
+		name string
+		// edit changes a valid submission of 60 tokens.
+		edit func(s *rewardscoordinator.IRewardsCoordinatorRewardsSubmission)
+		err  string
+	}{
+		{
+			name: "valid",
+			edit: func(s *rewardscoordinator.IRewardsCoordinatorRewardsSubmission) {},
+		},
+		{
+			name: "no strategies",
+			edit: func(s *rewardscoordinator.IRewardsCoordinatorRewardsSubmission) { s.StrategiesAndMultipliers = nil },
+			err:  "RewardsCoordinator._validateRewardsSubmission: no strategies set",
+		},
+		{
+			name: "zero amount",
+			edit: func(s *rewardscoordinator.IRewardsCoordinatorRewardsSubmission) { s.Amount = new(big.Int) },
+			err:  "RewardsCoordinator._validateRewardsSubmission: amount cannot be 0",
+		},
+		{
+			name: "duration longer than the maximum",
+			edit: func(s *rewardscoordinator.IRewardsCoordinatorRewardsSubmission) {
+				s.Duration = cfg.MaxRewardsDuration + interval
+			},
+			err: "RewardsCoordinator._validateRewardsSubmission: duration exceeds MAX_REWARDS_DURATION",
+		},
+		{
+			name: "duration not a multiple of the interval",
+			edit: func(s *rewardscoordinator.IRewardsCoordinatorRewardsSubmission) { s.Duration = interval + 1 },
+			err:  "RewardsCoordinator._validateRewardsSubmission: duration must be a multiple of CALCULATION_INTERVAL_SECONDS",
+		},
+		{
+			name: "start not a multiple of the interval",
+			edit: func(s *rewardscoordinator.IRewardsCoordinatorRewardsSubmission) { s.StartTimestamp++ },
+			err:  "RewardsCoordinator._validateRewardsSubmission: startTimestamp must be a multiple of CALCULATION_INTERVAL_SECONDS",
+		},
+		{
+			name: "start before genesis",
+			edit: func(s *rewardscoordinator.IRewardsCoordinatorRewardsSubmission) { s.StartTimestamp -= interval },
+			err:  "RewardsCoordinator._validateRewardsSubmission: startTimestamp too far in the past",
+		},
+		{
+			name: "start too far in the future",
+			edit: func(s *rewardscoordinator.IRewardsCoordinatorRewardsSubmission) { s.StartTimestamp += 5 * interval },
+			err:  "RewardsCoordinator._validateRewardsSubmission: startTimestamp too far in the future",
+		},
+		{
+			name: "strategy not whitelisted",
+			edit: func(s *rewardscoordinator.IRewardsCoordinatorRewardsSubmission) {
+				s.StrategiesAndMultipliers[0].Strategy = stranger
+			},
+			err: "RewardsCoordinator._validateRewardsSubmission: invalid strategy considered",
+		},
+		{
+			name: "strategies in descending order",
+			edit: func(s *rewardscoordinator.IRewardsCoordinatorRewardsSubmission) {
+				sm := s.StrategiesAndMultipliers
+				sm[0], sm[1] = sm[1], sm[0]
+			},
+			err: "RewardsCoordinator._validateRewardsSubmission: strategies must be in ascending order to handle duplicates",
+		},
+		{
+			name: "duplicate strategies",
+			edit: func(s *rewardscoordinator.IRewardsCoordinatorRewardsSubmission) {
+				s.StrategiesAndMultipliers[1] = s.StrategiesAndMultipliers[0]
+			},
+			err: "RewardsCoordinator._validateRewardsSubmission: strategies must be in ascending order to handle duplicates",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := newEnv(t)
+			e.Chain.Mint(token, avs, big.NewInt(100))
+			s := rewardscoordinator.IRewardsCoordinatorRewardsSubmission{
+				StrategiesAndMultipliers: []rewardscoordinator.IRewardsCoordinatorStrategyAndMultiplier{
+					{Strategy: strategy, Multiplier: big.NewInt(1e18)},
+					{Strategy: fakes.BeaconChainETHStrategy, Multiplier: big.NewInt(2e18)},
+				},
+				Token:          token,
+				Amount:         big.NewInt(60),
+				StartTimestamp: cfg.GenesisRewardsTimestamp,
+				Duration:       interval,
+			}
+			tt.edit(&s)
+			_, err := e.RewardsCoordinator.CreateAVSRewardsSubmission(from(avs), []rewardscoordinator.IRewardsCoordinatorRewardsSubmission{s})
+			var revert *fakes.RevertError
+			switch {
+			case tt.err == "" && err != nil:
+				t.Fatal(err)
+			case tt.err != "" && (!errors.As(err, &revert) || revert.Reason != tt.err):
+				t.Fatalf("err = %v, want a revert with %q", err, tt.err)
+			}
+			want := rewardsState{held: 60, avs: 40}
+			if tt.err != "" {
+				want = rewardsState{avs: 100}
+			}
+			if got := e.rewardsState(); got != want {
+				t.Errorf("state %+v, want %+v", got, want)
+			}
+		})
+	}
+}
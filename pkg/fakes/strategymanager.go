@@ -0,0 +1,395 @@
+package fakes
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	strategymanager "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/StrategyManager"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/constants"
+)
+
+const (
+	// maxStakerStrategyListLength bounds the number of strategies a staker
+	// can hold shares in.
+	maxStakerStrategyListLength = constants.StrategyManagerStorageMaxStakerStrategyListLength
+
+	smPausedDeposits = uint8(constants.StrategyManagerPausedDeposits)
+)
+
+var depositTypehash = crypto.Keccak256Hash([]byte("Deposit(address staker,address strategy,address token,uint256 amount,uint256 nonce,uint256 expiry)"))
+
+// StrategyManager is a fake of the StrategyManager contract. Strategies are
+// modeled as vaults minting one share per token deposited, and the tokens
+// deposited are held by the strategy address in the chain's token ledger.
+type StrategyManager struct {
+	*strategymanager.StrategyManagerFilterer
+	pausable
+
+	delegation *DelegationManager
+	eigenPods  *EigenPodManager
+	slasher    common.Address
+
+	whitelister       common.Address
+	whitelisted       map[common.Address]bool
+	thirdPartyBlocked map[common.Address]bool
+	nonces            map[common.Address]*big.Int
+	shares            map[pair]*big.Int
+	strategyList      map[common.Address][]common.Address
+}
+
+var (
+	_ strategymanager.StrategyManagerReader = (*StrategyManager)(nil)
+	_ strategymanager.StrategyManagerWriter = (*StrategyManager)(nil)
+	_ strategymanager.StrategyManagerEvents = (*StrategyManager)(nil)
+)
+
+func newStrategyManager(chain *Chain, address common.Address, cfg *Config) *StrategyManager {
+	filterer, err := strategymanager.NewStrategyManagerFilterer(address, chain)
+	if err != nil {
+		panic(err)
+	}
+	c := newContract(chain, address, strategymanager.StrategyManagerMetaData)
+	sm := &StrategyManager{
+		StrategyManagerFilterer: filterer,
+		pausable:                newPausable(c, cfg.Owner, cfg.PauserRegistry, nil),
+		slasher:                 cfg.Slasher,
+		whitelister:             cfg.StrategyWhitelister,
+		whitelisted:             make(map[common.Address]bool),
+		thirdPartyBlocked:       make(map[common.Address]bool),
+		nonces:                  make(map[common.Address]*big.Int),
+		shares:                  make(map[pair]*big.Int),
+		strategyList:            make(map[common.Address][]common.Address),
+	}
+	for _, strategy := range cfg.Strategies {
+		sm.whitelisted[strategy] = true
+	}
+	chain.Register(address, sm)
+	return sm
+}
+
+// DEPOSITTYPEHASH returns the EIP-712 typehash of deposits by signature.
+func (sm *StrategyManager) DEPOSITTYPEHASH(opts *bind.CallOpts) ([32]byte, error) {
+	return depositTypehash, nil
+}
+
+// DOMAINTYPEHASH returns the EIP-712 domain typehash.
+func (sm *StrategyManager) DOMAINTYPEHASH(opts *bind.CallOpts) ([32]byte, error) {
+	return domainTypehash, nil
+}
+
+// Delegation returns the address of the DelegationManager.
+func (sm *StrategyManager) Delegation(opts *bind.CallOpts) (common.Address, error) {
+	return sm.delegation.address, nil
+}
+
+// DomainSeparator returns the EIP-712 domain separator.
+func (sm *StrategyManager) DomainSeparator(opts *bind.CallOpts) ([32]byte, error) {
+	return sm.domainSeparator(), nil
+}
+
+// EigenPodManager returns the address of the EigenPodManager.
+func (sm *StrategyManager) EigenPodManager(opts *bind.CallOpts) (common.Address, error) {
+	return sm.eigenPods.address, nil
+}
+
+// GetDeposits returns the strategies staker holds shares in and the shares
+// held in each.
+func (sm *StrategyManager) GetDeposits(opts *bind.CallOpts, staker common.Address) ([]common.Address, []*big.Int, error) {
+	sm.chain.mu.Lock()
+	defer sm.chain.mu.Unlock()
+	strategies, shares := sm.getDeposits(staker)
+	return strategies, shares, nil
+}
+
+func (sm *StrategyManager) getDeposits(staker common.Address) ([]common.Address, []*big.Int) {
+	strategies := append([]common.Address{}, sm.strategyList[staker]...)
+	shares := make([]*big.Int, len(strategies))
+	for i, strategy := range strategies {
+		shares[i] = orZero(sm.shares[pair{staker, strategy}])
+	}
+	return strategies, shares
+}
+
+// Nonces returns the deposit-by-signature nonce of a staker.
+func (sm *StrategyManager) Nonces(opts *bind.CallOpts, staker common.Address) (*big.Int, error) {
+	sm.chain.mu.Lock()
+	defer sm.chain.mu.Unlock()
+	return orZero(sm.nonces[staker]), nil
+}
+
+// Slasher returns the address of the Slasher.
+func (sm *StrategyManager) Slasher(opts *bind.CallOpts) (common.Address, error) {
+	return sm.slasher, nil
+}
+
+// StakerStrategyList returns the index-th strategy staker holds shares in.
+func (sm *StrategyManager) StakerStrategyList(opts *bind.CallOpts, staker common.Address, index *big.Int) (common.Address, error) {
+	sm.chain.mu.Lock()
+	defer sm.chain.mu.Unlock()
+	list := sm.strategyList[staker]
+	if !index.IsUint64() || index.Uint64() >= uint64(len(list)) {
+		return common.Address{}, revert("index out of bounds")
+	}
+	return list[index.Uint64()], nil
+}
+
+// StakerStrategyListLength returns the number of strategies staker holds
+// shares in.
+func (sm *StrategyManager) StakerStrategyListLength(opts *bind.CallOpts, staker common.Address) (*big.Int, error) {
+	sm.chain.mu.Lock()
+	defer sm.chain.mu.Unlock()
+	return big.NewInt(int64(len(sm.strategyList[staker]))), nil
+}
+
+// StakerStrategyShares returns the shares staker holds in strategy.
+func (sm *StrategyManager) StakerStrategyShares(opts *bind.CallOpts, staker common.Address, strategy common.Address) (*big.Int, error) {
+	sm.chain.mu.Lock()
+	defer sm.chain.mu.Unlock()
+	return orZero(sm.shares[pair{staker, strategy}]), nil
+}
+
+// StrategyIsWhitelistedForDeposit reports whether strategy accepts deposits.
+func (sm *StrategyManager) StrategyIsWhitelistedForDeposit(opts *bind.CallOpts, strategy common.Address) (bool, error) {
+	sm.chain.mu.Lock()
+	defer sm.chain.mu.Unlock()
+	return sm.whitelisted[strategy], nil
+}
+
+// StrategyWhitelister returns the account managing the deposit whitelist.
+func (sm *StrategyManager) StrategyWhitelister(opts *bind.CallOpts) (common.Address, error) {
+	sm.chain.mu.Lock()
+	defer sm.chain.mu.Unlock()
+	return sm.whitelister, nil
+}
+
+// ThirdPartyTransfersForbidden reports whether deposits by signature and
+// withdrawals to a third party are disabled for strategy.
+func (sm *StrategyManager) ThirdPartyTransfersForbidden(opts *bind.CallOpts, strategy common.Address) (bool, error) {
+	sm.chain.mu.Lock()
+	defer sm.chain.mu.Unlock()
+	return sm.thirdPartyBlocked[strategy], nil
+}
+
+// AddShares is called by the DelegationManager when a withdrawal is
+// completed as shares.
+func (sm *StrategyManager) AddShares(opts *bind.TransactOpts, staker common.Address, token common.Address, strategy common.Address, shares *big.Int) (*types.Transaction, error) {
+	return sm.chain.transact(opts, &sm.contract, "addShares", []any{staker, token, strategy, shares}, func(tx *txContext) error {
+		if err := sm.onlyDelegationManager(tx); err != nil {
+			return err
+		}
+		return sm.addShares(tx, staker, token, strategy, shares)
+	})
+}
+
+// AddStrategiesToDepositWhitelist whitelists strategies for deposit. It can
+// only be called by the strategy whitelister.
+func (sm *StrategyManager) AddStrategiesToDepositWhitelist(opts *bind.TransactOpts, strategiesToWhitelist []common.Address, thirdPartyTransfersForbiddenValues []bool) (*types.Transaction, error) {
+	return sm.chain.transact(opts, &sm.contract, "addStrategiesToDepositWhitelist", []any{strategiesToWhitelist, thirdPartyTransfersForbiddenValues}, func(tx *txContext) error {
+		if err := sm.onlyStrategyWhitelister(tx); err != nil {
+			return err
+		}
+		if len(strategiesToWhitelist) != len(thirdPartyTransfersForbiddenValues) {
+			return revert("StrategyManager.addStrategiesToDepositWhitelist: array lengths do not match")
+		}
+		for i, strategy := range strategiesToWhitelist {
+			if sm.whitelisted[strategy] {
+				continue
+			}
+			set(tx, sm.whitelisted, strategy, true)
+			sm.emit(tx, "StrategyAddedToDepositWhitelist", strategy)
+			sm.setThirdPartyTransfersForbidden(tx, strategy, thirdPartyTransfersForbiddenValues[i])
+		}
+		return nil
+	})
+}
+
+// DepositIntoStrategy deposits amount of token held by the sender into
+// strategy, crediting the sender with shares.
+func (sm *StrategyManager) DepositIntoStrategy(opts *bind.TransactOpts, strategy common.Address, token common.Address, amount *big.Int) (*types.Transaction, error) {
+	return sm.chain.transact(opts, &sm.contract, "depositIntoStrategy", []any{strategy, token, amount}, func(tx *txContext) error {
+		if err := sm.onlyWhenNotPaused(smPausedDeposits); err != nil {
+			return err
+		}
+		return sm.depositIntoStrategy(tx, tx.sender, strategy, token, amount)
+	})
+}
+
+// DepositIntoStrategyWithSignature deposits tokens held by the sender on
+// behalf of staker, who authorizes the deposit with an EIP-712 signature.
+func (sm *StrategyManager) DepositIntoStrategyWithSignature(opts *bind.TransactOpts, strategy common.Address, token common.Address, amount *big.Int, staker common.Address, expiry *big.Int, signature []byte) (*types.Transaction, error) {
+	return sm.chain.transact(opts, &sm.contract, "depositIntoStrategyWithSignature", []any{strategy, token, amount, staker, expiry, signature}, func(tx *txContext) error {
+		if err := sm.onlyWhenNotPaused(smPausedDeposits); err != nil {
+			return err
+		}
+		if sm.thirdPartyBlocked[strategy] {
+			return revert("StrategyManager.depositIntoStrategyWithSignature: third transfers disabled")
+		}
+		if expiry.Cmp(new(big.Int).SetUint64(tx.time)) < 0 {
+			return revert("StrategyManager.depositIntoStrategyWithSignature: signature expired")
+		}
+		nonce := orZero(sm.nonces[staker])
+		structHash := crypto.Keccak256Hash(words(depositTypehash, staker, strategy, token, amount, nonce, expiry))
+		set(tx, sm.nonces, staker, new(big.Int).Add(nonce, common.Big1))
+		if err := checkSignature(staker, sm.digest(structHash), signature); err != nil {
+			return err
+		}
+		return sm.depositIntoStrategy(tx, staker, strategy, token, amount)
+	})
+}
+
+// Initialize always reverts, since the fake is deployed initialized.
+func (sm *StrategyManager) Initialize(opts *bind.TransactOpts, initialOwner common.Address, initialStrategyWhitelister common.Address, _pauserRegistry common.Address, initialPausedStatus *big.Int) (*types.Transaction, error) {
+	return sm.alreadyInitialized(opts, initialOwner, initialStrategyWhitelister, _pauserRegistry, initialPausedStatus)
+}
+
+// RemoveShares is called by the DelegationManager when a withdrawal is
+// queued.
+func (sm *StrategyManager) RemoveShares(opts *bind.TransactOpts, staker common.Address, strategy common.Address, shares *big.Int) (*types.Transaction, error) {
+	return sm.chain.transact(opts, &sm.contract, "removeShares", []any{staker, strategy, shares}, func(tx *txContext) error {
+		if err := sm.onlyDelegationManager(tx); err != nil {
+			return err
+		}
+		return sm.removeShares(tx, staker, strategy, shares)
+	})
+}
+
+// RemoveStrategiesFromDepositWhitelist removes strategies from the deposit
+// whitelist. It can only be called by the strategy whitelister.
+func (sm *StrategyManager) RemoveStrategiesFromDepositWhitelist(opts *bind.TransactOpts, strategiesToRemoveFromWhitelist []common.Address) (*types.Transaction, error) {
+	return sm.chain.transact(opts, &sm.contract, "removeStrategiesFromDepositWhitelist", []any{strategiesToRemoveFromWhitelist}, func(tx *txContext) error {
+		if err := sm.onlyStrategyWhitelister(tx); err != nil {
+			return err
+		}
+		for _, strategy := range strategiesToRemoveFromWhitelist {
+			if !sm.whitelisted[strategy] {
+				continue
+			}
+			set(tx, sm.whitelisted, strategy, false)
+			sm.emit(tx, "StrategyRemovedFromDepositWhitelist", strategy)
+			sm.setThirdPartyTransfersForbidden(tx, strategy, false)
+		}
+		return nil
+	})
+}
+
+// SetStrategyWhitelister replaces the strategy whitelister. It can only be
+// called by the owner.
+func (sm *StrategyManager) SetStrategyWhitelister(opts *bind.TransactOpts, newStrategyWhitelister common.Address) (*types.Transaction, error) {
+	return sm.chain.transact(opts, &sm.contract, "setStrategyWhitelister", []any{newStrategyWhitelister}, func(tx *txContext) error {
+		if err := sm.onlyOwner(tx); err != nil {
+			return err
+		}
+		sm.emit(tx, "StrategyWhitelisterChanged", sm.whitelister, newStrategyWhitelister)
+		assign(tx, &sm.whitelister, newStrategyWhitelister)
+		return nil
+	})
+}
+
+// SetThirdPartyTransfersForbidden toggles third party transfers for
+// strategy. It can only be called by the strategy whitelister.
+func (sm *StrategyManager) SetThirdPartyTransfersForbidden(opts *bind.TransactOpts, strategy common.Address, value bool) (*types.Transaction, error) {
+	return sm.chain.transact(opts, &sm.contract, "setThirdPartyTransfersForbidden", []any{strategy, value}, func(tx *txContext) error {
+		if err := sm.onlyStrategyWhitelister(tx); err != nil {
+			return err
+		}
+		sm.setThirdPartyTransfersForbidden(tx, strategy, value)
+		return nil
+	})
+}
+
+// WithdrawSharesAsTokens is called by the DelegationManager when a
+// withdrawal is completed as tokens.
+func (sm *StrategyManager) WithdrawSharesAsTokens(opts *bind.TransactOpts, recipient common.Address, strategy common.Address, shares *big.Int, token common.Address) (*types.Transaction, error) {
+	return sm.chain.transact(opts, &sm.contract, "withdrawSharesAsTokens", []any{recipient, strategy, shares, token}, func(tx *txContext) error {
+		if err := sm.onlyDelegationManager(tx); err != nil {
+			return err
+		}
+		return sm.withdrawSharesAsTokens(tx, recipient, strategy, shares, token)
+	})
+}
+
+func (sm *StrategyManager) onlyDelegationManager(tx *txContext) error {
+	if tx.sender != sm.delegation.address {
+		return revert("StrategyManager.onlyDelegationManager: not the DelegationManager")
+	}
+	return nil
+}
+
+func (sm *StrategyManager) onlyStrategyWhitelister(tx *txContext) error {
+	if tx.sender != sm.whitelister {
+		return revert("StrategyManager.onlyStrategyWhitelister: not the strategyWhitelister")
+	}
+	return nil
+}
+
+func (sm *StrategyManager) depositIntoStrategy(tx *txContext, staker, strategy, token common.Address, amount *big.Int) error {
+	if !sm.whitelisted[strategy] {
+		return revert("StrategyManager.onlyStrategiesWhitelistedForDeposit: strategy not whitelisted")
+	}
+	if err := tx.transfer(token, tx.sender, strategy, amount); err != nil {
+		return err
+	}
+	if err := sm.addShares(tx, staker, token, strategy, amount); err != nil {
+		return err
+	}
+	return sm.delegation.increaseDelegatedShares(tx, staker, strategy, amount)
+}
+
+func (sm *StrategyManager) addShares(tx *txContext, staker, token, strategy common.Address, shares *big.Int) error {
+	if staker == (common.Address{}) {
+		return revert("StrategyManager._addShares: staker cannot be zero address")
+	}
+	if shares.Sign() == 0 {
+		return revert("StrategyManager._addShares: shares should not be zero!")
+	}
+	current := orZero(sm.shares[pair{staker, strategy}])
+	if current.Sign() == 0 {
+		list := sm.strategyList[staker]
+		if len(list) >= maxStakerStrategyListLength {
+			return revert("StrategyManager._addShares: deposit would exceed MAX_STAKER_STRATEGY_LIST_LENGTH")
+		}
+		set(tx, sm.strategyList, staker, append(append([]common.Address{}, list...), strategy))
+	}
+	set(tx, sm.shares, pair{staker, strategy}, current.Add(current, shares))
+	sm.emit(tx, "Deposit", staker, token, strategy, shares)
+	return nil
+}
+
+func (sm *StrategyManager) removeShares(tx *txContext, staker, strategy common.Address, shares *big.Int) error {
+	if shares.Sign() == 0 {
+		return revert("StrategyManager._removeShares: shareAmount should not be zero!")
+	}
+	current := orZero(sm.shares[pair{staker, strategy}])
+	if shares.Cmp(current) > 0 {
+		return revert("StrategyManager._removeShares: shareAmount too high")
+	}
+	remaining := current.Sub(current, shares)
+	set(tx, sm.shares, pair{staker, strategy}, remaining)
+	if remaining.Sign() != 0 {
+		return nil
+	}
+	list := sm.strategyList[staker]
+	for j, s := range list {
+		if s == strategy {
+			// Swap with the last entry and pop, like the contract does.
+			updated := append([]common.Address{}, list...)
+			updated[j] = updated[len(updated)-1]
+			set(tx, sm.strategyList, staker, updated[:len(updated)-1])
+			return nil
+		}
+	}
+	return revert("StrategyManager._removeStrategyFromStakerStrategyList: strategy not found")
+}
+
+func (sm *StrategyManager) withdrawSharesAsTokens(tx *txContext, recipient, strategy common.Address, shares *big.Int, token common.Address) error {
+	return tx.transfer(token, strategy, recipient, shares)
+}
+
+func (sm *StrategyManager) setThirdPartyTransfersForbidden(tx *txContext, strategy common.Address, value bool) {
+	sm.emit(tx, "UpdatedThirdPartyTransfersForbidden", strategy, value)
+	set(tx, sm.thirdPartyBlocked, strategy, value)
+}
@@ -0,0 +1,159 @@
+// Package merkle is a Go port of the Merkle library in
+// src/contracts/libraries/Merkle.sol, whose functions are all internal and
+// so absent from its binding. Results, including those for inputs the
+// library does not expect, match the Solidity implementation: proofs are
+// the siblings from the leaf up, packed into 32-byte words, and the tree is
+// built assuming the leaf is the index'th from the bottom left.
+//
+// NewTree builds the trees to take proofs from, and pkg/merkle/merkletest
+// checks the port against the library itself.
+package merkle
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/reverts"
+)
+
+const (
+	reasonKeccakProofLength = "Merkle.processInclusionProofKeccak: proof length should be a multiple of 32"
+	reasonSha256ProofLength = "Merkle.processInclusionProofSha256: proof length should be a non-zero multiple of 32"
+)
+
+// ErrTooFewLeaves is returned by MerkleizeSha256 for fewer than two leaves,
+// for which the library panics with an out-of-bounds array access.
+var ErrTooFewLeaves = errors.New("merkle: fewer than two leaves")
+
+// VerifyInclusionKeccak reports whether proof proves leaf to be the
+// index'th leaf of the keccak256 tree with the given root.
+func VerifyInclusionKeccak(proof []byte, root, leaf common.Hash, index uint64) (bool, error) {
+	computed, err := ProcessInclusionProofKeccak(proof, leaf, index)
+	if err != nil {
+		return false, err
+	}
+	return computed == root, nil
+}
+
+// ProcessInclusionProofKeccak returns the root of the keccak256 tree whose
+// index'th leaf is leaf, rebuilt from proof. An empty proof returns leaf.
+// A proof whose length is not a multiple of 32 fails with the library's
+// revert, matching reverts.ErrProofLengthShouldBeMultipleOf32.
+func ProcessInclusionProofKeccak(proof []byte, leaf common.Hash, index uint64) (common.Hash, error) {
+	if len(proof)%32 != 0 {
+		return common.Hash{}, reverts.FromReason(reasonKeccakProofLength)
+	}
+	return process(proof, leaf, index, hashKeccak), nil
+}
+
+// VerifyInclusionSha256 reports whether proof proves leaf to be the
+// index'th leaf of the sha256 tree with the given root.
+func VerifyInclusionSha256(proof []byte, root, leaf common.Hash, index uint64) (bool, error) {
+	computed, err := ProcessInclusionProofSha256(proof, leaf, index)
+	if err != nil {
+		return false, err
+	}
+	return computed == root, nil
+}
+
+// ProcessInclusionProofSha256 returns the root of the sha256 tree whose
+// index'th leaf is leaf, rebuilt from proof. Unlike its keccak256
+// counterpart, an empty proof fails with the library's revert, matching
+// reverts.ErrProofLengthShouldBeNonZeroMultipleOf32, as does a proof whose
+// length is not a multiple of 32.
+func ProcessInclusionProofSha256(proof []byte, leaf common.Hash, index uint64) (common.Hash, error) {
+	if len(proof) == 0 || len(proof)%32 != 0 {
+		return common.Hash{}, reverts.FromReason(reasonSha256ProofLength)
+	}
+	return process(proof, leaf, index, hashSha256), nil
+}
+
+// MerkleizeSha256 returns the root of the sha256 tree of leaves. Like the
+// library, it expects the number of leaves to be a power of two; for other
+// counts it returns the same, incorrect, root the library does, hashing
+// only the first 2^k nodes of each layer. Fewer than two leaves fail with
+// ErrTooFewLeaves.
+func MerkleizeSha256(leaves []common.Hash) (common.Hash, error) {
+	n := len(leaves) / 2
+	if n == 0 {
+		return common.Hash{}, fmt.Errorf("%w: got %d", ErrTooFewLeaves, len(leaves))
+	}
+	layer := make([]common.Hash, n)
+	for i := range layer {
+		layer[i] = hashSha256(leaves[2*i], leaves[2*i+1])
+	}
+	for n /= 2; n != 0; n /= 2 {
+		for i := 0; i < n; i++ {
+			layer[i] = hashSha256(layer[2*i], layer[2*i+1])
+		}
+	}
+	return layer[0], nil
+}
+
+// IsPowerOfTwo reports whether n leaves meet the precondition of
+// MerkleizeSha256 and NewTree.
+func IsPowerOfTwo(n int) bool {
+	return n > 0 && n&(n-1) == 0
+}
+
+// PackProof packs siblings, ordered from the leaf up, into the proof format
+// the library takes.
+func PackProof(siblings []common.Hash) []byte {
+	proof := make([]byte, 0, len(siblings)*32)
+	for _, s := range siblings {
+		proof = append(proof, s[:]...)
+	}
+	return proof
+}
+
+// UnpackProof splits a proof into its siblings, ordered from the leaf up.
+func UnpackProof(proof []byte) ([]common.Hash, error) {
+	if len(proof)%32 != 0 {
+		return nil, fmt.Errorf("merkle: proof of %d bytes is not a multiple of 32", len(proof))
+	}
+	siblings := make([]common.Hash, len(proof)/32)
+	for i := range siblings {
+		siblings[i] = common.BytesToHash(proof[32*i : 32*i+32])
+	}
+	return siblings, nil
+}
+
+// Hash combines two sibling nodes into their parent.
+type Hash func(left, right common.Hash) common.Hash
+
+// Keccak256 and Sha256 are the node hashes of the library's two tree kinds.
+var (
+	Keccak256 Hash = hashKeccak
+	Sha256    Hash = hashSha256
+)
+
+func hashKeccak(left, right common.Hash) common.Hash {
+	return crypto.Keccak256Hash(left[:], right[:])
+}
+
+func hashSha256(left, right common.Hash) common.Hash {
+	h := sha256.New()
+	h.Write(left[:])
+	h.Write(right[:])
+	return common.BytesToHash(h.Sum(nil))
+}
+
+// process walks proof up from leaf, taking the index'th leaf's path. Index
+// bits above the proof's depth are ignored, as in the library.
+func process(proof []byte, leaf common.Hash, index uint64, hash Hash) common.Hash {
+	computed := leaf
+	for i := 0; i < len(proof); i += 32 {
+		sibling := common.BytesToHash(proof[i : i+32])
+		if index%2 == 0 {
+			computed = hash(computed, sibling)
+		} else {
+			computed = hash(sibling, computed)
+		}
+		index /= 2
+	}
+	return computed
+}
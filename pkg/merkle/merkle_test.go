@@ -0,0 +1,182 @@
+package merkle_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/merkle"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/merkle/merkletest"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/reverts"
+)
+
+func leaves(n int) []common.Hash {
+	out := make([]common.Hash, n)
+	for i := range out {
+		out[i] = crypto.Keccak256Hash([]byte{byte(i)})
+	}
+	return out
+}
+
+func TestMatchesHarness(t *testing.T) {
+	merkletest.AssertMatchesHarness(t, "../..")
+}
+
+func TestMerkleizeSha256(t *testing.T) {
+	tests := []struct {
+		leaves int
+		// prefix is the number of leading leaves whose tree has the
+		// expected root, or 0 if merkleizing fails.
+		prefix int
+	}{
+		{leaves: 0},
+		{leaves: 1},
+		{leaves: 2, prefix: 2},
+		{leaves: 3, prefix: 2},
+		{leaves: 4, prefix: 4},
+		{leaves: 5, prefix: 4},
+		{leaves: 7, prefix: 4},
+		{leaves: 8, prefix: 8},
+		{leaves: 15, prefix: 8},
+		{leaves: 16, prefix: 16},
+	}
+	for _, tt := range tests {
+		ls := leaves(tt.leaves)
+		got, err := merkle.MerkleizeSha256(ls)
+		if tt.prefix == 0 {
+			if !errors.Is(err, merkle.ErrTooFewLeaves) {
+				t.Errorf("%d leaves: err = %v, want ErrTooFewLeaves", tt.leaves, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%d leaves: %v", tt.leaves, err)
+			continue
+		}
+		tree, err := merkle.NewTree(ls[:tt.prefix], merkle.Sha256)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tree.Root() {
+			t.Errorf("%d leaves: root %s, want the root %s of the first %d", tt.leaves, got, tree.Root(), tt.prefix)
+		}
+	}
+}
+
+func TestNewTree(t *testing.T) {
+	for _, n := range []int{0, 3, 5, 6, 7, 9} {
+		if _, err := merkle.NewTree(leaves(n), merkle.Keccak256); err == nil {
+			t.Errorf("NewTree of %d leaves succeeded", n)
+		}
+	}
+	tree, err := merkle.NewTree(leaves(1), merkle.Keccak256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tree.Root() != leaves(1)[0] || tree.Depth() != 0 {
+		t.Errorf("tree of one leaf has root %s and depth %d", tree.Root(), tree.Depth())
+	}
+}
+
+func TestProof(t *testing.T) {
+	ls := leaves(8)
+	for _, tt := range []struct {
+		name   string
+		hash   merkle.Hash
+		verify func(proof []byte, root, leaf common.Hash, index uint64) (bool, error)
+	}{
+		{"keccak", merkle.Keccak256, merkle.VerifyInclusionKeccak},
+		{"sha256", merkle.Sha256, merkle.VerifyInclusionSha256},
+	} {
+		tree, err := merkle.NewTree(ls, tt.hash)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i, leaf := range ls {
+			index := uint64(i)
+			proof, err := tree.Proof(index)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(proof) != 32*tree.Depth() {
+				t.Errorf("%s: proof of %d bytes for depth %d", tt.name, len(proof), tree.Depth())
+			}
+			for _, c := range []struct {
+				index uint64
+				want  bool
+			}{
+				{index, true},
+				{index ^ 1, false},
+				// Index bits above the depth are ignored, as in the library.
+				{index + uint64(len(ls)), true},
+			} {
+				ok, err := tt.verify(proof, tree.Root(), leaf, c.index)
+				if err != nil || ok != c.want {
+					t.Errorf("%s: verify leaf %d at index %d = %v, %v, want %v", tt.name, i, c.index, ok, err, c.want)
+				}
+			}
+		}
+		for _, index := range []uint64{uint64(len(ls)), 1 << 40} {
+			if _, err := tree.Proof(index); err == nil {
+				t.Errorf("%s: proof of out of range leaf %d", tt.name, index)
+			}
+		}
+	}
+}
+
+func TestProcessInclusionProof(t *testing.T) {
+	leaf := leaves(1)[0]
+	tests := []struct {
+		name    string
+		process func(proof []byte, leaf common.Hash, index uint64) (common.Hash, error)
+		proof   []byte
+		want    common.Hash
+		err     error
+	}{
+		{"keccak empty proof", merkle.ProcessInclusionProofKeccak, nil, leaf, nil},
+		{"keccak short proof", merkle.ProcessInclusionProofKeccak, make([]byte, 31), common.Hash{}, reverts.ErrProofLengthShouldBeMultipleOf32},
+		{"keccak long proof", merkle.ProcessInclusionProofKeccak, make([]byte, 33), common.Hash{}, reverts.ErrProofLengthShouldBeMultipleOf32},
+		{"keccak one sibling", merkle.ProcessInclusionProofKeccak, make([]byte, 32), merkle.Keccak256(leaf, common.Hash{}), nil},
+		{"sha256 empty proof", merkle.ProcessInclusionProofSha256, nil, common.Hash{}, reverts.ErrProofLengthShouldBeNonZeroMultipleOf32},
+		{"sha256 short proof", merkle.ProcessInclusionProofSha256, make([]byte, 31), common.Hash{}, reverts.ErrProofLengthShouldBeNonZeroMultipleOf32},
+		{"sha256 one sibling", merkle.ProcessInclusionProofSha256, make([]byte, 32), merkle.Sha256(leaf, common.Hash{}), nil},
+	}
+	for _, tt := range tests {
+		got, err := tt.process(tt.proof, leaf, 0)
+		if !errors.Is(err, tt.err) || tt.err == nil && err != nil {
+			t.Errorf("%s: err = %v, want %v", tt.name, err, tt.err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("%s: root %s, want %s", tt.name, got, tt.want)
+		}
+	}
+
+	if ok, err := merkle.VerifyInclusionSha256(nil, leaf, leaf, 0); ok || err == nil {
+		t.Errorf("VerifyInclusionSha256 of an empty proof = %v, %v, want a revert", ok, err)
+	}
+	if ok, err := merkle.VerifyInclusionKeccak(nil, leaf, leaf, 0); !ok || err != nil {
+		t.Errorf("VerifyInclusionKeccak of an empty proof for the root itself = %v, %v, want true", ok, err)
+	}
+}
+
+func TestPackProof(t *testing.T) {
+	siblings := leaves(3)
+	got, err := merkle.UnpackProof(merkle.PackProof(siblings))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != len(siblings) {
+		t.Fatalf("unpacked %d siblings, want %d", len(got), len(siblings))
+	}
+	for i := range got {
+		if got[i] != siblings[i] {
+			t.Errorf("sibling %d: %s, want %s", i, got[i], siblings[i])
+		}
+	}
+	if _, err := merkle.UnpackProof(make([]byte, 40)); err == nil {
+		t.Error("unpacked a proof of 40 bytes")
+	}
+}
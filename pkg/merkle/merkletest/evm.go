@@ -0,0 +1,233 @@
+package merkletest
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/holiman/uint256"
+)
+
+// callGas is the gas of each call to the harness.
+const callGas = 30_000_000
+
+// evm runs contracts on an in-memory state with no chain behind it, which
+// is all the harness's pure functions need.
+type evm struct {
+	state *memState
+}
+
+func newEVM() *evm {
+	return &evm{state: newMemState()}
+}
+
+func (e *evm) new() *vm.EVM {
+	blockCtx := vm.BlockContext{
+		CanTransfer: func(db vm.StateDB, addr common.Address, amount *uint256.Int) bool {
+			return db.GetBalance(addr).Cmp(amount) >= 0
+		},
+		Transfer: func(db vm.StateDB, from, to common.Address, amount *uint256.Int) {
+			db.SubBalance(from, amount, tracing.BalanceChangeTransfer)
+			db.AddBalance(to, amount, tracing.BalanceChangeTransfer)
+		},
+		GetHash:     func(uint64) common.Hash { return common.Hash{} },
+		BlockNumber: new(big.Int),
+		Difficulty:  new(big.Int),
+		GasLimit:    callGas,
+		BaseFee:     new(big.Int),
+	}
+	return vm.NewEVM(blockCtx, vm.TxContext{GasPrice: new(big.Int)}, e.state, params.AllEthashProtocolChanges, vm.Config{})
+}
+
+// deploy runs the creation code and returns the address of the contract.
+func (e *evm) deploy(code []byte) (common.Address, error) {
+	_, address, _, err := e.new().Create(vm.AccountRef(common.Address{}), code, callGas, new(uint256.Int))
+	return address, err
+}
+
+func (e *evm) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
+	return e.state.GetCode(contract), nil
+}
+
+// CallContract calls the contract, reporting a revert with its data as a
+// node would.
+func (e *evm) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	ret, _, err := e.new().Call(vm.AccountRef(call.From), *call.To, call.Data, callGas, new(uint256.Int))
+	if errors.Is(err, vm.ErrExecutionReverted) {
+		return nil, &revertError{data: ret}
+	}
+	return ret, err
+}
+
+// revertError is a reverted call carrying its revert data.
+type revertError struct {
+	data []byte
+}
+
+func (e *revertError) Error() string          { return vm.ErrExecutionReverted.Error() }
+func (e *revertError) ErrorCode() int         { return 3 }
+func (e *revertError) ErrorData() interface{} { return hexutil.Bytes(e.data) }
+
+type account struct {
+	balance uint256.Int
+	nonce   uint64
+	code    []byte
+	storage map[common.Hash]common.Hash
+}
+
+// memState is a vm.StateDB held in maps. A snapshot copies the accounts,
+// which is cheap for the handful the harness has.
+type memState struct {
+	accounts  map[common.Address]*account
+	transient map[common.Address]map[common.Hash]common.Hash
+	refund    uint64
+	snapshots []map[common.Address]*account
+}
+
+var _ vm.StateDB = (*memState)(nil)
+
+func newMemState() *memState {
+	return &memState{accounts: make(map[common.Address]*account)}
+}
+
+func (s *memState) account(addr common.Address) *account {
+	a, ok := s.accounts[addr]
+	if !ok {
+		a = &account{storage: make(map[common.Hash]common.Hash)}
+		s.accounts[addr] = a
+	}
+	return a
+}
+
+func (s *memState) CreateAccount(addr common.Address) { s.account(addr) }
+
+func (s *memState) SubBalance(addr common.Address, amount *uint256.Int, _ tracing.BalanceChangeReason) {
+	a := s.account(addr)
+	a.balance.Sub(&a.balance, amount)
+}
+
+func (s *memState) AddBalance(addr common.Address, amount *uint256.Int, _ tracing.BalanceChangeReason) {
+	a := s.account(addr)
+	a.balance.Add(&a.balance, amount)
+}
+
+func (s *memState) GetBalance(addr common.Address) *uint256.Int {
+	if a, ok := s.accounts[addr]; ok {
+		return new(uint256.Int).Set(&a.balance)
+	}
+	return new(uint256.Int)
+}
+
+func (s *memState) GetNonce(addr common.Address) uint64 {
+	if a, ok := s.accounts[addr]; ok {
+		return a.nonce
+	}
+	return 0
+}
+
+func (s *memState) SetNonce(addr common.Address, nonce uint64) { s.account(addr).nonce = nonce }
+
+func (s *memState) GetCodeHash(addr common.Address) common.Hash {
+	a, ok := s.accounts[addr]
+	if !ok {
+		return common.Hash{}
+	}
+	if len(a.code) == 0 {
+		return types.EmptyCodeHash
+	}
+	return crypto.Keccak256Hash(a.code)
+}
+
+func (s *memState) GetCode(addr common.Address) []byte {
+	if a, ok := s.accounts[addr]; ok {
+		return a.code
+	}
+	return nil
+}
+
+func (s *memState) SetCode(addr common.Address, code []byte) { s.account(addr).code = code }
+
+func (s *memState) GetCodeSize(addr common.Address) int { return len(s.GetCode(addr)) }
+
+func (s *memState) AddRefund(gas uint64) { s.refund += gas }
+func (s *memState) SubRefund(gas uint64) { s.refund -= gas }
+func (s *memState) GetRefund() uint64    { return s.refund }
+
+func (s *memState) GetCommittedState(addr common.Address, key common.Hash) common.Hash {
+	return s.GetState(addr, key)
+}
+
+func (s *memState) GetState(addr common.Address, key common.Hash) common.Hash {
+	if a, ok := s.accounts[addr]; ok {
+		return a.storage[key]
+	}
+	return common.Hash{}
+}
+
+func (s *memState) SetState(addr common.Address, key, value common.Hash) {
+	s.account(addr).storage[key] = value
+}
+
+func (s *memState) GetStorageRoot(common.Address) common.Hash { return types.EmptyRootHash }
+
+func (s *memState) GetTransientState(addr common.Address, key common.Hash) common.Hash {
+	return s.transient[addr][key]
+}
+
+func (s *memState) SetTransientState(addr common.Address, key, value common.Hash) {
+	if s.transient == nil {
+		s.transient = make(map[common.Address]map[common.Hash]common.Hash)
+	}
+	if s.transient[addr] == nil {
+		s.transient[addr] = make(map[common.Hash]common.Hash)
+	}
+	s.transient[addr][key] = value
+}
+
+func (s *memState) SelfDestruct(addr common.Address)      { delete(s.accounts, addr) }
+func (s *memState) HasSelfDestructed(common.Address) bool { return false }
+func (s *memState) Selfdestruct6780(addr common.Address)  { s.SelfDestruct(addr) }
+func (s *memState) Exist(addr common.Address) bool        { _, ok := s.accounts[addr]; return ok }
+
+// Every address and slot is warm: the harness does not check gas.
+func (s *memState) AddressInAccessList(common.Address) bool         { return true }
+func (s *memState) AddAddressToAccessList(common.Address)           {}
+func (s *memState) AddSlotToAccessList(common.Address, common.Hash) {}
+func (s *memState) AddLog(*types.Log)                               {}
+func (s *memState) AddPreimage(common.Hash, []byte)                 {}
+
+func (s *memState) Empty(addr common.Address) bool {
+	a, ok := s.accounts[addr]
+	return !ok || a.balance.IsZero() && a.nonce == 0 && len(a.code) == 0
+}
+
+func (s *memState) SlotInAccessList(common.Address, common.Hash) (bool, bool) { return true, true }
+
+func (s *memState) Prepare(params.Rules, common.Address, common.Address, *common.Address, []common.Address, types.AccessList) {
+	s.transient = nil
+}
+
+func (s *memState) Snapshot() int {
+	copied := make(map[common.Address]*account, len(s.accounts))
+	for addr, a := range s.accounts {
+		c := *a
+		c.storage = maps.Clone(a.storage)
+		copied[addr] = &c
+	}
+	s.snapshots = append(s.snapshots, copied)
+	return len(s.snapshots) - 1
+}
+
+func (s *memState) RevertToSnapshot(id int) {
+	s.accounts = s.snapshots[id]
+	s.snapshots = s.snapshots[:id]
+}
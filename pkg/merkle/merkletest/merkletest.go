@@ -0,0 +1,207 @@
+// Package merkletest checks pkg/merkle against the Solidity Merkle library,
+// by calling src/test/harnesses/MerkleHarness.sol on an in-memory EVM.
+package merkletest
+
+import (
+	"errors"
+	"math/big"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindgen"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/merkle"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/reverts"
+)
+
+// HarnessName is the name of the harness contract and of its artifact.
+const HarnessName = "MerkleHarness"
+
+// Harness calls the deployed MerkleHarness.
+type Harness struct {
+	contract *bind.BoundContract
+}
+
+// Deploy deploys the harness from its forge artifact in artifactDir onto a
+// new in-memory EVM. The test is skipped if the artifacts have not been
+// built.
+func Deploy(t testing.TB, artifactDir string) *Harness {
+	t.Helper()
+	if _, err := os.Stat(bindgen.ArtifactPath(artifactDir, HarnessName)); os.IsNotExist(err) {
+		t.Skipf("no %s artifact in %s, run `forge build`", HarnessName, artifactDir)
+	}
+	artifact, err := bindgen.LoadArtifact(artifactDir, HarnessName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	parsed, err := abi.JSON(strings.NewReader(artifact.ABI))
+	if err != nil {
+		t.Fatalf("failed to parse %s abi: %v", HarnessName, err)
+	}
+
+	e := newEVM()
+	address, err := e.deploy(common.FromHex(artifact.Bytecode))
+	if err != nil {
+		t.Fatalf("failed to deploy %s: %v", HarnessName, err)
+	}
+	return &Harness{contract: bind.NewBoundContract(address, parsed, e, nil, nil)}
+}
+
+// call calls a harness function returning a single value.
+func call[T any](h *Harness, method string, args ...any) (T, error) {
+	var out []any
+	var zero T
+	if err := h.contract.Call(&bind.CallOpts{}, &out, method, args...); err != nil {
+		return zero, reverts.Decode(err)
+	}
+	return *abi.ConvertType(out[0], new(T)).(*T), nil
+}
+
+// VerifyInclusionKeccak calls Merkle.verifyInclusionKeccak.
+func (h *Harness) VerifyInclusionKeccak(proof []byte, root, leaf common.Hash, index uint64) (bool, error) {
+	return call[bool](h, "verifyInclusionKeccak", proof, root, leaf, new(big.Int).SetUint64(index))
+}
+
+// ProcessInclusionProofKeccak calls Merkle.processInclusionProofKeccak.
+func (h *Harness) ProcessInclusionProofKeccak(proof []byte, leaf common.Hash, index uint64) (common.Hash, error) {
+	return call[common.Hash](h, "processInclusionProofKeccak", proof, leaf, new(big.Int).SetUint64(index))
+}
+
+// VerifyInclusionSha256 calls Merkle.verifyInclusionSha256.
+func (h *Harness) VerifyInclusionSha256(proof []byte, root, leaf common.Hash, index uint64) (bool, error) {
+	return call[bool](h, "verifyInclusionSha256", proof, root, leaf, new(big.Int).SetUint64(index))
+}
+
+// ProcessInclusionProofSha256 calls Merkle.processInclusionProofSha256.
+func (h *Harness) ProcessInclusionProofSha256(proof []byte, leaf common.Hash, index uint64) (common.Hash, error) {
+	return call[common.Hash](h, "processInclusionProofSha256", proof, leaf, new(big.Int).SetUint64(index))
+}
+
+// MerkleizeSha256 calls Merkle.merkleizeSha256.
+func (h *Harness) MerkleizeSha256(leaves []common.Hash) (common.Hash, error) {
+	words := make([][32]byte, len(leaves))
+	for i, leaf := range leaves {
+		words[i] = leaf
+	}
+	return call[common.Hash](h, "merkleizeSha256", words)
+}
+
+// AssertEquivalent fails t if any function of pkg/merkle disagrees with
+// the harness on rounds random trees and proofs drawn from seed, including
+// tampered proofs, out-of-range indices, malformed proof lengths and leaf
+// counts that are not a power of two.
+func AssertEquivalent(t testing.TB, h *Harness, seed int64, rounds int) {
+	t.Helper()
+	rng := rand.New(rand.NewSource(seed))
+	hash := func() (out common.Hash) {
+		rng.Read(out[:])
+		return out
+	}
+	for round := 0; round < rounds; round++ {
+		depth := rng.Intn(8)
+		leaves := make([]common.Hash, 1<<depth)
+		for i := range leaves {
+			leaves[i] = hash()
+		}
+		index := uint64(rng.Intn(len(leaves)))
+		for kind, nodeHash := range map[string]merkle.Hash{"keccak": merkle.Keccak256, "sha256": merkle.Sha256} {
+			tree, err := merkle.NewTree(leaves, nodeHash)
+			if err != nil {
+				t.Fatal(err)
+			}
+			proof, err := tree.Proof(index)
+			if err != nil {
+				t.Fatal(err)
+			}
+			tampered := append([]byte(nil), proof...)
+			if len(tampered) > 0 {
+				tampered[rng.Intn(len(tampered))] ^= 1 << rng.Intn(8)
+			}
+			cases := []struct {
+				proof []byte
+				index uint64
+			}{
+				{proof, index},
+				{tampered, index},
+				{proof, index ^ 1},
+				{proof, index | rng.Uint64()<<depth},
+				{append(proof, hash().Bytes()[:1+rng.Intn(31)]...), index},
+				{nil, index},
+			}
+			for _, c := range cases {
+				if kind == "keccak" {
+					compare(t, "verifyInclusionKeccak", h.VerifyInclusionKeccak, merkle.VerifyInclusionKeccak, c.proof, tree.Root(), leaves[index], c.index)
+					compare(t, "processInclusionProofKeccak",
+						func(p []byte, _, l common.Hash, i uint64) (common.Hash, error) {
+							return h.ProcessInclusionProofKeccak(p, l, i)
+						},
+						func(p []byte, _, l common.Hash, i uint64) (common.Hash, error) {
+							return merkle.ProcessInclusionProofKeccak(p, l, i)
+						},
+						c.proof, tree.Root(), leaves[index], c.index)
+				} else {
+					compare(t, "verifyInclusionSha256", h.VerifyInclusionSha256, merkle.VerifyInclusionSha256, c.proof, tree.Root(), leaves[index], c.index)
+					compare(t, "processInclusionProofSha256",
+						func(p []byte, _, l common.Hash, i uint64) (common.Hash, error) {
+							return h.ProcessInclusionProofSha256(p, l, i)
+						},
+						func(p []byte, _, l common.Hash, i uint64) (common.Hash, error) {
+							return merkle.ProcessInclusionProofSha256(p, l, i)
+						},
+						c.proof, tree.Root(), leaves[index], c.index)
+				}
+			}
+		}
+
+		count := rng.Intn(2 * len(leaves))
+		more := make([]common.Hash, count)
+		for i := range more {
+			more[i] = hash()
+		}
+		want, wantErr := h.MerkleizeSha256(more)
+		got, err := merkle.MerkleizeSha256(more)
+		switch {
+		case wantErr != nil && err == nil:
+			t.Errorf("merkleizeSha256 of %d leaves: harness reverted with %v, port returned %s", count, wantErr, got)
+		case wantErr == nil && err != nil:
+			t.Errorf("merkleizeSha256 of %d leaves: harness returned %s, port failed with %v", count, want, err)
+		case wantErr == nil && got != want:
+			t.Errorf("merkleizeSha256 of %d leaves: harness returned %s, port %s", count, want, got)
+		}
+	}
+}
+
+// compare calls a function of the harness and of the port with the same
+// arguments, and fails t unless both return the same value or revert with
+// the same reason.
+func compare[T comparable](t testing.TB, name string, harness, port func([]byte, common.Hash, common.Hash, uint64) (T, error), proof []byte, root, leaf common.Hash, index uint64) {
+	t.Helper()
+	want, wantErr := harness(proof, root, leaf, index)
+	got, err := port(proof, root, leaf, index)
+	var wantRevert, gotRevert *reverts.Error
+	switch {
+	case wantErr != nil && !errors.As(wantErr, &wantRevert):
+		t.Fatalf("%s: failed to call harness: %v", name, wantErr)
+	case wantErr != nil && (!errors.As(err, &gotRevert) || gotRevert.Reason != wantRevert.Reason):
+		t.Errorf("%s(%#x, %s, %d): harness reverted with %q, port returned %v, %v", name, proof, leaf, index, wantRevert.Reason, got, err)
+	case wantErr == nil && err != nil:
+		t.Errorf("%s(%#x, %s, %d): harness returned %v, port failed with %v", name, proof, leaf, index, want, err)
+	case wantErr == nil && got != want:
+		t.Errorf("%s(%#x, %s, %d): harness returned %v, port %v", name, proof, leaf, index, want, got)
+	}
+}
+
+// AssertMatchesHarness deploys the harness from the forge artifacts of the
+// checkout at root and runs AssertEquivalent against it. The test is
+// skipped if the artifacts have not been built.
+func AssertMatchesHarness(t testing.TB, root string) {
+	t.Helper()
+	h := Deploy(t, filepath.Join(root, bindgen.DefaultArtifactDir))
+	AssertEquivalent(t, h, 1, 64)
+}
@@ -0,0 +1,55 @@
+package merkle
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Tree is a complete binary tree built with one of the library's hashes,
+// from which inclusion proofs are taken.
+type Tree struct {
+	// layers holds the leaves first and the root last.
+	layers [][]common.Hash
+}
+
+// NewTree builds the tree of leaves, whose number must be a power of two.
+func NewTree(leaves []common.Hash, hash Hash) (*Tree, error) {
+	if !IsPowerOfTwo(len(leaves)) {
+		return nil, fmt.Errorf("merkle: %d leaves is not a power of two", len(leaves))
+	}
+	layer := append([]common.Hash(nil), leaves...)
+	t := &Tree{layers: [][]common.Hash{layer}}
+	for len(layer) > 1 {
+		next := make([]common.Hash, len(layer)/2)
+		for i := range next {
+			next[i] = hash(layer[2*i], layer[2*i+1])
+		}
+		t.layers = append(t.layers, next)
+		layer = next
+	}
+	return t, nil
+}
+
+// Root returns the root of the tree, which is its only leaf if it has one.
+func (t *Tree) Root() common.Hash {
+	return t.layers[len(t.layers)-1][0]
+}
+
+// Depth returns the number of siblings in a proof.
+func (t *Tree) Depth() int {
+	return len(t.layers) - 1
+}
+
+// Proof returns the packed proof of the index'th leaf.
+func (t *Tree) Proof(index uint64) ([]byte, error) {
+	if index >= uint64(len(t.layers[0])) {
+		return nil, fmt.Errorf("merkle: leaf %d out of range of %d leaves", index, len(t.layers[0]))
+	}
+	siblings := make([]common.Hash, t.Depth())
+	for i := range siblings {
+		siblings[i] = t.layers[i][index^1]
+		index /= 2
+	}
+	return PackProof(siblings), nil
+}
@@ -0,0 +1,478 @@
+package podproofs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/beacon"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/beaconproofs"
+	eigenpod "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/EigenPod"
+	eigenpodmanager "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/EigenPodManager"
+	ibeaconchainoracle "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/IBeaconChainOracle"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/constants"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/prooffile"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/reverts"
+)
+
+var (
+	// ErrUpToDate is the error of a validator whose restaked balance
+	// already matches its effective balance.
+	ErrUpToDate = errors.New("podproofs: restaked balance is up to date")
+	// ErrPending is the error of a validator whose balance update of an
+	// earlier round has been sent but not yet mined or dropped.
+	ErrPending = errors.New("podproofs: balance update pending")
+)
+
+// BalancePod is the subset of the EigenPod binding Balances reads, writes
+// and filters.
+type BalancePod interface {
+	MAXRESTAKEDBALANCEGWEIPERVALIDATOR(opts *bind.CallOpts) (uint64, error)
+	PodOwner(opts *bind.CallOpts) (common.Address, error)
+	ValidatorPubkeyToInfo(opts *bind.CallOpts, validatorPubkey []byte) (eigenpod.IEigenPodValidatorInfo, error)
+	VerifyBalanceUpdates(opts *bind.TransactOpts, oracleTimestamp uint64, validatorIndices []*big.Int, stateRootProof eigenpod.BeaconChainProofsStateRootProof, validatorFieldsProofs [][]byte, validatorFields [][][32]byte) (*types.Transaction, error)
+	FilterValidatorRestaked(opts *bind.FilterOpts) (*eigenpod.EigenPodValidatorRestakedIterator, error)
+	FilterValidatorBalanceUpdated(opts *bind.FilterOpts) (*eigenpod.EigenPodValidatorBalanceUpdatedIterator, error)
+}
+
+var _ BalancePod = (*eigenpod.EigenPod)(nil)
+
+// SharesFilterer is the subset of the EigenPodManager binding Balances
+// filters.
+type SharesFilterer interface {
+	FilterPodSharesUpdated(opts *bind.FilterOpts, podOwner []common.Address) (*eigenpodmanager.EigenPodManagerPodSharesUpdatedIterator, error)
+}
+
+var _ SharesFilterer = (*eigenpodmanager.EigenPodManager)(nil)
+
+// BalanceGas estimates VerifyBalanceUpdates: the state root proof, the
+// oracle lookup and the shares update of the EigenPodManager, then the
+// calldata, proof and updated ValidatorInfo of each validator.
+var BalanceGas = GasModel{Base: 150_000, PerItem: 80_000}
+
+// WatchedPod is a pod whose validators Balances keeps up to date.
+type WatchedPod struct {
+	Address common.Address
+	Pod     BalancePod
+	// Validators are the IDs of the pod's validators. If nil, they are
+	// found from the pod's ValidatorRestaked events.
+	Validators []string
+	// FromBlock is the first block searched for the events of the
+	// transactions sent to the pod. It advances past the events of each
+	// round.
+	FromBlock uint64
+
+	discovered []string
+	// next is the first block not yet searched for ValidatorRestaked.
+	next uint64
+	// pending are the balance updates sent and not yet mined or dropped,
+	// by validator index.
+	pending map[uint64]pendingUpdate
+}
+
+// pendingUpdate is a balance update sent in tx, proving the balance at
+// oracleTimestamp.
+type pendingUpdate struct {
+	tx              common.Hash
+	oracleTimestamp uint64
+}
+
+// BalanceReport is the outcome of a round of balance updates of one pod.
+type BalanceReport struct {
+	*Report
+	Pod      common.Address
+	PodOwner common.Address
+	// Updates are the ValidatorBalanceUpdated events of the report's
+	// transactions, and SharesUpdates the PodSharesUpdated events.
+	Updates       []*eigenpod.EigenPodValidatorBalanceUpdated
+	SharesUpdates []*eigenpodmanager.EigenPodManagerPodSharesUpdated
+}
+
+// SharesDelta returns the total change in the pod owner's shares the
+// report's transactions recorded, in wei.
+func (rep *BalanceReport) SharesDelta() *big.Int {
+	total := new(big.Int)
+	for _, ev := range rep.SharesUpdates {
+		total.Add(total, ev.SharesDelta)
+	}
+	return total
+}
+
+// Balances is a service proving the effective balances of the validators
+// of a set of pods whenever they diverge from the balances the pods have
+// restaked.
+type Balances struct {
+	Beacon  beacon.Client
+	Oracle  ibeaconchainoracle.IBeaconChainOracleReader
+	Manager SharesFilterer
+	Pods    []*WatchedPod
+	// StateID names the state balances are proven against. It defaults to
+	// "finalized".
+	StateID string
+	// OracleTimestamp returns the timestamp the oracle serves the block
+	// root of slot at. It defaults to the timestamp of slot, as
+	// beaconoracle.SlotTimestamp computes it from the beacon genesis.
+	OracleTimestamp func(ctx context.Context, slot uint64) (uint64, error)
+	// Now returns the timestamp of the chain's latest block, which proofs
+	// must be within the update window of. It defaults to the wall clock.
+	Now func() uint64
+	// Backend, if set, is asked for the receipts of the transactions sent,
+	// which decide whether their validators are confirmed or reverted. It
+	// is not waited on: a transaction not yet mined leaves its validators
+	// pending for later rounds. If Backend is an ethereum.TransactionReader,
+	// a pending transaction it no longer knows is taken as dropped.
+	Backend bind.DeployBackend
+	// Gas and GasLimit bound the validators of a transaction. They default
+	// to BalanceGas and DefaultGasLimit.
+	Gas      GasModel
+	GasLimit uint64
+}
+
+// Round proves the diverged balances of the validators of every pod against
+// the latest state, and reads back the events of the transactions sent. A
+// validator whose update of an earlier round is still pending is skipped
+// with ErrPending until the update is mined, or dropped: no longer known
+// to the Backend, or too old for the pod to accept. It returns the report
+// of each pod it got to.
+func (b *Balances) Round(ctx context.Context, opts *bind.TransactOpts) ([]*BalanceReport, error) {
+	stateID := b.StateID
+	if stateID == "" {
+		stateID = "finalized"
+	}
+	// Balance update proofs do not depend on the fork schedule.
+	p, slot, err := prover(ctx, b.Beacon, stateID, beaconproofs.UnsetSchedule)
+	if err != nil {
+		return nil, err
+	}
+	oracleTimestamp, err := b.oracleTimestamp(ctx, p.LatestBlockHeader().Slot)
+	if err != nil {
+		return nil, err
+	}
+	epoch, err := b.epoch(ctx, oracleTimestamp)
+	if err != nil {
+		return nil, err
+	}
+
+	var reps []*BalanceReport
+	for _, w := range b.Pods {
+		ids, err := w.validators(ctx)
+		if err != nil {
+			return reps, err
+		}
+		results, err := lookup(ctx, b.Beacon, slot, ids)
+		if err != nil {
+			return reps, err
+		}
+		rep := &BalanceReport{
+			Report: &Report{OracleTimestamp: oracleTimestamp, StateRoot: p.StateRoot(), Results: results},
+			Pod:    w.Address,
+		}
+		reps = append(reps, rep)
+		if err := b.settle(ctx, w); err != nil {
+			return reps, err
+		}
+		if err := b.prepare(ctx, w, rep, epoch, p.BalanceUpdateProof); err != nil {
+			return reps, err
+		}
+		if err := rep.checkStateRoot(ctx, b.Oracle); err != nil {
+			return reps, err
+		}
+		if err := b.submit(opts, w, rep); err != nil {
+			return reps, err
+		}
+		if err := b.confirm(ctx, w, rep); err != nil {
+			return reps, err
+		}
+	}
+	return reps, nil
+}
+
+// Run runs a round every interval until ctx is done, passing each round's
+// reports and error to handle. A failed round does not stop the service.
+func (b *Balances) Run(ctx context.Context, opts *bind.TransactOpts, interval time.Duration, handle func([]*BalanceReport, error)) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		reps, err := b.Round(ctx, opts)
+		if handle != nil {
+			handle(reps, err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// prepare proves the validators of rep whose restaked balance diverged.
+// Validators with a pending update are skipped with ErrPending. Validators
+// the pod would reject are skipped with the reason it would revert with:
+// those it has already updated at the report's timestamp, those it has not
+// restaked, and those that are withdrawable but have not withdrawn.
+// Validators whose balance has not diverged are skipped with ErrUpToDate.
+func (b *Balances) prepare(ctx context.Context, w *WatchedPod, rep *BalanceReport, epoch uint64, prove func(validatorIndex uint64) (*prooffile.File, error)) error {
+	call := &bind.CallOpts{Context: ctx}
+	podOwner, err := w.Pod.PodOwner(call)
+	if err != nil {
+		return fmt.Errorf("podproofs: failed to read the owner of pod %s: %w", w.Address, err)
+	}
+	maxGwei, err := w.Pod.MAXRESTAKEDBALANCEGWEIPERVALIDATOR(call)
+	if err != nil {
+		return fmt.Errorf("podproofs: failed to read the restaked balance limit of pod %s: %w", w.Address, err)
+	}
+	rep.PodOwner = podOwner
+
+	stale := rep.OracleTimestamp+constants.EigenPodVerifyBalanceUpdateWindowSeconds < b.now()
+	for _, r := range rep.ready() {
+		if _, ok := w.pending[r.Validator.Index]; ok {
+			r.skip(ErrPending)
+			continue
+		}
+		if stale {
+			r.skip(reverts.FromReason("EigenPod.verifyBalanceUpdates: specified timestamp is too far in past"))
+			continue
+		}
+		info, err := w.Pod.ValidatorPubkeyToInfo(call, r.Validator.Validator.Pubkey)
+		if err != nil {
+			return fmt.Errorf("podproofs: failed to read validator %d of pod %s: %w", r.Validator.Index, w.Address, err)
+		}
+		v := r.Validator.Validator
+		switch {
+		case info.MostRecentBalanceUpdateTimestamp >= rep.OracleTimestamp:
+			r.skip(reverts.FromReason("EigenPod.verifyBalanceUpdate: Validators balance has already been updated for this timestamp"))
+			continue
+		case info.Status != ValidatorActive:
+			r.skip(reverts.FromReason("EigenPod.verifyBalanceUpdate: Validator not active"))
+			continue
+		case v.WithdrawableEpoch <= epoch && v.EffectiveBalance == 0:
+			r.skip(reverts.FromReason("EigenPod.verifyBalanceUpdate: validator is withdrawable but has not withdrawn"))
+			continue
+		case min(v.EffectiveBalance, maxGwei) == info.RestakedBalanceGwei:
+			r.skip(ErrUpToDate)
+			continue
+		}
+		proof, err := prove(r.Validator.Index)
+		if err != nil {
+			r.skip(err)
+			continue
+		}
+		r.Proof = proof
+	}
+	return nil
+}
+
+// submit sends the ready validators of rep to the pod, a batch per
+// transaction.
+func (b *Balances) submit(opts *bind.TransactOpts, w *WatchedPod, rep *BalanceReport) error {
+	gas := b.Gas
+	if gas == (GasModel{}) {
+		gas = BalanceGas
+	}
+	return rep.submit(gas, b.GasLimit, func(files []*prooffile.File) (*types.Transaction, error) {
+		validatorIndices, validatorFieldsProofs, validatorFields := prooffile.Credentials(files...)
+		return w.Pod.VerifyBalanceUpdates(opts, rep.OracleTimestamp, validatorIndices, rep.stateRootProof, validatorFieldsProofs, validatorFields)
+	})
+}
+
+// confirm reads the events of rep's transactions, marking each submitted
+// validator confirmed once the pod has recorded its new balance. With a
+// Backend, the receipts of the mined transactions decide instead.
+// Validators whose transaction has neither a receipt nor events yet are
+// left submitted, and pending for later rounds.
+func (b *Balances) confirm(ctx context.Context, w *WatchedPod, rep *BalanceReport) error {
+	if len(rep.Txs) == 0 {
+		return nil
+	}
+	for _, tx := range rep.Txs {
+		receipt, err := b.receipt(ctx, tx.Hash())
+		if err != nil {
+			return err
+		}
+		if receipt != nil {
+			rep.mined(tx, receipt)
+		}
+	}
+	sent := make(map[common.Hash]bool)
+	for _, tx := range rep.Txs {
+		sent[tx.Hash()] = true
+	}
+	filter := &bind.FilterOpts{Start: w.FromBlock, Context: ctx}
+	next, err := w.balanceUpdates(rep, filter, sent)
+	if err != nil {
+		return err
+	}
+	sharesNext, err := b.sharesUpdates(rep, filter, sent)
+	if err != nil {
+		return err
+	}
+
+	for _, r := range rep.Results {
+		if r.Outcome != Submitted {
+			continue
+		}
+		for _, ev := range rep.Updates {
+			if ev.Raw.TxHash == r.Tx.Hash() && ev.ValidatorIndex.Uint64() == r.Validator.Index && ev.BalanceTimestamp == rep.OracleTimestamp {
+				r.Outcome = Confirmed
+				break
+			}
+		}
+	}
+	for _, r := range rep.Results {
+		switch r.Outcome {
+		case Submitted:
+			if w.pending == nil {
+				w.pending = make(map[uint64]pendingUpdate)
+			}
+			w.pending[r.Validator.Index] = pendingUpdate{tx: r.Tx.Hash(), oracleTimestamp: rep.OracleTimestamp}
+		case Confirmed, Reverted:
+			delete(w.pending, r.Validator.Index)
+		}
+	}
+	w.FromBlock = max(w.FromBlock, next, sharesNext)
+	return nil
+}
+
+// balanceUpdates adds the ValidatorBalanceUpdated events of the pod from
+// filter's start that were emitted by the sent transactions to rep,
+// returning the block after the last of them.
+func (w *WatchedPod) balanceUpdates(rep *BalanceReport, filter *bind.FilterOpts, sent map[common.Hash]bool) (uint64, error) {
+	it, err := w.Pod.FilterValidatorBalanceUpdated(filter)
+	if err != nil {
+		return 0, err
+	}
+	defer it.Close()
+	next := filter.Start
+	for it.Next() {
+		if ev := it.Event; sent[ev.Raw.TxHash] {
+			rep.Updates = append(rep.Updates, ev)
+			next = max(next, ev.Raw.BlockNumber+1)
+		}
+	}
+	return next, it.Error()
+}
+
+// sharesUpdates adds the PodSharesUpdated events of the pod owner from
+// filter's start that were emitted by the sent transactions to rep,
+// returning the block after the last of them.
+func (b *Balances) sharesUpdates(rep *BalanceReport, filter *bind.FilterOpts, sent map[common.Hash]bool) (uint64, error) {
+	it, err := b.Manager.FilterPodSharesUpdated(filter, []common.Address{rep.PodOwner})
+	if err != nil {
+		return 0, err
+	}
+	defer it.Close()
+	next := filter.Start
+	for it.Next() {
+		if ev := it.Event; sent[ev.Raw.TxHash] {
+			rep.SharesUpdates = append(rep.SharesUpdates, ev)
+			next = max(next, ev.Raw.BlockNumber+1)
+		}
+	}
+	return next, it.Error()
+}
+
+// settle forgets the pending updates of w that have been mined or dropped.
+// An update is dropped once the pod would reject its timestamp as too far
+// in the past, or once a Backend that reads transactions no longer knows
+// it.
+func (b *Balances) settle(ctx context.Context, w *WatchedPod) error {
+	now := b.now()
+	for index, p := range w.pending {
+		if p.oracleTimestamp+constants.EigenPodVerifyBalanceUpdateWindowSeconds < now {
+			delete(w.pending, index)
+			continue
+		}
+		receipt, err := b.receipt(ctx, p.tx)
+		if err != nil {
+			return err
+		}
+		if receipt != nil {
+			delete(w.pending, index)
+			continue
+		}
+		reader, ok := b.Backend.(ethereum.TransactionReader)
+		if !ok {
+			continue
+		}
+		_, _, err = reader.TransactionByHash(ctx, p.tx)
+		switch {
+		case errors.Is(err, ethereum.NotFound):
+			delete(w.pending, index)
+		case err != nil:
+			return fmt.Errorf("podproofs: failed to read pending transaction %s: %w", p.tx, err)
+		}
+	}
+	return nil
+}
+
+// receipt returns the receipt of the mined transaction hash, or nil if
+// there is no Backend or the transaction has not been mined.
+func (b *Balances) receipt(ctx context.Context, hash common.Hash) (*types.Receipt, error) {
+	if b.Backend == nil {
+		return nil, nil
+	}
+	receipt, err := b.Backend.TransactionReceipt(ctx, hash)
+	if errors.Is(err, ethereum.NotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("podproofs: failed to read the receipt of %s: %w", hash, err)
+	}
+	return receipt, nil
+}
+
+// validators returns the IDs of the pod's validators, first searching for
+// any restaked since the last search if they are found from events.
+func (w *WatchedPod) validators(ctx context.Context) ([]string, error) {
+	if w.Validators != nil {
+		return w.Validators, nil
+	}
+	it, err := w.Pod.FilterValidatorRestaked(&bind.FilterOpts{Start: w.next, Context: ctx})
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+	for it.Next() {
+		w.discovered = append(w.discovered, it.Event.ValidatorIndex.String())
+		w.next = max(w.next, it.Event.Raw.BlockNumber+1)
+	}
+	return w.discovered, it.Error()
+}
+
+func (b *Balances) oracleTimestamp(ctx context.Context, slot uint64) (uint64, error) {
+	if b.OracleTimestamp != nil {
+		return b.OracleTimestamp(ctx, slot)
+	}
+	genesis, err := b.Beacon.Genesis(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return genesis.GenesisTime + slot*constants.BeaconChainProofsSecondsPerSlot, nil
+}
+
+// epoch returns the epoch of timestamp, as EigenPod._timestampToEpoch
+// computes it.
+func (b *Balances) epoch(ctx context.Context, timestamp uint64) (uint64, error) {
+	genesis, err := b.Beacon.Genesis(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if timestamp < genesis.GenesisTime {
+		return 0, fmt.Errorf("podproofs: timestamp %d is before genesis at %d", timestamp, genesis.GenesisTime)
+	}
+	return (timestamp - genesis.GenesisTime) / constants.BeaconChainProofsSecondsPerEpoch, nil
+}
+
+func (b *Balances) now() uint64 {
+	if b.Now != nil {
+		return b.Now()
+	}
+	return uint64(time.Now().Unix())
+}
@@ -0,0 +1,288 @@
+package podproofs_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/beacon/beacontest"
+	eigenpod "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/EigenPod"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/constants"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/fakes"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/podproofs"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/reverts"
+)
+
+// The slots of the states of the balance tests, an epoch apart.
+const (
+	restakeSlot = 9_000_000
+	updateSlot  = restakeSlot + 32
+	laterSlot   = updateSlot + 32
+)
+
+// round runs a round of b, failing t if it fails, and returns the report
+// of its only pod.
+func round(t *testing.T, e *env, b *podproofs.Balances) *podproofs.BalanceReport {
+	t.Helper()
+	reps, err := b.Round(context.Background(), e.opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reps) != 1 {
+		t.Fatalf("round reported %d pods, want 1", len(reps))
+	}
+	return reps[0]
+}
+
+func (e *env) restakedGwei(t *testing.T, index uint64) uint64 {
+	t.Helper()
+	info, err := e.pod.ValidatorPubkeyToInfo(nil, beacontest.Pubkey(index))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return info.RestakedBalanceGwei
+}
+
+func (e *env) shares(t *testing.T) *big.Int {
+	t.Helper()
+	shares, err := e.EigenPodManager.PodOwnerShares(nil, owner)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return shares
+}
+
+func gwei(n uint64) *big.Int {
+	return new(big.Int).Mul(new(big.Int).SetUint64(n), big.NewInt(1e9))
+}
+
+func TestBalancesRound(t *testing.T) {
+	e := newEnv(t, restakeSlot)
+	e.addState(t, restakeSlot, e.validator(0, 32e9), e.validator(1, 32e9), e.validator(2, 32e9), e.validator(3, 32e9), e.validator(4, 40e9))
+	e.restake(t, e.replay(t), restakeSlot, 0, 1, 2, 4)
+
+	withdrawable := e.validator(2, 0)
+	withdrawable.WithdrawableEpoch = e.beacon.Epoch(updateSlot)
+	e.addState(t, updateSlot, e.validator(0, 31e9), e.validator(1, 32e9), withdrawable, e.validator(3, 32e9), e.validator(4, 40e9))
+	client := e.replay(t)
+	before := e.shares(t)
+
+	w := &podproofs.WatchedPod{Address: e.pod.Address(), Pod: e.pod, Validators: []string{"0", "1", "2", "3", "4"}}
+	b := &podproofs.Balances{Beacon: client, Oracle: e.archive, Manager: e.EigenPodManager, Pods: []*podproofs.WatchedPod{w}, Now: e.Chain.Time}
+	rep := round(t, e, b)
+	checkResults(t, rep.Report, []want{
+		{"0", podproofs.Confirmed, nil},
+		{"1", podproofs.Skipped, podproofs.ErrUpToDate},
+		{"2", podproofs.Skipped, reverts.ErrValidatorWithdrawableButNotWithdrawn},
+		{"3", podproofs.Skipped, reverts.ErrValidatorNotActive},
+		// The restaked balance is capped at 32 ETH.
+		{"4", podproofs.Skipped, podproofs.ErrUpToDate},
+	})
+	if len(rep.Txs) != 1 || len(rep.Updates) != 1 || len(rep.SharesUpdates) != 1 {
+		t.Fatalf("%d transactions, %d balance updates and %d shares updates, want 1 of each", len(rep.Txs), len(rep.Updates), len(rep.SharesUpdates))
+	}
+	if ev := rep.Updates[0]; ev.ValidatorIndex.Uint64() != 0 || ev.NewValidatorBalanceGwei != 31e9 || ev.BalanceTimestamp != e.beacon.Timestamp(updateSlot) {
+		t.Errorf("balance update of validator %d to %d gwei at %d", ev.ValidatorIndex, ev.NewValidatorBalanceGwei, ev.BalanceTimestamp)
+	}
+	if got, want := rep.SharesDelta(), gwei(1e9); got.Cmp(new(big.Int).Neg(want)) != 0 {
+		t.Errorf("shares delta %s, want -%s", got, want)
+	}
+	if got, want := e.shares(t), new(big.Int).Sub(before, gwei(1e9)); got.Cmp(want) != 0 {
+		t.Errorf("pod owner shares %s, want %s", got, want)
+	}
+	if got := e.restakedGwei(t, 0); got != 31e9 {
+		t.Errorf("validator 0 restaked %d gwei, want 31e9", got)
+	}
+	if w.FromBlock != e.Chain.BlockNumber()+1 {
+		t.Errorf("pod searched from block %d, want %d", w.FromBlock, e.Chain.BlockNumber()+1)
+	}
+
+	// The pod's validators are found from its ValidatorRestaked events, and
+	// a validator is not updated twice at a timestamp.
+	w.Validators = nil
+	rep = round(t, e, b)
+	checkResults(t, rep.Report, []want{
+		{"0", podproofs.Skipped, reverts.ErrValidatorsBalanceAlreadyUpdatedForThisTimestamp},
+		{"1", podproofs.Skipped, podproofs.ErrUpToDate},
+		{"2", podproofs.Skipped, reverts.ErrValidatorWithdrawableButNotWithdrawn},
+		{"4", podproofs.Skipped, podproofs.ErrUpToDate},
+	})
+	if len(rep.Txs) != 0 {
+		t.Errorf("sent %d transactions for no diverged validators", len(rep.Txs))
+	}
+}
+
+func TestBalancesStale(t *testing.T) {
+	e := newEnv(t, restakeSlot)
+	e.addState(t, restakeSlot, e.validator(0, 32e9))
+	e.restake(t, e.replay(t), restakeSlot, 0)
+	e.addState(t, updateSlot, e.validator(0, 31e9))
+	client := e.replay(t)
+
+	e.Chain.AdvanceTime(e.beacon.Timestamp(updateSlot) + constants.EigenPodVerifyBalanceUpdateWindowSeconds + 1 - e.Chain.Time())
+	w := &podproofs.WatchedPod{Address: e.pod.Address(), Pod: e.pod}
+	b := &podproofs.Balances{Beacon: client, Oracle: e.archive, Manager: e.EigenPodManager, Pods: []*podproofs.WatchedPod{w}, Now: e.Chain.Time}
+	rep := round(t, e, b)
+	checkResults(t, rep.Report, []want{{"0", podproofs.Skipped, reverts.ErrSpecifiedTimestampTooFarInPast}})
+	if got := e.restakedGwei(t, 0); got != 32e9 {
+		t.Errorf("validator 0 restaked %d gwei, want 32e9", got)
+	}
+}
+
+// mempool is a pod whose balance updates wait to be mined or dropped, and
+// the backend knowing what became of them. A transaction it has neither
+// mined nor dropped is pending.
+type mempool struct {
+	*fakes.EigenPod
+	// sent are the transactions sent to the pod, in order.
+	sent     []*types.Transaction
+	held     map[common.Hash]func() error
+	receipts map[common.Hash]*types.Receipt
+	dropped  map[common.Hash]bool
+}
+
+var (
+	_ podproofs.BalancePod       = (*mempool)(nil)
+	_ bind.DeployBackend         = (*mempool)(nil)
+	_ ethereum.TransactionReader = (*mempool)(nil)
+)
+
+func newMempool(pod *fakes.EigenPod) *mempool {
+	return &mempool{
+		EigenPod: pod,
+		held:     make(map[common.Hash]func() error),
+		receipts: make(map[common.Hash]*types.Receipt),
+		dropped:  make(map[common.Hash]bool),
+	}
+}
+
+// VerifyBalanceUpdates holds the update back until it is mined.
+func (m *mempool) VerifyBalanceUpdates(opts *bind.TransactOpts, oracleTimestamp uint64, validatorIndices []*big.Int, stateRootProof eigenpod.BeaconChainProofsStateRootProof, validatorFieldsProofs [][]byte, validatorFields [][][32]byte) (*types.Transaction, error) {
+	tx := types.NewTx(&types.LegacyTx{Nonce: uint64(len(m.sent))})
+	m.sent = append(m.sent, tx)
+	m.held[tx.Hash()] = func() error {
+		_, err := m.EigenPod.VerifyBalanceUpdates(opts, oracleTimestamp, validatorIndices, stateRootProof, validatorFieldsProofs, validatorFields)
+		return err
+	}
+	return tx, nil
+}
+
+// mine executes the held transaction tx.
+func (m *mempool) mine(t *testing.T, tx *types.Transaction) {
+	t.Helper()
+	receipt := &types.Receipt{TxHash: tx.Hash(), Status: types.ReceiptStatusSuccessful}
+	if err := m.held[tx.Hash()](); err != nil {
+		receipt.Status = types.ReceiptStatusFailed
+	}
+	m.receipts[tx.Hash()] = receipt
+}
+
+func (m *mempool) CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error) {
+	return nil, nil
+}
+
+func (m *mempool) TransactionReceipt(ctx context.Context, hash common.Hash) (*types.Receipt, error) {
+	if r, ok := m.receipts[hash]; ok {
+		return r, nil
+	}
+	return nil, ethereum.NotFound
+}
+
+func (m *mempool) TransactionByHash(ctx context.Context, hash common.Hash) (*types.Transaction, bool, error) {
+	if m.dropped[hash] {
+		return nil, false, ethereum.NotFound
+	}
+	return nil, m.receipts[hash] == nil, nil
+}
+
+func (m *mempool) TransactionSender(ctx context.Context, tx *types.Transaction, block common.Hash, index uint) (common.Address, error) {
+	return owner, nil
+}
+
+func (m *mempool) TransactionCount(ctx context.Context, blockHash common.Hash) (uint, error) {
+	return 0, nil
+}
+
+func (m *mempool) TransactionInBlock(ctx context.Context, blockHash common.Hash, index uint) (*types.Transaction, error) {
+	return nil, ethereum.NotFound
+}
+
+func TestBalancesPending(t *testing.T) {
+	e := newEnv(t, restakeSlot)
+	e.addState(t, restakeSlot, e.validator(0, 32e9), e.validator(1, 32e9))
+	e.restake(t, e.replay(t), restakeSlot, 0, 1)
+	e.addState(t, updateSlot, e.validator(0, 31e9), e.validator(1, 32e9))
+
+	pool := newMempool(e.pod)
+	w := &podproofs.WatchedPod{Address: e.pod.Address(), Pod: pool}
+	b := &podproofs.Balances{Beacon: e.replay(t), Oracle: e.archive, Manager: e.EigenPodManager, Pods: []*podproofs.WatchedPod{w}, Now: e.Chain.Time, Backend: pool}
+
+	steps := []struct {
+		name string
+		// before runs before the round.
+		before func()
+		want   []want
+		// sent is whether the round sends a transaction.
+		sent bool
+	}{
+		{
+			name: "sent",
+			want: []want{{"0", podproofs.Submitted, nil}, {"1", podproofs.Skipped, podproofs.ErrUpToDate}},
+			sent: true,
+		},
+		{
+			name: "pending",
+			want: []want{{"0", podproofs.Skipped, podproofs.ErrPending}, {"1", podproofs.Skipped, podproofs.ErrUpToDate}},
+		},
+		{
+			name:   "mined",
+			before: func() { pool.mine(t, pool.sent[len(pool.sent)-1]) },
+			want:   []want{{"0", podproofs.Skipped, reverts.ErrValidatorsBalanceAlreadyUpdatedForThisTimestamp}, {"1", podproofs.Skipped, podproofs.ErrUpToDate}},
+		},
+		{
+			name: "sent at a later state",
+			before: func() {
+				e.addState(t, laterSlot, e.validator(0, 30e9), e.validator(1, 32e9))
+				b.Beacon, b.Oracle = e.replay(t), e.archive
+			},
+			want: []want{{"0", podproofs.Submitted, nil}, {"1", podproofs.Skipped, podproofs.ErrUpToDate}},
+			sent: true,
+		},
+		{
+			name:   "dropped",
+			before: func() { pool.dropped[pool.sent[len(pool.sent)-1].Hash()] = true },
+			want:   []want{{"0", podproofs.Submitted, nil}, {"1", podproofs.Skipped, podproofs.ErrUpToDate}},
+			sent:   true,
+		},
+		{
+			// A pending update too old for the pod is dropped: the round
+			// skips the validator for the timestamp rather than the update.
+			name: "expired",
+			before: func() {
+				e.Chain.AdvanceTime(e.beacon.Timestamp(laterSlot) + constants.EigenPodVerifyBalanceUpdateWindowSeconds + 1 - e.Chain.Time())
+			},
+			want: []want{{"0", podproofs.Skipped, reverts.ErrSpecifiedTimestampTooFarInPast}, {"1", podproofs.Skipped, reverts.ErrSpecifiedTimestampTooFarInPast}},
+		},
+	}
+	for _, step := range steps {
+		if step.before != nil {
+			step.before()
+		}
+		sent := len(pool.sent)
+		rep := round(t, e, b)
+		t.Run(step.name, func(t *testing.T) {
+			checkResults(t, rep.Report, step.want)
+			if got := len(pool.sent) > sent; got != step.sent {
+				t.Errorf("sent a transaction: %v, want %v", got, step.sent)
+			}
+		})
+	}
+	if got := e.restakedGwei(t, 0); got != 31e9 {
+		t.Errorf("validator 0 restaked %d gwei, want the 31e9 of the only update mined", got)
+	}
+}
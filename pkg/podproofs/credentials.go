@@ -0,0 +1,200 @@
+package podproofs
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/beacon"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/beaconproofs"
+	eigenpod "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/EigenPod"
+	eigenpodmanager "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/EigenPodManager"
+	ibeaconchainoracle "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/IBeaconChainOracle"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/constants"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/prooffile"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/reverts"
+)
+
+// CredentialPod is the subset of the EigenPod binding Credentials reads
+// and writes.
+type CredentialPod interface {
+	GENESISTIME(opts *bind.CallOpts) (uint64, error)
+	HasRestaked(opts *bind.CallOpts) (bool, error)
+	MostRecentWithdrawalTimestamp(opts *bind.CallOpts) (uint64, error)
+	ValidatorPubkeyToInfo(opts *bind.CallOpts, validatorPubkey []byte) (eigenpod.IEigenPodValidatorInfo, error)
+	VerifyWithdrawalCredentials(opts *bind.TransactOpts, oracleTimestamp uint64, stateRootProof eigenpod.BeaconChainProofsStateRootProof, validatorIndices []*big.Int, validatorFieldsProofs [][]byte, validatorFields [][][32]byte) (*types.Transaction, error)
+}
+
+var _ CredentialPod = (*eigenpod.EigenPod)(nil)
+
+// PauseReader is the subset of the EigenPodManager binding Credentials reads
+// the pause flags of its pods from.
+type PauseReader interface {
+	Paused(opts *bind.CallOpts, index uint8) (bool, error)
+}
+
+var _ PauseReader = (*eigenpodmanager.EigenPodManager)(nil)
+
+// CredentialGas estimates VerifyWithdrawalCredentials from the storage,
+// calldata and hashing of the pod's code path, with about a third to spare.
+//
+// The base of about 120,000 is the 21,000 of the transaction, the pause,
+// restaking and timestamp checks, the oracle lookup and state root proof,
+// the first write to activeValidatorCount, and the writes of
+// EigenPodManager.recordBeaconChainETHBalanceUpdate: the owner's shares,
+// and the operator's shares in the DelegationManager, each up to 22,100
+// when set from zero.
+//
+// Each validator costs about 72,000: up to 30,000 for the calldata of its
+// 46 proof siblings and 8 fields, 13,000 for the 53 sha256 precompile
+// calls proving them, 22,100 for its new ValidatorInfo slot and 2,000 for
+// its ValidatorRestaked and ValidatorBalanceUpdated events.
+var CredentialGas = GasModel{Base: 150_000, PerItem: 100_000}
+
+// Credentials proves that validators' withdrawal credentials point at a
+// pod, restaking them.
+type Credentials struct {
+	Beacon beacon.Client
+	Oracle ibeaconchainoracle.IBeaconChainOracleReader
+	// Manager is the pod's EigenPodManager, which pauses credential proofs
+	// for every pod.
+	Manager PauseReader
+	Pod     CredentialPod
+	// PodAddress is the address of Pod, which the withdrawal credentials
+	// of its validators hold.
+	PodAddress common.Address
+	// Now returns the timestamp of the chain's latest block, which proofs
+	// must be within the update window of. It defaults to the wall clock.
+	Now func() uint64
+	// Gas and GasLimit bound the validators of a transaction. They default
+	// to CredentialGas and DefaultGasLimit.
+	Gas      GasModel
+	GasLimit uint64
+}
+
+// Prepare proves the validators ids name in the state stateID names,
+// against the block root the oracle serves at oracleTimestamp. It fails
+// with the pkg/reverts error the pod would revert every validator with if
+// the EigenPodManager has paused credential proofs, the pod has not enabled
+// restaking or oracleTimestamp is too early or too old for it. Validators the pod would reject alone are skipped with the
+// reason it would revert with: those it has already restaked or withdrawn,
+// and those whose withdrawal credentials are not the pod's.
+func (c *Credentials) Prepare(ctx context.Context, stateID string, oracleTimestamp uint64, ids []string) (*Report, error) {
+	if err := c.checkPod(ctx, oracleTimestamp); err != nil {
+		return nil, err
+	}
+	// Credential proofs do not depend on the fork schedule.
+	p, slot, err := prover(ctx, c.Beacon, stateID, beaconproofs.UnsetSchedule)
+	if err != nil {
+		return nil, err
+	}
+	results, err := lookup(ctx, c.Beacon, slot, ids)
+	if err != nil {
+		return nil, err
+	}
+	rep := &Report{OracleTimestamp: oracleTimestamp, StateRoot: p.StateRoot(), Results: results}
+	credentials := beaconproofs.PodWithdrawalCredentials(c.PodAddress)
+	for _, r := range rep.ready() {
+		info, err := c.Pod.ValidatorPubkeyToInfo(&bind.CallOpts{Context: ctx}, r.Validator.Validator.Pubkey)
+		if err != nil {
+			return nil, fmt.Errorf("podproofs: failed to read validator %d of pod %s: %w", r.Validator.Index, c.PodAddress, err)
+		}
+		if info.Status != ValidatorInactive {
+			r.skip(reverts.FromReason("EigenPod.verifyCorrectWithdrawalCredentials: Validator must be inactive to prove withdrawal credentials"))
+			continue
+		}
+		proof, err := p.WithdrawalCredentialProof(r.Validator.Index)
+		if err != nil {
+			r.skip(err)
+			continue
+		}
+		got, err := beaconproofs.ValidatorFields(proof.ValidatorFields).WithdrawalCredentials()
+		if err != nil {
+			r.skip(err)
+			continue
+		}
+		if got != credentials {
+			r.skip(reverts.FromReason("EigenPod.verifyCorrectWithdrawalCredentials: Proof is not for this EigenPod"))
+			continue
+		}
+		r.Proof = proof
+	}
+	return rep, rep.checkStateRoot(ctx, c.Oracle)
+}
+
+// checkPod checks the conditions under which the pod rejects a proof at
+// oracleTimestamp whatever its validators, in the order it checks them.
+func (c *Credentials) checkPod(ctx context.Context, oracleTimestamp uint64) error {
+	call := &bind.CallOpts{Context: ctx}
+	paused, err := c.Manager.Paused(call, uint8(constants.EigenPodManagerPausedEigenPodsVerifyCredentials))
+	if err != nil {
+		return fmt.Errorf("podproofs: failed to read whether credential proofs are paused: %w", err)
+	}
+	if paused {
+		return reverts.FromReason("EigenPod.onlyWhenNotPaused: index is paused in EigenPodManager")
+	}
+	hasRestaked, err := c.Pod.HasRestaked(call)
+	if err != nil {
+		return fmt.Errorf("podproofs: failed to read whether pod %s has restaked: %w", c.PodAddress, err)
+	}
+	if !hasRestaked {
+		return reverts.FromReason("EigenPod.hasEnabledRestaking: restaking is not enabled")
+	}
+	// Pods that restaked after M2 have never withdrawn before restaking.
+	mostRecentWithdrawal, err := c.Pod.MostRecentWithdrawalTimestamp(call)
+	if err != nil {
+		return fmt.Errorf("podproofs: failed to read the most recent withdrawal of pod %s: %w", c.PodAddress, err)
+	}
+	if mostRecentWithdrawal != 0 {
+		genesis, err := c.Pod.GENESISTIME(call)
+		if err != nil {
+			return fmt.Errorf("podproofs: failed to read the genesis time of pod %s: %w", c.PodAddress, err)
+		}
+		if mostRecentWithdrawal < genesis {
+			return reverts.FromReason("EigenPod._timestampToEpoch: timestamp is before genesis")
+		}
+		epoch := (mostRecentWithdrawal - genesis) / constants.BeaconChainProofsSecondsPerEpoch
+		if oracleTimestamp < genesis+(epoch+1)*constants.BeaconChainProofsSecondsPerEpoch {
+			return reverts.FromReason("EigenPod.verifyWithdrawalCredentials: proof must be in the epoch after activation")
+		}
+	}
+	if oracleTimestamp+constants.EigenPodVerifyBalanceUpdateWindowSeconds < c.now() {
+		return reverts.FromReason("EigenPod.verifyWithdrawalCredentials: specified timestamp is too far in past")
+	}
+	return nil
+}
+
+func (c *Credentials) now() uint64 {
+	if c.Now != nil {
+		return c.Now()
+	}
+	return uint64(time.Now().Unix())
+}
+
+// Submit sends the ready validators of rep to the pod, a batch per
+// transaction.
+func (c *Credentials) Submit(opts *bind.TransactOpts, rep *Report) error {
+	gas := c.Gas
+	if gas == (GasModel{}) {
+		gas = CredentialGas
+	}
+	return rep.submit(gas, c.GasLimit, func(files []*prooffile.File) (*types.Transaction, error) {
+		validatorIndices, validatorFieldsProofs, validatorFields := prooffile.Credentials(files...)
+		return c.Pod.VerifyWithdrawalCredentials(opts, rep.OracleTimestamp, rep.stateRootProof, validatorIndices, validatorFieldsProofs, validatorFields)
+	})
+}
+
+// Run prepares and submits the validators ids name. The report it returns
+// along with an error holds the outcome of every validator up to it.
+func (c *Credentials) Run(ctx context.Context, opts *bind.TransactOpts, stateID string, oracleTimestamp uint64, ids []string) (*Report, error) {
+	rep, err := c.Prepare(ctx, stateID, oracleTimestamp, ids)
+	if err != nil {
+		return rep, err
+	}
+	return rep, c.Submit(opts, rep)
+}
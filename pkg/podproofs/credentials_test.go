@@ -0,0 +1,164 @@
+package podproofs_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strconv"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/constants"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/fakes"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/podproofs"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/reverts"
+)
+
+// The slot of the state of the credential tests.
+const credentialSlot = 9_000_000
+
+func TestCredentialsRun(t *testing.T) {
+	e := newEnv(t, credentialSlot)
+	other := e.validator(3, 32e9)
+	other.WithdrawalCredentials = common.Hash{1}
+	e.addState(t, credentialSlot, e.validator(0, 32e9), e.validator(1, 32e9), e.validator(2, 40e9), other, e.validator(4, 32e9))
+	client := e.replay(t)
+	e.restake(t, client, credentialSlot, 0)
+
+	// Two validators fit a transaction.
+	c := &podproofs.Credentials{
+		Beacon:     client,
+		Oracle:     e.archive,
+		Manager:    e.EigenPodManager,
+		Pod:        e.pod,
+		PodAddress: e.pod.Address(),
+		Now:        e.Chain.Time,
+		Gas:        podproofs.GasModel{Base: 100, PerItem: 10},
+		GasLimit:   125,
+	}
+	ids := []string{"0", "1", "2", "3", "4", "1"}
+	rep, err := c.Run(context.Background(), e.opts, strconv.Itoa(credentialSlot), e.beacon.Timestamp(credentialSlot), ids)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkResults(t, rep, []want{
+		{"0", podproofs.Skipped, reverts.ErrValidatorMustBeInactiveToProveWithdrawalCredentials},
+		{"1", podproofs.Submitted, nil},
+		{"2", podproofs.Submitted, nil},
+		{"3", podproofs.Skipped, reverts.ErrProofNotForThisEigenPod},
+		{"4", podproofs.Submitted, nil},
+		{"1", podproofs.Skipped, podproofs.ErrDuplicate},
+	})
+	if len(rep.Txs) != 2 || rep.Results[1].Tx != rep.Txs[0] || rep.Results[2].Tx != rep.Txs[0] || rep.Results[4].Tx != rep.Txs[1] {
+		t.Errorf("sent %d transactions, want validators 1 and 2 in the first and 4 in the second", len(rep.Txs))
+	}
+	for _, index := range []uint64{0, 1, 2, 4} {
+		if got := e.restakedGwei(t, index); got != 32e9 {
+			t.Errorf("validator %d restaked %d gwei, want 32e9", index, got)
+		}
+	}
+	if got := e.restakedGwei(t, 3); got != 0 {
+		t.Errorf("validator 3 of another pod restaked %d gwei", got)
+	}
+	if got := e.shares(t); got.Cmp(gwei(4*32e9)) != 0 {
+		t.Errorf("pod owner shares %s, want %s", got, gwei(4*32e9))
+	}
+}
+
+// legacyPod is a pod deployed before M2, which has not enabled restaking
+// or has withdrawn before it did.
+type legacyPod struct {
+	*fakes.EigenPod
+	hasRestaked          bool
+	mostRecentWithdrawal uint64
+}
+
+func (p *legacyPod) HasRestaked(opts *bind.CallOpts) (bool, error) {
+	return p.hasRestaked, nil
+}
+
+func (p *legacyPod) MostRecentWithdrawalTimestamp(opts *bind.CallOpts) (uint64, error) {
+	return p.mostRecentWithdrawal, nil
+}
+
+func TestCredentialsPrepare(t *testing.T) {
+	tests := []struct {
+		name string
+		// setup runs before Prepare, and may replace its pod.
+		setup           func(t *testing.T, e *env, c *podproofs.Credentials)
+		oracleTimestamp func(e *env) uint64
+		err             error
+	}{
+		{
+			name: "ready",
+		},
+		{
+			name: "paused",
+			setup: func(t *testing.T, e *env, c *podproofs.Credentials) {
+				flag := new(big.Int).Lsh(common.Big1, uint(constants.EigenPodManagerPausedEigenPodsVerifyCredentials))
+				if _, err := e.EigenPodManager.Pause(e.opts, flag); err != nil {
+					t.Fatal(err)
+				}
+			},
+			err: reverts.ErrIndexPausedInEigenPodManager,
+		},
+		{
+			name: "restaking not enabled",
+			setup: func(t *testing.T, e *env, c *podproofs.Credentials) {
+				c.Pod = &legacyPod{EigenPod: e.pod}
+			},
+			err: reverts.ErrRestakingNotEnabled,
+		},
+		{
+			name: "withdrawn in the epoch of the proof",
+			setup: func(t *testing.T, e *env, c *podproofs.Credentials) {
+				c.Pod = &legacyPod{EigenPod: e.pod, hasRestaked: true, mostRecentWithdrawal: e.beacon.Timestamp(credentialSlot)}
+			},
+			err: reverts.ErrProofMustBeInEpochAfterActivation,
+		},
+		{
+			name: "stale oracle timestamp",
+			setup: func(t *testing.T, e *env, c *podproofs.Credentials) {
+				e.Chain.AdvanceTime(constants.EigenPodVerifyBalanceUpdateWindowSeconds + 1)
+			},
+			err: reverts.ErrSpecifiedTimestampTooFarInPast,
+		},
+		{
+			name:            "no block root at the oracle timestamp",
+			oracleTimestamp: func(e *env) uint64 { return e.beacon.Timestamp(credentialSlot + 1) },
+			err:             reverts.ErrStateRootAtTimestampNotFinalized,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := newEnv(t, credentialSlot)
+			e.addState(t, credentialSlot, e.validator(0, 32e9))
+			c := &podproofs.Credentials{
+				Beacon:     e.replay(t),
+				Oracle:     e.archive,
+				Manager:    e.EigenPodManager,
+				Pod:        e.pod,
+				PodAddress: e.pod.Address(),
+				Now:        e.Chain.Time,
+			}
+			if tt.setup != nil {
+				tt.setup(t, e, c)
+			}
+			oracleTimestamp := e.beacon.Timestamp(credentialSlot)
+			if tt.oracleTimestamp != nil {
+				oracleTimestamp = tt.oracleTimestamp(e)
+			}
+			rep, err := c.Prepare(context.Background(), strconv.Itoa(credentialSlot), oracleTimestamp, []string{"0"})
+			switch {
+			case tt.err == nil && err != nil:
+				t.Fatal(err)
+			case tt.err != nil && !errors.Is(err, tt.err):
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			case tt.err == nil:
+				checkResults(t, rep, []want{{"0", podproofs.Ready, nil}})
+			}
+		})
+	}
+}
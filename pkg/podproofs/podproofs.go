@@ -0,0 +1,291 @@
+// Package podproofs proves the beacon chain state of an EigenPod's
+// validators to the pod.
+//
+// Each pipeline reads the beacon chain through a beacon.Client, builds its
+// proofs with proofgen and checks them with beaconproofs against the block
+// root the oracle serves, and against the pod's own records, before
+// anything is sent: a single bad validator reverts the whole transaction it
+// is batched in, with a reason that does not say which validator it was.
+// Validators that pass are submitted in batches whose estimated gas fits a
+// limit, and a Report records the outcome of every one.
+//
+// Credentials restakes validators with VerifyWithdrawalCredentials.
+// Balances is a long-running service keeping the restaked balances of a
+// set of pods current with VerifyBalanceUpdates. Withdrawals proves the
+// withdrawals the beacon chain swept to a pod with
+// VerifyAndProcessWithdrawals. Run against a fakes.Deployment, with a
+// beacon.Replayer as the client and a beaconoracle.Archive as the oracle,
+// none of them needs a node.
+package podproofs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/beacon"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/beaconproofs"
+	eigenpod "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/EigenPod"
+	ibeaconchainoracle "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/IBeaconChainOracle"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/prooffile"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/proofgen"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/reverts"
+)
+
+// The VALIDATOR_STATUS of a validator in IEigenPod.ValidatorInfo.
+const (
+	ValidatorInactive uint8 = iota
+	ValidatorActive
+	ValidatorWithdrawn
+)
+
+var (
+	// ErrValidatorNotFound is the error of a validator the beacon state
+	// does not have.
+	ErrValidatorNotFound = errors.New("podproofs: validator not found")
+	// ErrDuplicate is the error of a validator requested more than once.
+	ErrDuplicate = errors.New("podproofs: validator requested more than once")
+)
+
+// GasModel estimates the gas of a pod transaction as a base cost plus a
+// cost per validator or withdrawal it proves.
+type GasModel struct {
+	Base    uint64
+	PerItem uint64
+}
+
+// Gas returns the estimated gas of a transaction proving n items.
+func (m GasModel) Gas(n int) uint64 {
+	return m.Base + uint64(n)*m.PerItem
+}
+
+// BatchSize returns the number of items a transaction may prove within
+// gasLimit.
+func (m GasModel) BatchSize(gasLimit uint64) (int, error) {
+	if m.PerItem == 0 || gasLimit < m.Gas(1) {
+		return 0, fmt.Errorf("podproofs: gas limit %d is below the %d gas of a single item", gasLimit, m.Gas(1))
+	}
+	return int((gasLimit - m.Base) / m.PerItem), nil
+}
+
+// DefaultGasLimit is the gas limit of a batch when none is given. It keeps
+// the calldata of a batch within the 128 KiB nodes accept in a transaction.
+const DefaultGasLimit = 5_000_000
+
+// Outcome is what became of a validator or withdrawal.
+type Outcome string
+
+const (
+	// Ready has passed every check and awaits submission.
+	Ready Outcome = "ready"
+	// Skipped failed a check and was not submitted; Result.Err says why.
+	Skipped Outcome = "skipped"
+	// Deferred cannot be proven against the report's state yet, and is to
+	// be retried against a later one; Result.Err says why.
+	Deferred Outcome = "deferred"
+	// Submitted was sent in Result.Tx.
+	Submitted Outcome = "submitted"
+	// Failed was in a batch that could not be sent; Result.Err says why.
+	Failed Outcome = "failed"
+	// Confirmed was in a transaction that was mined and succeeded.
+	Confirmed Outcome = "confirmed"
+	// Reverted was in a transaction that was mined and reverted.
+	Reverted Outcome = "reverted"
+)
+
+// Result is the outcome of proving one validator or withdrawal.
+type Result struct {
+	// ID is the validator ID the result was asked for by.
+	ID string
+	// Validator is the validator in the beacon state, or nil if the state
+	// does not have it.
+	Validator *beacon.Validator
+	Outcome   Outcome
+	// Err is why the validator was skipped or its batch failed.
+	Err error
+	// Proof is the proof submitted, once the validator is ready.
+	Proof *prooffile.File
+	// Tx is the transaction the proof was submitted in.
+	Tx *types.Transaction
+	// Kind is whether a withdrawal is full or partial.
+	Kind WithdrawalKind
+}
+
+// skip marks r as skipped for err.
+func (r *Result) skip(err error) {
+	r.Outcome, r.Err = Skipped, err
+}
+
+// Report is the outcome of a run of a pipeline against one beacon state.
+type Report struct {
+	OracleTimestamp uint64
+	StateRoot       common.Hash
+	Results         []*Result
+	// Txs are the transactions sent, in order.
+	Txs []*types.Transaction
+
+	stateRootProof eigenpod.BeaconChainProofsStateRootProof
+}
+
+// Count returns the number of results with outcome o.
+func (rep *Report) Count(o Outcome) int {
+	n := 0
+	for _, r := range rep.Results {
+		if r.Outcome == o {
+			n++
+		}
+	}
+	return n
+}
+
+// ready returns the results awaiting submission.
+func (rep *Report) ready() []*Result {
+	var out []*Result
+	for _, r := range rep.Results {
+		if r.Outcome == Ready {
+			out = append(out, r)
+		}
+	}
+	return out
+}
+
+// submit sends the ready results in batches that fit gasLimit under gas,
+// marking each submitted or failed. It stops at the first batch that cannot
+// be sent, failing the rest.
+func (rep *Report) submit(gas GasModel, gasLimit uint64, send func(batch []*prooffile.File) (*types.Transaction, error)) error {
+	if gasLimit == 0 {
+		gasLimit = DefaultGasLimit
+	}
+	size, err := gas.BatchSize(gasLimit)
+	if err != nil {
+		return err
+	}
+	ready := rep.ready()
+	var sendErr error
+	for start := 0; start < len(ready); start += size {
+		batch := ready[start:min(start+size, len(ready))]
+		if sendErr != nil {
+			for _, r := range batch {
+				r.Outcome, r.Err = Failed, sendErr
+			}
+			continue
+		}
+		files := make([]*prooffile.File, len(batch))
+		for i, r := range batch {
+			files[i] = r.Proof
+		}
+		tx, err := send(files)
+		if err != nil {
+			sendErr = reverts.Decode(err)
+			for _, r := range batch {
+				r.Outcome, r.Err = Failed, sendErr
+			}
+			continue
+		}
+		rep.Txs = append(rep.Txs, tx)
+		for _, r := range batch {
+			r.Outcome, r.Tx = Submitted, tx
+		}
+	}
+	return sendErr
+}
+
+// Confirm waits for every transaction of the report to be mined and marks
+// its results confirmed or reverted.
+func (rep *Report) Confirm(ctx context.Context, backend bind.DeployBackend) error {
+	for _, tx := range rep.Txs {
+		receipt, err := bind.WaitMined(ctx, backend, tx)
+		if err != nil {
+			return err
+		}
+		rep.mined(tx, receipt)
+	}
+	return nil
+}
+
+// mined marks the submitted results of tx confirmed or reverted as its
+// receipt says.
+func (rep *Report) mined(tx *types.Transaction, receipt *types.Receipt) {
+	outcome := Confirmed
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		outcome = Reverted
+	}
+	for _, r := range rep.Results {
+		if r.Tx == tx && r.Outcome == Submitted {
+			r.Outcome = outcome
+		}
+	}
+}
+
+// checkStateRoot checks the state root proof of the ready results against
+// the block root the oracle serves at the report's timestamp, failing as
+// the pod would if the oracle serves no root or the root of another block.
+func (rep *Report) checkStateRoot(ctx context.Context, oracle ibeaconchainoracle.IBeaconChainOracleReader) error {
+	ready := rep.ready()
+	if len(ready) == 0 {
+		return nil
+	}
+	rep.stateRootProof = ready[0].Proof.ToStateRootProof()
+	root, err := oracle.TimestampToBlockRoot(&bind.CallOpts{Context: ctx}, new(big.Int).SetUint64(rep.OracleTimestamp))
+	if err != nil {
+		return err
+	}
+	if root == ([32]byte{}) {
+		return reverts.FromReason("EigenPodManager.getBlockRootAtTimestamp: state root at timestamp not yet finalized")
+	}
+	if err := beaconproofs.VerifyStateRootProof(root, rep.stateRootProof.Canonical()); err != nil {
+		return fmt.Errorf("podproofs: state %s is not the state of block %s served at %d: %w", rep.StateRoot, common.Hash(root), rep.OracleTimestamp, err)
+	}
+	return nil
+}
+
+// prover loads the state stateID names and returns its prover, with the
+// slot of the state as an ID naming the same state in later requests.
+func prover(ctx context.Context, client beacon.Client, stateID string, schedule beaconproofs.ForkSchedule) (*proofgen.Prover, string, error) {
+	state, err := client.State(ctx, stateID)
+	if err != nil {
+		return nil, "", err
+	}
+	p, err := proofgen.NewProver(state, schedule)
+	if err != nil {
+		return nil, "", err
+	}
+	return p, strconv.FormatUint(state.Slot(), 10), nil
+}
+
+// lookup returns a result for each of ids holding its validator in the
+// state stateID names, skipping those the state does not have and repeats
+// of a validator already asked for.
+func lookup(ctx context.Context, client beacon.Client, stateID string, ids []string) ([]*Result, error) {
+	vs, err := client.Validators(ctx, stateID, ids)
+	if err != nil {
+		return nil, err
+	}
+	seen := make(map[uint64]bool)
+	results := make([]*Result, len(ids))
+	for i, id := range ids {
+		r := &Result{ID: id, Outcome: Ready}
+		results[i] = r
+		for j := range vs {
+			if vs[j].HasID(id) {
+				r.Validator = &vs[j]
+				break
+			}
+		}
+		switch {
+		case r.Validator == nil:
+			r.skip(ErrValidatorNotFound)
+		case seen[r.Validator.Index]:
+			r.skip(ErrDuplicate)
+		default:
+			seen[r.Validator.Index] = true
+		}
+	}
+	return results, nil
+}
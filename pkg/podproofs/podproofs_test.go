@@ -0,0 +1,143 @@
+package podproofs_test
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/beacon"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/beacon/beacontest"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/beaconoracle"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/beaconproofs"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/fakes"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/podproofs"
+)
+
+var (
+	owner  = common.HexToAddress("0x1000")
+	oracle = common.HexToAddress("0x2000")
+)
+
+// env is a deployment of the core contract fakes whose EigenPodManager
+// reads block roots from the headers of a beacon chain built in memory,
+// and owner's pod on it.
+type env struct {
+	*fakes.Deployment
+	beacon *beacontest.Chain
+	pod    *fakes.EigenPod
+	opts   *bind.TransactOpts
+	// archive is the oracle, once the beacon chain is replayed.
+	archive *beaconoracle.Archive
+}
+
+// newEnv starts the chain at the timestamp of slot of the beacon chain,
+// with owner's pod deployed.
+func newEnv(t *testing.T, slot uint64) *env {
+	t.Helper()
+	cfg := fakes.DefaultConfig(owner)
+	cfg.BeaconChainOracle = oracle
+	chain := beacontest.NewChain(cfg.BeaconGenesisTime, beaconproofs.ForkSchedule{DenebForkTimestamp: cfg.DenebForkTimestamp})
+	d, err := fakes.NewDeployment(fakes.NewChain(chain.Timestamp(slot)), cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	opts := &bind.TransactOpts{From: owner}
+	if _, err := d.EigenPodManager.CreatePod(opts); err != nil {
+		t.Fatal(err)
+	}
+	return &env{Deployment: d, beacon: chain, pod: d.EigenPodManager.Pod(owner), opts: opts}
+}
+
+// validator returns the i'th validator, withdrawing to the pod with
+// balance gwei.
+func (e *env) validator(i, balance uint64) beacontest.Validator {
+	return beacontest.Validator{
+		Pubkey:                beacontest.Pubkey(i),
+		WithdrawalCredentials: beaconproofs.PodWithdrawalCredentials(e.pod.Address()),
+		EffectiveBalance:      balance,
+		Balance:               balance,
+		ExitEpoch:             beaconproofs.FarFutureEpoch,
+		WithdrawableEpoch:     beaconproofs.FarFutureEpoch,
+	}
+}
+
+// addState adds the finalized state at slot, failing t if it cannot be
+// built.
+func (e *env) addState(t *testing.T, slot uint64, validators ...beacontest.Validator) {
+	t.Helper()
+	if _, err := e.beacon.AddState(slot, validators, "finalized"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// replay records the beacon chain as fixtures and returns a client
+// replaying them, having the oracle serve the block roots of the recorded
+// headers.
+func (e *env) replay(t *testing.T) beacon.Client {
+	t.Helper()
+	dir := t.TempDir()
+	if err := e.beacon.Record(dir); err != nil {
+		t.Fatal(err)
+	}
+	genesis, err := e.beacon.Genesis(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	archive, err := beaconoracle.Load(genesis.GenesisTime, filepath.Join(dir, "headers"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	e.Chain.Register(oracle, archive)
+	e.archive = archive
+	return beacon.NewReplayer(dir)
+}
+
+// restake proves the withdrawal credentials of the validators with the
+// given indices against the finalized state at slot.
+func (e *env) restake(t *testing.T, client beacon.Client, slot uint64, indices ...uint64) {
+	t.Helper()
+	c := &podproofs.Credentials{
+		Beacon:     client,
+		Oracle:     e.archive,
+		Manager:    e.EigenPodManager,
+		Pod:        e.pod,
+		PodAddress: e.pod.Address(),
+		Now:        e.Chain.Time,
+	}
+	ids := make([]string, len(indices))
+	for i, index := range indices {
+		ids[i] = strconv.FormatUint(index, 10)
+	}
+	rep, err := c.Run(context.Background(), e.opts, strconv.FormatUint(slot, 10), e.beacon.Timestamp(slot), ids)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := rep.Count(podproofs.Submitted); n != len(ids) {
+		t.Fatalf("restaked %d of %d validators: %+v", n, len(ids), rep.Results)
+	}
+}
+
+// want is the expected outcome of a result of a report.
+type want struct {
+	id      string
+	outcome podproofs.Outcome
+	err     error
+}
+
+func checkResults(t *testing.T, rep *podproofs.Report, wants []want) {
+	t.Helper()
+	if len(rep.Results) != len(wants) {
+		t.Fatalf("%d results, want %d", len(rep.Results), len(wants))
+	}
+	for i, w := range wants {
+		r := rep.Results[i]
+		if r.ID != w.id || r.Outcome != w.outcome || !errors.Is(r.Err, w.err) || w.err == nil && r.Err != nil {
+			t.Errorf("result %s: %s, %v; want result %s %s, %v", r.ID, r.Outcome, r.Err, w.id, w.outcome, w.err)
+		}
+	}
+}
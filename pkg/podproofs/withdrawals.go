@@ -0,0 +1,419 @@
+package podproofs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/beacon"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/beaconproofs"
+	eigenpod "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/EigenPod"
+	ibeaconchainoracle "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/IBeaconChainOracle"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/constants"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/prooffile"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/proofgen"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/reverts"
+)
+
+// slotsPerHistoricalRoot is the number of slots whose block roots a
+// historical summary holds.
+const slotsPerHistoricalRoot = 8192
+
+// ErrNotSummarized is the error of a withdrawal deferred because the state
+// proven against has no historical summary of its block's period yet.
+var ErrNotSummarized = errors.New("podproofs: period not yet summarized")
+
+// WithdrawalKind is how the pod processes a withdrawal.
+type WithdrawalKind string
+
+const (
+	// FullWithdrawal is a withdrawal at or after the validator's
+	// withdrawable epoch, which the pod queues as restaked ETH.
+	FullWithdrawal WithdrawalKind = "full"
+	// PartialWithdrawal is a withdrawal of rewards before the validator's
+	// withdrawable epoch, which the pod sends to the pod owner.
+	PartialWithdrawal WithdrawalKind = "partial"
+)
+
+// ClassifyWithdrawal returns the kind of a withdrawal in the block at slot
+// of a validator with withdrawableEpoch, as EigenPod._verifyAndProcessWithdrawal
+// decides it.
+func ClassifyWithdrawal(slot, withdrawableEpoch uint64) WithdrawalKind {
+	if slot/constants.BeaconChainProofsSlotsPerEpoch >= withdrawableEpoch {
+		return FullWithdrawal
+	}
+	return PartialWithdrawal
+}
+
+// WithdrawalPod is the subset of the EigenPod binding Withdrawals reads,
+// writes and filters.
+type WithdrawalPod interface {
+	MostRecentWithdrawalTimestamp(opts *bind.CallOpts) (uint64, error)
+	PodOwner(opts *bind.CallOpts) (common.Address, error)
+	ProvenWithdrawal(opts *bind.CallOpts, arg0 [32]byte, arg1 uint64) (bool, error)
+	ValidatorPubkeyHashToInfo(opts *bind.CallOpts, validatorPubkeyHash [32]byte) (eigenpod.IEigenPodValidatorInfo, error)
+	VerifyAndProcessWithdrawals(opts *bind.TransactOpts, oracleTimestamp uint64, stateRootProof eigenpod.BeaconChainProofsStateRootProof, withdrawalProofs []eigenpod.BeaconChainProofsWithdrawalProof, validatorFieldsProofs [][]byte, validatorFields [][][32]byte, withdrawalFields [][][32]byte) (*types.Transaction, error)
+	FilterFullWithdrawalRedeemed(opts *bind.FilterOpts, recipient []common.Address) (*eigenpod.EigenPodFullWithdrawalRedeemedIterator, error)
+	FilterPartialWithdrawalRedeemed(opts *bind.FilterOpts, recipient []common.Address) (*eigenpod.EigenPodPartialWithdrawalRedeemedIterator, error)
+}
+
+var _ WithdrawalPod = (*eigenpod.EigenPod)(nil)
+
+// WithdrawalGas estimates VerifyAndProcessWithdrawals: the state root
+// proof, the oracle lookup, the delayed withdrawal and the shares update
+// of the EigenPodManager, then the calldata and proofs of each withdrawal
+// and its validator, and the storage it updates.
+var WithdrawalGas = GasModel{Base: 250_000, PerItem: 200_000}
+
+// WithdrawalReport is the outcome of proving the withdrawals of a pod
+// against one beacon state.
+type WithdrawalReport struct {
+	*Report
+	PodOwner common.Address
+	// Full and Partial are the FullWithdrawalRedeemed and
+	// PartialWithdrawalRedeemed events of the report's transactions.
+	Full    []*eigenpod.EigenPodFullWithdrawalRedeemed
+	Partial []*eigenpod.EigenPodPartialWithdrawalRedeemed
+}
+
+// AmountGwei returns the total amount of the withdrawals of kind the pod
+// redeemed in the report's transactions.
+func (rep *WithdrawalReport) AmountGwei(kind WithdrawalKind) *big.Int {
+	total := new(big.Int)
+	if kind == FullWithdrawal {
+		for _, ev := range rep.Full {
+			total.Add(total, new(big.Int).SetUint64(ev.WithdrawalAmountGwei))
+		}
+		return total
+	}
+	for _, ev := range rep.Partial {
+		total.Add(total, new(big.Int).SetUint64(ev.PartialWithdrawalAmountGwei))
+	}
+	return total
+}
+
+// Withdrawals proves the withdrawals the beacon chain swept to a pod.
+type Withdrawals struct {
+	Beacon beacon.Client
+	Oracle ibeaconchainoracle.IBeaconChainOracleReader
+	// Manager is the pod's EigenPodManager, whose Deneb fork timestamp
+	// decides the layout of each withdrawal proof.
+	Manager beaconproofs.DenebForkTimestampReader
+	Pod     WithdrawalPod
+	// PodAddress is the address of Pod, which the withdrawals it is sent
+	// are to.
+	PodAddress common.Address
+	// Backend, if set, is waited on for the receipts of the transactions
+	// sent before their events are read.
+	Backend bind.DeployBackend
+	// FromBlock is the first block searched for the events of the
+	// transactions sent. It advances past the events of each reconciled
+	// report.
+	FromBlock uint64
+	// Gas and GasLimit bound the withdrawals of a transaction. They default
+	// to WithdrawalGas and DefaultGasLimit.
+	Gas      GasModel
+	GasLimit uint64
+}
+
+// Prepare proves the withdrawals to the pod in the blocks blockIDs name,
+// against the state stateID names and the block root the oracle serves at
+// oracleTimestamp. Each result is the withdrawal of a block, with an ID of
+// the block's slot and the withdrawal's index in it, classified as full or
+// partial. Withdrawals the pod would reject are skipped with the reason it
+// would revert with: those before the pod's most recent withdrawal
+// timestamp, those of validators it has not restaked, and those it has
+// already recorded in ProvenWithdrawal. Withdrawals in the period of the
+// state, whose block roots it has not summarized yet, are deferred with
+// ErrNotSummarized.
+func (w *Withdrawals) Prepare(ctx context.Context, stateID string, oracleTimestamp uint64, blockIDs []string) (*WithdrawalReport, error) {
+	call := &bind.CallOpts{Context: ctx}
+	schedule, err := beaconproofs.ReadForkSchedule(call, w.Manager)
+	if err != nil {
+		return nil, err
+	}
+	p, slot, err := prover(ctx, w.Beacon, stateID, schedule)
+	if err != nil {
+		return nil, err
+	}
+	stateSlot, err := strconv.ParseUint(slot, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	podOwner, err := w.Pod.PodOwner(call)
+	if err != nil {
+		return nil, fmt.Errorf("podproofs: failed to read the owner of pod %s: %w", w.PodAddress, err)
+	}
+	mostRecent, err := w.Pod.MostRecentWithdrawalTimestamp(call)
+	if err != nil {
+		return nil, fmt.Errorf("podproofs: failed to read the most recent withdrawal timestamp of pod %s: %w", w.PodAddress, err)
+	}
+	rep := &WithdrawalReport{
+		Report:   &Report{OracleTimestamp: oracleTimestamp, StateRoot: p.StateRoot()},
+		PodOwner: podOwner,
+	}
+
+	type key struct {
+		pubkeyHash common.Hash
+		timestamp  uint64
+	}
+	seen := make(map[key]bool)
+	historical := make(map[uint64]*proofgen.State)
+	for _, blockID := range blockIDs {
+		block, err := w.Beacon.Block(ctx, blockID)
+		if err != nil {
+			return rep, err
+		}
+		withdrawals, err := w.blockWithdrawals(ctx, block, slot)
+		if err != nil {
+			return rep, err
+		}
+		for _, bw := range withdrawals {
+			r := bw.Result
+			rep.Results = append(rep.Results, r)
+			if r.Validator == nil {
+				r.skip(ErrValidatorNotFound)
+				continue
+			}
+			pubkeyHash, err := beaconproofs.HashValidatorBLSPubkey(r.Validator.Validator.Pubkey)
+			if err != nil {
+				r.skip(err)
+				continue
+			}
+			r.Kind = ClassifyWithdrawal(block.Slot(), r.Validator.Validator.WithdrawableEpoch)
+			k := key{pubkeyHash, bw.timestamp}
+			if seen[k] {
+				r.skip(ErrDuplicate)
+				continue
+			}
+			seen[k] = true
+			if bw.timestamp < mostRecent {
+				r.skip(reverts.FromReason("EigenPod.proofIsForValidTimestamp: beacon chain proof must be at or after mostRecentWithdrawalTimestamp"))
+				continue
+			}
+			info, err := w.Pod.ValidatorPubkeyHashToInfo(call, pubkeyHash)
+			if err != nil {
+				return rep, fmt.Errorf("podproofs: failed to read validator %d of pod %s: %w", r.Validator.Index, w.PodAddress, err)
+			}
+			if info.Status == ValidatorInactive {
+				r.skip(reverts.FromReason("EigenPod._verifyAndProcessWithdrawal: Validator never proven to have withdrawal credentials pointed to this contract"))
+				continue
+			}
+			proven, err := w.Pod.ProvenWithdrawal(call, pubkeyHash, bw.timestamp)
+			if err != nil {
+				return rep, fmt.Errorf("podproofs: failed to read withdrawal %s of pod %s: %w", r.ID, w.PodAddress, err)
+			}
+			if proven {
+				r.skip(reverts.FromReason("EigenPod._verifyAndProcessWithdrawal: withdrawal has already been proven for this timestamp"))
+				continue
+			}
+
+			// The block roots of the block's period are summarized in the
+			// state at the start of the next one.
+			period := block.Slot()/slotsPerHistoricalRoot + 1
+			if period*slotsPerHistoricalRoot > stateSlot {
+				r.Outcome, r.Err = Deferred, ErrNotSummarized
+				continue
+			}
+			if historical[period] == nil {
+				state, err := w.Beacon.State(ctx, strconv.FormatUint(period*slotsPerHistoricalRoot, 10))
+				if err != nil {
+					return rep, err
+				}
+				historical[period] = state
+			}
+			proof, err := p.WithdrawalProof(historical[period], block, bw.index)
+			if err != nil {
+				r.skip(err)
+				continue
+			}
+			r.Proof = proof
+		}
+	}
+	return rep, rep.checkStateRoot(ctx, w.Oracle)
+}
+
+// blockWithdrawal is a withdrawal to the pod in a block.
+type blockWithdrawal struct {
+	*Result
+	index     uint64
+	timestamp uint64
+}
+
+// blockWithdrawals returns the withdrawals of block to the pod, with their
+// validators in the state at slot.
+func (w *Withdrawals) blockWithdrawals(ctx context.Context, block *proofgen.Block, slot string) ([]blockWithdrawal, error) {
+	payload := block.Value.Field("body").Field("execution_payload")
+	timestamp := payload.Field("timestamp").Uint64()
+	withdrawals := payload.Field("withdrawals")
+	var out []blockWithdrawal
+	var ids []string
+	for i := 0; i < withdrawals.Len(); i++ {
+		withdrawal := withdrawals.Index(i)
+		if common.BytesToAddress(withdrawal.Field("address").Bytes()) != w.PodAddress {
+			continue
+		}
+		id := strconv.FormatUint(withdrawal.Field("validator_index").Uint64(), 10)
+		ids = append(ids, id)
+		out = append(out, blockWithdrawal{
+			Result:    &Result{ID: fmt.Sprintf("%d/%d", block.Slot(), i), Outcome: Ready},
+			index:     uint64(i),
+			timestamp: timestamp,
+		})
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	vs, err := w.Beacon.Validators(ctx, slot, ids)
+	if err != nil {
+		return nil, err
+	}
+	for i, bw := range out {
+		for j := range vs {
+			if vs[j].HasID(ids[i]) {
+				bw.Validator = &vs[j]
+				break
+			}
+		}
+	}
+	return out, nil
+}
+
+// Submit sends the ready withdrawals of rep to the pod, a batch per
+// transaction.
+func (w *Withdrawals) Submit(opts *bind.TransactOpts, rep *WithdrawalReport) error {
+	gas := w.Gas
+	if gas == (GasModel{}) {
+		gas = WithdrawalGas
+	}
+	return rep.submit(gas, w.GasLimit, func(files []*prooffile.File) (*types.Transaction, error) {
+		withdrawalProofs, validatorFieldsProofs, validatorFields, withdrawalFields := prooffile.Withdrawals(files...)
+		return w.Pod.VerifyAndProcessWithdrawals(opts, rep.OracleTimestamp, rep.stateRootProof, withdrawalProofs, validatorFieldsProofs, validatorFields, withdrawalFields)
+	})
+}
+
+// Reconcile reads the FullWithdrawalRedeemed and PartialWithdrawalRedeemed
+// events of rep's transactions, marking each submitted withdrawal
+// confirmed once the pod has redeemed it. A withdrawal the pod redeemed as
+// another kind, or for another amount, than the proof is confirmed with an
+// error saying so. With a Backend, the receipts decide whether a
+// withdrawal is confirmed. Withdrawals whose transaction has no events yet
+// are left submitted.
+func (w *Withdrawals) Reconcile(ctx context.Context, rep *WithdrawalReport) error {
+	if len(rep.Txs) == 0 {
+		return nil
+	}
+	if w.Backend != nil {
+		if err := rep.Confirm(ctx, w.Backend); err != nil {
+			return err
+		}
+	}
+	sent := make(map[common.Hash]bool)
+	for _, tx := range rep.Txs {
+		sent[tx.Hash()] = true
+	}
+	filter := &bind.FilterOpts{Start: w.FromBlock, Context: ctx}
+	fullNext, err := w.fullRedeemed(rep, filter, sent)
+	if err != nil {
+		return err
+	}
+	partialNext, err := w.partialRedeemed(rep, filter, sent)
+	if err != nil {
+		return err
+	}
+
+	type redeemed struct {
+		kind   WithdrawalKind
+		amount uint64
+	}
+	type key struct {
+		tx             common.Hash
+		validatorIndex uint64
+		timestamp      uint64
+	}
+	events := make(map[key]redeemed)
+	for _, ev := range rep.Full {
+		events[key{ev.Raw.TxHash, ev.ValidatorIndex.Uint64(), ev.WithdrawalTimestamp}] = redeemed{FullWithdrawal, ev.WithdrawalAmountGwei}
+	}
+	for _, ev := range rep.Partial {
+		events[key{ev.Raw.TxHash, ev.ValidatorIndex.Uint64(), ev.WithdrawalTimestamp}] = redeemed{PartialWithdrawal, ev.PartialWithdrawalAmountGwei}
+	}
+	for _, r := range rep.Results {
+		if r.Outcome != Submitted && r.Outcome != Confirmed {
+			continue
+		}
+		proof := r.Proof.ToWithdrawalProof().Canonical()
+		amountGwei, err := beaconproofs.WithdrawalFields(r.Proof.WithdrawalFields).AmountGwei()
+		if err != nil {
+			return err
+		}
+		ev, ok := events[key{r.Tx.Hash(), r.Validator.Index, beaconproofs.WithdrawalTimestamp(proof)}]
+		if !ok {
+			continue
+		}
+		r.Outcome = Confirmed
+		if ev.kind != r.Kind || ev.amount != amountGwei {
+			r.Err = fmt.Errorf("podproofs: withdrawal %s of %d gwei was redeemed as a %s withdrawal of %d gwei", r.ID, amountGwei, ev.kind, ev.amount)
+		}
+	}
+	w.FromBlock = max(w.FromBlock, fullNext, partialNext)
+	return nil
+}
+
+// fullRedeemed adds the FullWithdrawalRedeemed events to the pod owner
+// from filter's start that were emitted by the sent transactions to rep,
+// returning the block after the last of them.
+func (w *Withdrawals) fullRedeemed(rep *WithdrawalReport, filter *bind.FilterOpts, sent map[common.Hash]bool) (uint64, error) {
+	it, err := w.Pod.FilterFullWithdrawalRedeemed(filter, []common.Address{rep.PodOwner})
+	if err != nil {
+		return 0, err
+	}
+	defer it.Close()
+	next := filter.Start
+	for it.Next() {
+		if ev := it.Event; sent[ev.Raw.TxHash] {
+			rep.Full = append(rep.Full, ev)
+			next = max(next, ev.Raw.BlockNumber+1)
+		}
+	}
+	return next, it.Error()
+}
+
+// partialRedeemed adds the PartialWithdrawalRedeemed events to the pod
+// owner from filter's start that were emitted by the sent transactions to
+// rep, returning the block after the last of them.
+func (w *Withdrawals) partialRedeemed(rep *WithdrawalReport, filter *bind.FilterOpts, sent map[common.Hash]bool) (uint64, error) {
+	it, err := w.Pod.FilterPartialWithdrawalRedeemed(filter, []common.Address{rep.PodOwner})
+	if err != nil {
+		return 0, err
+	}
+	defer it.Close()
+	next := filter.Start
+	for it.Next() {
+		if ev := it.Event; sent[ev.Raw.TxHash] {
+			rep.Partial = append(rep.Partial, ev)
+			next = max(next, ev.Raw.BlockNumber+1)
+		}
+	}
+	return next, it.Error()
+}
+
+// Run prepares, submits and reconciles the withdrawals to the pod in the
+// blocks blockIDs name. The report it returns along with an error holds
+// the outcome of every withdrawal up to it.
+func (w *Withdrawals) Run(ctx context.Context, opts *bind.TransactOpts, stateID string, oracleTimestamp uint64, blockIDs []string) (*WithdrawalReport, error) {
+	rep, err := w.Prepare(ctx, stateID, oracleTimestamp, blockIDs)
+	if err != nil {
+		return rep, err
+	}
+	if err := w.Submit(opts, rep); err != nil {
+		return rep, err
+	}
+	return rep, w.Reconcile(ctx, rep)
+}
@@ -0,0 +1,158 @@
+package podproofs_test
+
+import (
+	"context"
+	"math/big"
+	"strconv"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/beacon/beacontest"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/beaconproofs"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/fakes"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/podproofs"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/reverts"
+)
+
+func TestClassifyWithdrawal(t *testing.T) {
+	const epoch = 1000
+	tests := []struct {
+		name              string
+		slot              uint64
+		withdrawableEpoch uint64
+		want              podproofs.WithdrawalKind
+	}{
+		{"last slot before the withdrawable epoch", epoch*32 - 1, epoch, podproofs.PartialWithdrawal},
+		{"first slot of the withdrawable epoch", epoch * 32, epoch, podproofs.FullWithdrawal},
+		{"last slot of the withdrawable epoch", epoch*32 + 31, epoch, podproofs.FullWithdrawal},
+		{"after the withdrawable epoch", (epoch + 1) * 32, epoch, podproofs.FullWithdrawal},
+		{"withdrawable from genesis", 0, 0, podproofs.FullWithdrawal},
+		{"never withdrawable", 1 << 40, beaconproofs.FarFutureEpoch, podproofs.PartialWithdrawal},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := podproofs.ClassifyWithdrawal(tt.slot, tt.withdrawableEpoch); got != tt.want {
+				t.Errorf("ClassifyWithdrawal(%d, %d) = %s, want %s", tt.slot, tt.withdrawableEpoch, got, tt.want)
+			}
+		})
+	}
+}
+
+// The slots of the withdrawal tests. The Capella block is in the last
+// period before Deneb, whose fork is at slot 8626176 under the default
+// config, and the Deneb blocks in the period before the state's. The
+// recent block is in the state's own period, which it has not summarized.
+const (
+	withdrawalSlot = 9_000_000
+	denebPeriod    = withdrawalSlot/8192 - 1
+	partialSlot    = denebPeriod*8192 + 10
+	fullSlot       = denebPeriod*8192 + 64
+	capellaSlot    = 1052*8192 + 5
+	recentSlot     = withdrawalSlot - 8
+)
+
+func TestWithdrawalsRun(t *testing.T) {
+	e := newEnv(t, withdrawalSlot)
+	pod, other := e.pod.Address(), common.HexToAddress("0x3000")
+	e.Chain.Mint(fakes.ETH, pod, gwei(64e9))
+	blocks := map[uint64][]beacontest.Withdrawal{
+		capellaSlot: {{ValidatorIndex: 0, Address: pod, Amount: 0.5e9}},
+		partialSlot: {
+			{ValidatorIndex: 0, Address: pod, Amount: 1e9},
+			{ValidatorIndex: 5, Address: other, Amount: 2e9},
+			{ValidatorIndex: 0, Address: pod, Amount: 1e9},
+			{ValidatorIndex: 2, Address: pod, Amount: 1e9},
+		},
+		// Validator 1 was slashed down to 31 ETH before it was swept.
+		fullSlot:   {{ValidatorIndex: 1, Address: pod, Amount: 31e9}},
+		recentSlot: {{ValidatorIndex: 0, Address: pod, Amount: 0.5e9}},
+	}
+	for _, slot := range []uint64{capellaSlot, partialSlot, fullSlot, recentSlot} {
+		if _, err := e.beacon.AddBlock(slot, blocks[slot]...); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// The block roots of each period are summarized in the state at the
+	// start of the next one.
+	for _, slot := range []uint64{(capellaSlot/8192 + 1) * 8192, (denebPeriod + 1) * 8192} {
+		if _, err := e.beacon.AddState(slot, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	exited := e.validator(1, 32e9)
+	exited.Balance, exited.ExitEpoch, exited.WithdrawableEpoch = 0, e.beacon.Epoch(fullSlot), e.beacon.Epoch(fullSlot)
+	e.addState(t, withdrawalSlot, e.validator(0, 32e9), exited, e.validator(2, 32e9))
+	client := e.replay(t)
+	e.restake(t, client, withdrawalSlot, 0, 1)
+	before := e.shares(t)
+
+	w := &podproofs.Withdrawals{Beacon: client, Oracle: e.archive, Manager: e.EigenPodManager, Pod: e.pod, PodAddress: pod}
+	blockIDs := []string{strconv.Itoa(capellaSlot), strconv.Itoa(partialSlot), strconv.Itoa(fullSlot), strconv.Itoa(recentSlot)}
+	rep, err := w.Run(context.Background(), e.opts, "finalized", e.beacon.Timestamp(withdrawalSlot), blockIDs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	never := reverts.ErrValidatorNeverProvenToWithdrawalCredentialsPointedToThisContract
+	checkResults(t, rep.Report, []want{
+		{blockIDs[0] + "/0", podproofs.Confirmed, nil},
+		{blockIDs[1] + "/0", podproofs.Confirmed, nil},
+		{blockIDs[1] + "/2", podproofs.Skipped, podproofs.ErrDuplicate},
+		{blockIDs[1] + "/3", podproofs.Skipped, never},
+		{blockIDs[2] + "/0", podproofs.Confirmed, nil},
+		{blockIDs[3] + "/0", podproofs.Deferred, podproofs.ErrNotSummarized},
+	})
+	for i, kind := range []podproofs.WithdrawalKind{podproofs.PartialWithdrawal, podproofs.PartialWithdrawal, podproofs.PartialWithdrawal, podproofs.PartialWithdrawal, podproofs.FullWithdrawal, podproofs.PartialWithdrawal} {
+		if r := rep.Results[i]; r.Kind != kind {
+			t.Errorf("withdrawal %s is %s, want %s", r.ID, r.Kind, kind)
+		}
+	}
+	if len(rep.Full) != 1 || len(rep.Partial) != 2 {
+		t.Fatalf("%d full and %d partial withdrawals redeemed, want 1 and 2", len(rep.Full), len(rep.Partial))
+	}
+	if got := rep.AmountGwei(podproofs.FullWithdrawal); got.Cmp(big.NewInt(31e9)) != 0 {
+		t.Errorf("full withdrawals of %s gwei, want 31e9", got)
+	}
+	if got := rep.AmountGwei(podproofs.PartialWithdrawal); got.Cmp(big.NewInt(1.5e9)) != 0 {
+		t.Errorf("partial withdrawals of %s gwei, want 1.5e9", got)
+	}
+	// The pod queues the full withdrawal, taking the 1 ETH validator 1
+	// lost from the owner's shares, and sends the partial ones on.
+	if got, want := e.shares(t), new(big.Int).Sub(before, gwei(1e9)); got.Cmp(want) != 0 {
+		t.Errorf("pod owner shares %s, want %s", got, want)
+	}
+	if got, err := e.pod.WithdrawableRestakedExecutionLayerGwei(nil); err != nil || got != 31e9 {
+		t.Errorf("withdrawable restaked gwei %d, %v; want 31e9", got, err)
+	}
+	if got, err := e.pod.SumOfPartialWithdrawalsClaimedGwei(nil); err != nil || got != 1.5e9 {
+		t.Errorf("partial withdrawals claimed %d gwei, %v; want 1.5e9", got, err)
+	}
+	router, err := e.pod.DelayedWithdrawalRouter(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := e.Chain.BalanceOf(fakes.ETH, router); got.Cmp(gwei(1.5e9)) != 0 {
+		t.Errorf("delayed withdrawals of %s wei, want %s", got, gwei(1.5e9))
+	}
+	if w.FromBlock != e.Chain.BlockNumber()+1 {
+		t.Errorf("pod searched from block %d, want %d", w.FromBlock, e.Chain.BlockNumber()+1)
+	}
+
+	// A withdrawal is not proven twice.
+	rep, err = w.Run(context.Background(), e.opts, "finalized", e.beacon.Timestamp(withdrawalSlot), blockIDs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	proven := reverts.ErrWithdrawalAlreadyProvenForThisTimestamp
+	checkResults(t, rep.Report, []want{
+		{blockIDs[0] + "/0", podproofs.Skipped, proven},
+		{blockIDs[1] + "/0", podproofs.Skipped, proven},
+		{blockIDs[1] + "/2", podproofs.Skipped, podproofs.ErrDuplicate},
+		{blockIDs[1] + "/3", podproofs.Skipped, never},
+		{blockIDs[2] + "/0", podproofs.Skipped, proven},
+		{blockIDs[3] + "/0", podproofs.Deferred, podproofs.ErrNotSummarized},
+	})
+	if len(rep.Txs) != 0 {
+		t.Errorf("sent %d transactions for proven withdrawals", len(rep.Txs))
+	}
+}
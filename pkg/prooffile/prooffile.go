@@ -0,0 +1,385 @@
+// Package prooffile reads and writes the EigenPod proof files in
+// src/test/test-data, the JSON format the proof generator emits and the
+// Solidity tests consume: withdrawal credential proofs
+// (withdrawal_credential_proof_*.json), balance update proofs
+// (balanceUpdateProof_*.json) and withdrawal proofs
+// (fullWithdrawalProof_*.json, partialWithdrawalProof_*.json). A File
+// converts to the proof structs of the EigenPod binding, and writes back out
+// with its keys in the order they were read.
+//
+// Credentials and Withdrawals assemble the array arguments of
+// VerifyWithdrawalCredentials, VerifyBalanceUpdates and
+// VerifyAndProcessWithdrawals from several files.
+package prooffile
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"math/big"
+	"os"
+	"path/filepath"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	eigenpod "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/EigenPod"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/merkle"
+)
+
+// File is a proof file. Its fields are named after the keys of the format;
+// proofs are the siblings from the leaf up.
+type File struct {
+	Slot                   uint64
+	ValidatorIndex         uint64
+	HistoricalSummaryIndex uint64
+	WithdrawalIndex        uint64
+	BlockHeaderRootIndex   uint64
+
+	BeaconStateRoot       common.Hash
+	SlotRoot              common.Hash
+	TimestampRoot         common.Hash
+	BlockHeaderRoot       common.Hash
+	BlockBodyRoot         common.Hash
+	ExecutionPayloadRoot  common.Hash
+	BalanceRoot           common.Hash
+	LatestBlockHeaderRoot common.Hash
+
+	SlotProof             []common.Hash
+	WithdrawalProof       []common.Hash
+	ValidatorProof        []common.Hash
+	TimestampProof        []common.Hash
+	ExecutionPayloadProof []common.Hash
+	ValidatorBalanceProof []common.Hash
+	// WithdrawalCredentialProof is the validator fields proof of
+	// withdrawal credential and balance update files, which withdrawal
+	// files call ValidatorProof.
+	WithdrawalCredentialProof              []common.Hash
+	StateRootAgainstLatestBlockHeaderProof []common.Hash
+	HistoricalSummaryProof                 []common.Hash
+	// LatestBlockHeaderProof is the proof of the latest block header in
+	// the state, which files predating StateRootAgainstLatestBlockHeaderProof
+	// carry instead.
+	LatestBlockHeaderProof []common.Hash
+
+	ValidatorFields  []common.Hash
+	WithdrawalFields []common.Hash
+
+	// keys are the keys read, in order, extra the values of those with no
+	// field and compact whether the file was on a single line, all kept to
+	// write the file back unchanged.
+	keys    []string
+	extra   map[string]json.RawMessage
+	compact bool
+}
+
+// values maps the keys of the format to the File fields holding them.
+func (f *File) values() map[string]any {
+	return map[string]any{
+		"slot":                                   &f.Slot,
+		"validatorIndex":                         &f.ValidatorIndex,
+		"historicalSummaryIndex":                 &f.HistoricalSummaryIndex,
+		"withdrawalIndex":                        &f.WithdrawalIndex,
+		"blockHeaderRootIndex":                   &f.BlockHeaderRootIndex,
+		"beaconStateRoot":                        &f.BeaconStateRoot,
+		"slotRoot":                               &f.SlotRoot,
+		"timestampRoot":                          &f.TimestampRoot,
+		"blockHeaderRoot":                        &f.BlockHeaderRoot,
+		"blockBodyRoot":                          &f.BlockBodyRoot,
+		"executionPayloadRoot":                   &f.ExecutionPayloadRoot,
+		"balanceRoot":                            &f.BalanceRoot,
+		"latestBlockHeaderRoot":                  &f.LatestBlockHeaderRoot,
+		"SlotProof":                              &f.SlotProof,
+		"WithdrawalProof":                        &f.WithdrawalProof,
+		"ValidatorProof":                         &f.ValidatorProof,
+		"TimestampProof":                         &f.TimestampProof,
+		"ExecutionPayloadProof":                  &f.ExecutionPayloadProof,
+		"ValidatorBalanceProof":                  &f.ValidatorBalanceProof,
+		"WithdrawalCredentialProof":              &f.WithdrawalCredentialProof,
+		"ValidatorFields":                        &f.ValidatorFields,
+		"WithdrawalFields":                       &f.WithdrawalFields,
+		"StateRootAgainstLatestBlockHeaderProof": &f.StateRootAgainstLatestBlockHeaderProof,
+		"HistoricalSummaryProof":                 &f.HistoricalSummaryProof,
+		"LatestBlockHeaderProof":                 &f.LatestBlockHeaderProof,
+	}
+}
+
+// Key orders of the files the generator writes, used for files that were
+// not read.
+var (
+	credentialKeys = []string{
+		"validatorIndex", "beaconStateRoot", "balanceRoot", "latestBlockHeaderRoot",
+		"ValidatorBalanceProof", "WithdrawalCredentialProof", "ValidatorFields",
+		"StateRootAgainstLatestBlockHeaderProof", "LatestBlockHeaderProof",
+	}
+	balanceUpdateKeys = []string{
+		"validatorIndex", "beaconStateRoot", "slotRoot", "balanceRoot", "latestBlockHeaderRoot",
+		"ValidatorBalanceProof", "ValidatorFields", "StateRootAgainstLatestBlockHeaderProof",
+		"LatestBlockHeaderProof", "WithdrawalCredentialProof",
+	}
+	withdrawalKeys = []string{
+		"slot", "validatorIndex", "historicalSummaryIndex", "withdrawalIndex", "blockHeaderRootIndex",
+		"beaconStateRoot", "slotRoot", "timestampRoot", "blockHeaderRoot", "blockBodyRoot",
+		"executionPayloadRoot", "latestBlockHeaderRoot", "SlotProof", "WithdrawalProof",
+		"ValidatorProof", "TimestampProof", "ExecutionPayloadProof", "ValidatorFields",
+		"WithdrawalFields", "StateRootAgainstLatestBlockHeaderProof", "HistoricalSummaryProof",
+	}
+)
+
+// IsWithdrawal reports whether f proves a withdrawal.
+func (f *File) IsWithdrawal() bool {
+	return f.WithdrawalFields != nil
+}
+
+// ErrNotProofFile is returned for JSON files holding no proofs, such as the
+// other fixtures in src/test/test-data.
+var ErrNotProofFile = errors.New("prooffile: not a proof file")
+
+// Load reads the proof file at path.
+func Load(path string) (*File, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	f := new(File)
+	if err := json.Unmarshal(data, f); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return f, nil
+}
+
+// LoadDir reads every proof file under dir, keyed by its path relative to
+// dir. JSON files that are not proof files are skipped.
+func LoadDir(dir string) (map[string]*File, error) {
+	out := make(map[string]*File)
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || filepath.Ext(path) != ".json" {
+			return err
+		}
+		f, err := Load(path)
+		if errors.Is(err, ErrNotProofFile) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		name, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		out[name] = f
+		return nil
+	})
+	return out, err
+}
+
+// Write writes f to path in the generator's layout, indented by four
+// spaces unless f was read from a file on a single line.
+func (f *File) Write(path string) error {
+	data, err := json.Marshal(f)
+	if err != nil {
+		return err
+	}
+	if f.compact {
+		return os.WriteFile(path, data, 0o644)
+	}
+	var out bytes.Buffer
+	if err := json.Indent(&out, data, "", "    "); err != nil {
+		return err
+	}
+	return os.WriteFile(path, out.Bytes(), 0o644)
+}
+
+// UnmarshalJSON reads a proof file, remembering the order of its keys.
+// Keys the format does not define are kept as they are. A file that is not
+// a JSON object, or whose ValidatorFields are missing, is not a proof file.
+// Anything but whitespace after the object is an error.
+func (f *File) UnmarshalJSON(data []byte) error {
+	*f = File{compact: !bytes.ContainsRune(bytes.TrimSpace(data), '\n')}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if tok, err := dec.Token(); err != nil || tok != json.Delim('{') {
+		return fmt.Errorf("%w: not a JSON object", ErrNotProofFile)
+	}
+	byKey := f.values()
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return fmt.Errorf("prooffile: %w", err)
+		}
+		key := tok.(string)
+		var raw json.RawMessage
+		if err := dec.Decode(&raw); err != nil {
+			return fmt.Errorf("prooffile: %s: %w", key, err)
+		}
+		f.keys = append(f.keys, key)
+		ptr, ok := byKey[key]
+		if !ok {
+			if f.extra == nil {
+				f.extra = make(map[string]json.RawMessage)
+			}
+			f.extra[key] = raw
+			continue
+		}
+		if err := json.Unmarshal(raw, ptr); err != nil {
+			return fmt.Errorf("prooffile: %s: %w", key, err)
+		}
+	}
+	if _, err := dec.Token(); err != nil {
+		return fmt.Errorf("prooffile: %w", err)
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return errors.New("prooffile: data after the proof object")
+	}
+	if f.ValidatorFields == nil {
+		return fmt.Errorf("%w: no ValidatorFields", ErrNotProofFile)
+	}
+	return nil
+}
+
+// MarshalJSON writes f with the keys it was read with, in their order,
+// followed by any it has since been given. Files that were not read are
+// written in the generator's order for their kind, leaving out roots and
+// proofs that are not set.
+func (f *File) MarshalJSON() ([]byte, error) {
+	byKey := f.values()
+	written := make(map[string]bool, len(f.keys))
+	for _, k := range f.keys {
+		written[k] = true
+	}
+	keys := append([]string(nil), f.keys...)
+	for _, k := range f.defaultKeys() {
+		ptr := byKey[k]
+		if written[k] || isZero(ptr) && (f.keys != nil || !isIndex(ptr)) {
+			continue
+		}
+		keys = append(keys, k)
+	}
+
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, k := range keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		name, _ := json.Marshal(k)
+		buf.Write(name)
+		buf.WriteByte(':')
+		var value []byte
+		if ptr, ok := byKey[k]; ok {
+			var err error
+			if value, err = json.Marshal(ptr); err != nil {
+				return nil, fmt.Errorf("prooffile: %s: %w", k, err)
+			}
+		} else {
+			value = f.extra[k]
+		}
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+func (f *File) defaultKeys() []string {
+	switch {
+	case f.IsWithdrawal():
+		return withdrawalKeys
+	case f.SlotRoot != (common.Hash{}):
+		return balanceUpdateKeys
+	default:
+		return credentialKeys
+	}
+}
+
+func isIndex(ptr any) bool {
+	_, ok := ptr.(*uint64)
+	return ok
+}
+
+func isZero(ptr any) bool {
+	switch v := ptr.(type) {
+	case *uint64:
+		return *v == 0
+	case *common.Hash:
+		return *v == common.Hash{}
+	case *[]common.Hash:
+		return *v == nil
+	}
+	return false
+}
+
+// ToStateRootProof returns the stateRootProof argument of the EigenPod
+// methods: the proof of the state root against the latest block root.
+func (f *File) ToStateRootProof() eigenpod.BeaconChainProofsStateRootProof {
+	return eigenpod.BeaconChainProofsStateRootProof{
+		BeaconStateRoot: f.BeaconStateRoot,
+		Proof:           merkle.PackProof(f.StateRootAgainstLatestBlockHeaderProof),
+	}
+}
+
+// ToWithdrawalProof returns the proof of the withdrawal against the state
+// root, as VerifyAndProcessWithdrawals takes it.
+func (f *File) ToWithdrawalProof() eigenpod.BeaconChainProofsWithdrawalProof {
+	return eigenpod.BeaconChainProofsWithdrawalProof{
+		WithdrawalProof:                 merkle.PackProof(f.WithdrawalProof),
+		SlotProof:                       merkle.PackProof(f.SlotProof),
+		ExecutionPayloadProof:           merkle.PackProof(f.ExecutionPayloadProof),
+		TimestampProof:                  merkle.PackProof(f.TimestampProof),
+		HistoricalSummaryBlockRootProof: merkle.PackProof(f.HistoricalSummaryProof),
+		BlockRootIndex:                  f.BlockHeaderRootIndex,
+		HistoricalSummaryIndex:          f.HistoricalSummaryIndex,
+		WithdrawalIndex:                 f.WithdrawalIndex,
+		BlockRoot:                       f.BlockHeaderRoot,
+		SlotRoot:                        f.SlotRoot,
+		TimestampRoot:                   f.TimestampRoot,
+		ExecutionPayloadRoot:            f.ExecutionPayloadRoot,
+	}
+}
+
+// ValidatorFieldsProof returns the proof of the validator's fields against
+// the state root.
+func (f *File) ValidatorFieldsProof() []byte {
+	if f.ValidatorProof != nil {
+		return merkle.PackProof(f.ValidatorProof)
+	}
+	return merkle.PackProof(f.WithdrawalCredentialProof)
+}
+
+// Credentials returns the validatorIndices, validatorFieldsProofs and
+// validatorFields arguments of EigenPod.VerifyWithdrawalCredentials and
+// VerifyBalanceUpdates proving the validators of files, which must share a
+// state root; the stateRootProof argument is ToStateRootProof of any of
+// them.
+func Credentials(files ...*File) (validatorIndices []*big.Int, validatorFieldsProofs [][]byte, validatorFields [][][32]byte) {
+	for _, f := range files {
+		validatorIndices = append(validatorIndices, new(big.Int).SetUint64(f.ValidatorIndex))
+		validatorFieldsProofs = append(validatorFieldsProofs, f.ValidatorFieldsProof())
+		validatorFields = append(validatorFields, Words(f.ValidatorFields))
+	}
+	return validatorIndices, validatorFieldsProofs, validatorFields
+}
+
+// Withdrawals returns the withdrawalProofs, validatorFieldsProofs,
+// validatorFields and withdrawalFields arguments of
+// EigenPod.VerifyAndProcessWithdrawals proving the withdrawals of files,
+// which must share a state root; the stateRootProof argument is
+// ToStateRootProof of any of them.
+func Withdrawals(files ...*File) (withdrawalProofs []eigenpod.BeaconChainProofsWithdrawalProof, validatorFieldsProofs [][]byte, validatorFields, withdrawalFields [][][32]byte) {
+	for _, f := range files {
+		withdrawalProofs = append(withdrawalProofs, f.ToWithdrawalProof())
+		validatorFieldsProofs = append(validatorFieldsProofs, f.ValidatorFieldsProof())
+		validatorFields = append(validatorFields, Words(f.ValidatorFields))
+		withdrawalFields = append(withdrawalFields, Words(f.WithdrawalFields))
+	}
+	return withdrawalProofs, validatorFieldsProofs, validatorFields, withdrawalFields
+}
+
+// Words converts fields to the [32]byte words the binding takes.
+func Words(fields []common.Hash) [][32]byte {
+	out := make([][32]byte, len(fields))
+	for i, f := range fields {
+		out[i] = f
+	}
+	return out
+}
@@ -0,0 +1,115 @@
+package prooffile_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/beaconproofs/beaconproofstest"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/prooffile"
+)
+
+// keys returns the keys of the JSON object data, in order.
+func keys(t *testing.T, data []byte) []string {
+	t.Helper()
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if _, err := dec.Token(); err != nil {
+		t.Fatal(err)
+	}
+	var keys []string
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			t.Fatal(err)
+		}
+		keys = append(keys, tok.(string))
+		var raw json.RawMessage
+		if err := dec.Decode(&raw); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return keys
+}
+
+func TestRoundTrip(t *testing.T) {
+	dir := filepath.Join("../..", beaconproofstest.FixtureDir)
+	paths, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var proofs int
+	for _, path := range paths {
+		t.Run(filepath.Base(path), func(t *testing.T) {
+			want, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			f, err := prooffile.Load(path)
+			if errors.Is(err, prooffile.ErrNotProofFile) {
+				t.Skip(err)
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			proofs++
+			out := filepath.Join(t.TempDir(), filepath.Base(path))
+			if err := f.Write(out); err != nil {
+				t.Fatal(err)
+			}
+			got, err := os.ReadFile(out)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if g, w := keys(t, got), keys(t, want); !reflect.DeepEqual(g, w) {
+				t.Errorf("wrote keys %q, want %q", g, w)
+			}
+			if !bytes.Equal(got, want) {
+				t.Errorf("wrote\n%s\nwant\n%s", got, want)
+			}
+		})
+	}
+	if proofs == 0 {
+		t.Fatalf("no proof files in %s", dir)
+	}
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	const valid = `{"validatorIndex":1,"ValidatorFields":["0x0000000000000000000000000000000000000000000000000000000000000001"]}`
+	tests := []struct {
+		name string
+		data string
+		err  string
+		// notProof is whether err is ErrNotProofFile.
+		notProof bool
+	}{
+		{name: "proof", data: valid},
+		{name: "trailing whitespace", data: valid + "\n\t "},
+		{name: "trailing object", data: valid + `{}`, err: "prooffile: data after the proof object"},
+		{name: "trailing text", data: valid + "x", err: "prooffile: data after the proof object"},
+		{name: "unclosed", data: valid[:len(valid)-1], err: "prooffile: unexpected end of JSON input"},
+		{name: "array", data: `[]`, err: "prooffile: not a proof file: not a JSON object", notProof: true},
+		{name: "no validator fields", data: `{"validatorIndex":1}`, err: "prooffile: not a proof file: no ValidatorFields", notProof: true},
+		{name: "bad index", data: `{"validatorIndex":"1"}`, err: "prooffile: validatorIndex: json: cannot unmarshal string into Go value of type uint64"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var f prooffile.File
+			err := f.UnmarshalJSON([]byte(tt.data))
+			switch {
+			case tt.err == "" && err != nil:
+				t.Fatal(err)
+			case tt.err != "" && (err == nil || err.Error() != tt.err):
+				t.Fatalf("err = %v, want %q", err, tt.err)
+			case errors.Is(err, prooffile.ErrNotProofFile) != tt.notProof:
+				t.Fatalf("err = %v, want ErrNotProofFile: %t", err, tt.notProof)
+			}
+			if tt.err == "" && (f.ValidatorIndex != 1 || len(f.ValidatorFields) != 1) {
+				t.Errorf("read %+v", f)
+			}
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package proofgen
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/ssz"
+)
+
+// Header is a BeaconBlockHeader, whose root is the block root.
+type Header struct {
+	Slot          uint64
+	ProposerIndex uint64
+	ParentRoot    common.Hash
+	StateRoot     common.Hash
+	BodyRoot      common.Hash
+}
+
+// DecodeHeader decodes an SSZ-encoded BeaconBlockHeader or
+// SignedBeaconBlockHeader.
+func DecodeHeader(data []byte) (Header, error) {
+	v, err := ssz.Decode(beaconBlockHeader, data)
+	if err != nil {
+		signed, serr := ssz.Decode(signedBeaconBlockHeader, data)
+		if serr != nil {
+			return Header{}, fmt.Errorf("proofgen: not a beacon block header: %w", err)
+		}
+		v = signed.Field("message")
+	}
+	return headerOf(v), nil
+}
+
+func headerOf(v ssz.Value) Header {
+	return Header{
+		Slot:          v.Field("slot").Uint64(),
+		ProposerIndex: v.Field("proposer_index").Uint64(),
+		ParentRoot:    common.BytesToHash(v.Field("parent_root").Bytes()),
+		StateRoot:     common.BytesToHash(v.Field("state_root").Bytes()),
+		BodyRoot:      common.BytesToHash(v.Field("body_root").Bytes()),
+	}
+}
+
+// Encode returns the SSZ encoding of the header.
+func (h Header) Encode() []byte {
+	enc, err := ssz.Compose(beaconBlockHeader,
+		ssz.EncodeUint64(h.Slot),
+		ssz.EncodeUint64(h.ProposerIndex),
+		h.ParentRoot.Bytes(),
+		h.StateRoot.Bytes(),
+		h.BodyRoot.Bytes(),
+	)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// Root returns the block root of the header.
+func (h Header) Root() common.Hash {
+	v, err := ssz.Decode(beaconBlockHeader, h.Encode())
+	if err != nil {
+		panic(err)
+	}
+	return v.Root()
+}
+
+// Header returns the header of the block.
+func (b *Block) Header() Header {
+	return Header{
+		Slot:          b.Slot(),
+		ProposerIndex: b.Value.Field("proposer_index").Uint64(),
+		ParentRoot:    common.BytesToHash(b.Value.Field("parent_root").Bytes()),
+		StateRoot:     common.BytesToHash(b.Value.Field("state_root").Bytes()),
+		BodyRoot:      b.Value.Field("body").Root(),
+	}
+}
@@ -0,0 +1,396 @@
+// Package proofgen generates the EigenPod proofs of withdrawal credentials,
+// balance updates and withdrawals from SSZ-encoded beacon states and blocks
+// on disk, such as those served by a beacon node's debug/beacon/states and
+// beacon/blocks endpoints, with no node needed. Proofs come out as
+// prooffile.Files, in the format of src/test/test-data, and are checked
+// with pkg/beaconproofs before they are returned.
+//
+// NewProver hashes a Capella or Deneb BeaconState once, with the fork
+// schedule withdrawals are laid out by, and the Prover then proves any of
+// its validators, or any withdrawal of a block its historical summaries
+// cover. cmd/proofgen runs it from the command line.
+package proofgen
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/beaconproofs"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/prooffile"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/ssz"
+)
+
+// Fork is a beacon chain fork whose state and block layouts the generator
+// reads.
+type Fork = beaconproofs.Fork
+
+const (
+	Capella = beaconproofs.Capella
+	Deneb   = beaconproofs.Deneb
+)
+
+// Forks are the forks the generator reads, oldest first.
+var Forks = []Fork{Capella, Deneb}
+
+// State is a decoded BeaconState.
+type State struct {
+	Fork  Fork
+	Value ssz.Value
+}
+
+// Block is a decoded BeaconBlock.
+type Block struct {
+	Fork  Fork
+	Value ssz.Value
+}
+
+// DecodeState decodes an SSZ-encoded BeaconState of any fork in Forks,
+// telling the fork from the layout of its execution payload header.
+func DecodeState(data []byte) (*State, error) {
+	var errs []error
+	for i := len(Forks) - 1; i >= 0; i-- {
+		v, err := ssz.Decode(beaconState(Forks[i]), data)
+		if err == nil {
+			return &State{Fork: Forks[i], Value: v}, nil
+		}
+		errs = append(errs, fmt.Errorf("%s: %w", Forks[i], err))
+	}
+	return nil, fmt.Errorf("proofgen: not a beacon state: %w", errors.Join(errs...))
+}
+
+// DecodeBlock decodes an SSZ-encoded BeaconBlock, or SignedBeaconBlock, of
+// any fork in Forks.
+func DecodeBlock(data []byte) (*Block, error) {
+	var errs []error
+	for i := len(Forks) - 1; i >= 0; i-- {
+		f := Forks[i]
+		if v, err := ssz.Decode(signedBeaconBlock(f), data); err == nil {
+			return &Block{Fork: f, Value: v.Field("message")}, nil
+		}
+		v, err := ssz.Decode(beaconBlock(f), data)
+		if err == nil {
+			return &Block{Fork: f, Value: v}, nil
+		}
+		errs = append(errs, fmt.Errorf("%s: %w", f, err))
+	}
+	return nil, fmt.Errorf("proofgen: not a beacon block: %w", errors.Join(errs...))
+}
+
+// NewState returns the BeaconState of fork f whose every field is zero, for
+// building states with ssz.Value.With.
+func NewState(f Fork) *State {
+	return &State{Fork: f, Value: zero(beaconState(f))}
+}
+
+// NewBlock returns the BeaconBlock of fork f whose every field is zero.
+func NewBlock(f Fork) *Block {
+	return &Block{Fork: f, Value: zero(beaconBlock(f))}
+}
+
+func zero(t *ssz.Type) ssz.Value {
+	v, err := ssz.Decode(t, ssz.Zero(t))
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// LoadState reads the SSZ-encoded BeaconState at path.
+func LoadState(path string) (*State, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	s, err := DecodeState(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return s, nil
+}
+
+// LoadBlock reads the SSZ-encoded BeaconBlock or SignedBeaconBlock at path.
+func LoadBlock(path string) (*Block, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	b, err := DecodeBlock(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return b, nil
+}
+
+// Slot returns the slot of the state.
+func (s *State) Slot() uint64 {
+	return s.Value.Field("slot").Uint64()
+}
+
+// Slot returns the slot of the block.
+func (b *Block) Slot() uint64 {
+	return b.Value.Field("slot").Uint64()
+}
+
+// Root returns the block root, the root of the block's header.
+func (b *Block) Root() common.Hash {
+	return b.Value.Root()
+}
+
+// Prover proves the fields of a beacon state, the state the beacon chain
+// oracle's block root at some timestamp commits to.
+type Prover struct {
+	state    *State
+	schedule beaconproofs.ForkSchedule
+	root     common.Hash
+	// fieldRoots are the roots of the fields of the state, the leaves of
+	// its tree.
+	fieldRoots []common.Hash
+	// validators and balances are the chunks of the state's validator and
+	// balance lists, kept to prove many validators.
+	validators, balances []common.Hash
+	latestBlockHeader    ssz.Value
+}
+
+// NewProver returns a Prover of state, hashing it once. Withdrawals are
+// proven in the layout schedule gives their timestamp, as by the
+// EigenPodManager whose fork schedule it is.
+func NewProver(state *State, schedule beaconproofs.ForkSchedule) (*Prover, error) {
+	p := &Prover{state: state, schedule: schedule}
+	t := state.Value.Type
+	p.fieldRoots = make([]common.Hash, len(t.Fields))
+	for i, f := range t.Fields {
+		v := state.Value.Field(f.Name)
+		switch f.Name {
+		case "validators":
+			p.validators, _ = v.Chunks()
+			p.fieldRoots[i] = listRoot(v, p.validators)
+		case "balances":
+			p.balances, _ = v.Chunks()
+			p.fieldRoots[i] = listRoot(v, p.balances)
+		default:
+			p.fieldRoots[i] = v.Root()
+		}
+	}
+	p.root = ssz.Merkleize(p.fieldRoots, uint64(len(p.fieldRoots)))
+
+	// The state's copy of the latest block header leaves its state root
+	// zero until the next slot fills it in.
+	header := state.Value.Field("latest_block_header")
+	if common.BytesToHash(header.Field("state_root").Bytes()) == (common.Hash{}) {
+		var err error
+		if header, err = header.With("state_root", p.root.Bytes()); err != nil {
+			return nil, err
+		}
+	}
+	p.latestBlockHeader = header
+	return p, nil
+}
+
+func listRoot(list ssz.Value, chunks []common.Hash) common.Hash {
+	_, limit := list.Chunks()
+	return ssz.MixInLength(ssz.Merkleize(chunks, limit), uint64(list.Len()))
+}
+
+// listProof proves the index'th of the chunks of list.
+func listProof(list ssz.Value, chunks []common.Hash, index uint64) []common.Hash {
+	_, limit := list.Chunks()
+	return append(ssz.MerkleProof(chunks, limit, index), ssz.Uint64Chunk(uint64(list.Len())))
+}
+
+// StateRoot returns the root of the state.
+func (p *Prover) StateRoot() common.Hash {
+	return p.root
+}
+
+// LatestBlockRoot returns the root of the latest block header of the state,
+// the block root the beacon chain oracle serves for the state.
+func (p *Prover) LatestBlockRoot() common.Hash {
+	return p.latestBlockHeader.Root()
+}
+
+// LatestBlockHeader returns the latest block header of the state, whose
+// root is LatestBlockRoot: the header of the block at the state's slot, or
+// of the last block before it.
+func (p *Prover) LatestBlockHeader() Header {
+	return headerOf(p.latestBlockHeader)
+}
+
+// stateProof proves the named field of the state.
+func (p *Prover) stateProof(name string) []common.Hash {
+	i := p.state.Value.Type.FieldIndex(name)
+	return ssz.MerkleProof(p.fieldRoots, uint64(len(p.fieldRoots)), uint64(i))
+}
+
+// validator returns the validator at index.
+func (p *Prover) validator(index uint64) (ssz.Value, error) {
+	validators := p.state.Value.Field("validators")
+	if index >= uint64(validators.Len()) {
+		return ssz.Value{}, fmt.Errorf("proofgen: no validator %d in a state of %d validators", index, validators.Len())
+	}
+	return validators.Index(int(index)), nil
+}
+
+// validatorProof returns the fields of the validator at index and their
+// proof against the state root.
+func (p *Prover) validatorProof(index uint64) ([]common.Hash, []common.Hash, error) {
+	v, err := p.validator(index)
+	if err != nil {
+		return nil, nil, err
+	}
+	fields, _ := v.Chunks()
+	proof := listProof(p.state.Value.Field("validators"), p.validators, index)
+	return fields, append(proof, p.stateProof("validators")...), nil
+}
+
+// stateFile returns a file of the proofs common to every kind: the state
+// root against the latest block root, and the validator's fields against
+// the state root.
+func (p *Prover) stateFile(validatorIndex uint64) (*prooffile.File, error) {
+	fields, proof, err := p.validatorProof(validatorIndex)
+	if err != nil {
+		return nil, err
+	}
+	stateRootIndex := p.latestBlockHeader.Type.FieldIndex("state_root")
+	return &prooffile.File{
+		ValidatorIndex:                         validatorIndex,
+		BeaconStateRoot:                        p.root,
+		LatestBlockHeaderRoot:                  p.LatestBlockRoot(),
+		ValidatorFields:                        fields,
+		WithdrawalCredentialProof:              proof,
+		StateRootAgainstLatestBlockHeaderProof: p.latestBlockHeader.Proof(stateRootIndex),
+	}, nil
+}
+
+// WithdrawalCredentialProof proves the validator at validatorIndex for
+// EigenPod.VerifyWithdrawalCredentials, with the proof of its balance the
+// generator has always added alongside.
+func (p *Prover) WithdrawalCredentialProof(validatorIndex uint64) (*prooffile.File, error) {
+	f, err := p.stateFile(validatorIndex)
+	if err != nil {
+		return nil, err
+	}
+	// Four balances pack into a chunk.
+	balances := p.state.Value.Field("balances")
+	chunk := validatorIndex / 4
+	f.BalanceRoot = p.balances[chunk]
+	f.ValidatorBalanceProof = append(listProof(balances, p.balances, chunk), p.stateProof("balances")...)
+	return f, p.verify(f)
+}
+
+// BalanceUpdateProof proves the validator at validatorIndex for
+// EigenPod.VerifyBalanceUpdates.
+func (p *Prover) BalanceUpdateProof(validatorIndex uint64) (*prooffile.File, error) {
+	f, err := p.stateFile(validatorIndex)
+	if err != nil {
+		return nil, err
+	}
+	f.SlotRoot = ssz.Uint64Chunk(p.state.Slot())
+	return f, p.verify(f)
+}
+
+// WithdrawalProof proves the withdrawalIndex'th withdrawal of block, and
+// its validator, for EigenPod.VerifyAndProcessWithdrawals. The block must
+// be of a period of slotsPerHistoricalRoot slots the state has a historical
+// summary of, and historical a state holding the block roots of that
+// period, such as the state at the first slot of the next period. A block
+// of a fork other than the one the schedule puts its timestamp in fails
+// with a beaconproofs.ForkMismatchError.
+func (p *Prover) WithdrawalProof(historical *State, block *Block, withdrawalIndex uint64) (*prooffile.File, error) {
+	body := block.Value.Field("body")
+	payload := body.Field("execution_payload")
+	withdrawals := payload.Field("withdrawals")
+	if withdrawalIndex >= uint64(withdrawals.Len()) {
+		return nil, fmt.Errorf("proofgen: no withdrawal %d in a block of %d withdrawals", withdrawalIndex, withdrawals.Len())
+	}
+	// The block's layout must be the one of its fork under the schedule,
+	// whatever the fork of the state: a Capella block proven against a
+	// Deneb state keeps its Capella payload.
+	timestamp := payload.Field("timestamp").Uint64()
+	if want := p.schedule.ForkAt(timestamp); want != block.Fork {
+		return nil, fmt.Errorf("proofgen: block at slot %d: %w", block.Slot(),
+			&beaconproofs.ForkMismatchError{Timestamp: timestamp, Want: want, Got: block.Fork})
+	}
+	withdrawal := withdrawals.Index(int(withdrawalIndex))
+	validatorIndex := withdrawal.Field("validator_index").Uint64()
+
+	blockRoot := block.Root()
+	blockRoots := historical.Value.Field("block_roots")
+	blockRootIndex := block.Slot() % slotsPerHistoricalRoot
+	if got := common.BytesToHash(blockRoots.Index(int(blockRootIndex)).Bytes()); got != blockRoot {
+		return nil, fmt.Errorf("proofgen: historical state at slot %d holds block root %s for slot %d, not %s",
+			historical.Slot(), got, block.Slot(), blockRoot)
+	}
+	blockSummaryRoot := blockRoots.Root()
+	summaries := p.state.Value.Field("historical_summaries")
+	summaryIndex := -1
+	for i := 0; i < summaries.Len(); i++ {
+		if common.BytesToHash(summaries.Index(i).Field("block_summary_root").Bytes()) == blockSummaryRoot {
+			summaryIndex = i
+			break
+		}
+	}
+	if summaryIndex < 0 {
+		return nil, fmt.Errorf("proofgen: state at slot %d has no historical summary of the block roots of the state at slot %d",
+			p.state.Slot(), historical.Slot())
+	}
+
+	f, err := p.stateFile(validatorIndex)
+	if err != nil {
+		return nil, err
+	}
+	// ValidatorProof is what withdrawal files call the validator fields
+	// proof.
+	f.ValidatorProof, f.WithdrawalCredentialProof = f.WithdrawalCredentialProof, nil
+
+	historicalSummaryProof := blockRoots.Proof(int(blockRootIndex))
+	historicalSummaryProof = append(historicalSummaryProof, summaries.Index(summaryIndex).Proof(0)...)
+	historicalSummaryProof = append(historicalSummaryProof, summaries.Proof(summaryIndex)...)
+	historicalSummaryProof = append(historicalSummaryProof, p.stateProof("historical_summaries")...)
+
+	blockType := block.Value.Type
+	payloadIndex := body.Type.FieldIndex("execution_payload")
+	executionPayloadProof := append(body.Proof(payloadIndex), block.Value.Proof(blockType.FieldIndex("body"))...)
+	payloadType := payload.Type
+	withdrawalProof := append(withdrawals.Proof(int(withdrawalIndex)), payload.Proof(payloadType.FieldIndex("withdrawals"))...)
+	withdrawalFields, _ := withdrawal.Chunks()
+
+	f.Slot = block.Slot()
+	f.HistoricalSummaryIndex = uint64(summaryIndex)
+	f.WithdrawalIndex = withdrawalIndex
+	f.BlockHeaderRootIndex = blockRootIndex
+	f.SlotRoot = ssz.Uint64Chunk(block.Slot())
+	f.TimestampRoot = ssz.Uint64Chunk(timestamp)
+	f.BlockHeaderRoot = blockRoot
+	f.BlockBodyRoot = body.Root()
+	f.ExecutionPayloadRoot = payload.Root()
+	f.SlotProof = block.Value.Proof(blockType.FieldIndex("slot"))
+	f.WithdrawalProof = withdrawalProof
+	f.TimestampProof = payload.Proof(payloadType.FieldIndex("timestamp"))
+	f.ExecutionPayloadProof = executionPayloadProof
+	f.WithdrawalFields = withdrawalFields
+	f.HistoricalSummaryProof = historicalSummaryProof
+
+	if err := p.verify(f); err != nil {
+		return nil, err
+	}
+	if err := p.schedule.VerifyWithdrawal(f.BeaconStateRoot, f.WithdrawalFields, f.ToWithdrawalProof().Canonical()); err != nil {
+		return nil, fmt.Errorf("proofgen: generated withdrawal proof does not verify: %w", err)
+	}
+	return f, nil
+}
+
+// verify checks the state root and validator proofs of f with
+// pkg/beaconproofs.
+func (p *Prover) verify(f *prooffile.File) error {
+	if err := beaconproofs.VerifyStateRootProof(f.LatestBlockHeaderRoot, f.ToStateRootProof().Canonical()); err != nil {
+		return fmt.Errorf("proofgen: generated state root proof does not verify: %w", err)
+	}
+	validatorIndex := f.ValidatorIndex
+	if err := beaconproofs.VerifyValidatorFields(f.BeaconStateRoot, f.ValidatorFields, f.ValidatorFieldsProof(), validatorIndex); err != nil {
+		return fmt.Errorf("proofgen: generated proof of validator %d does not verify: %w", validatorIndex, err)
+	}
+	return nil
+}
@@ -0,0 +1,265 @@
+package proofgen_test
+
+import (
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/beaconproofs"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/beaconproofs/beaconproofstest"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/prooffile"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/proofgen"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/ssz"
+)
+
+// slotsPerHistoricalRoot is the length of the block_roots vector of a
+// state.
+const slotsPerHistoricalRoot = 8192
+
+func fixture(t *testing.T, name string) *prooffile.File {
+	t.Helper()
+	f, err := prooffile.Load(filepath.Join("../..", beaconproofstest.FixtureDir, name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return f
+}
+
+// with replaces the named fields of the container v by their encodings.
+func with(t *testing.T, v ssz.Value, fields map[string][]byte) ssz.Value {
+	t.Helper()
+	for name, enc := range fields {
+		var err error
+		if v, err = v.With(name, enc); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return v
+}
+
+func compose(t *testing.T, typ *ssz.Type, parts ...[]byte) []byte {
+	t.Helper()
+	enc, err := ssz.Compose(typ, parts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return enc
+}
+
+// fieldsOf returns the encodings of fields of a container given their
+// chunks, the start of which each field of at most 32 bytes packs into.
+func fieldsOf(fields []ssz.Field, chunks []common.Hash) [][]byte {
+	parts := make([][]byte, len(chunks))
+	for i, c := range chunks {
+		size, _ := fields[i].Type.FixedSize()
+		parts[i] = common.CopyBytes(c[:size])
+	}
+	return parts
+}
+
+// newState builds a state of fork at slot holding the validator a fixture
+// records the fields of at index, with a zero pubkey as the fixture holds
+// only its hash, and the balances a fixture records the chunk of. If
+// blockSummaryRoot is set, the state's last historical summary, at
+// summaryIndex, summarizes it.
+func newState(t *testing.T, fork proofgen.Fork, slot uint64, f *prooffile.File, index uint64, blockSummaryRoot common.Hash) *proofgen.State {
+	t.Helper()
+	v := proofgen.NewState(fork).Value
+	vt, bt, st := v.Field("validators").Type, v.Field("balances").Type, v.Field("historical_summaries").Type
+	// Four balances pack into a chunk.
+	count := (index/4 + 1) * 4
+	validators := make([][]byte, count)
+	balances := make([][]byte, count)
+	for i := range validators {
+		validators[i] = ssz.Zero(vt.Elem)
+		balances[i] = f.BalanceRoot[8*(i%4) : 8*(i%4)+8]
+	}
+	pubkey := make([]byte, 48)
+	validators[index] = compose(t, vt.Elem, append([][]byte{pubkey}, fieldsOf(vt.Elem.Fields[1:], f.ValidatorFields[1:])...)...)
+	var summaries [][]byte
+	if blockSummaryRoot != (common.Hash{}) {
+		for i := uint64(0); i < f.HistoricalSummaryIndex; i++ {
+			summaries = append(summaries, ssz.Zero(st.Elem))
+		}
+		summaries = append(summaries, compose(t, st.Elem, blockSummaryRoot.Bytes(), common.Hash{}.Bytes()))
+	}
+	return &proofgen.State{Fork: fork, Value: with(t, v, map[string][]byte{
+		"slot":                 ssz.EncodeUint64(slot),
+		"validators":           compose(t, vt, validators...),
+		"balances":             compose(t, bt, balances...),
+		"historical_summaries": compose(t, st, summaries...),
+	})}
+}
+
+// newBlock builds the block a withdrawal fixture proves a withdrawal of, at
+// its slot and timestamp, with the withdrawal at its index. The fork of
+// the block is the one the fixtures' schedule puts its timestamp in.
+func newBlock(t *testing.T, f *prooffile.File) *proofgen.Block {
+	t.Helper()
+	timestamp := beaconproofs.FromLittleEndianUint64(f.TimestampRoot)
+	fork := beaconproofstest.Schedule.ForkAt(timestamp)
+	block := proofgen.NewBlock(fork).Value
+	body := block.Field("body")
+	payload := body.Field("execution_payload")
+	list := payload.Field("withdrawals").Type
+	withdrawals := make([][]byte, f.WithdrawalIndex+1)
+	for i := range withdrawals {
+		withdrawals[i] = ssz.Zero(list.Elem)
+	}
+	withdrawals[f.WithdrawalIndex] = compose(t, list.Elem, fieldsOf(list.Elem.Fields, f.WithdrawalFields)...)
+	payload = with(t, payload, map[string][]byte{
+		"timestamp":   ssz.EncodeUint64(timestamp),
+		"withdrawals": compose(t, list, withdrawals...),
+	})
+	body = with(t, body, map[string][]byte{"execution_payload": payload.Bytes()})
+	block = with(t, block, map[string][]byte{"slot": ssz.EncodeUint64(f.Slot), "body": body.Bytes()})
+	return &proofgen.Block{Fork: fork, Value: block}
+}
+
+// historicalState builds the state at the start of the period after
+// block's, whose block roots hold block's root.
+func historicalState(t *testing.T, fork proofgen.Fork, block *proofgen.Block) *proofgen.State {
+	t.Helper()
+	v := proofgen.NewState(fork).Value
+	rt := v.Field("block_roots").Type
+	roots := make([][]byte, slotsPerHistoricalRoot)
+	for i := range roots {
+		roots[i] = common.Hash{}.Bytes()
+	}
+	roots[block.Slot()%slotsPerHistoricalRoot] = block.Root().Bytes()
+	slot := (block.Slot()/slotsPerHistoricalRoot + 1) * slotsPerHistoricalRoot
+	return &proofgen.State{Fork: fork, Value: with(t, v, map[string][]byte{
+		"slot":        ssz.EncodeUint64(slot),
+		"block_roots": compose(t, rt, roots...),
+	})}
+}
+
+// recorded is what of a proof file the leaves of the state and block
+// determine: its indices, its leaves but for the validator's pubkey hash,
+// and the number of siblings of each proof. The fixtures hold proofs
+// rather than the states and blocks they were generated from, whose other
+// fields and so the roots and siblings of the proofs the tests cannot
+// rebuild.
+type recorded struct {
+	Slot, HistoricalSummaryIndex, WithdrawalIndex, BlockHeaderRootIndex uint64
+	SlotRoot, TimestampRoot, BalanceRoot                                common.Hash
+	ValidatorFields, WithdrawalFields                                   []common.Hash
+	Proofs                                                              map[string]int
+}
+
+func record(f *prooffile.File) recorded {
+	r := recorded{
+		Slot:                   f.Slot,
+		HistoricalSummaryIndex: f.HistoricalSummaryIndex,
+		WithdrawalIndex:        f.WithdrawalIndex,
+		BlockHeaderRootIndex:   f.BlockHeaderRootIndex,
+		SlotRoot:               f.SlotRoot,
+		TimestampRoot:          f.TimestampRoot,
+		BalanceRoot:            f.BalanceRoot,
+		ValidatorFields:        f.ValidatorFields[1:],
+		WithdrawalFields:       f.WithdrawalFields,
+		Proofs:                 make(map[string]int),
+	}
+	v := reflect.ValueOf(f).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		if name := v.Type().Field(i).Name; strings.HasSuffix(name, "Proof") && v.Field(i).Len() > 0 {
+			r.Proofs[name] = v.Field(i).Len()
+		}
+	}
+	return r
+}
+
+func TestProver(t *testing.T) {
+	tests := []struct {
+		fixture string
+		// fork is the fork of the state the fixture proves against.
+		fork  proofgen.Fork
+		prove func(t *testing.T, f *prooffile.File, fork proofgen.Fork) (*prooffile.File, error)
+	}{
+		{"withdrawal_credential_proof_302913.json", proofgen.Capella, credentials},
+		{"balanceUpdateProof_updated_to_30ETH_302913.json", proofgen.Capella, balanceUpdate},
+		{"fullWithdrawalProof_Latest_28ETH.json", proofgen.Capella, withdrawal},
+		{"fullWithdrawalDeneb.json", proofgen.Deneb, withdrawal},
+		{"fullWithdrawalCapellaAgainstDenebRoot.json", proofgen.Deneb, withdrawal},
+	}
+	// The fixtures prove validator 302913, so each state holds over 300k
+	// validators to hash.
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.fixture, func(t *testing.T) {
+			t.Parallel()
+			want := fixture(t, tt.fixture)
+			got, err := tt.prove(t, want, tt.fork)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if g, w := record(got), record(want); !reflect.DeepEqual(g, w) {
+				t.Errorf("generated %+v, want %+v", g, w)
+			}
+		})
+	}
+}
+
+func credentials(t *testing.T, f *prooffile.File, fork proofgen.Fork) (*prooffile.File, error) {
+	p, err := proofgen.NewProver(newState(t, fork, 0, f, f.ValidatorIndex, common.Hash{}), beaconproofstest.Schedule)
+	if err != nil {
+		return nil, err
+	}
+	return p.WithdrawalCredentialProof(f.ValidatorIndex)
+}
+
+func balanceUpdate(t *testing.T, f *prooffile.File, fork proofgen.Fork) (*prooffile.File, error) {
+	slot := beaconproofs.FromLittleEndianUint64(f.SlotRoot)
+	p, err := proofgen.NewProver(newState(t, fork, slot, f, f.ValidatorIndex, common.Hash{}), beaconproofstest.Schedule)
+	if err != nil {
+		return nil, err
+	}
+	return p.BalanceUpdateProof(f.ValidatorIndex)
+}
+
+func withdrawal(t *testing.T, f *prooffile.File, fork proofgen.Fork) (*prooffile.File, error) {
+	block := newBlock(t, f)
+	historical := historicalState(t, fork, block)
+	index, err := beaconproofs.WithdrawalFields(f.WithdrawalFields).ValidatorIndex()
+	if err != nil {
+		return nil, err
+	}
+	state := newState(t, fork, historical.Slot(), f, index, historical.Value.Field("block_roots").Root())
+	p, err := proofgen.NewProver(state, beaconproofstest.Schedule)
+	if err != nil {
+		return nil, err
+	}
+	return p.WithdrawalProof(historical, block, f.WithdrawalIndex)
+}
+
+func TestDecode(t *testing.T) {
+	for _, fork := range proofgen.Forks {
+		t.Run(string(fork), func(t *testing.T) {
+			state, err := proofgen.DecodeState(proofgen.NewState(fork).Value.Bytes())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if state.Fork != fork {
+				t.Errorf("decoded a %s state, want %s", state.Fork, fork)
+			}
+			block, err := proofgen.DecodeBlock(proofgen.NewBlock(fork).Value.Bytes())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if block.Fork != fork {
+				t.Errorf("decoded a %s block, want %s", block.Fork, fork)
+			}
+			header := block.Header()
+			decoded, err := proofgen.DecodeHeader(header.Encode())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if decoded != header || header.Root() != block.Root() {
+				t.Errorf("decoded header %+v with root %s, want %+v with root %s", decoded, decoded.Root(), header, block.Root())
+			}
+		})
+	}
+}
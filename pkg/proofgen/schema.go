@@ -0,0 +1,240 @@
+package proofgen
+
+import (
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/ssz"
+)
+
+// Mainnet preset values bounding the consensus types, which Goerli and
+// Holesky share.
+const (
+	slotsPerHistoricalRoot     = 8192
+	historicalRootsLimit       = 1 << 24
+	epochsPerEth1VotingPeriod  = 64
+	slotsPerEpoch              = 32
+	validatorRegistryLimit     = 1 << 40
+	epochsPerHistoricalVector  = 65536
+	epochsPerSlashingsVector   = 8192
+	syncCommitteeSize          = 512
+	maxProposerSlashings       = 16
+	maxAttesterSlashings       = 2
+	maxAttestations            = 128
+	maxDeposits                = 16
+	maxVoluntaryExits          = 16
+	maxBLSToExecutionChanges   = 16
+	maxValidatorsPerCommittee  = 2048
+	depositContractTreeDepth   = 32
+	bytesPerLogsBloom          = 256
+	maxExtraDataBytes          = 32
+	maxBytesPerTransaction     = 1 << 30
+	maxTransactionsPerPayload  = 1 << 20
+	maxWithdrawalsPerPayload   = 16
+	maxBlobCommitmentsPerBlock = 4096
+	justificationBitsLength    = 4
+)
+
+var (
+	fork = ssz.Container("Fork",
+		ssz.F("previous_version", ssz.Bytes4),
+		ssz.F("current_version", ssz.Bytes4),
+		ssz.F("epoch", ssz.Uint64),
+	)
+	checkpoint = ssz.Container("Checkpoint",
+		ssz.F("epoch", ssz.Uint64),
+		ssz.F("root", ssz.Bytes32),
+	)
+	beaconBlockHeader = ssz.Container("BeaconBlockHeader",
+		ssz.F("slot", ssz.Uint64),
+		ssz.F("proposer_index", ssz.Uint64),
+		ssz.F("parent_root", ssz.Bytes32),
+		ssz.F("state_root", ssz.Bytes32),
+		ssz.F("body_root", ssz.Bytes32),
+	)
+	signedBeaconBlockHeader = ssz.Container("SignedBeaconBlockHeader",
+		ssz.F("message", beaconBlockHeader),
+		ssz.F("signature", ssz.Bytes96),
+	)
+	eth1Data = ssz.Container("Eth1Data",
+		ssz.F("deposit_root", ssz.Bytes32),
+		ssz.F("deposit_count", ssz.Uint64),
+		ssz.F("block_hash", ssz.Bytes32),
+	)
+	validator = ssz.Container("Validator",
+		ssz.F("pubkey", ssz.Bytes48),
+		ssz.F("withdrawal_credentials", ssz.Bytes32),
+		ssz.F("effective_balance", ssz.Uint64),
+		ssz.F("slashed", ssz.Bool()),
+		ssz.F("activation_eligibility_epoch", ssz.Uint64),
+		ssz.F("activation_epoch", ssz.Uint64),
+		ssz.F("exit_epoch", ssz.Uint64),
+		ssz.F("withdrawable_epoch", ssz.Uint64),
+	)
+	syncCommittee = ssz.Container("SyncCommittee",
+		ssz.F("pubkeys", ssz.Vector(ssz.Bytes48, syncCommitteeSize)),
+		ssz.F("aggregate_pubkey", ssz.Bytes48),
+	)
+	historicalSummary = ssz.Container("HistoricalSummary",
+		ssz.F("block_summary_root", ssz.Bytes32),
+		ssz.F("state_summary_root", ssz.Bytes32),
+	)
+	withdrawal = ssz.Container("Withdrawal",
+		ssz.F("index", ssz.Uint64),
+		ssz.F("validator_index", ssz.Uint64),
+		ssz.F("address", ssz.Bytes20),
+		ssz.F("amount", ssz.Uint64),
+	)
+	attestationData = ssz.Container("AttestationData",
+		ssz.F("slot", ssz.Uint64),
+		ssz.F("index", ssz.Uint64),
+		ssz.F("beacon_block_root", ssz.Bytes32),
+		ssz.F("source", checkpoint),
+		ssz.F("target", checkpoint),
+	)
+	indexedAttestation = ssz.Container("IndexedAttestation",
+		ssz.F("attesting_indices", ssz.List(ssz.Uint64, maxValidatorsPerCommittee)),
+		ssz.F("data", attestationData),
+		ssz.F("signature", ssz.Bytes96),
+	)
+	proposerSlashing = ssz.Container("ProposerSlashing",
+		ssz.F("signed_header_1", signedBeaconBlockHeader),
+		ssz.F("signed_header_2", signedBeaconBlockHeader),
+	)
+	attesterSlashing = ssz.Container("AttesterSlashing",
+		ssz.F("attestation_1", indexedAttestation),
+		ssz.F("attestation_2", indexedAttestation),
+	)
+	attestation = ssz.Container("Attestation",
+		ssz.F("aggregation_bits", ssz.Bitlist(maxValidatorsPerCommittee)),
+		ssz.F("data", attestationData),
+		ssz.F("signature", ssz.Bytes96),
+	)
+	deposit = ssz.Container("Deposit",
+		ssz.F("proof", ssz.Vector(ssz.Bytes32, depositContractTreeDepth+1)),
+		ssz.F("data", ssz.Container("DepositData",
+			ssz.F("pubkey", ssz.Bytes48),
+			ssz.F("withdrawal_credentials", ssz.Bytes32),
+			ssz.F("amount", ssz.Uint64),
+			ssz.F("signature", ssz.Bytes96),
+		)),
+	)
+	signedVoluntaryExit = ssz.Container("SignedVoluntaryExit",
+		ssz.F("message", ssz.Container("VoluntaryExit",
+			ssz.F("epoch", ssz.Uint64),
+			ssz.F("validator_index", ssz.Uint64),
+		)),
+		ssz.F("signature", ssz.Bytes96),
+	)
+	syncAggregate = ssz.Container("SyncAggregate",
+		ssz.F("sync_committee_bits", ssz.Bitvector(syncCommitteeSize)),
+		ssz.F("sync_committee_signature", ssz.Bytes96),
+	)
+	signedBLSToExecutionChange = ssz.Container("SignedBLSToExecutionChange",
+		ssz.F("message", ssz.Container("BLSToExecutionChange",
+			ssz.F("validator_index", ssz.Uint64),
+			ssz.F("from_bls_pubkey", ssz.Bytes48),
+			ssz.F("to_execution_address", ssz.Bytes20),
+		)),
+		ssz.F("signature", ssz.Bytes96),
+	)
+)
+
+// executionPayloadFields returns the fields of the execution payload, or
+// with header set of its header, which replaces the transactions and
+// withdrawals by their roots.
+func executionPayloadFields(f Fork, header bool) []ssz.Field {
+	fields := []ssz.Field{
+		ssz.F("parent_hash", ssz.Bytes32),
+		ssz.F("fee_recipient", ssz.Bytes20),
+		ssz.F("state_root", ssz.Bytes32),
+		ssz.F("receipts_root", ssz.Bytes32),
+		ssz.F("logs_bloom", ssz.ByteVector(bytesPerLogsBloom)),
+		ssz.F("prev_randao", ssz.Bytes32),
+		ssz.F("block_number", ssz.Uint64),
+		ssz.F("gas_limit", ssz.Uint64),
+		ssz.F("gas_used", ssz.Uint64),
+		ssz.F("timestamp", ssz.Uint64),
+		ssz.F("extra_data", ssz.ByteList(maxExtraDataBytes)),
+		ssz.F("base_fee_per_gas", ssz.Uint256),
+		ssz.F("block_hash", ssz.Bytes32),
+	}
+	if header {
+		fields = append(fields, ssz.F("transactions_root", ssz.Bytes32), ssz.F("withdrawals_root", ssz.Bytes32))
+	} else {
+		fields = append(fields,
+			ssz.F("transactions", ssz.List(ssz.ByteList(maxBytesPerTransaction), maxTransactionsPerPayload)),
+			ssz.F("withdrawals", ssz.List(withdrawal, maxWithdrawalsPerPayload)),
+		)
+	}
+	if f == Deneb {
+		fields = append(fields, ssz.F("blob_gas_used", ssz.Uint64), ssz.F("excess_blob_gas", ssz.Uint64))
+	}
+	return fields
+}
+
+// beaconState returns the BeaconState of the fork.
+func beaconState(f Fork) *ssz.Type {
+	return ssz.Container("BeaconState",
+		ssz.F("genesis_time", ssz.Uint64),
+		ssz.F("genesis_validators_root", ssz.Bytes32),
+		ssz.F("slot", ssz.Uint64),
+		ssz.F("fork", fork),
+		ssz.F("latest_block_header", beaconBlockHeader),
+		ssz.F("block_roots", ssz.Vector(ssz.Bytes32, slotsPerHistoricalRoot)),
+		ssz.F("state_roots", ssz.Vector(ssz.Bytes32, slotsPerHistoricalRoot)),
+		ssz.F("historical_roots", ssz.List(ssz.Bytes32, historicalRootsLimit)),
+		ssz.F("eth1_data", eth1Data),
+		ssz.F("eth1_data_votes", ssz.List(eth1Data, epochsPerEth1VotingPeriod*slotsPerEpoch)),
+		ssz.F("eth1_deposit_index", ssz.Uint64),
+		ssz.F("validators", ssz.List(validator, validatorRegistryLimit)),
+		ssz.F("balances", ssz.List(ssz.Uint64, validatorRegistryLimit)),
+		ssz.F("randao_mixes", ssz.Vector(ssz.Bytes32, epochsPerHistoricalVector)),
+		ssz.F("slashings", ssz.Vector(ssz.Uint64, epochsPerSlashingsVector)),
+		ssz.F("previous_epoch_participation", ssz.List(ssz.Uint8, validatorRegistryLimit)),
+		ssz.F("current_epoch_participation", ssz.List(ssz.Uint8, validatorRegistryLimit)),
+		ssz.F("justification_bits", ssz.Bitvector(justificationBitsLength)),
+		ssz.F("previous_justified_checkpoint", checkpoint),
+		ssz.F("current_justified_checkpoint", checkpoint),
+		ssz.F("finalized_checkpoint", checkpoint),
+		ssz.F("inactivity_scores", ssz.List(ssz.Uint64, validatorRegistryLimit)),
+		ssz.F("current_sync_committee", syncCommittee),
+		ssz.F("next_sync_committee", syncCommittee),
+		ssz.F("latest_execution_payload_header", ssz.Container("ExecutionPayloadHeader", executionPayloadFields(f, true)...)),
+		ssz.F("next_withdrawal_index", ssz.Uint64),
+		ssz.F("next_withdrawal_validator_index", ssz.Uint64),
+		ssz.F("historical_summaries", ssz.List(historicalSummary, historicalRootsLimit)),
+	)
+}
+
+// beaconBlock returns the BeaconBlock of the fork.
+func beaconBlock(f Fork) *ssz.Type {
+	body := []ssz.Field{
+		ssz.F("randao_reveal", ssz.Bytes96),
+		ssz.F("eth1_data", eth1Data),
+		ssz.F("graffiti", ssz.Bytes32),
+		ssz.F("proposer_slashings", ssz.List(proposerSlashing, maxProposerSlashings)),
+		ssz.F("attester_slashings", ssz.List(attesterSlashing, maxAttesterSlashings)),
+		ssz.F("attestations", ssz.List(attestation, maxAttestations)),
+		ssz.F("deposits", ssz.List(deposit, maxDeposits)),
+		ssz.F("voluntary_exits", ssz.List(signedVoluntaryExit, maxVoluntaryExits)),
+		ssz.F("sync_aggregate", syncAggregate),
+		ssz.F("execution_payload", ssz.Container("ExecutionPayload", executionPayloadFields(f, false)...)),
+		ssz.F("bls_to_execution_changes", ssz.List(signedBLSToExecutionChange, maxBLSToExecutionChanges)),
+	}
+	if f == Deneb {
+		body = append(body, ssz.F("blob_kzg_commitments", ssz.List(ssz.Bytes48, maxBlobCommitmentsPerBlock)))
+	}
+	return ssz.Container("BeaconBlock",
+		ssz.F("slot", ssz.Uint64),
+		ssz.F("proposer_index", ssz.Uint64),
+		ssz.F("parent_root", ssz.Bytes32),
+		ssz.F("state_root", ssz.Bytes32),
+		ssz.F("body", ssz.Container("BeaconBlockBody", body...)),
+	)
+}
+
+// signedBeaconBlock returns the SignedBeaconBlock of the fork.
+func signedBeaconBlock(f Fork) *ssz.Type {
+	return ssz.Container("SignedBeaconBlock",
+		ssz.F("message", beaconBlock(f)),
+		ssz.F("signature", ssz.Bytes96),
+	)
+}
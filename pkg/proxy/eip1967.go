@@ -0,0 +1,59 @@
+// Package proxy inspects the EIP-1967 proxies the EigenLayer contracts are
+// deployed behind.
+//
+// TransparentUpgradeableProxy only answers admin() and implementation() when
+// called by its admin, so the functions here read the EIP-1967 storage slots
+// directly instead.
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// EIP-1967 storage slots, each keccak256 of a label minus one.
+var (
+	ImplementationSlot = slot("eip1967.proxy.implementation")
+	AdminSlot          = slot("eip1967.proxy.admin")
+	BeaconSlot         = slot("eip1967.proxy.beacon")
+)
+
+func slot(label string) common.Hash {
+	h := new(big.Int).SetBytes(crypto.Keccak256([]byte(label)))
+	return common.BigToHash(h.Sub(h, common.Big1))
+}
+
+// StorageReader reads contract storage, as ethclient.Client and the
+// simulated backend's client do.
+type StorageReader interface {
+	StorageAt(ctx context.Context, account common.Address, key common.Hash, blockNumber *big.Int) ([]byte, error)
+}
+
+// Implementation returns the logic contract proxy delegates to at
+// blockNumber, or the latest block if blockNumber is nil.
+func Implementation(ctx context.Context, r StorageReader, proxy common.Address, blockNumber *big.Int) (common.Address, error) {
+	return readAddress(ctx, r, proxy, ImplementationSlot, blockNumber)
+}
+
+// Admin returns the admin of proxy, the ProxyAdmin for EigenLayer
+// deployments.
+func Admin(ctx context.Context, r StorageReader, proxy common.Address, blockNumber *big.Int) (common.Address, error) {
+	return readAddress(ctx, r, proxy, AdminSlot, blockNumber)
+}
+
+// Beacon returns the beacon of a beacon proxy, such as an EigenPod.
+func Beacon(ctx context.Context, r StorageReader, proxy common.Address, blockNumber *big.Int) (common.Address, error) {
+	return readAddress(ctx, r, proxy, BeaconSlot, blockNumber)
+}
+
+func readAddress(ctx context.Context, r StorageReader, account common.Address, key common.Hash, blockNumber *big.Int) (common.Address, error) {
+	word, err := r.StorageAt(ctx, account, key, blockNumber)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to read slot %s of %s: %w", key, account, err)
+	}
+	return common.BytesToAddress(word), nil
+}
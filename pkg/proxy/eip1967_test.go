@@ -0,0 +1,86 @@
+package proxy_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/proxy"
+)
+
+// storage serves the slots of a single block, as eth_getStorageAt does:
+// a slot never written reads as zero.
+type storage map[common.Address]map[common.Hash]common.Hash
+
+func (s storage) StorageAt(_ context.Context, account common.Address, key common.Hash, blockNumber *big.Int) ([]byte, error) {
+	if blockNumber != nil {
+		return nil, errors.New("header not found")
+	}
+	v := s[account][key]
+	return v.Bytes(), nil
+}
+
+func TestSlots(t *testing.T) {
+	// The slots published in EIP-1967.
+	for name, tt := range map[string]struct{ got, want common.Hash }{
+		"implementation": {proxy.ImplementationSlot, common.HexToHash("0x360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbc")},
+		"admin":          {proxy.AdminSlot, common.HexToHash("0xb53127684a568b3173ae13b9f8a6016e243e63b6e8ee1178d6a717850b5d6103")},
+		"beacon":         {proxy.BeaconSlot, common.HexToHash("0xa3f0ad74e5423aebfd80d3ef4346578335a9a72aeaee59ff6cb3582b35133d50")},
+	} {
+		if tt.got != tt.want {
+			t.Errorf("%s slot %s, want %s", name, tt.got, tt.want)
+		}
+	}
+}
+
+func TestRead(t *testing.T) {
+	var (
+		transparent    = common.HexToAddress("0x1000")
+		beaconProxy    = common.HexToAddress("0x2000")
+		implementation = common.HexToAddress("0x3000")
+		admin          = common.HexToAddress("0x4000")
+		beacon         = common.HexToAddress("0x5000")
+	)
+	s := storage{
+		transparent: {
+			proxy.ImplementationSlot: common.BytesToHash(implementation.Bytes()),
+			proxy.AdminSlot:          common.BytesToHash(admin.Bytes()),
+		},
+		beaconProxy: {proxy.BeaconSlot: common.BytesToHash(beacon.Bytes())},
+	}
+	tests := []struct {
+		name  string
+		read  func(context.Context, proxy.StorageReader, common.Address, *big.Int) (common.Address, error)
+		proxy common.Address
+		block *big.Int
+		want  common.Address
+		err   string
+	}{
+		{"implementation", proxy.Implementation, transparent, nil, implementation, ""},
+		{"admin", proxy.Admin, transparent, nil, admin, ""},
+		{"beacon", proxy.Beacon, beaconProxy, nil, beacon, ""},
+		{"beacon of a transparent proxy", proxy.Beacon, transparent, nil, common.Address{}, ""},
+		{"implementation of a beacon proxy", proxy.Implementation, beaconProxy, nil, common.Address{}, ""},
+		{
+			"unknown block", proxy.Implementation, transparent, big.NewInt(1), common.Address{},
+			"failed to read slot 0x360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbc of 0x0000000000000000000000000000000000001000: header not found",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.read(context.Background(), s, tt.proxy, tt.block)
+			switch {
+			case tt.err == "" && err != nil:
+				t.Fatal(err)
+			case tt.err != "" && (err == nil || err.Error() != tt.err):
+				t.Fatalf("err = %v, want %q", err, tt.err)
+			}
+			if got != tt.want {
+				t.Errorf("read %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
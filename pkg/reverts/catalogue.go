@@ -0,0 +1,380 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package reverts
+
+import "errors"
+
+// Sentinel errors, one per distinct revert message. Catalogued reverts
+// match the sentinel of their message with errors.Is.
+var (
+	ErrActionNotInQueue                                                  = errors.New("action is not in queue")
+	ErrAmountCannotBe0                                                   = errors.New("amount cannot be 0")
+	ErrAmountGweiExceedsWithdrawableRestakedExecutionLayerGwei           = errors.New("amountGwei exceeds withdrawableRestakedExecutionLayerGwei")
+	ErrAmountSharesMustBeLessThanOrEqualToTotalShares                    = errors.New("amountShares must be less than or equal to totalShares")
+	ErrAmountToWithdrawGreaterThanNonBeaconChainETHBalanceWei            = errors.New("amountToWithdraw is greater than nonBeaconChainETHBalanceWei")
+	ErrAmountTooLarge                                                    = errors.New("amount too large")
+	ErrAmountWeiMustBeWholeGweiAmount                                    = errors.New("amountWei must be a whole Gwei amount")
+	ErrApproverSaltAlreadySpent                                          = errors.New("approverSalt already spent")
+	ErrApproverSignatureExpired                                          = errors.New("approver signature expired")
+	ErrArrayLengthsDoNotMatch                                            = errors.New("array lengths do not match")
+	ErrBEIGENTransferFailed                                              = errors.New("bEIGEN transfer failed")
+	ErrBeaconChainProofMustBeAtOrAfterMostRecentWithdrawalTimestamp      = errors.New("beacon chain proof must be at or after mostRecentWithdrawalTimestamp")
+	ErrBlockRootIndexTooLarge                                            = errors.New("blockRootIndex is too large")
+	ErrCalculationIntervalSecondsMustBeMultipleOfSnapshotCadence         = errors.New("CALCULATION_INTERVAL_SECONDS must be a multiple of SNAPSHOT_CADENCE")
+	ErrCallerAlreadyActivelyDelegated                                    = errors.New("caller is already actively delegated")
+	ErrCallerCannotUndelegateStaker                                      = errors.New("caller cannot undelegate staker")
+	ErrCallerMustBeOperator                                              = errors.New("caller must be an operator")
+	ErrCallerNotRewardsUpdater                                           = errors.New("caller is not the rewardsUpdater")
+	ErrCallerNotValidClaimer                                             = errors.New("caller is not valid claimer")
+	ErrCallerNotValidCreateRewardsForAllSubmissionSubmitter              = errors.New("caller is not a valid createRewardsForAllSubmission submitter")
+	ErrCanOnlyDepositBEIGENOrEigen                                       = errors.New("Can only deposit bEIGEN or EIGEN")
+	ErrCanOnlyDepositUnderlyingToken                                     = errors.New("Can only deposit underlyingToken")
+	ErrCanOnlyWithdrawBEIGENOrEigen                                      = errors.New("Can only withdraw bEIGEN or EIGEN")
+	ErrCanOnlyWithdrawStrategyToken                                      = errors.New("Can only withdraw the strategy token")
+	ErrCannotCancelSpentSalt                                             = errors.New("cannot cancel spent salt")
+	ErrCannotResultInPodOwnerHavingNegativeShares                        = errors.New("cannot result in pod owner having negative shares")
+	ErrCannotSetDenebForkTimestampMoreThanOnce                           = errors.New("cannot set denebForkTimestamp more than once")
+	ErrCannotSetNewDenebForkTimestampTo0                                 = errors.New("cannot set newDenebForkTimestamp to 0")
+	ErrCannotUndelegateZeroAddress                                       = errors.New("cannot undelegate zero address")
+	ErrContractPaused                                                    = errors.New("contract is paused")
+	ErrCumulativeEarningsMustBeGtThanCumulativeClaimed                   = errors.New("cumulativeEarnings must be gt than cumulativeClaimed")
+	ErrDepositWouldExceedMaxStakerStrategyListLength                     = errors.New("deposit would exceed MAX_STAKER_STRATEGY_LIST_LENGTH")
+	ErrDestinationCannotBeZeroAddress                                    = errors.New("destination cannot be zero address")
+	ErrDurationExceedsMaxRewardsDuration                                 = errors.New("duration exceeds MAX_REWARDS_DURATION")
+	ErrDurationMustBeMultipleOfCalculationIntervalSeconds                = errors.New("duration must be a multiple of CALCULATION_INTERVAL_SECONDS")
+	ErrERC1271SignatureVerificationFailed                                = errors.New("ERC1271 signature verification failed")
+	ErrEigenPodManagerCannotBeZeroAddress                                = errors.New("_eigenPodManager cannot be zero address")
+	ErrExecutionPayloadProofIncorrectLength                              = errors.New("executionPayloadProof has incorrect length")
+	ErrFromOrToMustBeWhitelisted                                         = errors.New("from or to must be whitelisted")
+	ErrGenesisRewardsTimestampMustBeMultipleOfCalculationIntervalSeconds = errors.New("GENESIS_REWARDS_TIMESTAMP must be a multiple of CALCULATION_INTERVAL_SECONDS")
+	ErrHistoricalSummaryBlockRootProofIncorrectLength                    = errors.New("historicalSummaryBlockRootProof has incorrect length")
+	ErrHistoricalSummaryIndexTooLarge                                    = errors.New("historicalSummaryIndex is too large")
+	ErrIndexPaused                                                       = errors.New("index is paused")
+	ErrIndexPausedInEigenPodManager                                      = errors.New("index is paused in EigenPodManager")
+	ErrInitializePauserCanOnlyBeCalledOnce                               = errors.New("_initializePauser() can only be called once")
+	ErrInputLengthMismatch                                               = errors.New("input length mismatch")
+	ErrInputShouldBe48BytesInLength                                      = errors.New("Input should be 48 bytes in length")
+	ErrInputsMustBeSameLength                                            = errors.New("inputs must be same length")
+	ErrInvalidAttemptToPauseFunctionality                                = errors.New("invalid attempt to pause functionality")
+	ErrInvalidAttemptToUnpauseFunctionality                              = errors.New("invalid attempt to unpause functionality")
+	ErrInvalidEarnerClaimProof                                           = errors.New("invalid earner claim proof")
+	ErrInvalidEarnerLeafIndex                                            = errors.New("invalid earnerLeafIndex")
+	ErrInvalidExecutionPayloadMerkleProof                                = errors.New("Invalid executionPayload merkle proof")
+	ErrInvalidHistoricalsummaryMerkleProof                               = errors.New("Invalid historicalsummary merkle proof")
+	ErrInvalidLatestBlockHeaderRootMerkleProof                           = errors.New("Invalid latest block header root merkle proof")
+	ErrInvalidMerkleProof                                                = errors.New("Invalid merkle proof")
+	ErrInvalidRootIndex                                                  = errors.New("invalid rootIndex")
+	ErrInvalidSlotMerkleProof                                            = errors.New("Invalid slot merkle proof")
+	ErrInvalidStrategyConsidered                                         = errors.New("invalid strategy considered")
+	ErrInvalidTimestampMerkleProof                                       = errors.New("Invalid timestamp merkle proof")
+	ErrInvalidTokenClaimProof                                            = errors.New("invalid token claim proof")
+	ErrInvalidTokenLeafIndex                                             = errors.New("invalid tokenLeafIndex")
+	ErrInvalidWithdrawalMerkleProof                                      = errors.New("Invalid withdrawal merkle proof")
+	ErrMaxDepositsExceeded                                               = errors.New("max deposits exceeded")
+	ErrMaxPerDepositExceeded                                             = errors.New("max per deposit exceeded")
+	ErrMaxPerDepositExceedsMaxTotalDeposits                              = errors.New("maxPerDeposit exceeds maxTotalDeposits")
+	ErrMinWithdrawalDelayBlocksTooLarge                                  = errors.New("_minWithdrawalDelayBlocks cannot be > MAX_WITHDRAWAL_DELAY_BLOCKS")
+	ErrMintersAndMintAllowedAftersMustBeSameLength                       = errors.New("minters and mintAllowedAfters must be the same length")
+	ErrMintersAndMintingAllowancesMustBeSameLength                       = errors.New("minters and mintingAllowances must be the same length")
+	ErrMsgSenderNoMintingAllowance                                       = errors.New("msg.sender has no minting allowance")
+	ErrMsgSenderNotAllowedToMint                                         = errors.New("msg.sender is not allowed to mint yet")
+	ErrMsgSenderNotPermissionedAsPauser                                  = errors.New("msg.sender is not permissioned as pauser")
+	ErrMsgSenderNotPermissionedAsUnpauser                                = errors.New("msg.sender is not permissioned as unpauser")
+	ErrMustBe48ByteBLSPublicKey                                          = errors.New("must be a 48-byte BLS public key")
+	ErrMustInitiallyStakeForAnyValidatorWith32Ether                      = errors.New("must initially stake for any validator with 32 ether")
+	ErrNewPauserRegistryCannotBeZeroAddress                              = errors.New("newPauserRegistry cannot be the zero address")
+	ErrNewRootMustBeForNewerCalculatedPeriod                             = errors.New("new root must be for newer calculated period")
+	ErrNewSharesCannotBeZero                                             = errors.New("newShares cannot be zero")
+	ErrNewValueTooLarge                                                  = errors.New("newValue too large")
+	ErrNoStrategiesSet                                                   = errors.New("no strategies set")
+	ErrNotDelegationManager                                              = errors.New("not the DelegationManager")
+	ErrNotEigenPodManager                                                = errors.New("not eigenPodManager")
+	ErrNotPod                                                            = errors.New("not a pod")
+	ErrNotPodOwner                                                       = errors.New("not podOwner")
+	ErrNotPodOwnersEigenPod                                              = errors.New("not podOwner's EigenPod")
+	ErrNotStrategyWhitelister                                            = errors.New("not the strategyWhitelister")
+	ErrOnlyStrategyManager                                               = errors.New("onlyStrategyManager")
+	ErrOnlyStrategyManagerOrEigenPodManager                              = errors.New("onlyStrategyManagerOrEigenPodManager")
+	ErrOnlyWithdrawerCanCompleteAction                                   = errors.New("only withdrawer can complete action")
+	ErrOperatorAlreadyRegistered                                         = errors.New("operator already registered")
+	ErrOperatorNotRegistered                                             = errors.New("operator not registered")
+	ErrOperatorNotRegisteredInEigenLayer                                 = errors.New("operator is not registered in EigenLayer")
+	ErrOperatorNotRegisteredToEigenLayer                                 = errors.New("operator not registered to EigenLayer yet")
+	ErrOperatorSignatureExpired                                          = errors.New("operator signature expired")
+	ErrOperatorsCannotBeUndelegated                                      = errors.New("operators cannot be undelegated")
+	ErrPodOwnerCannotBeZeroAddress                                       = errors.New("podOwner cannot be zero address")
+	ErrProofIncorrectLength                                              = errors.New("Proof has incorrect length")
+	ErrProofLengthShouldBeMultipleOf32                                   = errors.New("proof length should be a multiple of 32")
+	ErrProofLengthShouldBeNonZeroMultipleOf32                            = errors.New("proof length should be a non-zero multiple of 32")
+	ErrProofMustBeInEpochAfterActivation                                 = errors.New("proof must be in the epoch after activation")
+	ErrProofNotForThisEigenPod                                           = errors.New("Proof is not for this EigenPod")
+	ErrReceiversAndAmountsMustBeSameLength                               = errors.New("receivers and amounts must be the same length")
+	ErrRecipientCannotBeZeroAddress                                      = errors.New("recipient cannot be zero address")
+	ErrRestakingEnabled                                                  = errors.New("restaking is enabled")
+	ErrRestakingNotEnabled                                               = errors.New("restaking is not enabled")
+	ErrRewardsCalculationEndTimestampCannotBeInFuture                    = errors.New("rewardsCalculationEndTimestamp cannot be in the future")
+	ErrRootAlreadyActivated                                              = errors.New("root already activated")
+	ErrRootAlreadyDisabled                                               = errors.New("root already disabled")
+	ErrRootDisabled                                                      = errors.New("root is disabled")
+	ErrRootNotActivated                                                  = errors.New("root not activated yet")
+	ErrRootNotFound                                                      = errors.New("root not found")
+	ErrSaltAlreadySpent                                                  = errors.New("salt already spent")
+	ErrSenderAlreadyPod                                                  = errors.New("Sender already has a pod")
+	ErrShareAmountShouldNotBeZero                                        = errors.New("shareAmount should not be zero!")
+	ErrShareAmountTooHigh                                                = errors.New("shareAmount too high")
+	ErrSharesCannotBeNegative                                            = errors.New("shares cannot be negative")
+	ErrSharesDeltaMustBeWholeGweiAmount                                  = errors.New("sharesDelta must be a whole Gwei amount")
+	ErrSharesMustBeWholeGweiAmount                                       = errors.New("shares must be a whole Gwei amount")
+	ErrSharesShouldNotBeZero                                             = errors.New("shares should not be zero!")
+	ErrSignatureExpired                                                  = errors.New("signature expired")
+	ErrSignatureNotFromSigner                                            = errors.New("signature not from signer")
+	ErrSliceOutOfBounds                                                  = errors.New("slice_outOfBounds")
+	ErrSliceOverflow                                                     = errors.New("slice_overflow")
+	ErrSlotProofIncorrectLength                                          = errors.New("slotProof has incorrect length")
+	ErrSpecifiedTimestampTooFarInPast                                    = errors.New("specified timestamp is too far in past")
+	ErrStakerAlreadyDelegated                                            = errors.New("staker is already actively delegated")
+	ErrStakerCannotBeZeroAddress                                         = errors.New("staker cannot be zero address")
+	ErrStakerMustBeDelegatedToUndelegate                                 = errors.New("staker must be delegated to undelegate")
+	ErrStakerOptOutWindowBlocksCannotBeDecreased                         = errors.New("stakerOptOutWindowBlocks cannot be decreased")
+	ErrStakerOptOutWindowBlocksTooLarge                                  = errors.New("stakerOptOutWindowBlocks cannot be > MAX_STAKER_OPT_OUT_WINDOW_BLOCKS")
+	ErrStakerSignatureExpired                                            = errors.New("staker signature expired")
+	ErrStartTimestampMustBeMultipleOfCalculationIntervalSeconds          = errors.New("startTimestamp must be a multiple of CALCULATION_INTERVAL_SECONDS")
+	ErrStartTimestampTooFarInFuture                                      = errors.New("startTimestamp too far in the future")
+	ErrStartTimestampTooFarInPast                                        = errors.New("startTimestamp too far in the past")
+	ErrStateRootAtTimestampNotFinalized                                  = errors.New("state root at timestamp not yet finalized")
+	ErrStrategiesCannotBeEmpty                                           = errors.New("strategies cannot be empty")
+	ErrStrategiesMustBeInAscendingOrderToHandleDuplicates                = errors.New("strategies must be in ascending order to handle duplicates")
+	ErrStrategyNotFound                                                  = errors.New("strategy not found")
+	ErrStrategyNotWhitelisted                                            = errors.New("strategy not whitelisted")
+	ErrStrategyWithdrawalDelayNotPassed                                  = errors.New("withdrawalDelayBlocks period has not yet passed for this strategy")
+	ErrThirdPartyTransfersForbidden                                      = errors.New("withdrawer must be same address as staker if thirdPartyTransfersForbidden are set")
+	ErrThirdTransfersDisabled                                            = errors.New("third transfers disabled")
+	ErrTimestampBeforeGenesis                                            = errors.New("timestamp is before genesis")
+	ErrTimestampProofIncorrectLength                                     = errors.New("timestampProof has incorrect length")
+	ErrToAddressOutOfBounds                                              = errors.New("toAddress_outOfBounds")
+	ErrToBytes32OutOfBounds                                              = errors.New("toBytes32_outOfBounds")
+	ErrToUint128OutOfBounds                                              = errors.New("toUint128_outOfBounds")
+	ErrToUint16OutOfBounds                                               = errors.New("toUint16_outOfBounds")
+	ErrToUint256OutOfBounds                                              = errors.New("toUint256_outOfBounds")
+	ErrToUint32OutOfBounds                                               = errors.New("toUint32_outOfBounds")
+	ErrToUint64OutOfBounds                                               = errors.New("toUint64_outOfBounds")
+	ErrToUint8OutOfBounds                                                = errors.New("toUint8_outOfBounds")
+	ErrToUint96OutOfBounds                                               = errors.New("toUint96_outOfBounds")
+	ErrTokenIndicesAndTokenProofsLengthMismatch                          = errors.New("tokenIndices and tokenProofs length mismatch")
+	ErrTokenListAndAmountsToWithdrawMustBeSameLength                     = errors.New("tokenList and amountsToWithdraw must be same length")
+	ErrTokenTreeProofsAndLeavesLengthMismatch                            = errors.New("tokenTreeProofs and leaves length mismatch")
+	ErrTransferRestrictionsAlreadyDisabled                               = errors.New("transfer restrictions are already disabled")
+	ErrValidatorFieldsIncorrectLength                                    = errors.New("Validator fields has incorrect length")
+	ErrValidatorIndicesAndProofsMustBeSameLength                         = errors.New("validatorIndices and proofs must be same length")
+	ErrValidatorMustBeInactiveToProveWithdrawalCredentials               = errors.New("Validator must be inactive to prove withdrawal credentials")
+	ErrValidatorNeverProvenToWithdrawalCredentialsPointedToThisContract  = errors.New("Validator never proven to have withdrawal credentials pointed to this contract")
+	ErrValidatorNotActive                                                = errors.New("Validator not active")
+	ErrValidatorWithdrawableButNotWithdrawn                              = errors.New("validator is withdrawable but has not withdrawn")
+	ErrValidatorsBalanceAlreadyUpdatedForThisTimestamp                   = errors.New("Validators balance has already been updated for this timestamp")
+	ErrWithdrawalAlreadyProvenForThisTimestamp                           = errors.New("withdrawal has already been proven for this timestamp")
+	ErrWithdrawalDelayBlocksTooLarge                                     = errors.New("_withdrawalDelayBlocks cannot be > MAX_WITHDRAWAL_DELAY_BLOCKS")
+	ErrWithdrawalDelayNotPassed                                          = errors.New("minWithdrawalDelayBlocks period has not yet passed")
+	ErrWithdrawalFieldsIncorrectLength                                   = errors.New("withdrawalFields has incorrect length")
+	ErrWithdrawalIndexTooLarge                                           = errors.New("withdrawalIndex is too large")
+	ErrWithdrawalProofIncorrectLength                                    = errors.New("withdrawalProof has incorrect length")
+	ErrWithdrawerMustBeStaker                                            = errors.New("withdrawer must be staker")
+	ErrZeroAddressInput                                                  = errors.New("zero address input")
+)
+
+// Catalogue maps the reason of every require and revert in the contracts to
+// where it is raised and its sentinel.
+var Catalogue = map[string]Reason{
+	"AVSDirectory.cancelSalt: cannot cancel spent salt":                                                                                    {Contract: "AVSDirectory", Function: "cancelSalt", Err: ErrCannotCancelSpentSalt},
+	"AVSDirectory.deregisterOperatorFromAVS: operator not registered":                                                                      {Contract: "AVSDirectory", Function: "deregisterOperatorFromAVS", Err: ErrOperatorNotRegistered},
+	"AVSDirectory.registerOperatorToAVS: operator already registered":                                                                      {Contract: "AVSDirectory", Function: "registerOperatorToAVS", Err: ErrOperatorAlreadyRegistered},
+	"AVSDirectory.registerOperatorToAVS: operator not registered to EigenLayer yet":                                                        {Contract: "AVSDirectory", Function: "registerOperatorToAVS", Err: ErrOperatorNotRegisteredToEigenLayer},
+	"AVSDirectory.registerOperatorToAVS: operator signature expired":                                                                       {Contract: "AVSDirectory", Function: "registerOperatorToAVS", Err: ErrOperatorSignatureExpired},
+	"AVSDirectory.registerOperatorToAVS: salt already spent":                                                                               {Contract: "AVSDirectory", Function: "registerOperatorToAVS", Err: ErrSaltAlreadySpent},
+	"BackingEigen._beforeTokenTransfer: from or to must be whitelisted":                                                                    {Contract: "BackingEigen", Function: "_beforeTokenTransfer", Err: ErrFromOrToMustBeWhitelisted},
+	"BackingEigen.disableTransferRestrictions: transfer restrictions are already disabled":                                                 {Contract: "BackingEigen", Function: "disableTransferRestrictions", Err: ErrTransferRestrictionsAlreadyDisabled},
+	"BeaconChainProofs.verifyStateRootAgainstLatestBlockRoot: Invalid latest block header root merkle proof":                               {Contract: "BeaconChainProofs", Function: "verifyStateRootAgainstLatestBlockRoot", Err: ErrInvalidLatestBlockHeaderRootMerkleProof},
+	"BeaconChainProofs.verifyStateRootAgainstLatestBlockRoot: Proof has incorrect length":                                                  {Contract: "BeaconChainProofs", Function: "verifyStateRootAgainstLatestBlockRoot", Err: ErrProofIncorrectLength},
+	"BeaconChainProofs.verifyValidatorFields: Invalid merkle proof":                                                                        {Contract: "BeaconChainProofs", Function: "verifyValidatorFields", Err: ErrInvalidMerkleProof},
+	"BeaconChainProofs.verifyValidatorFields: Proof has incorrect length":                                                                  {Contract: "BeaconChainProofs", Function: "verifyValidatorFields", Err: ErrProofIncorrectLength},
+	"BeaconChainProofs.verifyValidatorFields: Validator fields has incorrect length":                                                       {Contract: "BeaconChainProofs", Function: "verifyValidatorFields", Err: ErrValidatorFieldsIncorrectLength},
+	"BeaconChainProofs.verifyWithdrawal: Invalid executionPayload merkle proof":                                                            {Contract: "BeaconChainProofs", Function: "verifyWithdrawal", Err: ErrInvalidExecutionPayloadMerkleProof},
+	"BeaconChainProofs.verifyWithdrawal: Invalid historicalsummary merkle proof":                                                           {Contract: "BeaconChainProofs", Function: "verifyWithdrawal", Err: ErrInvalidHistoricalsummaryMerkleProof},
+	"BeaconChainProofs.verifyWithdrawal: Invalid slot merkle proof":                                                                        {Contract: "BeaconChainProofs", Function: "verifyWithdrawal", Err: ErrInvalidSlotMerkleProof},
+	"BeaconChainProofs.verifyWithdrawal: Invalid timestamp merkle proof":                                                                   {Contract: "BeaconChainProofs", Function: "verifyWithdrawal", Err: ErrInvalidTimestampMerkleProof},
+	"BeaconChainProofs.verifyWithdrawal: Invalid withdrawal merkle proof":                                                                  {Contract: "BeaconChainProofs", Function: "verifyWithdrawal", Err: ErrInvalidWithdrawalMerkleProof},
+	"BeaconChainProofs.verifyWithdrawal: blockRootIndex is too large":                                                                      {Contract: "BeaconChainProofs", Function: "verifyWithdrawal", Err: ErrBlockRootIndexTooLarge},
+	"BeaconChainProofs.verifyWithdrawal: executionPayloadProof has incorrect length":                                                       {Contract: "BeaconChainProofs", Function: "verifyWithdrawal", Err: ErrExecutionPayloadProofIncorrectLength},
+	"BeaconChainProofs.verifyWithdrawal: historicalSummaryBlockRootProof has incorrect length":                                             {Contract: "BeaconChainProofs", Function: "verifyWithdrawal", Err: ErrHistoricalSummaryBlockRootProofIncorrectLength},
+	"BeaconChainProofs.verifyWithdrawal: historicalSummaryIndex is too large":                                                              {Contract: "BeaconChainProofs", Function: "verifyWithdrawal", Err: ErrHistoricalSummaryIndexTooLarge},
+	"BeaconChainProofs.verifyWithdrawal: slotProof has incorrect length":                                                                   {Contract: "BeaconChainProofs", Function: "verifyWithdrawal", Err: ErrSlotProofIncorrectLength},
+	"BeaconChainProofs.verifyWithdrawal: timestampProof has incorrect length":                                                              {Contract: "BeaconChainProofs", Function: "verifyWithdrawal", Err: ErrTimestampProofIncorrectLength},
+	"BeaconChainProofs.verifyWithdrawal: withdrawalFields has incorrect length":                                                            {Contract: "BeaconChainProofs", Function: "verifyWithdrawal", Err: ErrWithdrawalFieldsIncorrectLength},
+	"BeaconChainProofs.verifyWithdrawal: withdrawalIndex is too large":                                                                     {Contract: "BeaconChainProofs", Function: "verifyWithdrawal", Err: ErrWithdrawalIndexTooLarge},
+	"BeaconChainProofs.verifyWithdrawal: withdrawalProof has incorrect length":                                                             {Contract: "BeaconChainProofs", Function: "verifyWithdrawal", Err: ErrWithdrawalProofIncorrectLength},
+	"DelayedWithdrawalRouter._setWithdrawalDelayBlocks: newValue too large":                                                                {Contract: "DelayedWithdrawalRouter", Function: "_setWithdrawalDelayBlocks", Err: ErrNewValueTooLarge},
+	"DelayedWithdrawalRouter.constructor: _eigenPodManager cannot be zero address":                                                         {Contract: "DelayedWithdrawalRouter", Function: "constructor", Err: ErrEigenPodManagerCannotBeZeroAddress},
+	"DelayedWithdrawalRouter.createDelayedWithdrawal: recipient cannot be zero address":                                                    {Contract: "DelayedWithdrawalRouter", Function: "createDelayedWithdrawal", Err: ErrRecipientCannotBeZeroAddress},
+	"DelayedWithdrawalRouter.onlyEigenPod: not podOwner's EigenPod":                                                                        {Contract: "DelayedWithdrawalRouter", Function: "onlyEigenPod", Err: ErrNotPodOwnersEigenPod},
+	"DelegationManager._completeQueuedWithdrawal: action is not in queue":                                                                  {Contract: "DelegationManager", Function: "_completeQueuedWithdrawal", Err: ErrActionNotInQueue},
+	"DelegationManager._completeQueuedWithdrawal: input length mismatch":                                                                   {Contract: "DelegationManager", Function: "_completeQueuedWithdrawal", Err: ErrInputLengthMismatch},
+	"DelegationManager._completeQueuedWithdrawal: minWithdrawalDelayBlocks period has not yet passed":                                      {Contract: "DelegationManager", Function: "_completeQueuedWithdrawal", Err: ErrWithdrawalDelayNotPassed},
+	"DelegationManager._completeQueuedWithdrawal: only withdrawer can complete action":                                                     {Contract: "DelegationManager", Function: "_completeQueuedWithdrawal", Err: ErrOnlyWithdrawerCanCompleteAction},
+	"DelegationManager._completeQueuedWithdrawal: withdrawalDelayBlocks period has not yet passed for this strategy":                       {Contract: "DelegationManager", Function: "_completeQueuedWithdrawal", Err: ErrStrategyWithdrawalDelayNotPassed},
+	"DelegationManager._delegate: approver signature expired":                                                                              {Contract: "DelegationManager", Function: "_delegate", Err: ErrApproverSignatureExpired},
+	"DelegationManager._delegate: approverSalt already spent":                                                                              {Contract: "DelegationManager", Function: "_delegate", Err: ErrApproverSaltAlreadySpent},
+	"DelegationManager._removeSharesAndQueueWithdrawal: staker cannot be zero address":                                                     {Contract: "DelegationManager", Function: "_removeSharesAndQueueWithdrawal", Err: ErrStakerCannotBeZeroAddress},
+	"DelegationManager._removeSharesAndQueueWithdrawal: strategies cannot be empty":                                                        {Contract: "DelegationManager", Function: "_removeSharesAndQueueWithdrawal", Err: ErrStrategiesCannotBeEmpty},
+	"DelegationManager._removeSharesAndQueueWithdrawal: withdrawer must be same address as staker if thirdPartyTransfersForbidden are set": {Contract: "DelegationManager", Function: "_removeSharesAndQueueWithdrawal", Err: ErrThirdPartyTransfersForbidden},
+	"DelegationManager._setMinWithdrawalDelayBlocks: _minWithdrawalDelayBlocks cannot be > MAX_WITHDRAWAL_DELAY_BLOCKS":                    {Contract: "DelegationManager", Function: "_setMinWithdrawalDelayBlocks", Err: ErrMinWithdrawalDelayBlocksTooLarge},
+	"DelegationManager._setOperatorDetails: stakerOptOutWindowBlocks cannot be > MAX_STAKER_OPT_OUT_WINDOW_BLOCKS":                         {Contract: "DelegationManager", Function: "_setOperatorDetails", Err: ErrStakerOptOutWindowBlocksTooLarge},
+	"DelegationManager._setOperatorDetails: stakerOptOutWindowBlocks cannot be decreased":                                                  {Contract: "DelegationManager", Function: "_setOperatorDetails", Err: ErrStakerOptOutWindowBlocksCannotBeDecreased},
+	"DelegationManager._setStrategyWithdrawalDelayBlocks: _withdrawalDelayBlocks cannot be > MAX_WITHDRAWAL_DELAY_BLOCKS":                  {Contract: "DelegationManager", Function: "_setStrategyWithdrawalDelayBlocks", Err: ErrWithdrawalDelayBlocksTooLarge},
+	"DelegationManager._setStrategyWithdrawalDelayBlocks: input length mismatch":                                                           {Contract: "DelegationManager", Function: "_setStrategyWithdrawalDelayBlocks", Err: ErrInputLengthMismatch},
+	"DelegationManager.delegateTo: operator is not registered in EigenLayer":                                                               {Contract: "DelegationManager", Function: "delegateTo", Err: ErrOperatorNotRegisteredInEigenLayer},
+	"DelegationManager.delegateTo: staker is already actively delegated":                                                                   {Contract: "DelegationManager", Function: "delegateTo", Err: ErrStakerAlreadyDelegated},
+	"DelegationManager.delegateToBySignature: operator is not registered in EigenLayer":                                                    {Contract: "DelegationManager", Function: "delegateToBySignature", Err: ErrOperatorNotRegisteredInEigenLayer},
+	"DelegationManager.delegateToBySignature: staker is already actively delegated":                                                        {Contract: "DelegationManager", Function: "delegateToBySignature", Err: ErrStakerAlreadyDelegated},
+	"DelegationManager.delegateToBySignature: staker signature expired":                                                                    {Contract: "DelegationManager", Function: "delegateToBySignature", Err: ErrStakerSignatureExpired},
+	"DelegationManager.modifyOperatorDetails: caller must be an operator":                                                                  {Contract: "DelegationManager", Function: "modifyOperatorDetails", Err: ErrCallerMustBeOperator},
+	"DelegationManager.queueWithdrawal: input length mismatch":                                                                             {Contract: "DelegationManager", Function: "queueWithdrawals", Err: ErrInputLengthMismatch},
+	"DelegationManager.queueWithdrawal: withdrawer must be staker":                                                                         {Contract: "DelegationManager", Function: "queueWithdrawals", Err: ErrWithdrawerMustBeStaker},
+	"DelegationManager.registerAsOperator: caller is already actively delegated":                                                           {Contract: "DelegationManager", Function: "registerAsOperator", Err: ErrCallerAlreadyActivelyDelegated},
+	"DelegationManager.undelegate: caller cannot undelegate staker":                                                                        {Contract: "DelegationManager", Function: "undelegate", Err: ErrCallerCannotUndelegateStaker},
+	"DelegationManager.undelegate: cannot undelegate zero address":                                                                         {Contract: "DelegationManager", Function: "undelegate", Err: ErrCannotUndelegateZeroAddress},
+	"DelegationManager.undelegate: operators cannot be undelegated":                                                                        {Contract: "DelegationManager", Function: "undelegate", Err: ErrOperatorsCannotBeUndelegated},
+	"DelegationManager.undelegate: staker must be delegated to undelegate":                                                                 {Contract: "DelegationManager", Function: "undelegate", Err: ErrStakerMustBeDelegatedToUndelegate},
+	"DelegationManager.updateOperatorMetadataURI: caller must be an operator":                                                              {Contract: "DelegationManager", Function: "updateOperatorMetadataURI", Err: ErrCallerMustBeOperator},
+	"DelegationManager: onlyStrategyManagerOrEigenPodManager":                                                                              {Contract: "DelegationManager", Function: "onlyStrategyManagerOrEigenPodManager", Err: ErrOnlyStrategyManagerOrEigenPodManager},
+	"EIP1271SignatureUtils.checkSignature_EIP1271: ERC1271 signature verification failed":                                                  {Contract: "EIP1271SignatureUtils", Function: "checkSignature_EIP1271", Err: ErrERC1271SignatureVerificationFailed},
+	"EIP1271SignatureUtils.checkSignature_EIP1271: signature not from signer":                                                              {Contract: "EIP1271SignatureUtils", Function: "checkSignature_EIP1271", Err: ErrSignatureNotFromSigner},
+	"Eigen._beforeTokenTransfer: from or to must be whitelisted":                                                                           {Contract: "Eigen", Function: "_beforeTokenTransfer", Err: ErrFromOrToMustBeWhitelisted},
+	"Eigen.disableTransferRestrictions: transfer restrictions are already disabled":                                                        {Contract: "Eigen", Function: "disableTransferRestrictions", Err: ErrTransferRestrictionsAlreadyDisabled},
+	"Eigen.initialize: minters and mintAllowedAfters must be the same length":                                                              {Contract: "Eigen", Function: "initialize", Err: ErrMintersAndMintAllowedAftersMustBeSameLength},
+	"Eigen.initialize: minters and mintingAllowances must be the same length":                                                              {Contract: "Eigen", Function: "initialize", Err: ErrMintersAndMintingAllowancesMustBeSameLength},
+	"Eigen.mint: msg.sender has no minting allowance":                                                                                      {Contract: "Eigen", Function: "mint", Err: ErrMsgSenderNoMintingAllowance},
+	"Eigen.mint: msg.sender is not allowed to mint yet":                                                                                    {Contract: "Eigen", Function: "mint", Err: ErrMsgSenderNotAllowedToMint},
+	"Eigen.multisend: receivers and amounts must be the same length":                                                                       {Contract: "Eigen", Function: "multisend", Err: ErrReceiversAndAmountsMustBeSameLength},
+	"Eigen.unwrap: bEIGEN transfer failed":                                                                                                 {Contract: "Eigen", Function: "unwrap", Err: ErrBEIGENTransferFailed},
+	"Eigen.wrap: bEIGEN transfer failed":                                                                                                   {Contract: "Eigen", Function: "wrap", Err: ErrBEIGENTransferFailed},
+	"EigenPod._calculateValidatorPubkeyHash must be a 48-byte BLS public key":                                                              {Contract: "EigenPod", Function: "_calculateValidatorPubkeyHash", Err: ErrMustBe48ByteBLSPublicKey},
+	"EigenPod._timestampToEpoch: timestamp is before genesis":                                                                              {Contract: "EigenPod", Function: "_timestampToEpoch", Err: ErrTimestampBeforeGenesis},
+	"EigenPod._verifyAndProcessWithdrawal: Validator never proven to have withdrawal credentials pointed to this contract":                 {Contract: "EigenPod", Function: "_verifyAndProcessWithdrawal", Err: ErrValidatorNeverProvenToWithdrawalCredentialsPointedToThisContract},
+	"EigenPod._verifyAndProcessWithdrawal: withdrawal has already been proven for this timestamp":                                          {Contract: "EigenPod", Function: "_verifyAndProcessWithdrawal", Err: ErrWithdrawalAlreadyProvenForThisTimestamp},
+	"EigenPod.hasEnabledRestaking: restaking is not enabled":                                                                               {Contract: "EigenPod", Function: "hasEnabledRestaking", Err: ErrRestakingNotEnabled},
+	"EigenPod.hasNeverRestaked: restaking is enabled":                                                                                      {Contract: "EigenPod", Function: "hasNeverRestaked", Err: ErrRestakingEnabled},
+	"EigenPod.initialize: podOwner cannot be zero address":                                                                                 {Contract: "EigenPod", Function: "initialize", Err: ErrPodOwnerCannotBeZeroAddress},
+	"EigenPod.onlyEigenPodManager: not eigenPodManager":                                                                                    {Contract: "EigenPod", Function: "onlyEigenPodManager", Err: ErrNotEigenPodManager},
+	"EigenPod.onlyEigenPodOwner: not podOwner":                                                                                             {Contract: "EigenPod", Function: "onlyEigenPodOwner", Err: ErrNotPodOwner},
+	"EigenPod.onlyWhenNotPaused: index is paused in EigenPodManager":                                                                       {Contract: "EigenPod", Function: "onlyWhenNotPaused", Err: ErrIndexPausedInEigenPodManager},
+	"EigenPod.proofIsForValidTimestamp: beacon chain proof must be at or after mostRecentWithdrawalTimestamp":                              {Contract: "EigenPod", Function: "proofIsForValidTimestamp", Err: ErrBeaconChainProofMustBeAtOrAfterMostRecentWithdrawalTimestamp},
+	"EigenPod.recoverTokens: tokenList and amountsToWithdraw must be same length":                                                          {Contract: "EigenPod", Function: "recoverTokens", Err: ErrTokenListAndAmountsToWithdrawMustBeSameLength},
+	"EigenPod.stake: must initially stake for any validator with 32 ether":                                                                 {Contract: "EigenPod", Function: "stake", Err: ErrMustInitiallyStakeForAnyValidatorWith32Ether},
+	"EigenPod.verifyAndProcessWithdrawals: inputs must be same length":                                                                     {Contract: "EigenPod", Function: "verifyAndProcessWithdrawals", Err: ErrInputsMustBeSameLength},
+	"EigenPod.verifyBalanceUpdate: Validator not active":                                                                                   {Contract: "EigenPod", Function: "_verifyBalanceUpdate", Err: ErrValidatorNotActive},
+	"EigenPod.verifyBalanceUpdate: Validators balance has already been updated for this timestamp":                                         {Contract: "EigenPod", Function: "_verifyBalanceUpdate", Err: ErrValidatorsBalanceAlreadyUpdatedForThisTimestamp},
+	"EigenPod.verifyBalanceUpdate: validator is withdrawable but has not withdrawn":                                                        {Contract: "EigenPod", Function: "_verifyBalanceUpdate", Err: ErrValidatorWithdrawableButNotWithdrawn},
+	"EigenPod.verifyBalanceUpdates: specified timestamp is too far in past":                                                                {Contract: "EigenPod", Function: "verifyBalanceUpdates", Err: ErrSpecifiedTimestampTooFarInPast},
+	"EigenPod.verifyBalanceUpdates: validatorIndices and proofs must be same length":                                                       {Contract: "EigenPod", Function: "verifyBalanceUpdates", Err: ErrValidatorIndicesAndProofsMustBeSameLength},
+	"EigenPod.verifyCorrectWithdrawalCredentials: Proof is not for this EigenPod":                                                          {Contract: "EigenPod", Function: "_verifyWithdrawalCredentials", Err: ErrProofNotForThisEigenPod},
+	"EigenPod.verifyCorrectWithdrawalCredentials: Validator must be inactive to prove withdrawal credentials":                              {Contract: "EigenPod", Function: "_verifyWithdrawalCredentials", Err: ErrValidatorMustBeInactiveToProveWithdrawalCredentials},
+	"EigenPod.verifyWithdrawalCredentials: proof must be in the epoch after activation":                                                    {Contract: "EigenPod", Function: "verifyWithdrawalCredentials", Err: ErrProofMustBeInEpochAfterActivation},
+	"EigenPod.verifyWithdrawalCredentials: specified timestamp is too far in past":                                                         {Contract: "EigenPod", Function: "verifyWithdrawalCredentials", Err: ErrSpecifiedTimestampTooFarInPast},
+	"EigenPod.verifyWithdrawalCredentials: validatorIndices and proofs must be same length":                                                {Contract: "EigenPod", Function: "verifyWithdrawalCredentials", Err: ErrValidatorIndicesAndProofsMustBeSameLength},
+	"EigenPod.withdrawRestakedBeaconChainETH: amountGwei exceeds withdrawableRestakedExecutionLayerGwei":                                   {Contract: "EigenPod", Function: "withdrawRestakedBeaconChainETH", Err: ErrAmountGweiExceedsWithdrawableRestakedExecutionLayerGwei},
+	"EigenPod.withdrawRestakedBeaconChainETH: amountWei must be a whole Gwei amount":                                                       {Contract: "EigenPod", Function: "withdrawRestakedBeaconChainETH", Err: ErrAmountWeiMustBeWholeGweiAmount},
+	"EigenPod.withdrawnonBeaconChainETHBalanceWei: amountToWithdraw is greater than nonBeaconChainETHBalanceWei":                           {Contract: "EigenPod", Function: "withdrawNonBeaconChainETHBalanceWei", Err: ErrAmountToWithdrawGreaterThanNonBeaconChainETHBalanceWei},
+	"EigenPodManager.addShares: podOwner cannot be zero address":                                                                           {Contract: "EigenPodManager", Function: "addShares", Err: ErrPodOwnerCannotBeZeroAddress},
+	"EigenPodManager.addShares: shares cannot be negative":                                                                                 {Contract: "EigenPodManager", Function: "addShares", Err: ErrSharesCannotBeNegative},
+	"EigenPodManager.addShares: shares must be a whole Gwei amount":                                                                        {Contract: "EigenPodManager", Function: "addShares", Err: ErrSharesMustBeWholeGweiAmount},
+	"EigenPodManager.createPod: Sender already has a pod":                                                                                  {Contract: "EigenPodManager", Function: "createPod", Err: ErrSenderAlreadyPod},
+	"EigenPodManager.getBlockRootAtTimestamp: state root at timestamp not yet finalized":                                                   {Contract: "EigenPodManager", Function: "getBlockRootAtTimestamp", Err: ErrStateRootAtTimestampNotFinalized},
+	"EigenPodManager.onlyDelegationManager: not the DelegationManager":                                                                     {Contract: "EigenPodManager", Function: "onlyDelegationManager", Err: ErrNotDelegationManager},
+	"EigenPodManager.onlyEigenPod: not a pod":                                                                                              {Contract: "EigenPodManager", Function: "onlyEigenPod", Err: ErrNotPod},
+	"EigenPodManager.recordBeaconChainETHBalanceUpdate: podOwner cannot be zero address":                                                   {Contract: "EigenPodManager", Function: "recordBeaconChainETHBalanceUpdate", Err: ErrPodOwnerCannotBeZeroAddress},
+	"EigenPodManager.recordBeaconChainETHBalanceUpdate: sharesDelta must be a whole Gwei amount":                                           {Contract: "EigenPodManager", Function: "recordBeaconChainETHBalanceUpdate", Err: ErrSharesDeltaMustBeWholeGweiAmount},
+	"EigenPodManager.removeShares: cannot result in pod owner having negative shares":                                                      {Contract: "EigenPodManager", Function: "removeShares", Err: ErrCannotResultInPodOwnerHavingNegativeShares},
+	"EigenPodManager.removeShares: shares cannot be negative":                                                                              {Contract: "EigenPodManager", Function: "removeShares", Err: ErrSharesCannotBeNegative},
+	"EigenPodManager.removeShares: shares must be a whole Gwei amount":                                                                     {Contract: "EigenPodManager", Function: "removeShares", Err: ErrSharesMustBeWholeGweiAmount},
+	"EigenPodManager.setDenebForkTimestamp: cannot set denebForkTimestamp more than once":                                                  {Contract: "EigenPodManager", Function: "setDenebForkTimestamp", Err: ErrCannotSetDenebForkTimestampMoreThanOnce},
+	"EigenPodManager.setDenebForkTimestamp: cannot set newDenebForkTimestamp to 0":                                                         {Contract: "EigenPodManager", Function: "setDenebForkTimestamp", Err: ErrCannotSetNewDenebForkTimestampTo0},
+	"EigenPodManager.withdrawSharesAsTokens: destination cannot be zero address":                                                           {Contract: "EigenPodManager", Function: "withdrawSharesAsTokens", Err: ErrDestinationCannotBeZeroAddress},
+	"EigenPodManager.withdrawSharesAsTokens: podOwner cannot be zero address":                                                              {Contract: "EigenPodManager", Function: "withdrawSharesAsTokens", Err: ErrPodOwnerCannotBeZeroAddress},
+	"EigenPodManager.withdrawSharesAsTokens: shares cannot be negative":                                                                    {Contract: "EigenPodManager", Function: "withdrawSharesAsTokens", Err: ErrSharesCannotBeNegative},
+	"EigenPodManager.withdrawSharesAsTokens: shares must be a whole Gwei amount":                                                           {Contract: "EigenPodManager", Function: "withdrawSharesAsTokens", Err: ErrSharesMustBeWholeGweiAmount},
+	"EigenStrategy.deposit: Can only deposit bEIGEN or EIGEN":                                                                              {Contract: "EigenStrategy", Function: "_beforeDeposit", Err: ErrCanOnlyDepositBEIGENOrEigen},
+	"EigenStrategy.withdraw: Can only withdraw bEIGEN or EIGEN":                                                                            {Contract: "EigenStrategy", Function: "_beforeWithdrawal", Err: ErrCanOnlyWithdrawBEIGENOrEigen},
+	"Input should be 48 bytes in length":                                                                                                   {Contract: "BeaconChainProofs", Function: "hashValidatorBLSPubkey", Err: ErrInputShouldBe48BytesInLength},
+	"Merkle.processInclusionProofKeccak: proof length should be a multiple of 32":                                                          {Contract: "Merkle", Function: "processInclusionProofKeccak", Err: ErrProofLengthShouldBeMultipleOf32},
+	"Merkle.processInclusionProofSha256: proof length should be a non-zero multiple of 32":                                                 {Contract: "Merkle", Function: "processInclusionProofSha256", Err: ErrProofLengthShouldBeNonZeroMultipleOf32},
+	"Pausable._initializePauser: _initializePauser() can only be called once":                                                              {Contract: "Pausable", Function: "_initializePauser", Err: ErrInitializePauserCanOnlyBeCalledOnce},
+	"Pausable._setPauserRegistry: newPauserRegistry cannot be the zero address":                                                            {Contract: "Pausable", Function: "_setPauserRegistry", Err: ErrNewPauserRegistryCannotBeZeroAddress},
+	"Pausable.pause: invalid attempt to unpause functionality":                                                                             {Contract: "Pausable", Function: "pause", Err: ErrInvalidAttemptToUnpauseFunctionality},
+	"Pausable.unpause: invalid attempt to pause functionality":                                                                             {Contract: "Pausable", Function: "unpause", Err: ErrInvalidAttemptToPauseFunctionality},
+	"Pausable: contract is paused":                                                                                                         {Contract: "Pausable", Function: "whenNotPaused", Err: ErrContractPaused},
+	"Pausable: index is paused":                                                                                                            {Contract: "Pausable", Function: "onlyWhenNotPaused", Err: ErrIndexPaused},
+	"PauserRegistry._setPauser: zero address input":                                                                                        {Contract: "PauserRegistry", Function: "_setIsPauser", Err: ErrZeroAddressInput},
+	"PauserRegistry._setUnpauser: zero address input":                                                                                      {Contract: "PauserRegistry", Function: "_setUnpauser", Err: ErrZeroAddressInput},
+	"RewardsCoordinator._checkClaim: root is disabled":                                                                                     {Contract: "RewardsCoordinator", Function: "_checkClaim", Err: ErrRootDisabled},
+	"RewardsCoordinator._checkClaim: root not activated yet":                                                                               {Contract: "RewardsCoordinator", Function: "_checkClaim", Err: ErrRootNotActivated},
+	"RewardsCoordinator._checkClaim: tokenIndices and tokenProofs length mismatch":                                                         {Contract: "RewardsCoordinator", Function: "_checkClaim", Err: ErrTokenIndicesAndTokenProofsLengthMismatch},
+	"RewardsCoordinator._checkClaim: tokenTreeProofs and leaves length mismatch":                                                           {Contract: "RewardsCoordinator", Function: "_checkClaim", Err: ErrTokenTreeProofsAndLeavesLengthMismatch},
+	"RewardsCoordinator._validateRewardsSubmission: amount cannot be 0":                                                                    {Contract: "RewardsCoordinator", Function: "_validateRewardsSubmission", Err: ErrAmountCannotBe0},
+	"RewardsCoordinator._validateRewardsSubmission: amount too large":                                                                      {Contract: "RewardsCoordinator", Function: "_validateRewardsSubmission", Err: ErrAmountTooLarge},
+	"RewardsCoordinator._validateRewardsSubmission: duration exceeds MAX_REWARDS_DURATION":                                                 {Contract: "RewardsCoordinator", Function: "_validateRewardsSubmission", Err: ErrDurationExceedsMaxRewardsDuration},
+	"RewardsCoordinator._validateRewardsSubmission: duration must be a multiple of CALCULATION_INTERVAL_SECONDS":                           {Contract: "RewardsCoordinator", Function: "_validateRewardsSubmission", Err: ErrDurationMustBeMultipleOfCalculationIntervalSeconds},
+	"RewardsCoordinator._validateRewardsSubmission: invalid strategy considered":                                                           {Contract: "RewardsCoordinator", Function: "_validateRewardsSubmission", Err: ErrInvalidStrategyConsidered},
+	"RewardsCoordinator._validateRewardsSubmission: no strategies set":                                                                     {Contract: "RewardsCoordinator", Function: "_validateRewardsSubmission", Err: ErrNoStrategiesSet},
+	"RewardsCoordinator._validateRewardsSubmission: startTimestamp must be a multiple of CALCULATION_INTERVAL_SECONDS":                     {Contract: "RewardsCoordinator", Function: "_validateRewardsSubmission", Err: ErrStartTimestampMustBeMultipleOfCalculationIntervalSeconds},
+	"RewardsCoordinator._validateRewardsSubmission: startTimestamp too far in the future":                                                  {Contract: "RewardsCoordinator", Function: "_validateRewardsSubmission", Err: ErrStartTimestampTooFarInFuture},
+	"RewardsCoordinator._validateRewardsSubmission: startTimestamp too far in the past":                                                    {Contract: "RewardsCoordinator", Function: "_validateRewardsSubmission", Err: ErrStartTimestampTooFarInPast},
+	"RewardsCoordinator._validateRewardsSubmission: strategies must be in ascending order to handle duplicates":                            {Contract: "RewardsCoordinator", Function: "_validateRewardsSubmission", Err: ErrStrategiesMustBeInAscendingOrderToHandleDuplicates},
+	"RewardsCoordinator._verifyEarnerClaimProof: invalid earner claim proof":                                                               {Contract: "RewardsCoordinator", Function: "_verifyEarnerClaimProof", Err: ErrInvalidEarnerClaimProof},
+	"RewardsCoordinator._verifyEarnerClaimProof: invalid earnerLeafIndex":                                                                  {Contract: "RewardsCoordinator", Function: "_verifyEarnerClaimProof", Err: ErrInvalidEarnerLeafIndex},
+	"RewardsCoordinator._verifyTokenClaim: invalid token claim proof":                                                                      {Contract: "RewardsCoordinator", Function: "_verifyTokenClaimProof", Err: ErrInvalidTokenClaimProof},
+	"RewardsCoordinator._verifyTokenClaim: invalid tokenLeafIndex":                                                                         {Contract: "RewardsCoordinator", Function: "_verifyTokenClaimProof", Err: ErrInvalidTokenLeafIndex},
+	"RewardsCoordinator.disableRoot: invalid rootIndex":                                                                                    {Contract: "RewardsCoordinator", Function: "disableRoot", Err: ErrInvalidRootIndex},
+	"RewardsCoordinator.disableRoot: root already activated":                                                                               {Contract: "RewardsCoordinator", Function: "disableRoot", Err: ErrRootAlreadyActivated},
+	"RewardsCoordinator.disableRoot: root already disabled":                                                                                {Contract: "RewardsCoordinator", Function: "disableRoot", Err: ErrRootAlreadyDisabled},
+	"RewardsCoordinator.getRootIndexFromHash: root not found":                                                                              {Contract: "RewardsCoordinator", Function: "getRootIndexFromHash", Err: ErrRootNotFound},
+	"RewardsCoordinator.processClaim: caller is not valid claimer":                                                                         {Contract: "RewardsCoordinator", Function: "processClaim", Err: ErrCallerNotValidClaimer},
+	"RewardsCoordinator.processClaim: cumulativeEarnings must be gt than cumulativeClaimed":                                                {Contract: "RewardsCoordinator", Function: "processClaim", Err: ErrCumulativeEarningsMustBeGtThanCumulativeClaimed},
+	"RewardsCoordinator.submitRoot: new root must be for newer calculated period":                                                          {Contract: "RewardsCoordinator", Function: "submitRoot", Err: ErrNewRootMustBeForNewerCalculatedPeriod},
+	"RewardsCoordinator.submitRoot: rewardsCalculationEndTimestamp cannot be in the future":                                                {Contract: "RewardsCoordinator", Function: "submitRoot", Err: ErrRewardsCalculationEndTimestampCannotBeInFuture},
+	"RewardsCoordinator: CALCULATION_INTERVAL_SECONDS must be a multiple of SNAPSHOT_CADENCE":                                              {Contract: "RewardsCoordinatorStorage", Function: "constructor", Err: ErrCalculationIntervalSecondsMustBeMultipleOfSnapshotCadence},
+	"RewardsCoordinator: GENESIS_REWARDS_TIMESTAMP must be a multiple of CALCULATION_INTERVAL_SECONDS":                                     {Contract: "RewardsCoordinatorStorage", Function: "constructor", Err: ErrGenesisRewardsTimestampMustBeMultipleOfCalculationIntervalSeconds},
+	"RewardsCoordinator: caller is not a valid createRewardsForAllSubmission submitter":                                                    {Contract: "RewardsCoordinator", Function: "onlyRewardsForAllSubmitter", Err: ErrCallerNotValidCreateRewardsForAllSubmissionSubmitter},
+	"RewardsCoordinator: caller is not the rewardsUpdater":                                                                                 {Contract: "RewardsCoordinator", Function: "onlyRewardsUpdater", Err: ErrCallerNotRewardsUpdater},
+	"StrategyBase.deposit: Can only deposit underlyingToken":                                                                               {Contract: "StrategyBase", Function: "_beforeDeposit", Err: ErrCanOnlyDepositUnderlyingToken},
+	"StrategyBase.deposit: newShares cannot be zero":                                                                                       {Contract: "StrategyBase", Function: "deposit", Err: ErrNewSharesCannotBeZero},
+	"StrategyBase.onlyStrategyManager":                                                                                                     {Contract: "StrategyBase", Function: "onlyStrategyManager", Err: ErrOnlyStrategyManager},
+	"StrategyBase.withdraw: Can only withdraw the strategy token":                                                                          {Contract: "StrategyBase", Function: "_beforeWithdrawal", Err: ErrCanOnlyWithdrawStrategyToken},
+	"StrategyBase.withdraw: amountShares must be less than or equal to totalShares":                                                        {Contract: "StrategyBase", Function: "withdraw", Err: ErrAmountSharesMustBeLessThanOrEqualToTotalShares},
+	"StrategyBaseTVLLimits._setTVLLimits: maxPerDeposit exceeds maxTotalDeposits":                                                          {Contract: "StrategyBaseTVLLimits", Function: "_setTVLLimits", Err: ErrMaxPerDepositExceedsMaxTotalDeposits},
+	"StrategyBaseTVLLimits: max deposits exceeded":                                                                                         {Contract: "StrategyBaseTVLLimits", Function: "_beforeDeposit", Err: ErrMaxDepositsExceeded},
+	"StrategyBaseTVLLimits: max per deposit exceeded":                                                                                      {Contract: "StrategyBaseTVLLimits", Function: "_beforeDeposit", Err: ErrMaxPerDepositExceeded},
+	"StrategyManager._addShares: deposit would exceed MAX_STAKER_STRATEGY_LIST_LENGTH":                                                     {Contract: "StrategyManager", Function: "_addShares", Err: ErrDepositWouldExceedMaxStakerStrategyListLength},
+	"StrategyManager._addShares: shares should not be zero!":                                                                               {Contract: "StrategyManager", Function: "_addShares", Err: ErrSharesShouldNotBeZero},
+	"StrategyManager._addShares: staker cannot be zero address":                                                                            {Contract: "StrategyManager", Function: "_addShares", Err: ErrStakerCannotBeZeroAddress},
+	"StrategyManager._removeShares: shareAmount should not be zero!":                                                                       {Contract: "StrategyManager", Function: "_removeShares", Err: ErrShareAmountShouldNotBeZero},
+	"StrategyManager._removeShares: shareAmount too high":                                                                                  {Contract: "StrategyManager", Function: "_removeShares", Err: ErrShareAmountTooHigh},
+	"StrategyManager._removeStrategyFromStakerStrategyList: strategy not found":                                                            {Contract: "StrategyManager", Function: "_removeStrategyFromStakerStrategyList", Err: ErrStrategyNotFound},
+	"StrategyManager.addStrategiesToDepositWhitelist: array lengths do not match":                                                          {Contract: "StrategyManager", Function: "addStrategiesToDepositWhitelist", Err: ErrArrayLengthsDoNotMatch},
+	"StrategyManager.depositIntoStrategyWithSignature: signature expired":                                                                  {Contract: "StrategyManager", Function: "depositIntoStrategyWithSignature", Err: ErrSignatureExpired},
+	"StrategyManager.depositIntoStrategyWithSignature: third transfers disabled":                                                           {Contract: "StrategyManager", Function: "depositIntoStrategyWithSignature", Err: ErrThirdTransfersDisabled},
+	"StrategyManager.onlyDelegationManager: not the DelegationManager":                                                                     {Contract: "StrategyManager", Function: "onlyDelegationManager", Err: ErrNotDelegationManager},
+	"StrategyManager.onlyStrategiesWhitelistedForDeposit: strategy not whitelisted":                                                        {Contract: "StrategyManager", Function: "onlyStrategiesWhitelistedForDeposit", Err: ErrStrategyNotWhitelisted},
+	"StrategyManager.onlyStrategyWhitelister: not the strategyWhitelister":                                                                 {Contract: "StrategyManager", Function: "onlyStrategyWhitelister", Err: ErrNotStrategyWhitelister},
+	"msg.sender is not permissioned as pauser":                                                                                             {Contract: "Pausable", Function: "onlyPauser", Err: ErrMsgSenderNotPermissionedAsPauser},
+	"msg.sender is not permissioned as unpauser":                                                                                           {Contract: "Pausable", Function: "onlyUnpauser", Err: ErrMsgSenderNotPermissionedAsUnpauser},
+	"slice_outOfBounds":     {Contract: "BytesLib", Function: "slice", Err: ErrSliceOutOfBounds},
+	"slice_overflow":        {Contract: "BytesLib", Function: "slice", Err: ErrSliceOverflow},
+	"toAddress_outOfBounds": {Contract: "BytesLib", Function: "toAddress", Err: ErrToAddressOutOfBounds},
+	"toBytes32_outOfBounds": {Contract: "BytesLib", Function: "toBytes32", Err: ErrToBytes32OutOfBounds},
+	"toUint128_outOfBounds": {Contract: "BytesLib", Function: "toUint128", Err: ErrToUint128OutOfBounds},
+	"toUint16_outOfBounds":  {Contract: "BytesLib", Function: "toUint16", Err: ErrToUint16OutOfBounds},
+	"toUint256_outOfBounds": {Contract: "BytesLib", Function: "toUint256", Err: ErrToUint256OutOfBounds},
+	"toUint32_outOfBounds":  {Contract: "BytesLib", Function: "toUint32", Err: ErrToUint32OutOfBounds},
+	"toUint64_outOfBounds":  {Contract: "BytesLib", Function: "toUint64", Err: ErrToUint64OutOfBounds},
+	"toUint8_outOfBounds":   {Contract: "BytesLib", Function: "toUint8", Err: ErrToUint8OutOfBounds},
+	"toUint96_outOfBounds":  {Contract: "BytesLib", Function: "toUint96", Err: ErrToUint96OutOfBounds},
+}
@@ -0,0 +1,115 @@
+// Package reverts maps the reasons the EigenLayer contracts revert with to
+// sentinel errors, so that callers can tell reverts apart with errors.Is
+// instead of matching reason strings:
+//
+//	_, err := dm.DelegateTo(opts, operator, sig, salt)
+//	if errors.Is(reverts.Decode(err), reverts.ErrStakerAlreadyDelegated) {
+//		...
+//	}
+//
+// The catalogue is generated by bindgen from the require and revert
+// statements in src/contracts.
+// Reverts of pkg/fakes match their sentinel without decoding.
+package reverts
+
+import (
+	"encoding/hex"
+	"errors"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// Reason locates a catalogued revert reason and holds the sentinel error of
+// its message.
+type Reason struct {
+	Contract string
+	// Function is the function or modifier raising the reason, or
+	// "constructor".
+	Function string
+	Err      error
+}
+
+// Error is a decoded revert. It matches the sentinel of its reason, if
+// catalogued, and the error it was decoded from with errors.Is and errors.As.
+type Error struct {
+	// Reason is the revert reason, e.g. "DelegationManager.delegateTo:
+	// staker is already actively delegated", or the description of a
+	// Solidity panic.
+	Reason string
+	// Contract and Function locate Reason. Both are empty if it is not
+	// catalogued.
+	Contract string
+	Function string
+
+	sentinel error
+	cause    error
+}
+
+func (e *Error) Error() string {
+	return "execution reverted: " + e.Reason
+}
+
+func (e *Error) Unwrap() []error {
+	var errs []error
+	if e.sentinel != nil {
+		errs = append(errs, e.sentinel)
+	}
+	if e.cause != nil {
+		errs = append(errs, e.cause)
+	}
+	return errs
+}
+
+// FromReason returns the Error for a revert reason.
+func FromReason(reason string) *Error {
+	r := Catalogue[reason]
+	return &Error{Reason: reason, Contract: r.Contract, Function: r.Function, sentinel: r.Err}
+}
+
+// Decode returns an *Error for err if it carries revert data, as the
+// JSON-RPC errors of eth_call and eth_estimateGas do, and err unchanged
+// otherwise.
+func Decode(err error) error {
+	if err == nil {
+		return nil
+	}
+	var decoded *Error
+	if errors.As(err, &decoded) {
+		return err
+	}
+	data, ok := Data(err)
+	if !ok {
+		return err
+	}
+	reason, uerr := abi.UnpackRevert(data)
+	if uerr != nil {
+		return err
+	}
+	e := FromReason(reason)
+	e.cause = err
+	return e
+}
+
+// Data returns the revert data carried by err, if any.
+func Data(err error) ([]byte, bool) {
+	var de rpc.DataError
+	if !errors.As(err, &de) {
+		return nil, false
+	}
+	switch data := de.ErrorData().(type) {
+	case []byte:
+		return data, true
+	case hexutil.Bytes:
+		return data, true
+	case string:
+		b, err := hex.DecodeString(strings.TrimPrefix(data, "0x"))
+		if err != nil {
+			return nil, false
+		}
+		return b, true
+	}
+	return nil, false
+}
@@ -0,0 +1,198 @@
+package reverts_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/reverts"
+)
+
+// rpcError is a JSON-RPC error carrying revert data, as eth_call and
+// eth_estimateGas return.
+type rpcError struct {
+	data any
+}
+
+func (e *rpcError) Error() string  { return "execution reverted" }
+func (e *rpcError) ErrorCode() int { return 3 }
+func (e *rpcError) ErrorData() any { return e.data }
+
+// revertData returns the data of a revert with signature and args.
+func revertData(t *testing.T, signature string, args ...any) []byte {
+	t.Helper()
+	var arguments abi.Arguments
+	for _, arg := range args {
+		name := "string"
+		if _, ok := arg.(*big.Int); ok {
+			name = "uint256"
+		}
+		typ, err := abi.NewType(name, "", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		arguments = append(arguments, abi.Argument{Type: typ})
+	}
+	packed, err := arguments.Pack(args...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return append(crypto.Keccak256([]byte(signature))[:4], packed...)
+}
+
+func TestDecode(t *testing.T) {
+	const delegated = "DelegationManager.delegateTo: staker is already actively delegated"
+	tests := []struct {
+		name string
+		err  func(t *testing.T) error
+		// want is the decoded revert, nil if err is returned unchanged.
+		want     *reverts.Error
+		sentinel error
+	}{
+		{
+			name:     "catalogued reason",
+			err:      func(t *testing.T) error { return &rpcError{revertData(t, "Error(string)", delegated)} },
+			want:     &reverts.Error{Reason: delegated, Contract: "DelegationManager", Function: "delegateTo"},
+			sentinel: reverts.ErrStakerAlreadyDelegated,
+		},
+		{
+			name: "catalogued reason as hexutil.Bytes",
+			err: func(t *testing.T) error {
+				return &rpcError{hexutil.Bytes(revertData(t, "Error(string)", delegated))}
+			},
+			want:     &reverts.Error{Reason: delegated, Contract: "DelegationManager", Function: "delegateTo"},
+			sentinel: reverts.ErrStakerAlreadyDelegated,
+		},
+		{
+			name: "catalogued reason as a hex string",
+			err: func(t *testing.T) error {
+				return &rpcError{hexutil.Encode(revertData(t, "Error(string)", delegated))}
+			},
+			want:     &reverts.Error{Reason: delegated, Contract: "DelegationManager", Function: "delegateTo"},
+			sentinel: reverts.ErrStakerAlreadyDelegated,
+		},
+		{
+			name: "reason of the library",
+			err: func(t *testing.T) error {
+				return &rpcError{revertData(t, "Error(string)", "Input should be 48 bytes in length")}
+			},
+			want:     &reverts.Error{Reason: "Input should be 48 bytes in length", Contract: "BeaconChainProofs", Function: "hashValidatorBLSPubkey"},
+			sentinel: reverts.ErrInputShouldBe48BytesInLength,
+		},
+		{
+			name: "unknown reason",
+			err: func(t *testing.T) error {
+				return &rpcError{revertData(t, "Error(string)", "Ownable: caller is not the owner!")}
+			},
+			want: &reverts.Error{Reason: "Ownable: caller is not the owner!"},
+		},
+		{
+			name: "panic",
+			err:  func(t *testing.T) error { return &rpcError{revertData(t, "Panic(uint256)", big.NewInt(0x32))} },
+			want: &reverts.Error{Reason: "out-of-bounds access of an array or bytesN"},
+		},
+		{
+			name: "custom error",
+			err:  func(t *testing.T) error { return &rpcError{revertData(t, "Unauthorized(string)", delegated)} },
+		},
+		{
+			name: "truncated reason",
+			err:  func(t *testing.T) error { return &rpcError{revertData(t, "Error(string)", delegated)[:40]} },
+		},
+		{
+			name: "selector only",
+			err:  func(t *testing.T) error { return &rpcError{revertData(t, "Error(string)")} },
+		},
+		{
+			name: "empty data",
+			err:  func(t *testing.T) error { return &rpcError{[]byte{}} },
+		},
+		{
+			name: "invalid hex string",
+			err:  func(t *testing.T) error { return &rpcError{"0xzz"} },
+		},
+		{
+			name: "data of another type",
+			err:  func(t *testing.T) error { return &rpcError{42} },
+		},
+		{
+			name: "no data",
+			err:  func(t *testing.T) error { return errors.New("connection refused") },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.err(t)
+			decoded := reverts.Decode(err)
+			var got *reverts.Error
+			if !errors.As(decoded, &got) {
+				if tt.want != nil {
+					t.Fatalf("Decode(%v) = %v, want a revert", err, decoded)
+				}
+				if decoded != err {
+					t.Fatalf("Decode(%v) = %v, want it unchanged", err, decoded)
+				}
+				return
+			}
+			if tt.want == nil {
+				t.Fatalf("Decode(%v) = %v, want it unchanged", err, decoded)
+			}
+			if got.Reason != tt.want.Reason || got.Contract != tt.want.Contract || got.Function != tt.want.Function {
+				t.Errorf("decoded %q of %s.%s, want %q of %s.%s", got.Reason, got.Contract, got.Function, tt.want.Reason, tt.want.Contract, tt.want.Function)
+			}
+			if !errors.Is(decoded, err) {
+				t.Errorf("%v does not match the error it was decoded from", decoded)
+			}
+			if tt.sentinel != nil && !errors.Is(decoded, tt.sentinel) {
+				t.Errorf("%v does not match %v", decoded, tt.sentinel)
+			}
+			if tt.sentinel == nil {
+				for reason, r := range reverts.Catalogue {
+					if errors.Is(decoded, r.Err) {
+						t.Errorf("%v matches the sentinel of %q", decoded, reason)
+						break
+					}
+				}
+			}
+			// Decoding again leaves the revert as it is.
+			if again := reverts.Decode(decoded); again != decoded {
+				t.Errorf("Decode(%v) = %v, want it unchanged", decoded, again)
+			}
+		})
+	}
+	if reverts.Decode(nil) != nil {
+		t.Error("Decode(nil) is not nil")
+	}
+}
+
+func TestFromReason(t *testing.T) {
+	sentinels := make(map[error]bool)
+	for reason, r := range reverts.Catalogue {
+		sentinels[r.Err] = true
+		err := reverts.FromReason(reason)
+		if !errors.Is(err, r.Err) {
+			t.Errorf("%q does not match %v", reason, r.Err)
+		}
+		if err.Contract != r.Contract || err.Function != r.Function {
+			t.Errorf("%q is of %s.%s, want %s.%s", reason, err.Contract, err.Function, r.Contract, r.Function)
+		}
+	}
+	// An unknown reason matches no sentinel.
+	err := reverts.FromReason("EigenPod.unknown: not a reason")
+	for sentinel := range sentinels {
+		if errors.Is(err, sentinel) {
+			t.Errorf("unknown reason matches %v", sentinel)
+		}
+	}
+	if err.Contract != "" || err.Function != "" || err.Error() != "execution reverted: EigenPod.unknown: not a reason" {
+		t.Errorf("unknown reason decoded as %v of %q.%q", err, err.Contract, err.Function)
+	}
+	// Reasons of different functions sharing a message share its sentinel.
+	if !errors.Is(reverts.FromReason("DelegationManager.delegateToBySignature: staker is already actively delegated"), reverts.ErrStakerAlreadyDelegated) {
+		t.Error("delegateToBySignature does not match the sentinel of delegateTo")
+	}
+}
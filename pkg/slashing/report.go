@@ -0,0 +1,122 @@
+// Package slashing reads the state of the legacy M1 Slasher.
+//
+// The Slasher is a stub as of the M2 release, but DelegationManager still
+// takes a middlewareTimesIndex for every queued withdrawal it completes.
+// Report collects everything the Slasher records about an operator so
+// tooling can inspect it and pick that index.
+package slashing
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/ISlasher"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/types"
+)
+
+// Reader is the subset of the ISlasher binding a Report is read from, bound
+// to the Slasher proxy.
+type Reader interface {
+	IsFrozen(opts *bind.CallOpts, operator common.Address) (bool, error)
+	MiddlewareTimesLength(opts *bind.CallOpts, operator common.Address) (*big.Int, error)
+	OperatorToMiddlewareTimes(opts *bind.CallOpts, operator common.Address, index *big.Int) (ISlasher.ISlasherMiddlewareTimes, error)
+	OperatorWhitelistedContractsLinkedListSize(opts *bind.CallOpts, operator common.Address) (*big.Int, error)
+	OperatorWhitelistedContractsLinkedListEntry(opts *bind.CallOpts, operator common.Address, node common.Address) (bool, *big.Int, *big.Int, error)
+	ContractCanSlashOperatorUntilBlock(opts *bind.CallOpts, operator common.Address, serviceContract common.Address) (uint32, error)
+	LatestUpdateBlock(opts *bind.CallOpts, operator common.Address, serviceContract common.Address) (uint32, error)
+}
+
+var _ Reader = ISlasher.ISlasherReader(nil)
+
+// WhitelistedContract is a middleware contract an operator has opted into
+// being slashed by.
+type WhitelistedContract struct {
+	Address                            common.Address
+	ContractCanSlashOperatorUntilBlock uint32
+	LatestUpdateBlock                  uint32
+}
+
+// Report is the Slasher state of a single operator.
+type Report struct {
+	Operator common.Address
+	Frozen   bool
+	// MiddlewareTimes is the operator's operatorToMiddlewareTimes array.
+	MiddlewareTimes []types.MiddlewareTimes
+	// Whitelisted lists the contracts that can slash the operator, in
+	// linked list order.
+	Whitelisted []WhitelistedContract
+}
+
+// Read builds the Report of operator.
+func Read(opts *bind.CallOpts, r Reader, operator common.Address) (*Report, error) {
+	rep := &Report{Operator: operator}
+	var err error
+	if rep.Frozen, err = r.IsFrozen(opts, operator); err != nil {
+		return nil, fmt.Errorf("failed to read frozen status of %s: %w", operator, err)
+	}
+
+	n, err := r.MiddlewareTimesLength(opts, operator)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read middleware times length of %s: %w", operator, err)
+	}
+	for i := int64(0); i < n.Int64(); i++ {
+		mt, err := r.OperatorToMiddlewareTimes(opts, operator, big.NewInt(i))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read middleware times %d of %s: %w", i, operator, err)
+		}
+		rep.MiddlewareTimes = append(rep.MiddlewareTimes, mt.Canonical())
+	}
+
+	size, err := r.OperatorWhitelistedContractsLinkedListSize(opts, operator)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read whitelisted contracts of %s: %w", operator, err)
+	}
+	// The list is a StructuredLinkedList keyed by address, whose head is
+	// node 0.
+	var node common.Address
+	for i := int64(0); i < size.Int64(); i++ {
+		_, _, next, err := r.OperatorWhitelistedContractsLinkedListEntry(opts, operator, node)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read whitelisted contract after %s of %s: %w", node, operator, err)
+		}
+		node = common.BigToAddress(next)
+		if node == (common.Address{}) {
+			return nil, fmt.Errorf("whitelisted contracts of %s end after %d of %s entries", operator, i, size)
+		}
+		wc := WhitelistedContract{Address: node}
+		if wc.ContractCanSlashOperatorUntilBlock, err = r.ContractCanSlashOperatorUntilBlock(opts, operator, node); err != nil {
+			return nil, fmt.Errorf("failed to read slashing deadline of %s for %s: %w", node, operator, err)
+		}
+		if wc.LatestUpdateBlock, err = r.LatestUpdateBlock(opts, operator, node); err != nil {
+			return nil, fmt.Errorf("failed to read latest update of %s for %s: %w", node, operator, err)
+		}
+		rep.Whitelisted = append(rep.Whitelisted, wc)
+	}
+	return rep, nil
+}
+
+// MiddlewareTimesIndex returns the middlewareTimesIndex to complete a
+// withdrawal queued at withdrawalStartBlock with, once the chain is at
+// currentBlock. It applies the M1 Slasher's canWithdraw rule and reports
+// false if no index satisfies it yet. An operator without middleware times
+// can always withdraw, with index 0.
+//
+// The M2 DelegationManager ignores the index, so any value is accepted while
+// the Slasher remains a stub.
+func (rep *Report) MiddlewareTimesIndex(withdrawalStartBlock, currentBlock uint32) (*big.Int, bool) {
+	if len(rep.MiddlewareTimes) == 0 {
+		return new(big.Int), true
+	}
+	for i, mt := range rep.MiddlewareTimes {
+		if withdrawalStartBlock >= mt.StalestUpdateBlock && len(rep.Whitelisted) == 0 {
+			return big.NewInt(int64(i)), true
+		}
+		if withdrawalStartBlock < mt.StalestUpdateBlock && currentBlock > mt.LatestServeUntilBlock {
+			return big.NewInt(int64(i)), true
+		}
+	}
+	return nil, false
+}
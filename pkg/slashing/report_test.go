@@ -0,0 +1,154 @@
+package slashing_test
+
+import (
+	"errors"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/ISlasher"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/slashing"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/types"
+)
+
+var (
+	operator = common.HexToAddress("0x1000")
+	first    = common.HexToAddress("0x2000")
+	second   = common.HexToAddress("0x3000")
+)
+
+// slasher is a Reader over the storage of the M1 Slasher for a single
+// operator. next is the operator's whitelisted contracts linked list, keyed
+// by node, with the head at node 0.
+type slasher struct {
+	frozen bool
+	times  []ISlasher.ISlasherMiddlewareTimes
+	size   int64
+	next   map[common.Address]common.Address
+	until  map[common.Address]uint32
+	update map[common.Address]uint32
+	err    error
+}
+
+func (s *slasher) IsFrozen(_ *bind.CallOpts, _ common.Address) (bool, error) {
+	return s.frozen, s.err
+}
+
+func (s *slasher) MiddlewareTimesLength(_ *bind.CallOpts, _ common.Address) (*big.Int, error) {
+	return big.NewInt(int64(len(s.times))), nil
+}
+
+func (s *slasher) OperatorToMiddlewareTimes(_ *bind.CallOpts, _ common.Address, index *big.Int) (ISlasher.ISlasherMiddlewareTimes, error) {
+	return s.times[index.Int64()], nil
+}
+
+func (s *slasher) OperatorWhitelistedContractsLinkedListSize(_ *bind.CallOpts, _ common.Address) (*big.Int, error) {
+	return big.NewInt(s.size), nil
+}
+
+func (s *slasher) OperatorWhitelistedContractsLinkedListEntry(_ *bind.CallOpts, _ common.Address, node common.Address) (bool, *big.Int, *big.Int, error) {
+	next, ok := s.next[node]
+	return ok, new(big.Int), new(big.Int).SetBytes(next.Bytes()), nil
+}
+
+func (s *slasher) ContractCanSlashOperatorUntilBlock(_ *bind.CallOpts, _ common.Address, serviceContract common.Address) (uint32, error) {
+	return s.until[serviceContract], nil
+}
+
+func (s *slasher) LatestUpdateBlock(_ *bind.CallOpts, _ common.Address, serviceContract common.Address) (uint32, error) {
+	return s.update[serviceContract], nil
+}
+
+func TestRead(t *testing.T) {
+	tests := []struct {
+		name    string
+		slasher *slasher
+		want    *slashing.Report
+		// err is the error Read fails with, if it does.
+		err string
+	}{
+		{
+			name:    "empty",
+			slasher: &slasher{},
+			want:    &slashing.Report{Operator: operator},
+		},
+		{
+			name: "frozen with middleware times and whitelisted contracts",
+			slasher: &slasher{
+				frozen: true,
+				times:  []ISlasher.ISlasherMiddlewareTimes{{StalestUpdateBlock: 10, LatestServeUntilBlock: 100}, {StalestUpdateBlock: 20, LatestServeUntilBlock: 200}},
+				size:   2,
+				next:   map[common.Address]common.Address{{}: second, second: first, first: {}},
+				until:  map[common.Address]uint32{first: 300, second: 400},
+				update: map[common.Address]uint32{first: 15, second: 25},
+			},
+			want: &slashing.Report{
+				Operator:        operator,
+				Frozen:          true,
+				MiddlewareTimes: []types.MiddlewareTimes{{StalestUpdateBlock: 10, LatestServeUntilBlock: 100}, {StalestUpdateBlock: 20, LatestServeUntilBlock: 200}},
+				Whitelisted: []slashing.WhitelistedContract{
+					{Address: second, ContractCanSlashOperatorUntilBlock: 400, LatestUpdateBlock: 25},
+					{Address: first, ContractCanSlashOperatorUntilBlock: 300, LatestUpdateBlock: 15},
+				},
+			},
+		},
+		{
+			name: "linked list shorter than its size",
+			slasher: &slasher{
+				size: 2,
+				next: map[common.Address]common.Address{{}: first, first: {}},
+			},
+			err: "whitelisted contracts of 0x0000000000000000000000000000000000001000 end after 1 of 2 entries",
+		},
+		{
+			name:    "failed call",
+			slasher: &slasher{err: errors.New("call failed")},
+			err:     "failed to read frozen status of 0x0000000000000000000000000000000000001000: call failed",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := slashing.Read(nil, tt.slasher, operator)
+			switch {
+			case tt.err == "" && err != nil:
+				t.Fatal(err)
+			case tt.err != "" && (err == nil || err.Error() != tt.err):
+				t.Fatalf("err = %v, want %q", err, tt.err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Read = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMiddlewareTimesIndex(t *testing.T) {
+	times := []types.MiddlewareTimes{{StalestUpdateBlock: 10, LatestServeUntilBlock: 100}, {StalestUpdateBlock: 20, LatestServeUntilBlock: 200}}
+	whitelisted := []slashing.WhitelistedContract{{Address: first}}
+	tests := []struct {
+		name                 string
+		rep                  slashing.Report
+		withdrawalStartBlock uint32
+		currentBlock         uint32
+		want                 int64
+		ok                   bool
+	}{
+		{"no middleware times", slashing.Report{}, 5, 5, 0, true},
+		{"queued after the stalest update of the first", slashing.Report{MiddlewareTimes: times}, 15, 15, 0, true},
+		{"still whitelisted", slashing.Report{MiddlewareTimes: times, Whitelisted: whitelisted}, 15, 150, -1, false},
+		{"served until before the current block", slashing.Report{MiddlewareTimes: times, Whitelisted: whitelisted}, 5, 101, 0, true},
+		{"served until the current block", slashing.Report{MiddlewareTimes: times, Whitelisted: whitelisted}, 5, 100, -1, false},
+		{"second served past", slashing.Report{MiddlewareTimes: times, Whitelisted: whitelisted}, 15, 201, 1, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := tt.rep.MiddlewareTimesIndex(tt.withdrawalStartBlock, tt.currentBlock)
+			if ok != tt.ok || ok && got.Int64() != tt.want {
+				t.Errorf("MiddlewareTimesIndex(%d, %d) = %v, %t; want %d, %t", tt.withdrawalStartBlock, tt.currentBlock, got, ok, tt.want, tt.ok)
+			}
+		})
+	}
+}
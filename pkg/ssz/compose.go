@@ -0,0 +1,110 @@
+package ssz
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// Compose returns the encoding of the container of type t whose fields
+// have the given encodings, or of the vector or list of type t whose
+// elements do. It is the inverse of Field and Index, for building values to
+// prove rather than decoding them.
+func Compose(t *Type, parts ...[]byte) ([]byte, error) {
+	var types []*Type
+	switch t.Kind {
+	case KindContainer:
+		if len(parts) != len(t.Fields) {
+			return nil, fmt.Errorf("ssz: %d fields for %s, want %d", len(parts), t, len(t.Fields))
+		}
+		for _, f := range t.Fields {
+			types = append(types, f.Type)
+		}
+	case KindVector, KindList:
+		for range parts {
+			types = append(types, t.Elem)
+		}
+	default:
+		return nil, fmt.Errorf("ssz: cannot compose %s", t)
+	}
+
+	var fixed, variable []byte
+	var offsets []int
+	for i, p := range parts {
+		if _, ok := types[i].FixedSize(); ok {
+			fixed = append(fixed, p...)
+			continue
+		}
+		offsets = append(offsets, len(fixed), len(variable))
+		fixed = append(fixed, make([]byte, offsetSize)...)
+		variable = append(variable, p...)
+	}
+	for i := 0; i < len(offsets); i += 2 {
+		binary.LittleEndian.PutUint32(fixed[offsets[i]:], uint32(len(fixed)+offsets[i+1]))
+	}
+	out := append(fixed, variable...)
+	if err := validate(t, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// EncodeUint64 returns the encoding of a uint64.
+func EncodeUint64(x uint64) []byte {
+	return binary.LittleEndian.AppendUint64(nil, x)
+}
+
+// Zero returns the encoding of the zero value of t: zero bytes, empty
+// lists and containers of zero values.
+func Zero(t *Type) []byte {
+	switch t.Kind {
+	case KindByteList, KindList:
+		return nil
+	case KindBitlist:
+		return []byte{1}
+	case KindVector:
+		if size, ok := t.Elem.FixedSize(); ok {
+			return make([]byte, size*t.Size)
+		}
+		parts := make([][]byte, t.Size)
+		for i := range parts {
+			parts[i] = Zero(t.Elem)
+		}
+		out, err := Compose(t, parts...)
+		if err != nil {
+			panic(err)
+		}
+		return out
+	case KindContainer:
+		parts := make([][]byte, len(t.Fields))
+		for i, f := range t.Fields {
+			parts[i] = Zero(f.Type)
+		}
+		out, err := Compose(t, parts...)
+		if err != nil {
+			panic(err)
+		}
+		return out
+	default:
+		size, _ := t.FixedSize()
+		return make([]byte, size)
+	}
+}
+
+// With returns the container v with the named field replaced by the value
+// encoded as enc.
+func (v Value) With(name string, enc []byte) (Value, error) {
+	i := v.Type.FieldIndex(name)
+	if i < 0 {
+		return Value{}, fmt.Errorf("ssz: %s has no field %s", v.Type.Name, name)
+	}
+	parts, err := fields(v.Type, v.raw)
+	if err != nil {
+		return Value{}, err
+	}
+	parts[i] = enc
+	out, err := Compose(v.Type, parts...)
+	if err != nil {
+		return Value{}, err
+	}
+	return Value{Type: v.Type, raw: out}, nil
+}
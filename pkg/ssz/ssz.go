@@ -0,0 +1,589 @@
+// Package ssz decodes and merkleizes SimpleSerialize values, the encoding of
+// the beacon chain, as far as proving the fields of a beacon state or block
+// needs: values are views over their encoding, described by a Type built
+// from the constructors below, and every chunk of a value can be proven to
+// its hash tree root.
+package ssz
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/bits"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Kind is the kind of an SSZ type.
+type Kind int
+
+const (
+	KindUint Kind = iota
+	KindBool
+	KindByteVector
+	KindByteList
+	KindBitvector
+	KindBitlist
+	KindVector
+	KindList
+	KindContainer
+)
+
+// Type describes an SSZ type.
+type Type struct {
+	Kind Kind
+	// Name names containers, for errors.
+	Name string
+	// Size is the number of bytes of a uint and the length of a vector, or
+	// the limit of a list.
+	Size   uint64
+	Elem   *Type
+	Fields []Field
+}
+
+// Field is a field of a container.
+type Field struct {
+	Name string
+	Type *Type
+}
+
+// Uint returns the type of uints of the given number of bytes.
+func Uint(size uint64) *Type { return &Type{Kind: KindUint, Size: size} }
+
+// Bool returns the boolean type.
+func Bool() *Type { return &Type{Kind: KindBool, Size: 1} }
+
+// ByteVector returns the type of n bytes, such as Bytes32.
+func ByteVector(n uint64) *Type { return &Type{Kind: KindByteVector, Size: n} }
+
+// ByteList returns the type of at most n bytes.
+func ByteList(n uint64) *Type { return &Type{Kind: KindByteList, Size: n} }
+
+// Bitvector returns the type of n bits.
+func Bitvector(n uint64) *Type { return &Type{Kind: KindBitvector, Size: n} }
+
+// Bitlist returns the type of at most n bits.
+func Bitlist(n uint64) *Type { return &Type{Kind: KindBitlist, Size: n} }
+
+// Vector returns the type of n values of elem.
+func Vector(elem *Type, n uint64) *Type { return &Type{Kind: KindVector, Size: n, Elem: elem} }
+
+// List returns the type of at most n values of elem.
+func List(elem *Type, n uint64) *Type { return &Type{Kind: KindList, Size: n, Elem: elem} }
+
+// Container returns the type of a container with the given fields.
+func Container(name string, fields ...Field) *Type {
+	return &Type{Kind: KindContainer, Name: name, Fields: fields}
+}
+
+// F returns a field of a container.
+func F(name string, t *Type) Field { return Field{Name: name, Type: t} }
+
+// Common types of the beacon chain.
+var (
+	Uint8   = Uint(1)
+	Uint64  = Uint(8)
+	Uint256 = Uint(32)
+	Bytes4  = ByteVector(4)
+	Bytes20 = ByteVector(20)
+	Bytes32 = ByteVector(32)
+	Bytes48 = ByteVector(48)
+	Bytes96 = ByteVector(96)
+)
+
+// String names t for errors.
+func (t *Type) String() string {
+	switch t.Kind {
+	case KindUint:
+		return fmt.Sprintf("uint%d", 8*t.Size)
+	case KindBool:
+		return "boolean"
+	case KindByteVector:
+		return fmt.Sprintf("Bytes%d", t.Size)
+	case KindByteList:
+		return fmt.Sprintf("ByteList[%d]", t.Size)
+	case KindBitvector:
+		return fmt.Sprintf("Bitvector[%d]", t.Size)
+	case KindBitlist:
+		return fmt.Sprintf("Bitlist[%d]", t.Size)
+	case KindVector:
+		return fmt.Sprintf("Vector[%s, %d]", t.Elem, t.Size)
+	case KindList:
+		return fmt.Sprintf("List[%s, %d]", t.Elem, t.Size)
+	default:
+		return t.Name
+	}
+}
+
+// basic reports whether values of t are packed together into chunks.
+func (t *Type) basic() bool {
+	return t.Kind == KindUint || t.Kind == KindBool
+}
+
+// isList reports whether the root of values of t mixes in their length.
+func (t *Type) isList() bool {
+	return t.Kind == KindList || t.Kind == KindByteList || t.Kind == KindBitlist
+}
+
+// FixedSize returns the size of encodings of t, or false if they vary.
+func (t *Type) FixedSize() (uint64, bool) {
+	switch t.Kind {
+	case KindUint, KindBool, KindByteVector:
+		return t.Size, true
+	case KindBitvector:
+		return (t.Size + 7) / 8, true
+	case KindVector:
+		size, ok := t.Elem.FixedSize()
+		return size * t.Size, ok
+	case KindContainer:
+		var total uint64
+		for _, f := range t.Fields {
+			size, ok := f.Type.FixedSize()
+			if !ok {
+				return 0, false
+			}
+			total += size
+		}
+		return total, true
+	default:
+		return 0, false
+	}
+}
+
+// offsetSize is the size of the offset standing in the fixed part of a
+// container or list for a variable-size value.
+const offsetSize = 4
+
+// ErrInvalid is wrapped by the errors of Decode.
+var ErrInvalid = errors.New("ssz: invalid encoding")
+
+func invalid(t *Type, format string, args ...any) error {
+	return fmt.Errorf("%w of %s: %s", ErrInvalid, t, fmt.Sprintf(format, args...))
+}
+
+// Value is a value of an SSZ type, a view over its encoding.
+type Value struct {
+	Type *Type
+	raw  []byte
+}
+
+// Decode checks that data is an encoding of a value of t and returns the
+// value.
+func Decode(t *Type, data []byte) (Value, error) {
+	if err := validate(t, data); err != nil {
+		return Value{}, err
+	}
+	return Value{Type: t, raw: data}, nil
+}
+
+func validate(t *Type, b []byte) error {
+	if size, ok := t.FixedSize(); ok && uint64(len(b)) != size {
+		return invalid(t, "%d bytes, want %d", len(b), size)
+	}
+	switch t.Kind {
+	case KindBool:
+		if b[0] > 1 {
+			return invalid(t, "byte %d", b[0])
+		}
+	case KindBitvector:
+		if t.Size%8 != 0 && b[len(b)-1]>>(t.Size%8) != 0 {
+			return invalid(t, "bits set past the end")
+		}
+	case KindByteList:
+		if uint64(len(b)) > t.Size {
+			return invalid(t, "%d bytes", len(b))
+		}
+	case KindBitlist:
+		if len(b) == 0 || b[len(b)-1] == 0 {
+			return invalid(t, "no delimiting bit")
+		}
+		if n := bitlistLen(b); n > t.Size {
+			return invalid(t, "%d bits", n)
+		}
+	case KindVector, KindList:
+		elems, err := elements(t, b)
+		if err != nil {
+			return err
+		}
+		if t.Kind == KindVector && uint64(len(elems)) != t.Size || uint64(len(elems)) > t.Size {
+			return invalid(t, "%d elements", len(elems))
+		}
+		if t.Elem.Kind == KindUint {
+			return nil
+		}
+		for _, e := range elems {
+			if err := validate(t.Elem, e); err != nil {
+				return err
+			}
+		}
+	case KindContainer:
+		fields, err := fields(t, b)
+		if err != nil {
+			return err
+		}
+		for i, f := range fields {
+			if err := validate(t.Fields[i].Type, f); err != nil {
+				return fmt.Errorf("%s.%s: %w", t.Name, t.Fields[i].Name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// elements splits the encoding of a vector or list into its elements.
+func elements(t *Type, b []byte) ([][]byte, error) {
+	if size, ok := t.Elem.FixedSize(); ok {
+		if uint64(len(b))%size != 0 {
+			return nil, invalid(t, "%d bytes of %d-byte elements", len(b), size)
+		}
+		out := make([][]byte, uint64(len(b))/size)
+		for i := range out {
+			out[i] = b[uint64(i)*size : uint64(i+1)*size]
+		}
+		return out, nil
+	}
+	if len(b) == 0 {
+		return nil, nil
+	}
+	if len(b) < offsetSize {
+		return nil, invalid(t, "truncated offset")
+	}
+	first := binary.LittleEndian.Uint32(b)
+	if first%offsetSize != 0 || first == 0 {
+		return nil, invalid(t, "first offset %d", first)
+	}
+	n := int(first / offsetSize)
+	offsets := make([]uint32, n)
+	for i := range offsets {
+		if (i+1)*offsetSize > len(b) {
+			return nil, invalid(t, "truncated offsets")
+		}
+		offsets[i] = binary.LittleEndian.Uint32(b[i*offsetSize:])
+	}
+	return split(t, b, offsets, first)
+}
+
+// fields splits the encoding of a container into the encodings of its
+// fields.
+func fields(t *Type, b []byte) ([][]byte, error) {
+	out := make([][]byte, len(t.Fields))
+	var pos uint64
+	var variable []int
+	var offsets []uint32
+	for i, f := range t.Fields {
+		size, ok := f.Type.FixedSize()
+		if !ok {
+			size = offsetSize
+		}
+		if pos+size > uint64(len(b)) {
+			return nil, invalid(t, "truncated at %s", f.Name)
+		}
+		if ok {
+			out[i] = b[pos : pos+size]
+		} else {
+			variable = append(variable, i)
+			offsets = append(offsets, binary.LittleEndian.Uint32(b[pos:]))
+		}
+		pos += size
+	}
+	if len(variable) == 0 {
+		if pos != uint64(len(b)) {
+			return nil, invalid(t, "%d bytes, want %d", len(b), pos)
+		}
+		return out, nil
+	}
+	parts, err := split(t, b, offsets, uint32(pos))
+	if err != nil {
+		return nil, err
+	}
+	for j, i := range variable {
+		out[i] = parts[j]
+	}
+	return out, nil
+}
+
+// split cuts b at offsets, the first of which must be start.
+func split(t *Type, b []byte, offsets []uint32, start uint32) ([][]byte, error) {
+	if offsets[0] != start {
+		return nil, invalid(t, "first offset %d, want %d", offsets[0], start)
+	}
+	out := make([][]byte, len(offsets))
+	for i, off := range offsets {
+		end := uint32(len(b))
+		if i+1 < len(offsets) {
+			end = offsets[i+1]
+		}
+		if off > end || end > uint32(len(b)) {
+			return nil, invalid(t, "offset %d out of order", off)
+		}
+		out[i] = b[off:end]
+	}
+	return out, nil
+}
+
+func bitlistLen(b []byte) uint64 {
+	return uint64(len(b))*8 - uint64(bits.LeadingZeros8(b[len(b)-1])) - 1
+}
+
+// Bytes returns the encoding of v.
+func (v Value) Bytes() []byte {
+	return v.raw
+}
+
+// Uint64 returns the value of a uint of at most 8 bytes, or of a boolean.
+func (v Value) Uint64() uint64 {
+	var buf [8]byte
+	copy(buf[:], v.raw)
+	return binary.LittleEndian.Uint64(buf[:])
+}
+
+// Len returns the number of elements of a vector or list, bytes of a byte
+// vector or list, or bits of a bitvector or bitlist.
+func (v Value) Len() int {
+	switch v.Type.Kind {
+	case KindByteVector, KindByteList:
+		return len(v.raw)
+	case KindBitvector, KindVector:
+		return int(v.Type.Size)
+	case KindBitlist:
+		return int(bitlistLen(v.raw))
+	case KindList:
+		if size, ok := v.Type.Elem.FixedSize(); ok {
+			return len(v.raw) / int(size)
+		}
+		if len(v.raw) == 0 {
+			return 0
+		}
+		return int(binary.LittleEndian.Uint32(v.raw) / offsetSize)
+	default:
+		return 0
+	}
+}
+
+// Index returns the i'th element of a vector or list.
+func (v Value) Index(i int) Value {
+	if size, ok := v.Type.Elem.FixedSize(); ok {
+		return Value{Type: v.Type.Elem, raw: v.raw[uint64(i)*size : uint64(i+1)*size]}
+	}
+	elems, err := elements(v.Type, v.raw)
+	if err != nil {
+		panic(err) // v was validated by Decode
+	}
+	return Value{Type: v.Type.Elem, raw: elems[i]}
+}
+
+// FieldIndex returns the index of the named field of a container, or -1.
+func (t *Type) FieldIndex(name string) int {
+	for i, f := range t.Fields {
+		if f.Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// Field returns the named field of a container. It panics if there is no
+// such field.
+func (v Value) Field(name string) Value {
+	i := v.Type.FieldIndex(name)
+	if i < 0 {
+		panic(fmt.Sprintf("ssz: %s has no field %s", v.Type.Name, name))
+	}
+	fs, err := fields(v.Type, v.raw)
+	if err != nil {
+		panic(err) // v was validated by Decode
+	}
+	return Value{Type: v.Type.Fields[i].Type, raw: fs[i]}
+}
+
+// Root returns the hash tree root of v.
+func (v Value) Root() common.Hash {
+	chunks, limit := v.Chunks()
+	root := Merkleize(chunks, limit)
+	if v.Type.isList() {
+		root = MixInLength(root, uint64(v.Len()))
+	}
+	return root
+}
+
+// Chunks returns the leaves of the tree of v, and the number of leaves the
+// tree is padded to: the roots of its fields or elements, or the 32-byte
+// chunks its bytes or basic elements are packed into. The root of a list
+// mixes its length in above these.
+func (v Value) Chunks() ([]common.Hash, uint64) {
+	t := v.Type
+	switch t.Kind {
+	case KindUint, KindBool:
+		return pack(v.raw), 1
+	case KindByteVector, KindByteList:
+		return pack(v.raw), (t.Size + 31) / 32
+	case KindBitvector:
+		return pack(v.raw), (t.Size + 255) / 256
+	case KindBitlist:
+		n := bitlistLen(v.raw)
+		b := append([]byte(nil), v.raw...)
+		b[n/8] &^= 1 << (n % 8)
+		return pack(b[:(n+7)/8]), (t.Size + 255) / 256
+	case KindVector, KindList:
+		if t.Elem.basic() {
+			return pack(v.raw), (t.Size*t.Elem.Size + 31) / 32
+		}
+		n := v.Len()
+		out := make([]common.Hash, n)
+		if size, ok := t.Elem.FixedSize(); ok {
+			for i := range out {
+				out[i] = Value{Type: t.Elem, raw: v.raw[uint64(i)*size : uint64(i+1)*size]}.Root()
+			}
+		} else {
+			elems, err := elements(t, v.raw)
+			if err != nil {
+				panic(err) // v was validated by Decode
+			}
+			for i, e := range elems {
+				out[i] = Value{Type: t.Elem, raw: e}.Root()
+			}
+		}
+		return out, t.Size
+	default:
+		fs, err := fields(t, v.raw)
+		if err != nil {
+			panic(err) // v was validated by Decode
+		}
+		out := make([]common.Hash, len(fs))
+		for i, f := range fs {
+			out[i] = Value{Type: t.Fields[i].Type, raw: f}.Root()
+		}
+		return out, uint64(len(fs))
+	}
+}
+
+// Proof returns the siblings proving the i'th chunk of v, as returned by
+// Chunks, to the root of v, from the chunk up. For lists the last sibling
+// is the chunk of the length.
+func (v Value) Proof(i int) []common.Hash {
+	chunks, limit := v.Chunks()
+	proof := MerkleProof(chunks, limit, uint64(i))
+	if v.Type.isList() {
+		proof = append(proof, Uint64Chunk(uint64(v.Len())))
+	}
+	return proof
+}
+
+// Depth returns the number of siblings in a proof of the chunks of v,
+// including the length of a list.
+func (t *Type) Depth() int {
+	var limit uint64
+	switch t.Kind {
+	case KindUint, KindBool:
+		limit = 1
+	case KindByteVector, KindByteList:
+		limit = (t.Size + 31) / 32
+	case KindBitvector, KindBitlist:
+		limit = (t.Size + 255) / 256
+	case KindVector, KindList:
+		limit = t.Size
+		if t.Elem.basic() {
+			limit = (t.Size*t.Elem.Size + 31) / 32
+		}
+	default:
+		limit = uint64(len(t.Fields))
+	}
+	d := depth(limit)
+	if t.isList() {
+		d++
+	}
+	return d
+}
+
+func pack(b []byte) []common.Hash {
+	out := make([]common.Hash, (len(b)+31)/32)
+	for i := range out {
+		copy(out[i][:], b[32*i:])
+	}
+	return out
+}
+
+// zeroHashes[d] is the root of a tree of depth d of zero chunks.
+var zeroHashes [65]common.Hash
+
+func init() {
+	for d := 1; d < len(zeroHashes); d++ {
+		zeroHashes[d] = hash(zeroHashes[d-1], zeroHashes[d-1])
+	}
+}
+
+func hash(left, right common.Hash) common.Hash {
+	var buf [64]byte
+	copy(buf[:32], left[:])
+	copy(buf[32:], right[:])
+	return sha256.Sum256(buf[:])
+}
+
+// depth returns the depth of a tree of limit leaves.
+func depth(limit uint64) int {
+	if limit <= 1 {
+		return 0
+	}
+	return bits.Len64(limit - 1)
+}
+
+// Merkleize returns the root of the tree of chunks padded with zero chunks
+// to the next power of two of limit.
+func Merkleize(chunks []common.Hash, limit uint64) common.Hash {
+	layer := chunks
+	d := depth(limit)
+	for level := 0; level < d; level++ {
+		layer = parents(layer, level)
+	}
+	if len(layer) == 0 {
+		return zeroHashes[d]
+	}
+	return layer[0]
+}
+
+// MerkleProof returns the siblings proving the index'th of chunks to
+// Merkleize(chunks, limit), from the chunk up.
+func MerkleProof(chunks []common.Hash, limit, index uint64) []common.Hash {
+	layer := chunks
+	d := depth(limit)
+	proof := make([]common.Hash, d)
+	for level := 0; level < d; level++ {
+		if sibling := index ^ 1; sibling < uint64(len(layer)) {
+			proof[level] = layer[sibling]
+		} else {
+			proof[level] = zeroHashes[level]
+		}
+		layer = parents(layer, level)
+		index /= 2
+	}
+	return proof
+}
+
+// parents hashes a layer of a tree at the given level into the next,
+// padding it with a zero subtree if it has an odd number of nodes.
+func parents(layer []common.Hash, level int) []common.Hash {
+	next := make([]common.Hash, (len(layer)+1)/2)
+	for i := range next {
+		right := zeroHashes[level]
+		if 2*i+1 < len(layer) {
+			right = layer[2*i+1]
+		}
+		next[i] = hash(layer[2*i], right)
+	}
+	return next
+}
+
+// Uint64Chunk returns the chunk a uint64 packs into, which is also the
+// chunk holding the length of a list.
+func Uint64Chunk(n uint64) common.Hash {
+	var c common.Hash
+	binary.LittleEndian.PutUint64(c[:], n)
+	return c
+}
+
+// MixInLength returns the root of a list of n elements whose elements have
+// the given root.
+func MixInLength(root common.Hash, n uint64) common.Hash {
+	return hash(root, Uint64Chunk(n))
+}
@@ -0,0 +1,252 @@
+package ssz_test
+
+import (
+	"errors"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/beaconproofs"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/beaconproofs/beaconproofstest"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/prooffile"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/ssz"
+)
+
+func TestMerkleize(t *testing.T) {
+	// The roots of trees of zero chunks, as in the deposit contract.
+	zero := []common.Hash{
+		{},
+		common.HexToHash("0xf5a5fd42d16a20302798ef6ed309979b43003d2320d9f0e8ea9831a92759fb4b"),
+		common.HexToHash("0xdb56114e00fdd4c1f85c892bf35ac9a89289aaecb1ebd0a96cde606a748b5d71"),
+		common.HexToHash("0xc78009fdf07fc56a11f122370658a353aaa542ed63e44c4bc15ff4cd105ab33c"),
+	}
+	for limit, d := range map[uint64]int{1: 0, 2: 1, 3: 2, 4: 2, 5: 3, 8: 3} {
+		if got := ssz.Merkleize(nil, limit); got != zero[d] {
+			t.Errorf("Merkleize(nil, %d) = %s, want %s", limit, got, zero[d])
+		}
+	}
+
+	chunks := []common.Hash{{1}, {2}, {3}}
+	pair := func(left, right common.Hash) common.Hash { return ssz.Merkleize([]common.Hash{left, right}, 2) }
+	root := ssz.Merkleize(chunks, 8)
+	if want := pair(pair(pair(chunks[0], chunks[1]), pair(chunks[2], zero[0])), zero[2]); root != want {
+		t.Errorf("Merkleize of 3 chunks padded to 8 = %s, want %s", root, want)
+	}
+	for i, c := range chunks {
+		proof := ssz.MerkleProof(chunks, 8, uint64(i))
+		if got := climb(c, proof, uint64(i)); got != root {
+			t.Errorf("proof of chunk %d leads to %s, want %s", i, got, root)
+		}
+	}
+}
+
+func TestDecode(t *testing.T) {
+	pair := ssz.Container("Pair", ssz.F("a", ssz.Uint64), ssz.F("b", ssz.ByteList(4)))
+	tests := []struct {
+		name string
+		t    *ssz.Type
+		data []byte
+		err  string
+	}{
+		{"uint64", ssz.Uint64, make([]byte, 8), ""},
+		{"short uint64", ssz.Uint64, make([]byte, 7), "ssz: invalid encoding of uint64: 7 bytes, want 8"},
+		{"boolean", ssz.Bool(), []byte{2}, "ssz: invalid encoding of boolean: byte 2"},
+		{"bits past a bitvector", ssz.Bitvector(4), []byte{0x10}, "ssz: invalid encoding of Bitvector[4]: bits set past the end"},
+		{"bitlist", ssz.Bitlist(8), []byte{0xff, 0x01}, ""},
+		{"bitlist without a delimiting bit", ssz.Bitlist(8), []byte{0xff, 0x00}, "ssz: invalid encoding of Bitlist[8]: no delimiting bit"},
+		{"long bitlist", ssz.Bitlist(8), []byte{0xff, 0x02}, "ssz: invalid encoding of Bitlist[8]: 9 bits"},
+		{"container", pair, []byte{1, 0, 0, 0, 0, 0, 0, 0, 12, 0, 0, 0, 0xaa}, ""},
+		{"container with a bad offset", pair, []byte{1, 0, 0, 0, 0, 0, 0, 0, 13, 0, 0, 0, 0xaa}, "ssz: invalid encoding of Pair: first offset 13, want 12"},
+		{"long list in a container", pair, []byte{1, 0, 0, 0, 0, 0, 0, 0, 12, 0, 0, 0, 1, 2, 3, 4, 5}, "Pair.b: ssz: invalid encoding of ByteList[4]: 5 bytes"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ssz.Decode(tt.t, tt.data)
+			switch {
+			case tt.err == "" && err != nil:
+				t.Fatal(err)
+			case tt.err != "" && (err == nil || err.Error() != tt.err):
+				t.Fatalf("err = %v, want %q", err, tt.err)
+			case tt.err != "" && !errors.Is(err, ssz.ErrInvalid):
+				t.Fatalf("err = %v, want it to wrap ssz.ErrInvalid", err)
+			}
+		})
+	}
+}
+
+// climb returns the root a proof of leaf, the index'th chunk of a tree as
+// deep as the proof is long, leads to.
+func climb(leaf common.Hash, proof []common.Hash, index uint64) common.Hash {
+	for _, sibling := range proof {
+		if index%2 == 0 {
+			leaf = ssz.Merkleize([]common.Hash{leaf, sibling}, 2)
+		} else {
+			leaf = ssz.Merkleize([]common.Hash{sibling, leaf}, 2)
+		}
+		index /= 2
+	}
+	return leaf
+}
+
+// step is the index of a node in a subtree, and the depth of the subtree.
+type step struct {
+	index uint64
+	depth int
+}
+
+// path returns the index of a chunk below the subtrees of steps, from the
+// chunk's up.
+func path(steps ...step) uint64 {
+	var index uint64
+	var depth int
+	for _, s := range steps {
+		index |= s.index << depth
+		depth += s.depth
+	}
+	return index
+}
+
+// The types and field indices of the beacon chain the fixtures prove
+// through. Only the number of fields of the state, block and block body
+// matter to their depth.
+var (
+	withdrawal = ssz.Container("Withdrawal",
+		ssz.F("index", ssz.Uint64),
+		ssz.F("validator_index", ssz.Uint64),
+		ssz.F("address", ssz.Bytes20),
+		ssz.F("amount", ssz.Uint64),
+	)
+	withdrawals  = ssz.List(withdrawal, 16)
+	blockRoots   = ssz.Vector(ssz.Bytes32, 8192)
+	summaries    = ssz.List(ssz.Container("HistoricalSummary", ssz.F("block_summary_root", ssz.Bytes32), ssz.F("state_summary_root", ssz.Bytes32)), 1<<24)
+	validators   = ssz.List(ssz.Container("Validator"), 1<<40)
+	balances     = ssz.List(ssz.Uint64, 1<<40)
+	stateDepth   = 5
+	headerDepth  = 3
+	bodyDepth    = 4
+	headerFields = struct{ slot, body uint64 }{0, 4}
+	stateFields  = struct{ validators, balances, historicalSummaries uint64 }{11, 12, 27}
+	payloadField = struct{ timestamp, withdrawals uint64 }{9, 14}
+	bodyPayload  = uint64(9)
+)
+
+// TestFixtures computes the roots every fixture in src/test/test-data
+// records from the leaves and siblings it records, up to the state and
+// block roots. The fixtures hold proofs rather than the states and blocks
+// they were generated from, so the roots below the leaves are taken as
+// recorded.
+func TestFixtures(t *testing.T) {
+	files, err := prooffile.LoadDir(filepath.Join("../..", beaconproofstest.FixtureDir))
+	if err != nil {
+		t.Fatal(err)
+	}
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		f := files[name]
+		t.Run(name, func(t *testing.T) {
+			validatorIndex, proof := f.ValidatorIndex, f.WithdrawalCredentialProof
+			if f.IsWithdrawal() {
+				var err error
+				if validatorIndex, err = beaconproofs.WithdrawalFields(f.WithdrawalFields).ValidatorIndex(); err != nil {
+					t.Fatal(err)
+				}
+				proof = f.ValidatorProof
+			}
+			validator := ssz.Merkleize(f.ValidatorFields, 8)
+			index := path(step{validatorIndex, validators.Depth() - 1}, step{0, 1}, step{stateFields.validators, stateDepth})
+			if got := climb(validator, proof, index); got != f.BeaconStateRoot {
+				t.Errorf("validator %d leads to state root %s, want %s", validatorIndex, got, f.BeaconStateRoot)
+			}
+			if f.BalanceRoot != (common.Hash{}) {
+				index := path(step{validatorIndex / 4, balances.Depth() - 1}, step{0, 1}, step{stateFields.balances, stateDepth})
+				if got := climb(f.BalanceRoot, f.ValidatorBalanceProof, index); got != f.BeaconStateRoot {
+					t.Errorf("balances of validator %d lead to state root %s, want %s", validatorIndex, got, f.BeaconStateRoot)
+				}
+			}
+			if f.StateRootAgainstLatestBlockHeaderProof != nil {
+				if got := climb(f.BeaconStateRoot, f.StateRootAgainstLatestBlockHeaderProof, 3); got != f.LatestBlockHeaderRoot {
+					t.Errorf("state root leads to latest block root %s, want %s", got, f.LatestBlockHeaderRoot)
+				}
+			}
+			if f.IsWithdrawal() {
+				checkWithdrawal(t, f)
+			}
+		})
+	}
+}
+
+// checkWithdrawal computes the roots of the block a withdrawal fixture
+// proves a withdrawal of: the root of the withdrawal, encoded from the
+// little-endian starts of its fields, up to the execution payload and block roots, and the block root
+// up to the state root through the historical summaries.
+func checkWithdrawal(t *testing.T, f *prooffile.File) {
+	t.Helper()
+	w := f.WithdrawalFields
+	enc, err := ssz.Compose(withdrawal, w[0][:8], w[1][:8], w[2][:common.AddressLength], w[3][:8])
+	if err != nil {
+		t.Fatal(err)
+	}
+	v, err := ssz.Decode(withdrawal, enc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if chunks, _ := v.Chunks(); len(chunks) != len(f.WithdrawalFields) {
+		t.Fatalf("withdrawal has %d chunks, want %d", len(chunks), len(f.WithdrawalFields))
+	} else {
+		for i := range chunks {
+			if chunks[i] != f.WithdrawalFields[i] {
+				t.Errorf("withdrawal chunk %d = %s, want %s", i, chunks[i], f.WithdrawalFields[i])
+			}
+		}
+	}
+
+	// The execution payload of Deneb has more fields than Capella's, and
+	// so a deeper tree.
+	timestamp := beaconproofs.FromLittleEndianUint64(f.TimestampRoot)
+	fork := beaconproofstest.Schedule.ForkAt(timestamp)
+	payloadDepth := fork.ExecutionPayloadHeaderFieldTreeHeight()
+	if want := withdrawals.Depth() + payloadDepth; len(f.WithdrawalProof) != want {
+		t.Errorf("%s withdrawal proof of %d siblings, want %d", fork, len(f.WithdrawalProof), want)
+	}
+	index := path(step{f.WithdrawalIndex, withdrawals.Depth() - 1}, step{0, 1}, step{payloadField.withdrawals, payloadDepth})
+	if got := climb(v.Root(), f.WithdrawalProof, index); got != f.ExecutionPayloadRoot {
+		t.Errorf("withdrawal leads to execution payload root %s, want %s", got, f.ExecutionPayloadRoot)
+	}
+	if got := climb(f.TimestampRoot, f.TimestampProof, payloadField.timestamp); got != f.ExecutionPayloadRoot {
+		t.Errorf("timestamp leads to execution payload root %s, want %s", got, f.ExecutionPayloadRoot)
+	}
+
+	if f.SlotRoot != ssz.Uint64Chunk(f.Slot) {
+		t.Errorf("slot root %s, want the chunk of slot %d", f.SlotRoot, f.Slot)
+	}
+	if got := climb(f.SlotRoot, f.SlotProof, headerFields.slot); got != f.BlockHeaderRoot {
+		t.Errorf("slot leads to block root %s, want %s", got, f.BlockHeaderRoot)
+	}
+	index = path(step{bodyPayload, bodyDepth}, step{headerFields.body, headerDepth})
+	if got := climb(f.ExecutionPayloadRoot, f.ExecutionPayloadProof, index); got != f.BlockHeaderRoot {
+		t.Errorf("execution payload leads to block root %s, want %s", got, f.BlockHeaderRoot)
+	}
+	if f.BlockBodyRoot != (common.Hash{}) {
+		// The body root is the fifth and last field of the header.
+		if got := ssz.Merkleize([]common.Hash{f.BlockBodyRoot}, 4); got != f.SlotProof[2] {
+			t.Errorf("block body subtree %s, want %s", got, f.SlotProof[2])
+		}
+	}
+
+	index = path(
+		step{f.BlockHeaderRootIndex, blockRoots.Depth()},
+		step{0, 1},
+		step{f.HistoricalSummaryIndex, summaries.Depth() - 1},
+		step{0, 1},
+		step{stateFields.historicalSummaries, stateDepth},
+	)
+	if got := climb(f.BlockHeaderRoot, f.HistoricalSummaryProof, index); got != f.BeaconStateRoot {
+		t.Errorf("block root leads to state root %s, want %s", got, f.BeaconStateRoot)
+	}
+}
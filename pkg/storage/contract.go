@@ -0,0 +1,267 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// StorageReader reads contract storage, as ethclient.Client and the
+// simulated backend's client do.
+type StorageReader interface {
+	StorageAt(ctx context.Context, account common.Address, key common.Hash, blockNumber *big.Int) ([]byte, error)
+}
+
+// Contract reads the storage of a deployed contract according to its
+// layout. The Layout of a proxied contract is that of its implementation,
+// while Address is the proxy's.
+type Contract struct {
+	Address common.Address
+	Layout  *Layout
+
+	client StorageReader
+}
+
+// NewContract returns a reader for the storage of the contract at address.
+func NewContract(address common.Address, layout *Layout, client StorageReader) *Contract {
+	return &Contract{Address: address, Layout: layout, client: client}
+}
+
+// Word reads the raw storage slot at the block selected by opts.
+func (c *Contract) Word(opts *bind.CallOpts, slot common.Hash) (common.Hash, error) {
+	ctx, block := context.Background(), (*big.Int)(nil)
+	if opts != nil {
+		if opts.Context != nil {
+			ctx = opts.Context
+		}
+		block = opts.BlockNumber
+	}
+	word, err := c.client.StorageAt(ctx, c.Address, slot, block)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("failed to read slot %s of %s: %w", slot, c.Address, err)
+	}
+	return common.BytesToHash(word), nil
+}
+
+// Value resolves label and path with Layout.Lookup and reads the value found.
+func (c *Contract) Value(opts *bind.CallOpts, label string, path ...any) (any, error) {
+	r, err := c.Layout.Lookup(label, path...)
+	if err != nil {
+		return nil, err
+	}
+	return c.Read(opts, r)
+}
+
+// Get is Contract.Value with the result asserted to be a T. Value types
+// decode as abigen would return them: common.Address for addresses and
+// contracts, bool, uint8 for enums, uint8 to uint64 and int8 to int64 for
+// integers of those sizes, *big.Int for larger ones and byte arrays for
+// fixed size bytes.
+func Get[T any](opts *bind.CallOpts, c *Contract, label string, path ...any) (T, error) {
+	var zero T
+	v, err := c.Value(opts, label, path...)
+	if err != nil {
+		return zero, err
+	}
+	t, ok := v.(T)
+	if !ok {
+		return zero, fmt.Errorf("%s decodes to %T, not %T", label, v, zero)
+	}
+	return t, nil
+}
+
+// Read reads and decodes the value at r. Structs decode to a map from member
+// label to value, arrays to a slice, string to string and bytes to []byte.
+// Every element of a dynamic array is read, one slot at a time. Mappings
+// cannot be enumerated and must be indexed with Ref.Key first.
+func (c *Contract) Read(opts *bind.CallOpts, r Ref) (any, error) {
+	t := r.Type
+	switch {
+	case t.Encoding == EncodingMapping:
+		return nil, fmt.Errorf("%s is a %s and can only be read by key", r, t.Label)
+	case t.Encoding == EncodingBytes:
+		b, err := c.readBytes(opts, r)
+		if err != nil {
+			return nil, err
+		}
+		if t.Label == "string" {
+			return string(b), nil
+		}
+		return b, nil
+	case t.Base != "":
+		n, err := c.Len(opts, r)
+		if err != nil {
+			return nil, err
+		}
+		out := make([]any, n)
+		for i := range out {
+			elem, err := r.Index(uint64(i))
+			if err != nil {
+				return nil, err
+			}
+			if out[i], err = c.Read(opts, elem); err != nil {
+				return nil, err
+			}
+		}
+		return out, nil
+	case len(t.Members) > 0:
+		out := make(map[string]any, len(t.Members))
+		for _, m := range t.Members {
+			field, err := r.Field(m.Label)
+			if err != nil {
+				return nil, err
+			}
+			if out[m.Label], err = c.Read(opts, field); err != nil {
+				return nil, err
+			}
+		}
+		return out, nil
+	}
+	word, err := c.Word(opts, r.Slot)
+	if err != nil {
+		return nil, err
+	}
+	v, err := Decode(t, r.Offset, word)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode %s: %w", r, err)
+	}
+	return v, nil
+}
+
+// Len returns the length of an array, or of the contents of a string or
+// bytes value.
+func (c *Contract) Len(opts *bind.CallOpts, r Ref) (uint64, error) {
+	switch {
+	case r.Type.Encoding == EncodingInplace && r.Type.Base != "":
+		return staticLength(r.Type)
+	case r.Type.Encoding == EncodingDynamicArray:
+		word, err := c.Word(opts, r.Slot)
+		if err != nil {
+			return 0, err
+		}
+		n := word.Big()
+		if !n.IsUint64() {
+			return 0, fmt.Errorf("%s has invalid length %s", r, n)
+		}
+		return n.Uint64(), nil
+	case r.Type.Encoding == EncodingBytes:
+		word, err := c.Word(opts, r.Slot)
+		if err != nil {
+			return 0, err
+		}
+		return bytesLength(r, word)
+	}
+	return 0, fmt.Errorf("%s is a %s and has no length", r, r.Type.Label)
+}
+
+// readBytes reads a string or bytes value. Values shorter than 32 bytes are
+// stored left aligned in their slot along with twice their length, longer
+// ones store twice their length plus one and their contents from
+// keccak256(slot).
+func (c *Contract) readBytes(opts *bind.CallOpts, r Ref) ([]byte, error) {
+	word, err := c.Word(opts, r.Slot)
+	if err != nil {
+		return nil, err
+	}
+	n, err := bytesLength(r, word)
+	if err != nil {
+		return nil, err
+	}
+	if word[31]&1 == 0 {
+		return append([]byte(nil), word[:n]...), nil
+	}
+	out := make([]byte, 0, n)
+	data := crypto.Keccak256Hash(r.Slot[:])
+	for i := int64(0); uint64(len(out)) < n; i++ {
+		chunk, err := c.Word(opts, addSlot(data, big.NewInt(i)))
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, chunk[:min(32, n-uint64(len(out)))]...)
+	}
+	return out, nil
+}
+
+func bytesLength(r Ref, word common.Hash) (uint64, error) {
+	if word[31]&1 == 0 {
+		n := uint64(word[31]) / 2
+		if n >= 32 {
+			return 0, fmt.Errorf("%s has invalid short length %d", r, n)
+		}
+		return n, nil
+	}
+	n := new(big.Int).Rsh(word.Big(), 1)
+	if !n.IsUint64() || n.Uint64() < 32 {
+		return 0, fmt.Errorf("%s has invalid long length %s", r, n)
+	}
+	return n.Uint64(), nil
+}
+
+// Decode extracts a value type stored at offset within word. See Get for
+// the Go types values decode to.
+func Decode(t *Type, offset int, word common.Hash) (any, error) {
+	if t.Encoding != EncodingInplace || t.Base != "" || len(t.Members) > 0 {
+		return nil, fmt.Errorf("%s is not a value type", t.Label)
+	}
+	size, err := t.size()
+	if err != nil {
+		return nil, err
+	}
+	if offset < 0 || offset+size > 32 {
+		return nil, fmt.Errorf("%s at offset %d does not fit a slot", t.Label, offset)
+	}
+	b := word[32-offset-size : 32-offset]
+	label := t.Label
+	switch {
+	case label == "address" || label == "address payable" || strings.HasPrefix(label, "contract "):
+		return common.BytesToAddress(b), nil
+	case label == "bool":
+		return b[0] != 0, nil
+	case strings.HasPrefix(label, "enum "):
+		return b[len(b)-1], nil
+	case strings.HasPrefix(label, "uint"):
+		n := new(big.Int).SetBytes(b)
+		return nativeInt(n, size, false), nil
+	case strings.HasPrefix(label, "int"):
+		n := new(big.Int).SetBytes(b)
+		if b[0]&0x80 != 0 {
+			n.Sub(n, new(big.Int).Lsh(common.Big1, uint(size*8)))
+		}
+		return nativeInt(n, size, true), nil
+	case strings.HasPrefix(label, "bytes"):
+		v := reflect.New(reflect.ArrayOf(size, reflect.TypeOf(byte(0)))).Elem()
+		reflect.Copy(v, reflect.ValueOf(b))
+		return v.Interface(), nil
+	}
+	return nil, fmt.Errorf("unsupported value type %s", label)
+}
+
+// nativeInt converts n to the Go integer abigen uses for a Solidity integer
+// of size bytes.
+func nativeInt(n *big.Int, size int, signed bool) any {
+	switch {
+	case signed && size == 1:
+		return int8(n.Int64())
+	case signed && size == 2:
+		return int16(n.Int64())
+	case signed && size == 4:
+		return int32(n.Int64())
+	case signed && size == 8:
+		return n.Int64()
+	case size == 1:
+		return uint8(n.Uint64())
+	case size == 2:
+		return uint16(n.Uint64())
+	case size == 4:
+		return uint32(n.Uint64())
+	case size == 8:
+		return n.Uint64()
+	}
+	return n
+}
@@ -0,0 +1,169 @@
+// Package storage reads contract state straight from storage slots, guided by
+// the storage layout solc reports for the contract.
+//
+// Many values the EigenLayer contracts keep have no getter, such as
+// EigenPod's validator info or the OpenZeppelin owner and initializer
+// fields. A Layout locates any state variable, mapping entry, array element
+// or struct field, and a Contract reads and decodes it with eth_getStorageAt.
+//
+// The bindings of a release index the layouts of their contracts, so
+// reading one field of a validator's info is:
+//
+//	c := storage.NewContract(pod, bindings.StorageLayouts["EigenPod"], client)
+//	restaked, err := storage.Get[uint64](nil, c, "_validatorPubkeyHashToInfo", pubkeyHash, "restakedBalanceGwei")
+package storage
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Type encodings reported by solc.
+const (
+	// EncodingInplace values are stored in the slots they are placed at:
+	// value types, structs and static arrays.
+	EncodingInplace = "inplace"
+	// EncodingMapping values live at keccak256(key . slot).
+	EncodingMapping = "mapping"
+	// EncodingDynamicArray values store their length at their slot and
+	// their elements from keccak256(slot).
+	EncodingDynamicArray = "dynamic_array"
+	// EncodingBytes values are bytes and string, stored inline when shorter
+	// than 32 bytes and from keccak256(slot) otherwise.
+	EncodingBytes = "bytes"
+)
+
+// Layout is the storageLayout output of solc for a single contract, as found
+// in forge artifacts.
+type Layout struct {
+	// Storage lists the contract's state variables, those of its base
+	// contracts first.
+	Storage []Variable `json:"storage"`
+	// Types describes every type referenced by Storage, keyed by the
+	// identifiers Variable.Type and the Type fields refer to.
+	Types map[string]*Type `json:"types"`
+}
+
+// Variable is a state variable or struct member.
+type Variable struct {
+	// Contract is the declaring contract, as "<source path>:<name>".
+	Contract string `json:"contract"`
+	Label    string `json:"label"`
+	// Offset is the byte offset within the slot, counted from the lower
+	// order end.
+	Offset int `json:"offset"`
+	// Slot is the decimal slot number. For struct members it is relative to
+	// the first slot of the struct.
+	Slot string `json:"slot"`
+	Type string `json:"type"`
+}
+
+// Type describes how values of a Solidity type are stored.
+type Type struct {
+	Encoding string `json:"encoding"`
+	// Label is the Solidity type, e.g. "mapping(address => uint256)".
+	Label string `json:"label"`
+	// NumberOfBytes is the decimal size of the type. Types spanning more
+	// than one slot are always a multiple of 32.
+	NumberOfBytes string `json:"numberOfBytes"`
+	// Key and Value are the key and value types of a mapping.
+	Key   string `json:"key,omitempty"`
+	Value string `json:"value,omitempty"`
+	// Base is the element type of an array.
+	Base string `json:"base,omitempty"`
+	// Members are the fields of a struct.
+	Members []Variable `json:"members,omitempty"`
+}
+
+// ContractName returns the name part of v.Contract.
+func (v Variable) ContractName() string {
+	if i := strings.LastIndexByte(v.Contract, ':'); i >= 0 {
+		return v.Contract[i+1:]
+	}
+	return v.Contract
+}
+
+// ParseLayout decodes a JSON encoded storage layout and checks that every
+// type it references is described.
+func ParseLayout(data []byte) (*Layout, error) {
+	var l Layout
+	if err := json.Unmarshal(data, &l); err != nil {
+		return nil, fmt.Errorf("failed to decode storage layout: %w", err)
+	}
+	if err := l.validate(); err != nil {
+		return nil, err
+	}
+	return &l, nil
+}
+
+// MustParseLayout is like ParseLayout but panics on error. It is used by the
+// generated binding packages.
+func MustParseLayout(data string) *Layout {
+	l, err := ParseLayout([]byte(data))
+	if err != nil {
+		panic(err)
+	}
+	return l
+}
+
+func (l *Layout) validate() error {
+	check := func(v Variable) error {
+		if _, ok := new(big.Int).SetString(v.Slot, 10); !ok {
+			return fmt.Errorf("variable %s has invalid slot %q", v.Label, v.Slot)
+		}
+		if _, ok := l.Types[v.Type]; !ok {
+			return fmt.Errorf("variable %s has undescribed type %s", v.Label, v.Type)
+		}
+		return nil
+	}
+	for _, v := range l.Storage {
+		if err := check(v); err != nil {
+			return err
+		}
+	}
+	for id, t := range l.Types {
+		if _, err := t.size(); err != nil {
+			return fmt.Errorf("type %s: %w", id, err)
+		}
+		for _, ref := range []string{t.Key, t.Value, t.Base} {
+			if _, ok := l.Types[ref]; ref != "" && !ok {
+				return fmt.Errorf("type %s references undescribed type %s", id, ref)
+			}
+		}
+		for _, m := range t.Members {
+			if err := check(m); err != nil {
+				return fmt.Errorf("type %s: %w", id, err)
+			}
+		}
+	}
+	return nil
+}
+
+// Variables returns the state variables labelled label. Labels such as
+// __gap repeat across base contracts.
+func (l *Layout) Variables(label string) []Variable {
+	var out []Variable
+	for _, v := range l.Storage {
+		if v.Label == label {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+func (t *Type) size() (int, error) {
+	n, err := strconv.Atoi(t.NumberOfBytes)
+	if err != nil || n <= 0 {
+		return 0, fmt.Errorf("invalid size %q", t.NumberOfBytes)
+	}
+	return n, nil
+}
+
+// slots returns the number of slots a value of type t occupies.
+func (t *Type) slots() int {
+	n, _ := t.size()
+	return (n + 31) / 32
+}
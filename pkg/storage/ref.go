@@ -0,0 +1,293 @@
+package storage
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Ref locates a value in contract storage: a state variable, or a mapping
+// entry, array element or struct field reached from one.
+type Ref struct {
+	Slot common.Hash
+	// Offset is the byte offset of a packed value within Slot, counted from
+	// the lower order end.
+	Offset int
+	Type   *Type
+
+	layout *Layout
+	path   string
+}
+
+// String renders the Solidity expression r was reached by, e.g.
+// "operatorShares[0x...][0x...]".
+func (r Ref) String() string {
+	return r.path
+}
+
+// Var returns the state variable labelled label. A label declared by more
+// than one contract, such as __gap, must be qualified as "Contract.label".
+func (l *Layout) Var(label string) (Ref, error) {
+	contract, name, qualified := strings.Cut(label, ".")
+	if !qualified {
+		name = label
+	}
+	var found []Variable
+	for _, v := range l.Variables(name) {
+		if !qualified || v.ContractName() == contract {
+			found = append(found, v)
+		}
+	}
+	switch len(found) {
+	case 0:
+		return Ref{}, fmt.Errorf("no state variable %s", label)
+	case 1:
+	default:
+		return Ref{}, fmt.Errorf("state variable %s is declared by %d contracts, qualify it as Contract.%s", label, len(found), name)
+	}
+	v := found[0]
+	slot, _ := new(big.Int).SetString(v.Slot, 10)
+	return Ref{
+		Slot:   common.BigToHash(slot),
+		Offset: v.Offset,
+		Type:   l.Types[v.Type],
+		layout: l,
+		path:   label,
+	}, nil
+}
+
+// Lookup resolves a state variable and walks path from it: a mapping takes
+// the next element as its key, an array as its index and a struct as the
+// name of its field. For example
+//
+//	l.Lookup("_validatorPubkeyHashToInfo", pubkeyHash, "restakedBalanceGwei")
+func (l *Layout) Lookup(label string, path ...any) (Ref, error) {
+	r, err := l.Var(label)
+	if err != nil {
+		return Ref{}, err
+	}
+	for _, p := range path {
+		switch {
+		case r.Type.Encoding == EncodingMapping:
+			r, err = r.Key(p)
+		case r.Type.Base != "":
+			var i uint64
+			if i, err = toIndex(p); err == nil {
+				r, err = r.Index(i)
+			}
+		case len(r.Type.Members) > 0:
+			name, ok := p.(string)
+			if !ok {
+				return Ref{}, fmt.Errorf("%s is a %s, want a field name instead of %T", r, r.Type.Label, p)
+			}
+			r, err = r.Field(name)
+		default:
+			return Ref{}, fmt.Errorf("%s is a %s and cannot be indexed", r, r.Type.Label)
+		}
+		if err != nil {
+			return Ref{}, err
+		}
+	}
+	return r, nil
+}
+
+// Key returns the entry of a mapping under key. Keys are given as
+// common.Address for addresses and contracts, bool, any Go integer or
+// *big.Int for integers and enums, a byte array, common.Hash or []byte for
+// fixed size byte arrays, and string or []byte for string and bytes.
+func (r Ref) Key(key any) (Ref, error) {
+	if r.Type.Encoding != EncodingMapping {
+		return Ref{}, fmt.Errorf("%s is a %s, not a mapping", r, r.Type.Label)
+	}
+	keyType := r.layout.Types[r.Type.Key]
+	enc, err := encodeKey(keyType, key)
+	if err != nil {
+		return Ref{}, fmt.Errorf("invalid key for %s: %w", r, err)
+	}
+	return Ref{
+		Slot:   crypto.Keccak256Hash(enc, r.Slot[:]),
+		Type:   r.layout.Types[r.Type.Value],
+		layout: r.layout,
+		path:   fmt.Sprintf("%s[%v]", r.path, key),
+	}, nil
+}
+
+// Index returns element i of an array. The length of a dynamic array is not
+// known without reading it, so only static arrays are bounds checked.
+func (r Ref) Index(i uint64) (Ref, error) {
+	if r.Type.Base == "" {
+		return Ref{}, fmt.Errorf("%s is a %s, not an array", r, r.Type.Label)
+	}
+	base := r.Slot
+	switch r.Type.Encoding {
+	case EncodingDynamicArray:
+		base = crypto.Keccak256Hash(r.Slot[:])
+	case EncodingInplace:
+		n, err := staticLength(r.Type)
+		if err != nil {
+			return Ref{}, err
+		}
+		if i >= n {
+			return Ref{}, fmt.Errorf("index %d out of bounds for %s of %s", i, r, r.Type.Label)
+		}
+	}
+	elem := r.layout.Types[r.Type.Base]
+	size, _ := elem.size()
+	out := Ref{Type: elem, layout: r.layout, path: fmt.Sprintf("%s[%d]", r.path, i)}
+	if size <= 16 {
+		// Small elements are packed, lowest index in the lowest order bytes.
+		perSlot := uint64(32 / size)
+		out.Slot = addSlot(base, new(big.Int).SetUint64(i/perSlot))
+		out.Offset = int(i%perSlot) * size
+	} else {
+		offset := new(big.Int).Mul(new(big.Int).SetUint64(i), big.NewInt(int64(elem.slots())))
+		out.Slot = addSlot(base, offset)
+	}
+	return out, nil
+}
+
+// Field returns the member of a struct labelled name.
+func (r Ref) Field(name string) (Ref, error) {
+	if len(r.Type.Members) == 0 {
+		return Ref{}, fmt.Errorf("%s is a %s, not a struct", r, r.Type.Label)
+	}
+	for _, m := range r.Type.Members {
+		if m.Label != name {
+			continue
+		}
+		slot, _ := new(big.Int).SetString(m.Slot, 10)
+		return Ref{
+			Slot:   addSlot(r.Slot, slot),
+			Offset: m.Offset,
+			Type:   r.layout.Types[m.Type],
+			layout: r.layout,
+			path:   r.path + "." + name,
+		}, nil
+	}
+	return Ref{}, fmt.Errorf("%s has no field %s", r.Type.Label, name)
+}
+
+// addSlot returns slot+n, wrapping around like the EVM.
+func addSlot(slot common.Hash, n *big.Int) common.Hash {
+	sum := new(big.Int).Add(slot.Big(), n)
+	return common.BigToHash(math.U256(sum))
+}
+
+// staticLength parses the length of a static array from its label, e.g.
+// "uint256[50]".
+func staticLength(t *Type) (uint64, error) {
+	i := strings.LastIndexByte(t.Label, '[')
+	if i < 0 || !strings.HasSuffix(t.Label, "]") {
+		return 0, fmt.Errorf("malformed array type %s", t.Label)
+	}
+	return strconv.ParseUint(t.Label[i+1:len(t.Label)-1], 10, 64)
+}
+
+func toIndex(v any) (uint64, error) {
+	n, err := toBig(v)
+	if err != nil {
+		return 0, err
+	}
+	if n.Sign() < 0 || !n.IsUint64() {
+		return 0, fmt.Errorf("invalid array index %v", v)
+	}
+	return n.Uint64(), nil
+}
+
+// toBig converts a Go integer or *big.Int to a *big.Int.
+func toBig(v any) (*big.Int, error) {
+	switch n := v.(type) {
+	case *big.Int:
+		return n, nil
+	case int:
+		return big.NewInt(int64(n)), nil
+	case int8:
+		return big.NewInt(int64(n)), nil
+	case int16:
+		return big.NewInt(int64(n)), nil
+	case int32:
+		return big.NewInt(int64(n)), nil
+	case int64:
+		return big.NewInt(n), nil
+	case uint:
+		return new(big.Int).SetUint64(uint64(n)), nil
+	case uint8:
+		return new(big.Int).SetUint64(uint64(n)), nil
+	case uint16:
+		return new(big.Int).SetUint64(uint64(n)), nil
+	case uint32:
+		return new(big.Int).SetUint64(uint64(n)), nil
+	case uint64:
+		return new(big.Int).SetUint64(n), nil
+	}
+	return nil, fmt.Errorf("%T is not an integer", v)
+}
+
+// encodeKey encodes a mapping key the way solc hashes it: value types padded
+// to a word, string and bytes as is.
+func encodeKey(t *Type, key any) ([]byte, error) {
+	label := t.Label
+	switch {
+	case label == "address" || label == "address payable" || strings.HasPrefix(label, "contract "):
+		addr, ok := key.(common.Address)
+		if !ok {
+			return nil, fmt.Errorf("want common.Address for %s, have %T", label, key)
+		}
+		return common.LeftPadBytes(addr[:], 32), nil
+	case label == "bool":
+		b, ok := key.(bool)
+		if !ok {
+			return nil, fmt.Errorf("want bool, have %T", key)
+		}
+		word := make([]byte, 32)
+		if b {
+			word[31] = 1
+		}
+		return word, nil
+	case label == "string" || label == "bytes":
+		switch k := key.(type) {
+		case string:
+			return []byte(k), nil
+		case []byte:
+			return k, nil
+		}
+		return nil, fmt.Errorf("want string or []byte for %s, have %T", label, key)
+	case strings.HasPrefix(label, "bytes"):
+		size, _ := t.size()
+		b, err := fixedBytes(key)
+		if err != nil {
+			return nil, err
+		}
+		if len(b) != size {
+			return nil, fmt.Errorf("want %d bytes for %s, have %d", size, label, len(b))
+		}
+		return common.RightPadBytes(b, 32), nil
+	case strings.HasPrefix(label, "uint") || strings.HasPrefix(label, "int") || strings.HasPrefix(label, "enum "):
+		n, err := toBig(key)
+		if err != nil {
+			return nil, err
+		}
+		return math.U256Bytes(new(big.Int).Set(n)), nil
+	}
+	return nil, fmt.Errorf("unsupported key type %s", label)
+}
+
+// fixedBytes returns the contents of a byte array, common.Hash or []byte.
+func fixedBytes(v any) ([]byte, error) {
+	if b, ok := v.([]byte); ok {
+		return b, nil
+	}
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Array || rv.Type().Elem().Kind() != reflect.Uint8 {
+		return nil, fmt.Errorf("%T is not a byte array", v)
+	}
+	b := make([]byte, rv.Len())
+	reflect.Copy(reflect.ValueOf(b), rv)
+	return b, nil
+}
@@ -0,0 +1,222 @@
+package storage_test
+
+import (
+	"context"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/storage"
+)
+
+// layout is the storageLayout solc reports for
+//
+//	contract Pod is Initializable {
+//	    address owner;                     // slot 0, packed with Initializable
+//	    mapping(bytes32 => ValidatorInfo) _validatorPubkeyHashToInfo;
+//	    address[] strategies;
+//	    uint32[] times;
+//	    string name;
+//	    mapping(address => mapping(address => uint256)) operatorShares;
+//	    uint256[2] __gap;
+//	}
+//
+// with Initializable's _initialized and _initializing placed after owner.
+const layout = `{
+	"storage": [
+		{"contract": "src/Pod.sol:Pod", "label": "owner", "offset": 0, "slot": "0", "type": "t_address"},
+		{"contract": "lib/Initializable.sol:Initializable", "label": "_initialized", "offset": 20, "slot": "0", "type": "t_uint8"},
+		{"contract": "lib/Initializable.sol:Initializable", "label": "_initializing", "offset": 21, "slot": "0", "type": "t_bool"},
+		{"contract": "src/Pod.sol:Pod", "label": "_validatorPubkeyHashToInfo", "offset": 0, "slot": "1", "type": "t_mapping(t_bytes32,t_struct(ValidatorInfo)10_storage)"},
+		{"contract": "src/Pod.sol:Pod", "label": "strategies", "offset": 0, "slot": "2", "type": "t_array(t_address)dyn_storage"},
+		{"contract": "src/Pod.sol:Pod", "label": "times", "offset": 0, "slot": "3", "type": "t_array(t_uint32)dyn_storage"},
+		{"contract": "src/Pod.sol:Pod", "label": "name", "offset": 0, "slot": "4", "type": "t_string_storage"},
+		{"contract": "src/Pod.sol:Pod", "label": "operatorShares", "offset": 0, "slot": "5", "type": "t_mapping(t_address,t_mapping(t_address,t_uint256))"},
+		{"contract": "lib/Initializable.sol:Initializable", "label": "__gap", "offset": 0, "slot": "6", "type": "t_array(t_uint256)2_storage"},
+		{"contract": "src/Pod.sol:Pod", "label": "__gap", "offset": 0, "slot": "8", "type": "t_array(t_uint256)2_storage"}
+	],
+	"types": {
+		"t_address": {"encoding": "inplace", "label": "address", "numberOfBytes": "20"},
+		"t_bool": {"encoding": "inplace", "label": "bool", "numberOfBytes": "1"},
+		"t_bytes32": {"encoding": "inplace", "label": "bytes32", "numberOfBytes": "32"},
+		"t_uint8": {"encoding": "inplace", "label": "uint8", "numberOfBytes": "1"},
+		"t_uint32": {"encoding": "inplace", "label": "uint32", "numberOfBytes": "4"},
+		"t_uint64": {"encoding": "inplace", "label": "uint64", "numberOfBytes": "8"},
+		"t_uint256": {"encoding": "inplace", "label": "uint256", "numberOfBytes": "32"},
+		"t_enum(VALIDATOR_STATUS)5": {"encoding": "inplace", "label": "enum IEigenPod.VALIDATOR_STATUS", "numberOfBytes": "1"},
+		"t_string_storage": {"encoding": "bytes", "label": "string", "numberOfBytes": "32"},
+		"t_array(t_address)dyn_storage": {"encoding": "dynamic_array", "label": "address[]", "numberOfBytes": "32", "base": "t_address"},
+		"t_array(t_uint32)dyn_storage": {"encoding": "dynamic_array", "label": "uint32[]", "numberOfBytes": "32", "base": "t_uint32"},
+		"t_array(t_uint256)2_storage": {"encoding": "inplace", "label": "uint256[2]", "numberOfBytes": "64", "base": "t_uint256"},
+		"t_mapping(t_address,t_uint256)": {"encoding": "mapping", "label": "mapping(address => uint256)", "numberOfBytes": "32", "key": "t_address", "value": "t_uint256"},
+		"t_mapping(t_address,t_mapping(t_address,t_uint256))": {"encoding": "mapping", "label": "mapping(address => mapping(address => uint256))", "numberOfBytes": "32", "key": "t_address", "value": "t_mapping(t_address,t_uint256)"},
+		"t_mapping(t_bytes32,t_struct(ValidatorInfo)10_storage)": {"encoding": "mapping", "label": "mapping(bytes32 => struct IEigenPod.ValidatorInfo)", "numberOfBytes": "32", "key": "t_bytes32", "value": "t_struct(ValidatorInfo)10_storage"},
+		"t_struct(ValidatorInfo)10_storage": {"encoding": "inplace", "label": "struct IEigenPod.ValidatorInfo", "numberOfBytes": "32", "members": [
+			{"contract": "src/IEigenPod.sol:IEigenPod", "label": "validatorIndex", "offset": 0, "slot": "0", "type": "t_uint64"},
+			{"contract": "src/IEigenPod.sol:IEigenPod", "label": "restakedBalanceGwei", "offset": 8, "slot": "0", "type": "t_uint64"},
+			{"contract": "src/IEigenPod.sol:IEigenPod", "label": "mostRecentBalanceUpdateTimestamp", "offset": 16, "slot": "0", "type": "t_uint64"},
+			{"contract": "src/IEigenPod.sol:IEigenPod", "label": "status", "offset": 24, "slot": "0", "type": "t_enum(VALIDATOR_STATUS)5"}
+		]}
+	}
+}`
+
+var (
+	pod      = common.HexToAddress("0x1000")
+	owner    = common.HexToAddress("0x2000")
+	operator = common.HexToAddress("0x3000")
+	strategy = common.HexToAddress("0x4000")
+	pubkey   = common.HexToHash("0xabcd")
+)
+
+// node serves eth_getStorageAt from the slots of a single block: a slot
+// never written reads as zero, and another account's storage is empty.
+type node map[common.Hash]common.Hash
+
+func (n node) StorageAt(_ context.Context, account common.Address, key common.Hash, _ *big.Int) ([]byte, error) {
+	if account != pod {
+		return make([]byte, 32), nil
+	}
+	v := n[key]
+	return v.Bytes(), nil
+}
+
+// word packs values into a slot, each (value, offset) pair placed at its
+// byte offset from the lower order end.
+func word(pairs ...uint64) common.Hash {
+	w := new(big.Int)
+	for i := 0; i < len(pairs); i += 2 {
+		w.Or(w, new(big.Int).Lsh(new(big.Int).SetUint64(pairs[i]), uint(pairs[i+1]*8)))
+	}
+	return common.BigToHash(w)
+}
+
+func pad(b []byte) []byte { return common.LeftPadBytes(b, 32) }
+
+func slot(n int64) []byte { return common.BigToHash(big.NewInt(n)).Bytes() }
+
+func plus(h common.Hash, n int64) common.Hash {
+	return common.BigToHash(new(big.Int).Add(h.Big(), big.NewInt(n)))
+}
+
+func TestLookup(t *testing.T) {
+	l, err := storage.ParseLayout([]byte(layout))
+	if err != nil {
+		t.Fatal(err)
+	}
+	info := crypto.Keccak256Hash(pubkey.Bytes(), slot(1))
+	strategies := crypto.Keccak256Hash(slot(2))
+	times := crypto.Keccak256Hash(slot(3))
+	shares := crypto.Keccak256Hash(pad(strategy.Bytes()), crypto.Keccak256(pad(operator.Bytes()), slot(5)))
+	tests := []struct {
+		label  string
+		path   []any
+		slot   common.Hash
+		offset int
+		err    string
+	}{
+		{label: "_initializing", slot: common.Hash{}, offset: 21},
+		{label: "_validatorPubkeyHashToInfo", path: []any{pubkey}, slot: info},
+		{label: "_validatorPubkeyHashToInfo", path: []any{pubkey, "status"}, slot: info, offset: 24},
+		{label: "strategies", path: []any{3}, slot: plus(strategies, 3)},
+		// Eight uint32 fit a slot.
+		{label: "times", path: []any{9}, slot: plus(times, 1), offset: 4},
+		{label: "operatorShares", path: []any{operator, strategy}, slot: shares},
+		{label: "Pod.__gap", path: []any{1}, slot: common.BigToHash(big.NewInt(9))},
+		{label: "__gap", err: "state variable __gap is declared by 2 contracts, qualify it as Contract.__gap"},
+		{label: "Pod.__gap", path: []any{2}, err: "index 2 out of bounds for Pod.__gap of uint256[2]"},
+		{label: "_validatorPubkeyHashToInfo", path: []any{pubkey[:4]}, err: "invalid key for _validatorPubkeyHashToInfo: want 32 bytes for bytes32, have 4"},
+		{label: "_validatorPubkeyHashToInfo", path: []any{pubkey, "balance"}, err: "struct IEigenPod.ValidatorInfo has no field balance"},
+		{label: "owner", path: []any{0}, err: "owner is a address and cannot be indexed"},
+	}
+	for _, tt := range tests {
+		r, err := l.Lookup(tt.label, tt.path...)
+		switch {
+		case tt.err == "" && err != nil:
+			t.Errorf("Lookup(%s, %v): %v", tt.label, tt.path, err)
+			continue
+		case tt.err != "" && (err == nil || err.Error() != tt.err):
+			t.Errorf("Lookup(%s, %v) err = %v, want %q", tt.label, tt.path, err, tt.err)
+			continue
+		case tt.err != "":
+			continue
+		}
+		if r.Slot != tt.slot || r.Offset != tt.offset {
+			t.Errorf("Lookup(%s, %v) = slot %s offset %d, want slot %s offset %d", tt.label, tt.path, r.Slot, r.Offset, tt.slot, tt.offset)
+		}
+	}
+}
+
+func TestContract(t *testing.T) {
+	l, err := storage.ParseLayout([]byte(layout))
+	if err != nil {
+		t.Fatal(err)
+	}
+	long := "a name longer than the thirty one bytes stored inline"
+	nameData := crypto.Keccak256Hash(slot(4))
+	var chunk1, chunk2 common.Hash
+	copy(chunk1[:], long[:32])
+	copy(chunk2[:], long[32:])
+	n := node{
+		common.Hash{}: common.BytesToHash(append([]byte{1, 1}, owner.Bytes()...)),
+		crypto.Keccak256Hash(pubkey.Bytes(), slot(1)): word(7, 0, 32e9, 8, 1_700_000_000, 16, 1, 24),
+		common.BigToHash(big.NewInt(2)):               common.BigToHash(big.NewInt(2)),
+		crypto.Keccak256Hash(slot(2)):                 common.BytesToHash(operator.Bytes()),
+		plus(crypto.Keccak256Hash(slot(2)), 1):        common.BytesToHash(strategy.Bytes()),
+		common.BigToHash(big.NewInt(3)):               common.BigToHash(big.NewInt(3)),
+		crypto.Keccak256Hash(slot(3)):                 word(10, 0, 20, 4, 30, 8),
+		common.BigToHash(big.NewInt(6)):               common.BigToHash(big.NewInt(1)),
+		common.BigToHash(big.NewInt(7)):               common.BigToHash(big.NewInt(2)),
+		common.BigToHash(big.NewInt(4)):               common.BigToHash(big.NewInt(int64(2*len(long) + 1))),
+		nameData:                                      chunk1,
+		plus(nameData, 1):                             chunk2,
+		crypto.Keccak256Hash(pad(strategy.Bytes()), crypto.Keccak256(pad(operator.Bytes()), slot(5))): common.BigToHash(big.NewInt(5e18)),
+	}
+	c := storage.NewContract(pod, l, n)
+
+	tests := []struct {
+		label string
+		path  []any
+		want  any
+	}{
+		{"owner", nil, owner},
+		{"_initialized", nil, uint8(1)},
+		{"_initializing", nil, true},
+		{"_validatorPubkeyHashToInfo", []any{pubkey, "restakedBalanceGwei"}, uint64(32e9)},
+		{"_validatorPubkeyHashToInfo", []any{pubkey}, map[string]any{
+			"validatorIndex":                   uint64(7),
+			"restakedBalanceGwei":              uint64(32e9),
+			"mostRecentBalanceUpdateTimestamp": uint64(1_700_000_000),
+			"status":                           uint8(1),
+		}},
+		{"_validatorPubkeyHashToInfo", []any{common.HexToHash("0x01"), "status"}, uint8(0)},
+		{"strategies", nil, []any{operator, strategy}},
+		{"times", nil, []any{uint32(10), uint32(20), uint32(30)}},
+		{"times", []any{1}, uint32(20)},
+		{"name", nil, long},
+		{"operatorShares", []any{operator, strategy}, big.NewInt(5e18)},
+		{"Initializable.__gap", nil, []any{big.NewInt(1), big.NewInt(2)}},
+	}
+	for _, tt := range tests {
+		got, err := c.Value(nil, tt.label, tt.path...)
+		if err != nil {
+			t.Errorf("Value(%s, %v): %v", tt.label, tt.path, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Value(%s, %v) = %v, want %v", tt.label, tt.path, got, tt.want)
+		}
+	}
+
+	if got, err := storage.Get[uint64](nil, c, "_validatorPubkeyHashToInfo", pubkey, "validatorIndex"); err != nil || got != 7 {
+		t.Errorf("Get validatorIndex = %d, %v; want 7", got, err)
+	}
+	if _, err := storage.Get[*big.Int](nil, c, "owner"); err == nil || err.Error() != "owner decodes to common.Address, not *big.Int" {
+		t.Errorf("Get owner as *big.Int err = %v", err)
+	}
+	if _, err := c.Value(nil, "operatorShares", operator); err == nil {
+		t.Error("read a mapping without a key")
+	}
+}
@@ -0,0 +1,171 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package types
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = big.NewInt
+	_ = common.Big1
+)
+
+// Checkpoint mirrors the Solidity struct ERC20VotesUpgradeable.Checkpoint, bound as
+// ERC20VotesUpgradeableCheckpoint in BackingEigen, Eigen.
+type Checkpoint struct {
+	FromBlock uint32
+	Votes     *big.Int
+}
+
+// DelayedWithdrawal mirrors the Solidity struct IDelayedWithdrawalRouter.DelayedWithdrawal, bound as
+// IDelayedWithdrawalRouterDelayedWithdrawal in DelayedWithdrawalRouter, IDelayedWithdrawalRouter.
+type DelayedWithdrawal struct {
+	Amount       *big.Int
+	BlockCreated uint32
+}
+
+// DistributionRoot mirrors the Solidity struct IRewardsCoordinator.DistributionRoot, bound as
+// IRewardsCoordinatorDistributionRoot in IRewardsCoordinator, RewardsCoordinator, RewardsCoordinatorStorage.
+type DistributionRoot struct {
+	Root                           [32]byte
+	RewardsCalculationEndTimestamp uint32
+	ActivatedAt                    uint32
+	Disabled                       bool
+}
+
+// EarnerTreeMerkleLeaf mirrors the Solidity struct IRewardsCoordinator.EarnerTreeMerkleLeaf, bound as
+// IRewardsCoordinatorEarnerTreeMerkleLeaf in IRewardsCoordinator, RewardsCoordinator, RewardsCoordinatorStorage.
+type EarnerTreeMerkleLeaf struct {
+	Earner          common.Address
+	EarnerTokenRoot [32]byte
+}
+
+// MiddlewareTimes mirrors the Solidity struct ISlasher.MiddlewareTimes, bound as
+// ISlasherMiddlewareTimes in ISlasher.
+type MiddlewareTimes struct {
+	StalestUpdateBlock    uint32
+	LatestServeUntilBlock uint32
+}
+
+// OperatorDetails mirrors the Solidity struct IDelegationManager.OperatorDetails, bound as
+// IDelegationManagerOperatorDetails in DelegationManager, DelegationManagerStorage, IDelegationManager.
+type OperatorDetails struct {
+	DeprecatedEarningsReceiver common.Address
+	DelegationApprover         common.Address
+	StakerOptOutWindowBlocks   uint32
+}
+
+// QueuedWithdrawalParams mirrors the Solidity struct IDelegationManager.QueuedWithdrawalParams, bound as
+// IDelegationManagerQueuedWithdrawalParams in DelegationManager, DelegationManagerStorage, IDelegationFaucet, IDelegationManager, IWhitelister.
+type QueuedWithdrawalParams struct {
+	Strategies []common.Address
+	Shares     []*big.Int
+	Withdrawer common.Address
+}
+
+// RewardsMerkleClaim mirrors the Solidity struct IRewardsCoordinator.RewardsMerkleClaim, bound as
+// IRewardsCoordinatorRewardsMerkleClaim in IRewardsCoordinator, RewardsCoordinator, RewardsCoordinatorStorage.
+type RewardsMerkleClaim struct {
+	RootIndex       uint32
+	EarnerIndex     uint32
+	EarnerTreeProof []byte
+	EarnerLeaf      EarnerTreeMerkleLeaf
+	TokenIndices    []uint32
+	TokenTreeProofs [][]byte
+	TokenLeaves     []TokenTreeMerkleLeaf
+}
+
+// RewardsSubmission mirrors the Solidity struct IRewardsCoordinator.RewardsSubmission, bound as
+// IRewardsCoordinatorRewardsSubmission in IRewardsCoordinator, RewardsCoordinator, RewardsCoordinatorStorage.
+type RewardsSubmission struct {
+	StrategiesAndMultipliers []StrategyAndMultiplier
+	Token                    common.Address
+	Amount                   *big.Int
+	StartTimestamp           uint32
+	Duration                 uint32
+}
+
+// SignatureWithExpiry mirrors the Solidity struct ISignatureUtils.SignatureWithExpiry, bound as
+// ISignatureUtilsSignatureWithExpiry in DelegationManager, DelegationManagerStorage, IDelegationFaucet, IDelegationManager.
+type SignatureWithExpiry struct {
+	Signature []byte
+	Expiry    *big.Int
+}
+
+// SignatureWithSaltAndExpiry mirrors the Solidity struct ISignatureUtils.SignatureWithSaltAndExpiry, bound as
+// ISignatureUtilsSignatureWithSaltAndExpiry in AVSDirectory, AVSDirectoryStorage, IAVSDirectory.
+type SignatureWithSaltAndExpiry struct {
+	Signature []byte
+	Salt      [32]byte
+	Expiry    *big.Int
+}
+
+// StateRootProof mirrors the Solidity struct BeaconChainProofs.StateRootProof, bound as
+// BeaconChainProofsStateRootProof in EigenPod, IEigenPod.
+type StateRootProof struct {
+	BeaconStateRoot [32]byte
+	Proof           []byte
+}
+
+// StrategyAndMultiplier mirrors the Solidity struct IRewardsCoordinator.StrategyAndMultiplier, bound as
+// IRewardsCoordinatorStrategyAndMultiplier in IRewardsCoordinator, RewardsCoordinator, RewardsCoordinatorStorage.
+type StrategyAndMultiplier struct {
+	Strategy   common.Address
+	Multiplier *big.Int
+}
+
+// TokenTreeMerkleLeaf mirrors the Solidity struct IRewardsCoordinator.TokenTreeMerkleLeaf, bound as
+// IRewardsCoordinatorTokenTreeMerkleLeaf in IRewardsCoordinator, RewardsCoordinator, RewardsCoordinatorStorage.
+type TokenTreeMerkleLeaf struct {
+	Token              common.Address
+	CumulativeEarnings *big.Int
+}
+
+// UserDelayedWithdrawals mirrors the Solidity struct IDelayedWithdrawalRouter.UserDelayedWithdrawals, bound as
+// IDelayedWithdrawalRouterUserDelayedWithdrawals in DelayedWithdrawalRouter, IDelayedWithdrawalRouter.
+type UserDelayedWithdrawals struct {
+	DelayedWithdrawalsCompleted *big.Int
+	DelayedWithdrawals          []DelayedWithdrawal
+}
+
+// ValidatorInfo mirrors the Solidity struct IEigenPod.ValidatorInfo, bound as
+// IEigenPodValidatorInfo in EigenPod, IEigenPod.
+type ValidatorInfo struct {
+	ValidatorIndex                   uint64
+	RestakedBalanceGwei              uint64
+	MostRecentBalanceUpdateTimestamp uint64
+	Status                           uint8
+}
+
+// Withdrawal mirrors the Solidity struct IDelegationManager.Withdrawal, bound as
+// IDelegationManagerWithdrawal in DelegationManager, DelegationManagerStorage, IDelegationFaucet, IDelegationManager, IWhitelister.
+type Withdrawal struct {
+	Staker      common.Address
+	DelegatedTo common.Address
+	Withdrawer  common.Address
+	Nonce       *big.Int
+	StartBlock  uint32
+	Strategies  []common.Address
+	Shares      []*big.Int
+}
+
+// WithdrawalProof mirrors the Solidity struct BeaconChainProofs.WithdrawalProof, bound as
+// BeaconChainProofsWithdrawalProof in EigenPod, IEigenPod.
+type WithdrawalProof struct {
+	WithdrawalProof                 []byte
+	SlotProof                       []byte
+	ExecutionPayloadProof           []byte
+	TimestampProof                  []byte
+	HistoricalSummaryBlockRootProof []byte
+	BlockRootIndex                  uint64
+	HistoricalSummaryIndex          uint64
+	WithdrawalIndex                 uint64
+	BlockRoot                       [32]byte
+	SlotRoot                        [32]byte
+	TimestampRoot                   [32]byte
+	ExecutionPayloadRoot            [32]byte
+}
@@ -0,0 +1,22 @@
+// Package types declares a single Go type for every Solidity struct used by
+// the contract bindings.
+//
+// abigen re-declares a struct in each binding package that references it, so
+// for example IDelegationManager.Withdrawal exists as
+// DelegationManager.IDelegationManagerWithdrawal,
+// IDelegationManager.IDelegationManagerWithdrawal and several more. Each of
+// those packages has a generated Canonical method converting its copy to the
+// type declared here, and a To<Copy> function converting back.
+package types
+
+// ConvertSlice applies convert to every element of s, preserving nil.
+func ConvertSlice[S, D any](s []S, convert func(S) D) []D {
+	if s == nil {
+		return nil
+	}
+	out := make([]D, len(s))
+	for i, v := range s {
+		out[i] = convert(v)
+	}
+	return out
+}
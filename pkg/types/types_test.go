@@ -0,0 +1,254 @@
+package types_test
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"math/big"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	avsdirectory "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/AVSDirectory"
+	avsdirectorystorage "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/AVSDirectoryStorage"
+	backingeigen "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/BackingEigen"
+	delayedwithdrawalrouter "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/DelayedWithdrawalRouter"
+	delegationmanager "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/DelegationManager"
+	delegationmanagerstorage "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/DelegationManagerStorage"
+	eigen "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/Eigen"
+	eigenpod "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/EigenPod"
+	iavsdirectory "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/IAVSDirectory"
+	idelayedwithdrawalrouter "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/IDelayedWithdrawalRouter"
+	idelegationfaucet "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/IDelegationFaucet"
+	idelegationmanager "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/IDelegationManager"
+	ieigenpod "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/IEigenPod"
+	irewardscoordinator "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/IRewardsCoordinator"
+	islasher "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/ISlasher"
+	iwhitelister "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/IWhitelister"
+	rewardscoordinator "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/RewardsCoordinator"
+	rewardscoordinatorstorage "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/RewardsCoordinatorStorage"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/types"
+)
+
+// Values of every canonical type with every field set, to distinct values
+// so that a converter swapping fields is caught.
+var (
+	checkpoint        = types.Checkpoint{FromBlock: 1, Votes: big.NewInt(2)}
+	delayedWithdrawal = types.DelayedWithdrawal{Amount: big.NewInt(3), BlockCreated: 4}
+	distributionRoot  = types.DistributionRoot{
+		Root:                           [32]byte{5},
+		RewardsCalculationEndTimestamp: 6,
+		ActivatedAt:                    7,
+		Disabled:                       true,
+	}
+	earnerTreeMerkleLeaf = types.EarnerTreeMerkleLeaf{Earner: common.Address{8}, EarnerTokenRoot: [32]byte{9}}
+	middlewareTimes      = types.MiddlewareTimes{StalestUpdateBlock: 10, LatestServeUntilBlock: 11}
+	operatorDetails      = types.OperatorDetails{
+		DeprecatedEarningsReceiver: common.Address{12},
+		DelegationApprover:         common.Address{13},
+		StakerOptOutWindowBlocks:   14,
+	}
+	queuedWithdrawalParams = types.QueuedWithdrawalParams{
+		Strategies: []common.Address{{15}, {16}},
+		Shares:     []*big.Int{big.NewInt(17), big.NewInt(18)},
+		Withdrawer: common.Address{19},
+	}
+	tokenTreeMerkleLeaf = types.TokenTreeMerkleLeaf{Token: common.Address{20}, CumulativeEarnings: big.NewInt(21)}
+	rewardsMerkleClaim  = types.RewardsMerkleClaim{
+		RootIndex:       22,
+		EarnerIndex:     23,
+		EarnerTreeProof: []byte{24},
+		EarnerLeaf:      earnerTreeMerkleLeaf,
+		TokenIndices:    []uint32{25, 26},
+		TokenTreeProofs: [][]byte{{27}, {28}},
+		TokenLeaves: []types.TokenTreeMerkleLeaf{
+			tokenTreeMerkleLeaf,
+			{Token: common.Address{29}, CumulativeEarnings: big.NewInt(30)},
+		},
+	}
+	strategyAndMultiplier = types.StrategyAndMultiplier{Strategy: common.Address{31}, Multiplier: big.NewInt(32)}
+	rewardsSubmission     = types.RewardsSubmission{
+		StrategiesAndMultipliers: []types.StrategyAndMultiplier{
+			strategyAndMultiplier,
+			{Strategy: common.Address{33}, Multiplier: big.NewInt(34)},
+		},
+		Token:          common.Address{35},
+		Amount:         big.NewInt(36),
+		StartTimestamp: 37,
+		Duration:       38,
+	}
+	signatureWithExpiry        = types.SignatureWithExpiry{Signature: []byte{39}, Expiry: big.NewInt(40)}
+	signatureWithSaltAndExpiry = types.SignatureWithSaltAndExpiry{Signature: []byte{41}, Salt: [32]byte{42}, Expiry: big.NewInt(43)}
+	stateRootProof             = types.StateRootProof{BeaconStateRoot: [32]byte{44}, Proof: []byte{45}}
+	userDelayedWithdrawals     = types.UserDelayedWithdrawals{
+		DelayedWithdrawalsCompleted: big.NewInt(46),
+		DelayedWithdrawals:          []types.DelayedWithdrawal{delayedWithdrawal, {Amount: big.NewInt(47), BlockCreated: 48}},
+	}
+	validatorInfo = types.ValidatorInfo{
+		ValidatorIndex:                   49,
+		RestakedBalanceGwei:              50,
+		MostRecentBalanceUpdateTimestamp: 51,
+		Status:                           2,
+	}
+	withdrawal = types.Withdrawal{
+		Staker:      common.Address{52},
+		DelegatedTo: common.Address{53},
+		Withdrawer:  common.Address{54},
+		Nonce:       big.NewInt(55),
+		StartBlock:  56,
+		Strategies:  []common.Address{{57}, {58}},
+		Shares:      []*big.Int{big.NewInt(59), big.NewInt(60)},
+	}
+	withdrawalProof = types.WithdrawalProof{
+		WithdrawalProof:                 []byte{61},
+		SlotProof:                       []byte{62},
+		ExecutionPayloadProof:           []byte{63},
+		TimestampProof:                  []byte{64},
+		HistoricalSummaryBlockRootProof: []byte{65},
+		BlockRootIndex:                  66,
+		HistoricalSummaryIndex:          67,
+		WithdrawalIndex:                 68,
+		BlockRoot:                       [32]byte{69},
+		SlotRoot:                        [32]byte{70},
+		TimestampRoot:                   [32]byte{71},
+		ExecutionPayloadRoot:            [32]byte{72},
+	}
+)
+
+// roundTrip returns a test converting v, and the zero value, to a binding's
+// copy with to and back with its Canonical method.
+func roundTrip[C any, B interface{ Canonical() C }](v C, to func(C) B) func(t *testing.T) {
+	return func(t *testing.T) {
+		var zero C
+		for _, want := range []C{v, zero} {
+			bound := to(want)
+			sameFields(t, reflect.TypeOf(bound).Name(), reflect.ValueOf(bound), reflect.ValueOf(want))
+			if got := bound.Canonical(); !reflect.DeepEqual(got, want) {
+				t.Errorf("round trip of %+v = %+v", want, got)
+			}
+		}
+	}
+}
+
+// sameFields checks that the binding copy bound holds the values of the
+// canonical value v, field by field.
+func sameFields(t *testing.T, path string, bound, v reflect.Value) {
+	t.Helper()
+	switch v.Kind() {
+	case reflect.Struct:
+		if bound.NumField() != v.NumField() {
+			t.Errorf("%s has %d fields, want %d", path, bound.NumField(), v.NumField())
+			return
+		}
+		for i := 0; i < v.NumField(); i++ {
+			name := v.Type().Field(i).Name
+			if got := bound.Type().Field(i).Name; got != name {
+				t.Errorf("field %d of %s is %s, want %s", i, path, got, name)
+				continue
+			}
+			sameFields(t, path+"."+name, bound.Field(i), v.Field(i))
+		}
+	case reflect.Slice:
+		if bound.IsNil() != v.IsNil() || bound.Len() != v.Len() {
+			t.Errorf("%s = %v, want %v", path, bound, v)
+			return
+		}
+		for i := 0; i < v.Len(); i++ {
+			sameFields(t, fmt.Sprintf("%s[%d]", path, i), bound.Index(i), v.Index(i))
+		}
+	default:
+		if !reflect.DeepEqual(bound.Interface(), v.Interface()) {
+			t.Errorf("%s = %v, want %v", path, bound, v)
+		}
+	}
+}
+
+func TestConverters(t *testing.T) {
+	tests := []struct {
+		pkg, bound string
+		test       func(t *testing.T)
+	}{
+		{"AVSDirectory", "ISignatureUtilsSignatureWithSaltAndExpiry", roundTrip(signatureWithSaltAndExpiry, avsdirectory.ToISignatureUtilsSignatureWithSaltAndExpiry)},
+		{"AVSDirectoryStorage", "ISignatureUtilsSignatureWithSaltAndExpiry", roundTrip(signatureWithSaltAndExpiry, avsdirectorystorage.ToISignatureUtilsSignatureWithSaltAndExpiry)},
+		{"BackingEigen", "ERC20VotesUpgradeableCheckpoint", roundTrip(checkpoint, backingeigen.ToERC20VotesUpgradeableCheckpoint)},
+		{"DelayedWithdrawalRouter", "IDelayedWithdrawalRouterDelayedWithdrawal", roundTrip(delayedWithdrawal, delayedwithdrawalrouter.ToIDelayedWithdrawalRouterDelayedWithdrawal)},
+		{"DelayedWithdrawalRouter", "IDelayedWithdrawalRouterUserDelayedWithdrawals", roundTrip(userDelayedWithdrawals, delayedwithdrawalrouter.ToIDelayedWithdrawalRouterUserDelayedWithdrawals)},
+		{"DelegationManager", "IDelegationManagerOperatorDetails", roundTrip(operatorDetails, delegationmanager.ToIDelegationManagerOperatorDetails)},
+		{"DelegationManager", "IDelegationManagerQueuedWithdrawalParams", roundTrip(queuedWithdrawalParams, delegationmanager.ToIDelegationManagerQueuedWithdrawalParams)},
+		{"DelegationManager", "ISignatureUtilsSignatureWithExpiry", roundTrip(signatureWithExpiry, delegationmanager.ToISignatureUtilsSignatureWithExpiry)},
+		{"DelegationManager", "IDelegationManagerWithdrawal", roundTrip(withdrawal, delegationmanager.ToIDelegationManagerWithdrawal)},
+		{"DelegationManagerStorage", "IDelegationManagerOperatorDetails", roundTrip(operatorDetails, delegationmanagerstorage.ToIDelegationManagerOperatorDetails)},
+		{"DelegationManagerStorage", "IDelegationManagerQueuedWithdrawalParams", roundTrip(queuedWithdrawalParams, delegationmanagerstorage.ToIDelegationManagerQueuedWithdrawalParams)},
+		{"DelegationManagerStorage", "ISignatureUtilsSignatureWithExpiry", roundTrip(signatureWithExpiry, delegationmanagerstorage.ToISignatureUtilsSignatureWithExpiry)},
+		{"DelegationManagerStorage", "IDelegationManagerWithdrawal", roundTrip(withdrawal, delegationmanagerstorage.ToIDelegationManagerWithdrawal)},
+		{"Eigen", "ERC20VotesUpgradeableCheckpoint", roundTrip(checkpoint, eigen.ToERC20VotesUpgradeableCheckpoint)},
+		{"EigenPod", "BeaconChainProofsStateRootProof", roundTrip(stateRootProof, eigenpod.ToBeaconChainProofsStateRootProof)},
+		{"EigenPod", "IEigenPodValidatorInfo", roundTrip(validatorInfo, eigenpod.ToIEigenPodValidatorInfo)},
+		{"EigenPod", "BeaconChainProofsWithdrawalProof", roundTrip(withdrawalProof, eigenpod.ToBeaconChainProofsWithdrawalProof)},
+		{"IAVSDirectory", "ISignatureUtilsSignatureWithSaltAndExpiry", roundTrip(signatureWithSaltAndExpiry, iavsdirectory.ToISignatureUtilsSignatureWithSaltAndExpiry)},
+		{"IDelayedWithdrawalRouter", "IDelayedWithdrawalRouterDelayedWithdrawal", roundTrip(delayedWithdrawal, idelayedwithdrawalrouter.ToIDelayedWithdrawalRouterDelayedWithdrawal)},
+		{"IDelayedWithdrawalRouter", "IDelayedWithdrawalRouterUserDelayedWithdrawals", roundTrip(userDelayedWithdrawals, idelayedwithdrawalrouter.ToIDelayedWithdrawalRouterUserDelayedWithdrawals)},
+		{"IDelegationFaucet", "IDelegationManagerQueuedWithdrawalParams", roundTrip(queuedWithdrawalParams, idelegationfaucet.ToIDelegationManagerQueuedWithdrawalParams)},
+		{"IDelegationFaucet", "ISignatureUtilsSignatureWithExpiry", roundTrip(signatureWithExpiry, idelegationfaucet.ToISignatureUtilsSignatureWithExpiry)},
+		{"IDelegationFaucet", "IDelegationManagerWithdrawal", roundTrip(withdrawal, idelegationfaucet.ToIDelegationManagerWithdrawal)},
+		{"IDelegationManager", "IDelegationManagerOperatorDetails", roundTrip(operatorDetails, idelegationmanager.ToIDelegationManagerOperatorDetails)},
+		{"IDelegationManager", "IDelegationManagerQueuedWithdrawalParams", roundTrip(queuedWithdrawalParams, idelegationmanager.ToIDelegationManagerQueuedWithdrawalParams)},
+		{"IDelegationManager", "ISignatureUtilsSignatureWithExpiry", roundTrip(signatureWithExpiry, idelegationmanager.ToISignatureUtilsSignatureWithExpiry)},
+		{"IDelegationManager", "IDelegationManagerWithdrawal", roundTrip(withdrawal, idelegationmanager.ToIDelegationManagerWithdrawal)},
+		{"IEigenPod", "BeaconChainProofsStateRootProof", roundTrip(stateRootProof, ieigenpod.ToBeaconChainProofsStateRootProof)},
+		{"IEigenPod", "IEigenPodValidatorInfo", roundTrip(validatorInfo, ieigenpod.ToIEigenPodValidatorInfo)},
+		{"IEigenPod", "BeaconChainProofsWithdrawalProof", roundTrip(withdrawalProof, ieigenpod.ToBeaconChainProofsWithdrawalProof)},
+		{"IRewardsCoordinator", "IRewardsCoordinatorDistributionRoot", roundTrip(distributionRoot, irewardscoordinator.ToIRewardsCoordinatorDistributionRoot)},
+		{"IRewardsCoordinator", "IRewardsCoordinatorEarnerTreeMerkleLeaf", roundTrip(earnerTreeMerkleLeaf, irewardscoordinator.ToIRewardsCoordinatorEarnerTreeMerkleLeaf)},
+		{"IRewardsCoordinator", "IRewardsCoordinatorRewardsMerkleClaim", roundTrip(rewardsMerkleClaim, irewardscoordinator.ToIRewardsCoordinatorRewardsMerkleClaim)},
+		{"IRewardsCoordinator", "IRewardsCoordinatorRewardsSubmission", roundTrip(rewardsSubmission, irewardscoordinator.ToIRewardsCoordinatorRewardsSubmission)},
+		{"IRewardsCoordinator", "IRewardsCoordinatorStrategyAndMultiplier", roundTrip(strategyAndMultiplier, irewardscoordinator.ToIRewardsCoordinatorStrategyAndMultiplier)},
+		{"IRewardsCoordinator", "IRewardsCoordinatorTokenTreeMerkleLeaf", roundTrip(tokenTreeMerkleLeaf, irewardscoordinator.ToIRewardsCoordinatorTokenTreeMerkleLeaf)},
+		{"ISlasher", "ISlasherMiddlewareTimes", roundTrip(middlewareTimes, islasher.ToISlasherMiddlewareTimes)},
+		{"IWhitelister", "IDelegationManagerQueuedWithdrawalParams", roundTrip(queuedWithdrawalParams, iwhitelister.ToIDelegationManagerQueuedWithdrawalParams)},
+		{"IWhitelister", "IDelegationManagerWithdrawal", roundTrip(withdrawal, iwhitelister.ToIDelegationManagerWithdrawal)},
+		{"RewardsCoordinator", "IRewardsCoordinatorDistributionRoot", roundTrip(distributionRoot, rewardscoordinator.ToIRewardsCoordinatorDistributionRoot)},
+		{"RewardsCoordinator", "IRewardsCoordinatorEarnerTreeMerkleLeaf", roundTrip(earnerTreeMerkleLeaf, rewardscoordinator.ToIRewardsCoordinatorEarnerTreeMerkleLeaf)},
+		{"RewardsCoordinator", "IRewardsCoordinatorRewardsMerkleClaim", roundTrip(rewardsMerkleClaim, rewardscoordinator.ToIRewardsCoordinatorRewardsMerkleClaim)},
+		{"RewardsCoordinator", "IRewardsCoordinatorRewardsSubmission", roundTrip(rewardsSubmission, rewardscoordinator.ToIRewardsCoordinatorRewardsSubmission)},
+		{"RewardsCoordinator", "IRewardsCoordinatorStrategyAndMultiplier", roundTrip(strategyAndMultiplier, rewardscoordinator.ToIRewardsCoordinatorStrategyAndMultiplier)},
+		{"RewardsCoordinator", "IRewardsCoordinatorTokenTreeMerkleLeaf", roundTrip(tokenTreeMerkleLeaf, rewardscoordinator.ToIRewardsCoordinatorTokenTreeMerkleLeaf)},
+		{"RewardsCoordinatorStorage", "IRewardsCoordinatorDistributionRoot", roundTrip(distributionRoot, rewardscoordinatorstorage.ToIRewardsCoordinatorDistributionRoot)},
+		{"RewardsCoordinatorStorage", "IRewardsCoordinatorEarnerTreeMerkleLeaf", roundTrip(earnerTreeMerkleLeaf, rewardscoordinatorstorage.ToIRewardsCoordinatorEarnerTreeMerkleLeaf)},
+		{"RewardsCoordinatorStorage", "IRewardsCoordinatorRewardsMerkleClaim", roundTrip(rewardsMerkleClaim, rewardscoordinatorstorage.ToIRewardsCoordinatorRewardsMerkleClaim)},
+		{"RewardsCoordinatorStorage", "IRewardsCoordinatorRewardsSubmission", roundTrip(rewardsSubmission, rewardscoordinatorstorage.ToIRewardsCoordinatorRewardsSubmission)},
+		{"RewardsCoordinatorStorage", "IRewardsCoordinatorStrategyAndMultiplier", roundTrip(strategyAndMultiplier, rewardscoordinatorstorage.ToIRewardsCoordinatorStrategyAndMultiplier)},
+		{"RewardsCoordinatorStorage", "IRewardsCoordinatorTokenTreeMerkleLeaf", roundTrip(tokenTreeMerkleLeaf, rewardscoordinatorstorage.ToIRewardsCoordinatorTokenTreeMerkleLeaf)},
+	}
+	tested := make(map[string]bool)
+	for _, tt := range tests {
+		tested[tt.pkg+"."+tt.bound] = true
+		t.Run(tt.pkg+"."+tt.bound, tt.test)
+	}
+
+	// Every converter bindgen generated is tested.
+	paths, err := filepath.Glob("../bindings/*/types.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, path := range paths {
+		file, err := parser.ParseFile(token.NewFileSet(), path, nil, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		pkg := filepath.Base(filepath.Dir(path))
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Recv != nil || !strings.HasPrefix(fn.Name.Name, "To") {
+				continue
+			}
+			if bound := strings.TrimPrefix(fn.Name.Name, "To"); !tested[pkg+"."+bound] {
+				t.Errorf("%s.%s is not tested", pkg, bound)
+			}
+		}
+	}
+}
@@ -0,0 +1,268 @@
+// Package version identifies the release of the contracts a deployment runs,
+// so that the matching binding set can be used to talk to it.
+//
+// Contracts are fingerprinted by the function selectors their runtime code
+// dispatches on. Code hashes would be more precise but differ between
+// deployments of the same release, since they embed immutables and compiler
+// metadata.
+//
+// Probe tries the DelegationManager, EigenPod or RewardsCoordinator of a
+// deployment against every set in bindings.Releases, and fails with
+// ErrAmbiguous when several releases match.
+package version
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/proxy"
+)
+
+var (
+	// ErrUnknownRelease is returned when no release matches every probed
+	// contract.
+	ErrUnknownRelease = errors.New("no release matches the deployment")
+	// ErrAmbiguous is returned by Result.Set when the probed contracts match
+	// several releases.
+	ErrAmbiguous = errors.New("several releases match the deployment")
+)
+
+// Client reads code and storage and calls contracts, as ethclient.Client and
+// the simulated backend's client do.
+type Client interface {
+	proxy.StorageReader
+	bind.ContractCaller
+}
+
+// beaconABI is the getter of OpenZeppelin's IBeacon.
+var beaconABI = `[{"type":"function","name":"implementation","inputs":[],"outputs":[{"name":"","type":"address"}],"stateMutability":"view"}]`
+
+// Deployment holds the addresses a deployment is probed through. Each may be
+// a proxy or the implementation behind it; zero addresses are skipped.
+type Deployment struct {
+	DelegationManager common.Address
+	// EigenPod is any pod, or the pod implementation.
+	EigenPod           common.Address
+	RewardsCoordinator common.Address
+}
+
+// Result lists the releases consistent with every probed contract.
+type Result struct {
+	// Candidates are the matching binding sets, oldest first.
+	Candidates []bindings.Set
+}
+
+// Set returns the binding set of the only candidate, or ErrAmbiguous if
+// there are several.
+//
+// Releases often share implementations, so a contract deployed unchanged by
+// several releases matches all of them. Probe a contract whose methods differ
+// between the candidates, such as one only newer releases deploy, to tell
+// them apart.
+func (res *Result) Set() (bindings.Set, error) {
+	if res.Ambiguous() {
+		names := make([]string, len(res.Candidates))
+		for i, s := range res.Candidates {
+			names[i] = s.Release
+		}
+		return bindings.Set{}, fmt.Errorf("%w: %s", ErrAmbiguous, strings.Join(names, ", "))
+	}
+	return res.Candidates[0], nil
+}
+
+// Ambiguous reports whether more than one release matched.
+func (res *Result) Ambiguous() bool {
+	return len(res.Candidates) > 1
+}
+
+// Probe fingerprints the contracts of d at the block selected by opts.
+func Probe(opts *bind.CallOpts, c Client, d Deployment) (*Result, error) {
+	candidates := bindings.Releases
+	probes := []struct {
+		name    string
+		address common.Address
+	}{
+		{"DelegationManager", d.DelegationManager},
+		{"EigenPod", d.EigenPod},
+		{"RewardsCoordinator", d.RewardsCoordinator},
+	}
+	probed := false
+	for _, p := range probes {
+		if p.address == (common.Address{}) {
+			continue
+		}
+		probed = true
+		matched, err := Match(opts, c, p.name, p.address)
+		if err != nil {
+			return nil, err
+		}
+		candidates = intersect(candidates, matched)
+		if len(candidates) == 0 {
+			return nil, fmt.Errorf("%w: %s at %s narrows it to none", ErrUnknownRelease, p.name, p.address)
+		}
+	}
+	if !probed {
+		return nil, errors.New("no contract to probe")
+	}
+	return &Result{Candidates: candidates}, nil
+}
+
+// Match returns the releases whose binding for the named contract matches
+// the code at address, oldest first. A release matches if the code
+// dispatches on every method of its binding and no release has a larger
+// method set the code also dispatches on, so that code of a newer release is
+// not mistaken for an older one whose methods it retains.
+func Match(opts *bind.CallOpts, c Client, name string, address common.Address) ([]bindings.Set, error) {
+	code, err := Code(opts, c, address)
+	if err != nil {
+		return nil, err
+	}
+	selectors := Selectors(code)
+
+	type match struct {
+		set     bindings.Set
+		methods int
+	}
+	var (
+		matches []match
+		best    int
+	)
+	for _, set := range bindings.Releases {
+		md, ok := set.MetaData[name]
+		if !ok {
+			continue
+		}
+		parsed, err := md.GetAbi()
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s abi of %s: %w", set.Release, name, err)
+		}
+		ok = true
+		for _, m := range parsed.Methods {
+			if !selectors[[4]byte(m.ID)] {
+				ok = false
+				break
+			}
+		}
+		if !ok {
+			continue
+		}
+		matches = append(matches, match{set, len(parsed.Methods)})
+		best = max(best, len(parsed.Methods))
+	}
+	var out []bindings.Set
+	for _, m := range matches {
+		if m.methods == best {
+			out = append(out, m.set)
+		}
+	}
+	if len(out) == 0 {
+		return nil, fmt.Errorf("%w: code at %s is not a known %s", ErrUnknownRelease, address, name)
+	}
+	return out, nil
+}
+
+// Code returns the runtime code behind address, following an EIP-1967
+// proxy to its implementation, or a beacon proxy to its beacon's
+// implementation.
+func Code(opts *bind.CallOpts, c Client, address common.Address) ([]byte, error) {
+	ctx, block := context.Background(), (*big.Int)(nil)
+	if opts != nil {
+		if opts.Context != nil {
+			ctx = opts.Context
+		}
+		block = opts.BlockNumber
+	}
+	target := address
+	impl, err := proxy.Implementation(ctx, c, address, block)
+	if err != nil {
+		return nil, err
+	}
+	if impl != (common.Address{}) {
+		target = impl
+	} else {
+		beacon, err := proxy.Beacon(ctx, c, address, block)
+		if err != nil {
+			return nil, err
+		}
+		if beacon != (common.Address{}) {
+			if target, err = beaconImplementation(opts, c, beacon); err != nil {
+				return nil, fmt.Errorf("failed to read implementation of beacon %s: %w", beacon, err)
+			}
+		}
+	}
+	code, err := c.CodeAt(ctx, target, block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read code of %s: %w", target, err)
+	}
+	if len(code) == 0 {
+		if target != address {
+			return nil, fmt.Errorf("no code at %s, the implementation behind %s", target, address)
+		}
+		return nil, fmt.Errorf("no code at %s", address)
+	}
+	return code, nil
+}
+
+// beaconImplementation calls implementation() on beacon.
+func beaconImplementation(opts *bind.CallOpts, c bind.ContractCaller, beacon common.Address) (common.Address, error) {
+	parsed, err := abi.JSON(strings.NewReader(beaconABI))
+	if err != nil {
+		return common.Address{}, err
+	}
+	var out []any
+	if err := bind.NewBoundContract(beacon, parsed, c, nil, nil).Call(opts, &out, "implementation"); err != nil {
+		return common.Address{}, err
+	}
+	return *abi.ConvertType(out[0], new(common.Address)).(*common.Address), nil
+}
+
+// Selectors returns the values pushed by PUSH2 to PUSH4 instructions in
+// runtime code, left padded to four bytes. solc's dispatcher pushes each
+// selector with the shortest push that fits, so this is a superset of the
+// selectors the code dispatches on.
+func Selectors(code []byte) map[[4]byte]bool {
+	const (
+		push1  = 0x60
+		push2  = 0x61
+		push4  = 0x63
+		push32 = 0x7f
+	)
+	out := make(map[[4]byte]bool)
+	for pc := 0; pc < len(code); pc++ {
+		op := code[pc]
+		if op < push1 || op > push32 {
+			continue
+		}
+		n := int(op-push1) + 1
+		if op >= push2 && op <= push4 && pc+n < len(code) {
+			var sel [4]byte
+			copy(sel[4-n:], code[pc+1:pc+1+n])
+			out[sel] = true
+		}
+		pc += n
+	}
+	return out
+}
+
+// intersect returns the sets of a whose release is also in b, in the order
+// of a.
+func intersect(a, b []bindings.Set) []bindings.Set {
+	var out []bindings.Set
+	for _, s := range a {
+		for _, t := range b {
+			if s.Release == t.Release {
+				out = append(out, s)
+				break
+			}
+		}
+	}
+	return out
+}
@@ -0,0 +1,202 @@
+package version_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/proxy"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/version"
+)
+
+// chain serves code and storage, and answers implementation() on the
+// beacons in beacons.
+type chain struct {
+	code    map[common.Address][]byte
+	storage map[common.Address]map[common.Hash]common.Hash
+	beacons map[common.Address]common.Address
+}
+
+func (c *chain) CodeAt(_ context.Context, contract common.Address, _ *big.Int) ([]byte, error) {
+	return c.code[contract], nil
+}
+
+func (c *chain) StorageAt(_ context.Context, account common.Address, key common.Hash, _ *big.Int) ([]byte, error) {
+	v := c.storage[account][key]
+	return v.Bytes(), nil
+}
+
+func (c *chain) CallContract(_ context.Context, call ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+	impl, ok := c.beacons[*call.To]
+	if !ok {
+		return nil, errors.New("execution reverted")
+	}
+	return common.LeftPadBytes(impl.Bytes(), 32), nil
+}
+
+// proxied deploys code behind a transparent proxy at address.
+func (c *chain) proxied(address common.Address, code []byte) {
+	impl := common.BigToAddress(new(big.Int).Add(address.Big(), common.Big1))
+	c.code[impl] = code
+	c.code[address] = []byte{0xfe}
+	c.storage[address] = map[common.Hash]common.Hash{proxy.ImplementationSlot: common.BytesToHash(impl.Bytes())}
+}
+
+// beaconProxied deploys code behind a beacon proxy at address.
+func (c *chain) beaconProxied(address common.Address, code []byte) {
+	impl := common.BigToAddress(new(big.Int).Add(address.Big(), common.Big1))
+	beacon := common.BigToAddress(new(big.Int).Add(address.Big(), common.Big2))
+	c.code[impl] = code
+	c.code[address] = []byte{0xfe}
+	c.storage[address] = map[common.Hash]common.Hash{proxy.BeaconSlot: common.BytesToHash(beacon.Bytes())}
+	c.beacons[beacon] = impl
+}
+
+// bin returns the code of the named contract in the latest binding set,
+// which holds its runtime code and so every selector it dispatches on.
+func bin(t *testing.T, name string) []byte {
+	t.Helper()
+	return hexutil.MustDecode(bindings.MetaData[name].Bin)
+}
+
+// dispatcher returns code pushing the selector of every method in the ABI
+// of md, as solc's dispatcher does.
+func dispatcher(t *testing.T, md *bind.MetaData) []byte {
+	t.Helper()
+	parsed, err := md.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var code []byte
+	for _, m := range parsed.Methods {
+		code = append(append(code, 0x63), m.ID...)
+	}
+	return code
+}
+
+// withOlderRelease prepends a release to bindings.Releases for the test.
+// Like M2, it deploys no RewardsCoordinator, and its DelegationManager
+// lacks the latest release's last method. Its EigenPod is unchanged.
+func withOlderRelease(t *testing.T) bindings.Set {
+	t.Helper()
+	var methods []map[string]any
+	if err := json.Unmarshal([]byte(bindings.MetaData["DelegationManager"].ABI), &methods); err != nil {
+		t.Fatal(err)
+	}
+	for i := len(methods) - 1; i >= 0; i-- {
+		if methods[i]["type"] == "function" {
+			methods = append(methods[:i], methods[i+1:]...)
+			break
+		}
+	}
+	older, err := json.Marshal(methods)
+	if err != nil {
+		t.Fatal(err)
+	}
+	set := bindings.Set{
+		Release: "v0_2",
+		MetaData: map[string]*bind.MetaData{
+			"DelegationManager": {ABI: string(older)},
+			"EigenPod":          bindings.MetaData["EigenPod"],
+		},
+	}
+	saved := bindings.Releases
+	bindings.Releases = append([]bindings.Set{set}, saved...)
+	t.Cleanup(func() { bindings.Releases = saved })
+	return set
+}
+
+func releases(sets []bindings.Set) []string {
+	var out []string
+	for _, s := range sets {
+		out = append(out, s.Release)
+	}
+	return out
+}
+
+func TestProbe(t *testing.T) {
+	older := withOlderRelease(t)
+	var (
+		delegation    = common.HexToAddress("0x1000")
+		oldDelegation = common.HexToAddress("0x2000")
+		pod           = common.HexToAddress("0x3000")
+		rewards       = common.HexToAddress("0x4000")
+		empty         = common.HexToAddress("0x5000")
+	)
+	c := &chain{code: map[common.Address][]byte{}, storage: map[common.Address]map[common.Hash]common.Hash{}, beacons: map[common.Address]common.Address{}}
+	c.proxied(delegation, bin(t, "DelegationManager"))
+	c.proxied(oldDelegation, dispatcher(t, older.MetaData["DelegationManager"]))
+	c.beaconProxied(pod, bin(t, "EigenPod"))
+	c.proxied(rewards, bin(t, "RewardsCoordinator"))
+	c.storage[empty] = map[common.Hash]common.Hash{proxy.ImplementationSlot: common.HexToHash("0x6000")}
+
+	latest := bindings.Latest.Release
+	tests := []struct {
+		name string
+		d    version.Deployment
+		want []string
+		err  error
+	}{
+		// The latest DelegationManager retains every method of the older
+		// one, and matches only the latest release.
+		{name: "latest DelegationManager", d: version.Deployment{DelegationManager: delegation}, want: []string{latest}},
+		{name: "older DelegationManager", d: version.Deployment{DelegationManager: oldDelegation}, want: []string{"v0_2"}},
+		{name: "EigenPod shared by both releases", d: version.Deployment{EigenPod: pod}, want: []string{"v0_2", latest}},
+		{name: "EigenPod and RewardsCoordinator", d: version.Deployment{EigenPod: pod, RewardsCoordinator: rewards}, want: []string{latest}},
+		{name: "all three", d: version.Deployment{DelegationManager: delegation, EigenPod: pod, RewardsCoordinator: rewards}, want: []string{latest}},
+		{name: "older DelegationManager with a RewardsCoordinator", d: version.Deployment{DelegationManager: oldDelegation, RewardsCoordinator: rewards}, err: version.ErrUnknownRelease},
+		{name: "EigenPod code at the DelegationManager", d: version.Deployment{DelegationManager: pod}, err: version.ErrUnknownRelease},
+		{name: "implementation without code", d: version.Deployment{DelegationManager: empty}},
+		{name: "nothing to probe", d: version.Deployment{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := version.Probe(nil, c, tt.d)
+			switch {
+			case tt.want == nil && err == nil:
+				t.Fatalf("probed releases %v, want an error", releases(res.Candidates))
+			case tt.want == nil:
+				if tt.err != nil && !errors.Is(err, tt.err) {
+					t.Fatalf("err = %v, want %v", err, tt.err)
+				}
+				return
+			case err != nil:
+				t.Fatal(err)
+			}
+			if got := releases(res.Candidates); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("probed releases %v, want %v", got, tt.want)
+			}
+			set, err := res.Set()
+			if len(tt.want) > 1 {
+				if !errors.Is(err, version.ErrAmbiguous) {
+					t.Errorf("Set err = %v, want %v", err, version.ErrAmbiguous)
+				}
+				return
+			}
+			if err != nil || set.Release != tt.want[0] {
+				t.Errorf("Set = %s, %v; want %s", set.Release, err, tt.want[0])
+			}
+		})
+	}
+}
+
+func TestSelectors(t *testing.T) {
+	// PUSH1 0x80, PUSH4 0x12345678, PUSH2 0xabcd, PUSH32 holding a PUSH4,
+	// then a PUSH3 cut short by the end of the code.
+	code := []byte{0x60, 0x80, 0x63, 0x12, 0x34, 0x56, 0x78, 0x61, 0xab, 0xcd, 0x7f}
+	code = append(code, append([]byte{0x63, 0xde, 0xad, 0xbe, 0xef}, make([]byte, 27)...)...)
+	code = append(code, 0x62, 0x01)
+	want := map[[4]byte]bool{{0x12, 0x34, 0x56, 0x78}: true, {0, 0, 0xab, 0xcd}: true}
+	if got := version.Selectors(code); !reflect.DeepEqual(got, want) {
+		t.Errorf("Selectors = %v, want %v", got, want)
+	}
+}
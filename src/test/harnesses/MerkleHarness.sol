@@ -0,0 +1,45 @@
+// SPDX-License-Identifier: BUSL-1.1
+pragma solidity ^0.8.12;
+
+import "../../contracts/libraries/Merkle.sol";
+
+// wrapper around the Merkle library that exposes its internal functions, to check pkg/merkle against.
+contract MerkleHarness {
+    function verifyInclusionKeccak(
+        bytes memory proof,
+        bytes32 root,
+        bytes32 leaf,
+        uint256 index
+    ) external pure returns (bool) {
+        return Merkle.verifyInclusionKeccak(proof, root, leaf, index);
+    }
+
+    function processInclusionProofKeccak(
+        bytes memory proof,
+        bytes32 leaf,
+        uint256 index
+    ) external pure returns (bytes32) {
+        return Merkle.processInclusionProofKeccak(proof, leaf, index);
+    }
+
+    function verifyInclusionSha256(
+        bytes memory proof,
+        bytes32 root,
+        bytes32 leaf,
+        uint256 index
+    ) external view returns (bool) {
+        return Merkle.verifyInclusionSha256(proof, root, leaf, index);
+    }
+
+    function processInclusionProofSha256(
+        bytes memory proof,
+        bytes32 leaf,
+        uint256 index
+    ) external view returns (bytes32) {
+        return Merkle.processInclusionProofSha256(proof, leaf, index);
+    }
+
+    function merkleizeSha256(bytes32[] memory leaves) external pure returns (bytes32) {
+        return Merkle.merkleizeSha256(leaves);
+    }
+}